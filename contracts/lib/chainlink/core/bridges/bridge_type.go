@@ -4,12 +4,16 @@ import (
 	"crypto/subtle"
 	"database/sql/driver"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
+	"net/url"
 	"regexp"
 	"strings"
 	"time"
 
+	"github.com/lib/pq"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
@@ -21,6 +25,8 @@ type BridgeTypeRequest struct {
 	URL                    models.WebURL `json:"url"`
 	Confirmations          uint32        `json:"confirmations"`
 	MinimumContractPayment *assets.Link  `json:"minimumContractPayment"`
+	CachePolicy
+	FailoverPolicy
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -60,8 +66,74 @@ type BridgeType struct {
 	Salt                   string
 	OutgoingToken          string
 	MinimumContractPayment *assets.Link
-	CreatedAt              time.Time
-	UpdatedAt              time.Time
+	CachePolicy
+	FailoverPolicy
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// CachePolicy configures how the responses of a bridge are shared between bridge tasks across all jobs. Responses
+// younger than CacheFreshTTL are served from the cache, responses within a further CacheStaleTTL are served from the
+// cache while they are refreshed in the background, and with CoalesceRequests concurrent identical requests share a
+// single in-flight call to the external adapter.
+type CachePolicy struct {
+	CacheFreshTTL    models.Interval `json:"cacheFreshTTL"`
+	CacheStaleTTL    models.Interval `json:"cacheStaleTTL"`
+	CoalesceRequests bool            `json:"coalesceRequests"`
+}
+
+// Enabled returns true if responses of the bridge are cached or coalesced.
+func (p CachePolicy) Enabled() bool {
+	return p.CacheFreshTTL > 0 || p.CacheStaleTTL > 0 || p.CoalesceRequests
+}
+
+// FailoverPolicy configures how a bridge copes with an unavailable external adapter. Requests go to URL and then to
+// BackupURLs in order, skipping every URL whose circuit breaker is open. A circuit breaker opens after
+// CircuitBreakerThreshold consecutive failures, and once CircuitBreakerCooldown has passed lets a single probe through,
+// which closes it again if it succeeds. If HealthCheckPath is set, the URLs are also probed in the background.
+type FailoverPolicy struct {
+	BackupURLs              pq.StringArray  `json:"backupURLs" db:"backup_urls"`
+	HealthCheckPath         string          `json:"healthCheckPath"`
+	CircuitBreakerThreshold uint32          `json:"circuitBreakerThreshold"`
+	CircuitBreakerCooldown  models.Interval `json:"circuitBreakerCooldown"`
+}
+
+// ValidateFailoverPolicy checks that the backup URLs are absolute URLs and that the health check path is a path.
+func ValidateFailoverPolicy(p FailoverPolicy) error {
+	for _, s := range p.BackupURLs {
+		u, err := url.Parse(s)
+		if err != nil || !u.IsAbs() || u.Host == "" {
+			return fmt.Errorf("backup URL %q must be an absolute URL", s)
+		}
+	}
+	if p.HealthCheckPath != "" && !strings.HasPrefix(p.HealthCheckPath, "/") {
+		return fmt.Errorf("health check path %q must start with /", p.HealthCheckPath)
+	}
+	if p.CircuitBreakerCooldown < 0 {
+		return errors.New("circuit breaker cooldown must not be negative")
+	}
+	return nil
+}
+
+// Redacted returns a copy of the bridge without its tokens, which can be recorded in the audit log.
+func (bt BridgeType) Redacted() BridgeType {
+	bt.IncomingTokenHash = ""
+	bt.Salt = ""
+	bt.OutgoingToken = ""
+	return bt
+}
+
+// URLs returns the primary URL of the bridge followed by its backup URLs.
+func (bt BridgeType) URLs() ([]*url.URL, error) {
+	urls := []*url.URL{(*url.URL)(&bt.URL)}
+	for _, s := range bt.BackupURLs {
+		u, err := url.Parse(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid backup URL %q: %w", s, err)
+		}
+		urls = append(urls, u)
+	}
+	return urls, nil
 }
 
 // NewBridgeType returns a bridge type authentication (with plaintext
@@ -92,6 +164,8 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 			Salt:                   salt,
 			OutgoingToken:          outgoingToken,
 			MinimumContractPayment: btr.MinimumContractPayment,
+			CachePolicy:            btr.CachePolicy,
+			FailoverPolicy:         btr.FailoverPolicy,
 		}, nil
 }
 
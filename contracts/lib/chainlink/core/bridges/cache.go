@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"net/http"
 	"sync"
 	"time"
 
@@ -18,6 +19,8 @@ import (
 const (
 	CacheServiceName      = "BridgeCache"
 	DefaultUpsertInterval = 5 * time.Second
+
+	healthCheckPageSize = 100
 )
 
 type Cache struct {
@@ -29,10 +32,13 @@ type Cache struct {
 	services.Service
 	eng *services.Engine
 
-	// data state
-	bridgeTypesCache     sync.Map
+	// data state, shared with the caches returned by WithDataSource
+	bridgeTypesCache     *sync.Map
 	bridgeLastValueCache map[string]BridgeResponse
-	mu                   sync.RWMutex
+	mu                   *sync.RWMutex
+	responses            *ResponseCache
+	health               *HealthTracker
+	healthClient         *http.Client
 }
 
 var _ ORM = (*Cache)(nil)
@@ -42,7 +48,12 @@ func NewCache(base ORM, lggr logger.Logger, upsertInterval time.Duration) *Cache
 	c := &Cache{
 		ORM:                  base,
 		interval:             upsertInterval,
+		bridgeTypesCache:     new(sync.Map),
 		bridgeLastValueCache: make(map[string]BridgeResponse),
+		mu:                   new(sync.RWMutex),
+		responses:            NewResponseCache(),
+		health:               NewHealthTracker(),
+		healthClient:         &http.Client{Timeout: DefaultHealthCheckTimeout},
 	}
 	c.Service, c.eng = services.Config{
 		Name:  CacheServiceName,
@@ -51,8 +62,21 @@ func NewCache(base ORM, lggr logger.Logger, upsertInterval time.Duration) *Cache
 	return c
 }
 
+// WithDataSource returns a Cache which reads and writes through ds, e.g. within a transaction, but shares the cached
+// bridges, responses and circuit breakers of c. It is not started, as c already flushes the shared responses.
 func (c *Cache) WithDataSource(ds sqlutil.DataSource) ORM {
-	return NewCache(NewORM(ds), c.eng, c.interval)
+	return &Cache{
+		ORM:                  c.ORM.WithDataSource(ds),
+		interval:             c.interval,
+		Service:              c.Service,
+		eng:                  c.eng,
+		bridgeTypesCache:     c.bridgeTypesCache,
+		bridgeLastValueCache: c.bridgeLastValueCache,
+		mu:                   c.mu,
+		responses:            c.responses,
+		health:               c.health,
+		healthClient:         c.healthClient,
+	}
 }
 
 func (c *Cache) FindBridge(ctx context.Context, name BridgeName) (BridgeType, error) {
@@ -119,6 +143,8 @@ func (c *Cache) DeleteBridgeType(ctx context.Context, bt *BridgeType) error {
 
 	// We delete regardless of the rows affected, in case it gets out of sync
 	c.bridgeTypesCache.Delete(bt.Name)
+	c.responses.Purge(bt.Name)
+	c.health.Purge(bt.Name)
 
 	return err
 }
@@ -144,10 +170,22 @@ func (c *Cache) UpdateBridgeType(ctx context.Context, bt *BridgeType, btr *Bridg
 	}
 
 	c.bridgeTypesCache.Store(bt.Name, *bt)
+	c.responses.Purge(bt.Name)
+	c.health.Purge(bt.Name)
 
 	return nil
 }
 
+// Responses returns the cache which applies the CachePolicy of bridges to responses of all jobs.
+func (c *Cache) Responses() *ResponseCache {
+	return c.responses
+}
+
+// Health returns the tracker of the circuit breakers of all bridge URLs.
+func (c *Cache) Health() *HealthTracker {
+	return c.health
+}
+
 func (c *Cache) GetCachedResponse(ctx context.Context, dotId string, specId int32, maxElapsed time.Duration) ([]byte, error) {
 	// prefer to get latest value from cache
 	cached, inCache := c.latestValue(dotId, specId)
@@ -196,6 +234,18 @@ func (c *Cache) start(_ context.Context) error {
 	}.NewTicker(c.interval)
 	c.eng.GoTick(ticker, c.doBulkUpsert)
 
+	purgeTicker := services.TickerConfig{
+		Initial:   c.interval,
+		JitterPct: services.DefaultJitter,
+	}.NewTicker(c.interval)
+	c.eng.GoTick(purgeTicker, func(context.Context) { c.responses.PurgeExpired() })
+
+	healthTicker := services.TickerConfig{
+		Initial:   DefaultHealthCheckInterval,
+		JitterPct: services.DefaultJitter,
+	}.NewTicker(DefaultHealthCheckInterval)
+	c.eng.GoTick(healthTicker, c.checkHealth)
+
 	return nil
 }
 
@@ -213,6 +263,26 @@ func (c *Cache) doBulkUpsert(ctx context.Context) {
 	}
 }
 
+// checkHealth probes every bridge which has a health check path configured, including bridges no job has used yet.
+func (c *Cache) checkHealth(ctx context.Context) {
+	for offset := 0; ; offset += healthCheckPageSize {
+		bts, _, err := c.ORM.BridgeTypes(ctx, offset, healthCheckPageSize)
+		if err != nil {
+			c.eng.Warnf("failed to load bridges to check: %s", err.Error())
+			return
+		}
+		for _, bt := range bts {
+			c.health.Check(ctx, c.healthClient, bt)
+			if ctx.Err() != nil {
+				return
+			}
+		}
+		if len(bts) < healthCheckPageSize {
+			return
+		}
+	}
+}
+
 func (c *Cache) latestValue(dotId string, specId int32) (BridgeResponse, bool) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
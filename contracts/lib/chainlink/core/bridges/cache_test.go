@@ -3,6 +3,8 @@ package bridges_test
 import (
 	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -12,6 +14,8 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 )
 
@@ -126,6 +130,38 @@ func TestBridgeCache_Type(t *testing.T) {
 		_, err = cache.FindBridge(ctx, bridge)
 		require.NotNil(t, err)
 	})
+
+	t.Run("shares bridges with the cache of a data source", func(t *testing.T) {
+		t.Parallel()
+
+		mORM := new(mocks.ORM)
+		txORM := new(mocks.ORM)
+		lggr, _ := logger.NewLogger()
+		cache := bridges.NewCache(mORM, lggr, bridges.DefaultUpsertInterval)
+
+		ctx := context.Background()
+		mORM.On("WithDataSource", mock.Anything).Return(txORM)
+		txCache := cache.WithDataSource(nil)
+
+		// a bridge created through the data source is cached for both, without reading it from mORM
+		expected := &bridges.BridgeType{Name: "test"}
+		txORM.On("CreateBridgeType", mock.Anything, expected).Return(nil)
+		require.NoError(t, txCache.CreateBridgeType(ctx, expected))
+
+		result, err := cache.FindBridge(ctx, expected.Name)
+		require.NoError(t, err)
+		assert.Equal(t, *expected, result)
+
+		// and deleting it through the data source evicts it from both
+		txORM.On("DeleteBridgeType", mock.Anything, expected).Return(nil)
+		require.NoError(t, txCache.DeleteBridgeType(ctx, expected))
+
+		mORM.On("FindBridge", mock.Anything, expected.Name).Return(bridges.BridgeType{}, errors.New("not found"))
+		_, err = cache.FindBridge(ctx, expected.Name)
+		require.Error(t, err)
+		mORM.AssertExpectations(t)
+		txORM.AssertExpectations(t)
+	})
 }
 
 func TestBridgeCache_Response(t *testing.T) {
@@ -200,3 +236,30 @@ func TestBridgeCache_Response(t *testing.T) {
 		}
 	})
 }
+
+func TestBridgeCache_CheckHealth(t *testing.T) {
+	t.Parallel()
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer s.Close()
+
+	mORM := mocks.NewORM(t)
+	lggr, _ := logger.NewLogger()
+	cache := bridges.NewCache(mORM, lggr, bridges.DefaultUpsertInterval)
+
+	// the bridge was never used by a job, so it is not in the cache
+	bt := bridges.BridgeType{
+		Name: "unused",
+		URL:  cltest.WebURL(t, s.URL),
+		FailoverPolicy: bridges.FailoverPolicy{
+			HealthCheckPath:         "/health",
+			CircuitBreakerThreshold: 1,
+		},
+	}
+	mORM.On("BridgeTypes", mock.Anything, 0, mock.Anything).Return([]bridges.BridgeType{bt}, 1, nil).Once()
+
+	cache.CheckHealth(testutils.Context(t))
+	assert.Equal(t, bridges.CircuitOpen, cache.Health().Health(bt)[0].State)
+}
@@ -0,0 +1,200 @@
+package bridges
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultCircuitBreakerCooldown is used by bridges with a circuit breaker but without a cooldown.
+	DefaultCircuitBreakerCooldown = 30 * time.Second
+	// DefaultHealthCheckInterval is how often the URLs of bridges with a health check path are probed.
+	DefaultHealthCheckInterval = 15 * time.Second
+	// DefaultHealthCheckTimeout bounds every health check probe.
+	DefaultHealthCheckTimeout = 5 * time.Second
+)
+
+// ErrCircuitOpen is returned for a bridge when the circuit breakers of all its URLs are open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitState is the state of the circuit breaker of a bridge URL.
+type CircuitState string
+
+const (
+	// CircuitClosed lets every request through.
+	CircuitClosed CircuitState = "closed"
+	// CircuitOpen rejects every request until the cooldown has passed.
+	CircuitOpen CircuitState = "open"
+	// CircuitHalfOpen lets a single probe through, which decides whether the circuit closes or opens again.
+	CircuitHalfOpen CircuitState = "half-open"
+)
+
+// URLHealth is the health of one URL of a bridge.
+type URLHealth struct {
+	URL                 string       `json:"url"`
+	State               CircuitState `json:"state"`
+	ConsecutiveFailures uint32       `json:"consecutiveFailures"`
+	LastError           string       `json:"lastError,omitempty"`
+	LastSuccessAt       *time.Time   `json:"lastSuccessAt,omitempty"`
+	LastFailureAt       *time.Time   `json:"lastFailureAt,omitempty"`
+	OpenedAt            *time.Time   `json:"openedAt,omitempty"`
+
+	probing bool
+}
+
+// HealthTracker keeps a circuit breaker for every URL of every bridge, fed by bridge requests and health checks.
+type HealthTracker struct {
+	mu   sync.Mutex
+	urls map[string]*URLHealth
+	now  func() time.Time
+}
+
+func NewHealthTracker() *HealthTracker {
+	return &HealthTracker{
+		urls: make(map[string]*URLHealth),
+		now:  time.Now,
+	}
+}
+
+func healthKey(name BridgeName, u string) string {
+	return name.String() + "|" + u
+}
+
+func (h *HealthTracker) getLocked(name BridgeName, u string) *URLHealth {
+	key := healthKey(name, u)
+	health, ok := h.urls[key]
+	if !ok {
+		health = &URLHealth{URL: u, State: CircuitClosed}
+		h.urls[key] = health
+	}
+	return health
+}
+
+// Allow returns true if a request may be sent to u. Once the cooldown of an open circuit has passed, the circuit turns
+// half-open and a single request is allowed through as a probe.
+func (h *HealthTracker) Allow(bt BridgeType, u string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	health := h.getLocked(bt.Name, u)
+	switch health.State {
+	case CircuitOpen:
+		cooldown := bt.CircuitBreakerCooldown.Duration()
+		if cooldown == 0 {
+			cooldown = DefaultCircuitBreakerCooldown
+		}
+		if h.now().Sub(*health.OpenedAt) < cooldown {
+			return false
+		}
+		health.State = CircuitHalfOpen
+		health.probing = true
+		return true
+	case CircuitHalfOpen:
+		if health.probing {
+			return false
+		}
+		health.probing = true
+		return true
+	default:
+		return true
+	}
+}
+
+// Record updates the circuit breaker of u with the outcome of a request or health check, where a nil err means
+// success. A failure opens the circuit if it was half-open, or if the bridge has a circuit breaker and the failure
+// reaches its threshold of consecutive failures.
+func (h *HealthTracker) Record(bt BridgeType, u string, err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	health := h.getLocked(bt.Name, u)
+	now := h.now()
+	health.probing = false
+	if err == nil {
+		health.State = CircuitClosed
+		health.ConsecutiveFailures = 0
+		health.LastSuccessAt = &now
+		health.OpenedAt = nil
+		return
+	}
+
+	health.ConsecutiveFailures++
+	health.LastError = err.Error()
+	health.LastFailureAt = &now
+	threshold := bt.CircuitBreakerThreshold
+	if health.State == CircuitHalfOpen || (threshold > 0 && health.ConsecutiveFailures >= threshold && health.State == CircuitClosed) {
+		health.State = CircuitOpen
+		health.OpenedAt = &now
+	}
+}
+
+// Health returns the health of every URL of the bridge, primary URL first.
+func (h *HealthTracker) Health(bt BridgeType) []URLHealth {
+	urls, err := bt.URLs()
+	if err != nil {
+		return nil
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	health := make([]URLHealth, len(urls))
+	for i, u := range urls {
+		health[i] = *h.getLocked(bt.Name, u.String())
+	}
+	return health
+}
+
+// Purge forgets the health of all URLs of the bridge, e.g. after its URLs or policy changed.
+func (h *HealthTracker) Purge(name BridgeName) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	prefix := healthKey(name, "")
+	for key := range h.urls {
+		if strings.HasPrefix(key, prefix) {
+			delete(h.urls, key)
+		}
+	}
+}
+
+// Check probes every URL of the bridge with a GET request to its health check path, and records the outcome. URLs
+// whose circuit is open are only probed once their cooldown has passed.
+func (h *HealthTracker) Check(ctx context.Context, client *http.Client, bt BridgeType) {
+	if bt.HealthCheckPath == "" {
+		return
+	}
+	urls, err := bt.URLs()
+	if err != nil {
+		return
+	}
+	for _, u := range urls {
+		if !h.Allow(bt, u.String()) {
+			continue
+		}
+		h.Record(bt, u.String(), probe(ctx, client, u.JoinPath(bt.HealthCheckPath)))
+	}
+}
+
+func probe(ctx context.Context, client *http.Client, u *url.URL) error {
+	ctx, cancel := context.WithTimeout(ctx, DefaultHealthCheckTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("health check returned status code %d", resp.StatusCode)
+	}
+	return nil
+}
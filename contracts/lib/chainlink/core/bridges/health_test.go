@@ -0,0 +1,105 @@
+package bridges_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+func TestHealthTracker_CircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	const u = "http://adapter.example.com"
+	h := bridges.NewHealthTracker()
+	bt := bridges.BridgeType{
+		Name: "bridge",
+		URL:  cltest.WebURL(t, u),
+		FailoverPolicy: bridges.FailoverPolicy{
+			CircuitBreakerThreshold: 2,
+			CircuitBreakerCooldown:  models.Interval(50 * time.Millisecond),
+		},
+	}
+	boom := errors.New("boom")
+
+	require.True(t, h.Allow(bt, u))
+	h.Record(bt, u, boom)
+	require.True(t, h.Allow(bt, u), "one failure is below the threshold")
+	h.Record(bt, u, boom)
+
+	health := h.Health(bt)
+	require.Len(t, health, 1)
+	assert.Equal(t, bridges.CircuitOpen, health[0].State)
+	assert.Equal(t, uint32(2), health[0].ConsecutiveFailures)
+	assert.Equal(t, "boom", health[0].LastError)
+	require.False(t, h.Allow(bt, u))
+
+	// after the cooldown a single probe is let through, and a failed probe opens the circuit again
+	time.Sleep(60 * time.Millisecond)
+	require.True(t, h.Allow(bt, u))
+	assert.Equal(t, bridges.CircuitHalfOpen, h.Health(bt)[0].State)
+	require.False(t, h.Allow(bt, u), "only one probe at a time")
+	h.Record(bt, u, boom)
+	require.False(t, h.Allow(bt, u))
+
+	// a successful probe closes the circuit
+	time.Sleep(60 * time.Millisecond)
+	require.True(t, h.Allow(bt, u))
+	h.Record(bt, u, nil)
+	health = h.Health(bt)
+	assert.Equal(t, bridges.CircuitClosed, health[0].State)
+	assert.Zero(t, health[0].ConsecutiveFailures)
+	require.True(t, h.Allow(bt, u))
+
+	// without a threshold the circuit never opens
+	bt.CircuitBreakerThreshold = 0
+	h.Purge(bt.Name)
+	for i := 0; i < 10; i++ {
+		h.Record(bt, u, boom)
+	}
+	require.True(t, h.Allow(bt, u))
+}
+
+func TestHealthTracker_Check(t *testing.T) {
+	t.Parallel()
+
+	var healthy atomic.Bool
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/health" || !healthy.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer s.Close()
+
+	h := bridges.NewHealthTracker()
+	bt := bridges.BridgeType{
+		Name: "bridge",
+		URL:  cltest.WebURL(t, s.URL),
+		FailoverPolicy: bridges.FailoverPolicy{
+			HealthCheckPath:         "/health",
+			CircuitBreakerThreshold: 1,
+			CircuitBreakerCooldown:  models.Interval(time.Millisecond),
+		},
+	}
+
+	h.Check(testutils.Context(t), s.Client(), bt)
+	health := h.Health(bt)
+	require.Len(t, health, 1)
+	assert.Equal(t, bridges.CircuitOpen, health[0].State)
+	assert.Contains(t, health[0].LastError, "503")
+
+	healthy.Store(true)
+	time.Sleep(5 * time.Millisecond)
+	h.Check(testutils.Context(t), s.Client(), bt)
+	assert.Equal(t, bridges.CircuitClosed, h.Health(bt)[0].State)
+}
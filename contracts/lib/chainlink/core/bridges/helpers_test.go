@@ -0,0 +1,7 @@
+package bridges
+
+import "context"
+
+func (c *Cache) CheckHealth(ctx context.Context) {
+	c.checkHealth(ctx)
+}
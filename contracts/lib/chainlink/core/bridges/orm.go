@@ -124,8 +124,10 @@ func (o *orm) BridgeTypes(ctx context.Context, offset int, limit int) (bridges [
 
 // CreateBridgeType saves the bridge type.
 func (o *orm) CreateBridgeType(ctx context.Context, bt *BridgeType) error {
-	stmt := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, minimum_contract_payment, created_at, updated_at)
-	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :minimum_contract_payment, now(), now())
+	stmt := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, minimum_contract_payment, cache_fresh_ttl, cache_stale_ttl, coalesce_requests,
+		backup_urls, health_check_path, circuit_breaker_threshold, circuit_breaker_cooldown, created_at, updated_at)
+	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :minimum_contract_payment, :cache_fresh_ttl, :cache_stale_ttl, :coalesce_requests,
+		COALESCE(CAST(:backup_urls AS text[]), '{}'), :health_check_path, :circuit_breaker_threshold, :circuit_breaker_cooldown, now(), now())
 	RETURNING *;`
 	err := o.transact(ctx, false, func(tx *orm) error {
 		stmt, err := tx.ds.PrepareNamedContext(ctx, stmt)
@@ -141,8 +143,12 @@ func (o *orm) CreateBridgeType(ctx context.Context, bt *BridgeType) error {
 
 // UpdateBridgeType updates the bridge type.
 func (o *orm) UpdateBridgeType(ctx context.Context, bt *BridgeType, btr *BridgeTypeRequest) error {
-	stmt := "UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3 WHERE name = $4 RETURNING *"
-	err := o.ds.GetContext(ctx, bt, stmt, btr.URL, btr.Confirmations, btr.MinimumContractPayment, bt.Name)
+	stmt := `UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3, cache_fresh_ttl = $4, cache_stale_ttl = $5, coalesce_requests = $6,
+	backup_urls = COALESCE($7::text[], '{}'), health_check_path = $8, circuit_breaker_threshold = $9, circuit_breaker_cooldown = $10
+	WHERE name = $11 RETURNING *`
+	err := o.ds.GetContext(ctx, bt, stmt, btr.URL, btr.Confirmations, btr.MinimumContractPayment,
+		btr.CacheFreshTTL, btr.CacheStaleTTL, btr.CoalesceRequests,
+		btr.BackupURLs, btr.HealthCheckPath, btr.CircuitBreakerThreshold, btr.CircuitBreakerCooldown, bt.Name)
 
 	return err
 }
@@ -116,6 +116,17 @@ func TestORM_UpdateBridgeType(t *testing.T) {
 
 	updateBridge := &bridges.BridgeTypeRequest{
 		URL: cltest.WebURL(t, "http:/updatedurl.com"),
+		CachePolicy: bridges.CachePolicy{
+			CacheFreshTTL:    models.Interval(time.Minute),
+			CacheStaleTTL:    models.Interval(5 * time.Minute),
+			CoalesceRequests: true,
+		},
+		FailoverPolicy: bridges.FailoverPolicy{
+			BackupURLs:              []string{"http://backupurl.com"},
+			HealthCheckPath:         "/health",
+			CircuitBreakerThreshold: 5,
+			CircuitBreakerCooldown:  models.Interval(time.Minute),
+		},
 	}
 
 	require.NoError(t, orm.UpdateBridgeType(ctx, firstBridge, updateBridge))
@@ -123,6 +134,8 @@ func TestORM_UpdateBridgeType(t *testing.T) {
 	foundbridge, err := orm.FindBridge(ctx, "UniqueName")
 	require.NoError(t, err)
 	require.Equal(t, updateBridge.URL, foundbridge.URL)
+	require.Equal(t, updateBridge.CachePolicy, foundbridge.CachePolicy)
+	require.Equal(t, updateBridge.FailoverPolicy, foundbridge.FailoverPolicy)
 
 	bs, count, err := orm.BridgeTypes(ctx, 0, 10)
 	require.NoError(t, err)
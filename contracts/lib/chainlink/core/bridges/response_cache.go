@@ -0,0 +1,190 @@
+package bridges
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CacheStatus describes how a response was obtained by ResponseCache.Do.
+type CacheStatus string
+
+const (
+	// CacheMiss means the external adapter was called for this request.
+	CacheMiss CacheStatus = "miss"
+	// CacheHit means a fresh response was served from the cache.
+	CacheHit CacheStatus = "hit"
+	// CacheStale means a stale response was served from the cache while it is refreshed in the background.
+	CacheStale CacheStatus = "stale"
+	// CacheCoalesced means the response of an identical in-flight request was shared.
+	CacheCoalesced CacheStatus = "coalesced"
+)
+
+// requestKeyIgnoredFields are set per run rather than per request, and would prevent requests of different jobs from
+// sharing a response.
+var requestKeyIgnoredFields = []string{"meta", "responseURL"}
+
+// FetchFunc calls the external adapter of a bridge, and returns the response if it may be cached.
+type FetchFunc func(ctx context.Context) ([]byte, error)
+
+type cachedResponse struct {
+	value     []byte
+	fetchedAt time.Time
+	expiresAt time.Time
+}
+
+type inflightRequest struct {
+	done  chan struct{}
+	value []byte
+	err   error
+}
+
+// ResponseCache applies the CachePolicy of bridges to their responses. It is shared by all bridge tasks of a node, so
+// that identical requests made by different jobs are served by the same cached or in-flight response.
+type ResponseCache struct {
+	mu        sync.Mutex
+	responses map[string]cachedResponse
+	inflight  map[string]*inflightRequest
+	now       func() time.Time
+}
+
+func NewResponseCache() *ResponseCache {
+	return &ResponseCache{
+		responses: make(map[string]cachedResponse),
+		inflight:  make(map[string]*inflightRequest),
+		now:       time.Now,
+	}
+}
+
+// RequestKey returns the key under which the response to a request with headers and requestData is cached for the
+// bridge. Keys are derived from the canonical JSON encoding of the request, so the order of fields and the formatting
+// of numbers do not matter, and run specific fields such as meta are ignored.
+func RequestKey(name BridgeName, headers []string, requestData map[string]interface{}) (string, error) {
+	data := make(map[string]interface{}, len(requestData))
+	for k, v := range requestData {
+		data[k] = v
+	}
+	for _, field := range requestKeyIgnoredFields {
+		delete(data, field)
+	}
+
+	// round-trip through JSON so that equivalent values of different Go types share a key
+	b, err := json.Marshal(map[string]interface{}{"headers": headers, "data": data})
+	if err != nil {
+		return "", err
+	}
+	var normalized interface{}
+	if err = json.Unmarshal(b, &normalized); err != nil {
+		return "", err
+	}
+	if b, err = json.Marshal(normalized); err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return name.String() + "/" + hex.EncodeToString(sum[:]), nil
+}
+
+// Do returns the response for key according to the policy of bt, calling fetch if there is no usable response in the
+// cache. Only responses returned by fetch without an error are cached, and errors are shared with coalesced requests.
+func (c *ResponseCache) Do(ctx context.Context, bt BridgeType, key string, fetch FetchFunc) ([]byte, CacheStatus, error) {
+	policy := bt.CachePolicy
+	fresh, stale := policy.CacheFreshTTL.Duration(), policy.CacheStaleTTL.Duration()
+
+	c.mu.Lock()
+	if cached, ok := c.responses[key]; ok {
+		age := c.now().Sub(cached.fetchedAt)
+		switch {
+		case age < fresh:
+			c.mu.Unlock()
+			return cached.value, CacheHit, nil
+		case age < fresh+stale:
+			if _, revalidating := c.inflight[key]; !revalidating {
+				c.startLocked(ctx, key, fresh+stale, fetch)
+			}
+			c.mu.Unlock()
+			return cached.value, CacheStale, nil
+		default:
+			delete(c.responses, key)
+		}
+	}
+
+	status := CacheMiss
+	req, ok := c.inflight[key]
+	if ok && policy.CoalesceRequests {
+		status = CacheCoalesced
+	} else {
+		req = c.startLocked(ctx, key, fresh+stale, fetch)
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-req.done:
+		return req.value, status, req.err
+	case <-ctx.Done():
+		return nil, status, ctx.Err()
+	}
+}
+
+// startLocked calls fetch in the background and caches its response for ttl. The call is detached from the
+// cancellation of ctx, so that coalesced requests and background refreshes do not fail when the request which started
+// them returns, but it keeps the deadline of ctx. c.mu must be held.
+func (c *ResponseCache) startLocked(ctx context.Context, key string, ttl time.Duration, fetch FetchFunc) *inflightRequest {
+	req := &inflightRequest{done: make(chan struct{})}
+	c.inflight[key] = req
+
+	var (
+		fetchCtx context.Context
+		cancel   context.CancelFunc
+	)
+	if deadline, ok := ctx.Deadline(); ok {
+		fetchCtx, cancel = context.WithDeadline(context.WithoutCancel(ctx), deadline)
+	} else {
+		fetchCtx, cancel = context.WithCancel(context.WithoutCancel(ctx))
+	}
+	go func() {
+		defer cancel()
+		value, err := fetch(fetchCtx)
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		req.value, req.err = value, err
+		if err == nil && ttl > 0 {
+			now := c.now()
+			c.responses[key] = cachedResponse{value: value, fetchedAt: now, expiresAt: now.Add(ttl)}
+		}
+		if c.inflight[key] == req {
+			delete(c.inflight, key)
+		}
+		close(req.done)
+	}()
+	return req
+}
+
+// PurgeExpired removes all responses which are too old to be served, even as stale responses.
+func (c *ResponseCache) PurgeExpired() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	for key, cached := range c.responses {
+		if !now.Before(cached.expiresAt) {
+			delete(c.responses, key)
+		}
+	}
+}
+
+// Purge removes all responses of the bridge from the cache, e.g. after its URL or policy changed.
+func (c *ResponseCache) Purge(name BridgeName) {
+	prefix := name.String() + "/"
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.responses {
+		if strings.HasPrefix(key, prefix) {
+			delete(c.responses, key)
+		}
+	}
+}
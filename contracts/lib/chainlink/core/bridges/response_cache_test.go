@@ -0,0 +1,137 @@
+package bridges_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+func TestRequestKey(t *testing.T) {
+	t.Parallel()
+
+	key := func(name bridges.BridgeName, headers []string, data map[string]interface{}) string {
+		k, err := bridges.RequestKey(name, headers, data)
+		require.NoError(t, err)
+		return k
+	}
+
+	base := key("bridge", nil, map[string]interface{}{"from": "ETH", "amount": 1, "nested": map[string]interface{}{"a": 1, "b": 2}})
+	assert.Equal(t, base, key("bridge", nil, map[string]interface{}{
+		"nested": map[string]interface{}{"b": 2.0, "a": 1},
+		"amount": 1.0,
+		"from":   "ETH",
+		"meta":   map[string]interface{}{"latestAnswer": 42},
+	}))
+	assert.NotEqual(t, base, key("other", nil, map[string]interface{}{"from": "ETH", "amount": 1, "nested": map[string]interface{}{"a": 1, "b": 2}}))
+	assert.NotEqual(t, base, key("bridge", []string{"X-Key", "1"}, map[string]interface{}{"from": "ETH", "amount": 1, "nested": map[string]interface{}{"a": 1, "b": 2}}))
+	assert.NotEqual(t, base, key("bridge", nil, map[string]interface{}{"from": "BTC", "amount": 1, "nested": map[string]interface{}{"a": 1, "b": 2}}))
+}
+
+func TestResponseCache_Do(t *testing.T) {
+	t.Parallel()
+
+	counting := func(calls *atomic.Int32, value string) bridges.FetchFunc {
+		return func(context.Context) ([]byte, error) {
+			calls.Add(1)
+			return []byte(value), nil
+		}
+	}
+
+	t.Run("serves fresh responses from the cache", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutils.Context(t)
+		c := bridges.NewResponseCache()
+		bt := bridges.BridgeType{Name: "bridge", CachePolicy: bridges.CachePolicy{CacheFreshTTL: models.Interval(time.Hour)}}
+		var calls atomic.Int32
+
+		value, status, err := c.Do(ctx, bt, "bridge/a", counting(&calls, "1"))
+		require.NoError(t, err)
+		assert.Equal(t, bridges.CacheMiss, status)
+		assert.Equal(t, "1", string(value))
+
+		value, status, err = c.Do(ctx, bt, "bridge/a", counting(&calls, "2"))
+		require.NoError(t, err)
+		assert.Equal(t, bridges.CacheHit, status)
+		assert.Equal(t, "1", string(value))
+		assert.Equal(t, int32(1), calls.Load())
+
+		c.Purge("bridge")
+		_, status, err = c.Do(ctx, bt, "bridge/a", counting(&calls, "2"))
+		require.NoError(t, err)
+		assert.Equal(t, bridges.CacheMiss, status)
+	})
+
+	t.Run("serves stale responses while revalidating", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutils.Context(t)
+		c := bridges.NewResponseCache()
+		bt := bridges.BridgeType{Name: "bridge", CachePolicy: bridges.CachePolicy{
+			CacheFreshTTL: models.Interval(10 * time.Millisecond),
+			CacheStaleTTL: models.Interval(time.Hour),
+		}}
+		var calls atomic.Int32
+
+		_, _, err := c.Do(ctx, bt, "bridge/a", counting(&calls, "1"))
+		require.NoError(t, err)
+		time.Sleep(20 * time.Millisecond)
+
+		value, status, err := c.Do(ctx, bt, "bridge/a", counting(&calls, "2"))
+		require.NoError(t, err)
+		assert.Equal(t, bridges.CacheStale, status)
+		assert.Equal(t, "1", string(value))
+
+		require.Eventually(t, func() bool {
+			value, status, err = c.Do(ctx, bt, "bridge/a", counting(&calls, "3"))
+			return err == nil && status == bridges.CacheHit && string(value) == "2"
+		}, testutils.WaitTimeout(t), 5*time.Millisecond)
+	})
+
+	t.Run("coalesces concurrent requests", func(t *testing.T) {
+		t.Parallel()
+		ctx := testutils.Context(t)
+		c := bridges.NewResponseCache()
+		bt := bridges.BridgeType{Name: "bridge", CachePolicy: bridges.CachePolicy{CoalesceRequests: true}}
+		started, release := make(chan struct{}), make(chan struct{})
+		var calls atomic.Int32
+		fetch := func(context.Context) ([]byte, error) {
+			calls.Add(1)
+			close(started)
+			<-release
+			return nil, errors.New("boom")
+		}
+
+		type result struct {
+			status bridges.CacheStatus
+			err    error
+		}
+		results := make(chan result, 2)
+		do := func() {
+			_, status, err := c.Do(ctx, bt, "bridge/a", fetch)
+			results <- result{status, err}
+		}
+		go do()
+		<-started
+		go do()
+		// give the second request time to join the first one, which is in flight until released
+		time.Sleep(100 * time.Millisecond)
+		close(release)
+
+		statuses := map[bridges.CacheStatus]int{}
+		for i := 0; i < 2; i++ {
+			r := <-results
+			require.EqualError(t, r.err, "boom")
+			statuses[r.status]++
+		}
+		assert.Equal(t, map[bridges.CacheStatus]int{bridges.CacheMiss: 1, bridges.CacheCoalesced: 1}, statuses)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+}
@@ -477,6 +477,7 @@ func makeTestEvmTxm(
 		keyStore,
 		estimator,
 		ht,
+		nil,
 		nil)
 	require.NoError(t, err, "can't create tx manager")
 
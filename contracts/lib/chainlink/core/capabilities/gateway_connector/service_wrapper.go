@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"errors"
+	"fmt"
 	"math/big"
 	"slices"
 
@@ -77,6 +78,9 @@ func (e *ServiceWrapper) Start(ctx context.Context) error {
 		if idx == -1 {
 			return errors.New("key for configured node address not found")
 		}
+		if enabledKeys[idx].IsRemote() {
+			return fmt.Errorf("the gateway connector signs with the key of node address %s itself: %w", enabledKeys[idx].ID(), ethkey.ErrRemoteKey)
+		}
 		e.signerKey = enabledKeys[idx].ToEcdsaPrivKey()
 		if enabledKeys[idx].ID() != nodeAddress {
 			return errors.New("node address mismatch")
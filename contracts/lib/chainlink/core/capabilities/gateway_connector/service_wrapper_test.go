@@ -78,4 +78,31 @@ func TestGatewayConnectorServiceWrapper_NonexistentKey(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestGatewayConnectorServiceWrapper_RemoteKey(t *testing.T) {
+	t.Parallel()
+
+	key, _ := testutils.NewPrivateKeyAndAddress(t)
+	remoteKey := ethkey.FromAddress(ethkey.FromPrivateKey(key).Address)
+	config, err := chainlink.GeneralConfigOpts{
+		Config: chainlink.Config{
+			Core: toml.Core{
+				Capabilities: toml.Capabilities{
+					GatewayConnector: toml.GatewayConnector{
+						ChainIDForNodeKey: ptr("1"),
+						NodeAddress:       ptr(remoteKey.Address.Hex()),
+						DonID:             ptr("5"),
+						Gateways:          []toml.ConnectorGateway{{ID: ptr("example_gateway"), URL: ptr("wss://localhost:8081/node")}},
+					},
+				},
+			},
+		},
+	}.New()
+	require.NoError(t, err)
+	ethKeystore := ksmocks.NewEth(t)
+	ethKeystore.On("EnabledKeysForChain", mock.Anything, mock.Anything).Return([]ethkey.KeyV2{remoteKey}, nil)
+	wrapper := gatewayconnector.NewGatewayConnectorServiceWrapper(config.Capabilities().GatewayConnector(), ethKeystore, clockwork.NewFakeClock(), logger.TestLogger(t))
+
+	require.ErrorIs(t, wrapper.Start(testutils.Context(t)), ethkey.ErrRemoteKey)
+}
+
 func ptr[T any](t T) *T { return &t }
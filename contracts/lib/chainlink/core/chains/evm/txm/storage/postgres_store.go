@@ -0,0 +1,594 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	evmtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	clnull "github.com/smartcontractkit/chainlink-common/pkg/utils/null"
+
+	"github.com/smartcontractkit/chainlink-framework/chains/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink-framework/chains/txmgr/types"
+	"github.com/smartcontractkit/chainlink-integrations/evm/assets"
+	"github.com/smartcontractkit/chainlink-integrations/evm/gas"
+	ubig "github.com/smartcontractkit/chainlink-integrations/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txm/types"
+)
+
+const PostgresStoreNotFoundForAddress string = "PostgresStore for address: %v not found"
+
+// PostgresStore is a durable TxStore backed by the evm.txm_v2_txes and evm.txm_v2_tx_attempts tables. It follows the
+// same semantics as the InMemoryStoreManager, but unstarted and in-flight transactions survive a restart.
+type PostgresStore struct {
+	lggr    logger.SugaredLogger
+	ds      sqlutil.DataSource
+	chainID *big.Int
+
+	addressesMu sync.RWMutex
+	addresses   map[common.Address]struct{}
+}
+
+func NewPostgresStore(lggr logger.Logger, ds sqlutil.DataSource, chainID *big.Int) *PostgresStore {
+	return &PostgresStore{
+		lggr:      logger.Sugared(logger.Named(lggr, "PostgresStore")),
+		ds:        ds,
+		chainID:   chainID,
+		addresses: make(map[common.Address]struct{}),
+	}
+}
+
+type dbTx struct {
+	ID                 int64
+	ChainID            ubig.Big
+	IdempotencyKey     *string
+	Nonce              *int64
+	FromAddress        common.Address
+	ToAddress          common.Address
+	Value              ubig.Big
+	Data               []byte
+	SpecifiedGasLimit  int64
+	CreatedAt          time.Time
+	InitialBroadcastAt *time.Time
+	LastBroadcastAt    *time.Time
+	State              txmgrtypes.TxState
+	IsPurgeable        bool
+	AttemptCount       int32
+	Meta               *sqlutil.JSON
+	Subject            uuid.NullUUID
+	PipelineTaskRunID  uuid.NullUUID
+	MinConfirmations   clnull.Uint32
+	SignalCallback     bool
+	CallbackCompleted  bool
+}
+
+func (db dbTx) toTransaction() *types.Transaction {
+	tx := &types.Transaction{
+		ID:                 uint64(db.ID), //nolint:gosec // BIGSERIAL is always positive
+		IdempotencyKey:     db.IdempotencyKey,
+		ChainID:            db.ChainID.ToInt(),
+		FromAddress:        db.FromAddress,
+		ToAddress:          db.ToAddress,
+		Value:              db.Value.ToInt(),
+		Data:               db.Data,
+		SpecifiedGasLimit:  uint64(db.SpecifiedGasLimit), //nolint:gosec // gas limit is stored from an uint64
+		CreatedAt:          db.CreatedAt,
+		InitialBroadcastAt: db.InitialBroadcastAt,
+		LastBroadcastAt:    db.LastBroadcastAt,
+		State:              db.State,
+		IsPurgeable:        db.IsPurgeable,
+		AttemptCount:       uint16(db.AttemptCount), //nolint:gosec // attempts are capped by the Txm
+		Meta:               db.Meta,
+		Subject:            db.Subject,
+		PipelineTaskRunID:  db.PipelineTaskRunID,
+		MinConfirmations:   db.MinConfirmations,
+		SignalCallback:     db.SignalCallback,
+		CallbackCompleted:  db.CallbackCompleted,
+	}
+	if db.Nonce != nil {
+		nonce := uint64(*db.Nonce) //nolint:gosec // nonces are stored from an uint64
+		tx.Nonce = &nonce
+	}
+	return tx
+}
+
+type dbAttempt struct {
+	ID          int64
+	TxID        int64
+	Hash        common.Hash
+	GasPrice    *assets.Wei
+	GasTipCap   *assets.Wei
+	GasFeeCap   *assets.Wei
+	GasLimit    int64
+	TxType      int16
+	SignedRawTx []byte
+	CreatedAt   time.Time
+	BroadcastAt *time.Time
+}
+
+func (db dbAttempt) toAttempt() (*types.Attempt, error) {
+	attempt := &types.Attempt{
+		ID:   uint64(db.ID),   //nolint:gosec // BIGSERIAL is always positive
+		TxID: uint64(db.TxID), //nolint:gosec // BIGSERIAL is always positive
+		Hash: db.Hash,
+		Fee: gas.EvmFee{
+			GasPrice:   db.GasPrice,
+			DynamicFee: gas.DynamicFee{GasTipCap: db.GasTipCap, GasFeeCap: db.GasFeeCap},
+		},
+		GasLimit:    uint64(db.GasLimit), //nolint:gosec // gas limit is stored from an uint64
+		Type:        byte(db.TxType),     //nolint:gosec // tx type is stored from a byte
+		CreatedAt:   db.CreatedAt,
+		BroadcastAt: db.BroadcastAt,
+	}
+	if len(db.SignedRawTx) > 0 {
+		signedTx := new(evmtypes.Transaction)
+		if err := signedTx.UnmarshalBinary(db.SignedRawTx); err != nil {
+			return nil, fmt.Errorf("failed to decode signed transaction for attempt: %d: %w", db.ID, err)
+		}
+		attempt.SignedTransaction = signedTx
+	}
+	return attempt, nil
+}
+
+func (s *PostgresStore) checkAddress(fromAddress common.Address) error {
+	s.addressesMu.RLock()
+	defer s.addressesMu.RUnlock()
+	if _, exists := s.addresses[fromAddress]; !exists {
+		return fmt.Errorf(PostgresStoreNotFoundForAddress, fromAddress)
+	}
+	return nil
+}
+
+// Add registers the addresses with the store. Attempt counts of in-flight transactions are reset, which preserves
+// the in-memory behavior of giving stuck transactions a new set of attempts after a restart.
+func (s *PostgresStore) Add(addresses ...common.Address) (err error) {
+	s.addressesMu.Lock()
+	defer s.addressesMu.Unlock()
+	for _, address := range addresses {
+		if _, exists := s.addresses[address]; exists {
+			err = errors.Join(err, fmt.Errorf("address %v already exists in store manager", address))
+		}
+		s.addresses[address] = struct{}{}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if _, e := s.ds.ExecContext(ctx, `UPDATE evm.txm_v2_txes SET attempt_count = 0
+		WHERE chain_id = $1 AND from_address = ANY($2) AND state = $3`,
+		ubig.New(s.chainID), pq.Array(addressesToBytes(addresses)), txmgr.TxUnconfirmed); e != nil {
+		err = errors.Join(err, fmt.Errorf("failed to reset attempt counts: %w", e))
+	}
+	return
+}
+
+func (s *PostgresStore) AbandonPendingTransactions(ctx context.Context, fromAddress common.Address) error {
+	if err := s.checkAddress(fromAddress); err != nil {
+		return err
+	}
+	_, err := s.ds.ExecContext(ctx, `UPDATE evm.txm_v2_txes SET state = $1
+		WHERE chain_id = $2 AND from_address = $3 AND state IN ($4, $5)`,
+		txmgr.TxFatalError, ubig.New(s.chainID), fromAddress, txmgr.TxUnstarted, txmgr.TxUnconfirmed)
+	return err
+}
+
+func (s *PostgresStore) AppendAttemptToTransaction(ctx context.Context, txNonce uint64, fromAddress common.Address, attempt *types.Attempt) error {
+	if err := s.checkAddress(fromAddress); err != nil {
+		return err
+	}
+	var signedRawTx []byte
+	if attempt.SignedTransaction != nil {
+		var err error
+		if signedRawTx, err = attempt.SignedTransaction.MarshalBinary(); err != nil {
+			return fmt.Errorf("failed to encode signed transaction for txID: %v: %w", attempt.TxID, err)
+		}
+	}
+
+	return sqlutil.TransactDataSource(ctx, s.ds, nil, func(ds sqlutil.DataSource) error {
+		tx, err := s.findUnconfirmedTx(ctx, ds, txNonce, fromAddress)
+		if err != nil {
+			return err
+		}
+		if tx == nil {
+			return fmt.Errorf("unconfirmed tx was not found for nonce: %d - txID: %v", txNonce, attempt.TxID)
+		}
+		if uint64(tx.ID) != attempt.TxID { //nolint:gosec // BIGSERIAL is always positive
+			return fmt.Errorf("unconfirmed tx with nonce exists but attempt points to a different txID. Found Tx: %v - txID: %v", tx.toTransaction(), attempt.TxID)
+		}
+
+		var inserted struct {
+			ID        int64
+			CreatedAt time.Time
+		}
+		err = ds.GetContext(ctx, &inserted, `INSERT INTO evm.txm_v2_tx_attempts
+			(tx_id, hash, gas_price, gas_tip_cap, gas_fee_cap, gas_limit, tx_type, signed_raw_tx, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW()) RETURNING id, created_at`,
+			tx.ID, attempt.Hash, attempt.Fee.GasPrice, attempt.Fee.GasTipCap, attempt.Fee.GasFeeCap,
+			attempt.GasLimit, attempt.Type, signedRawTx)
+		if err != nil {
+			return fmt.Errorf("failed to insert attempt for txID: %v: %w", attempt.TxID, err)
+		}
+		if _, err = ds.ExecContext(ctx, `UPDATE evm.txm_v2_txes SET attempt_count = attempt_count + 1 WHERE id = $1`, tx.ID); err != nil {
+			return err
+		}
+		attempt.ID = uint64(inserted.ID) //nolint:gosec // BIGSERIAL is always positive
+		attempt.CreatedAt = inserted.CreatedAt
+		return nil
+	})
+}
+
+func (s *PostgresStore) CreateEmptyUnconfirmedTransaction(ctx context.Context, fromAddress common.Address, nonce uint64, gasLimit uint64) (tx *types.Transaction, err error) {
+	if err = s.checkAddress(fromAddress); err != nil {
+		return nil, err
+	}
+	err = sqlutil.TransactDataSource(ctx, s.ds, nil, func(ds sqlutil.DataSource) error {
+		var existing dbTx
+		err := ds.GetContext(ctx, &existing, `SELECT * FROM evm.txm_v2_txes
+			WHERE chain_id = $1 AND from_address = $2 AND nonce = $3 AND state IN ($4, $5) LIMIT 1`,
+			ubig.New(s.chainID), fromAddress, nonce, txmgr.TxUnconfirmed, txmgr.TxConfirmed)
+		if err == nil {
+			return fmt.Errorf("an %s tx with the same nonce already exists: %v", existing.State, existing.toTransaction())
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		var created dbTx
+		err = ds.GetContext(ctx, &created, `INSERT INTO evm.txm_v2_txes
+			(chain_id, nonce, from_address, to_address, value, specified_gas_limit, created_at, state)
+			VALUES ($1, $2, $3, $4, 0, $5, NOW(), $6) RETURNING *`,
+			ubig.New(s.chainID), nonce, fromAddress, common.Address{}, gasLimit, txmgr.TxUnconfirmed)
+		if err != nil {
+			return err
+		}
+		tx = created.toTransaction()
+		return nil
+	})
+	return
+}
+
+func (s *PostgresStore) CreateTransaction(ctx context.Context, txRequest *types.TxRequest) (tx *types.Transaction, err error) {
+	if err = s.checkAddress(txRequest.FromAddress); err != nil {
+		return nil, err
+	}
+	value := txRequest.Value
+	if value == nil {
+		value = big.NewInt(0)
+	}
+	err = sqlutil.TransactDataSource(ctx, s.ds, nil, func(ds sqlutil.DataSource) error {
+		var created dbTx
+		err := ds.GetContext(ctx, &created, `INSERT INTO evm.txm_v2_txes
+			(chain_id, idempotency_key, from_address, to_address, value, data, specified_gas_limit, created_at, state, meta,
+			pipeline_task_run_id, min_confirmations, signal_callback)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), $8, $9, $10, $11, $12) RETURNING *`,
+			ubig.New(s.chainID), txRequest.IdempotencyKey, txRequest.FromAddress, txRequest.ToAddress, ubig.New(value),
+			txRequest.Data, txRequest.SpecifiedGasLimit, txmgr.TxUnstarted, txRequest.Meta,
+			txRequest.PipelineTaskRunID, txRequest.MinConfirmations, txRequest.SignalCallback)
+		if err != nil {
+			return err
+		}
+		tx = created.toTransaction()
+
+		var droppedIDs []int64
+		err = ds.SelectContext(ctx, &droppedIDs, `DELETE FROM evm.txm_v2_txes WHERE id IN (
+			SELECT id FROM evm.txm_v2_txes WHERE chain_id = $1 AND from_address = $2 AND state = $3
+			ORDER BY id DESC OFFSET $4) RETURNING id`,
+			ubig.New(s.chainID), txRequest.FromAddress, txmgr.TxUnstarted, maxQueuedTransactions)
+		if err != nil {
+			return err
+		}
+		if len(droppedIDs) > 0 {
+			s.lggr.Warnw(fmt.Sprintf("Unstarted transactions queue for address: %v reached max limit of: %d. Dropping oldest transactions",
+				txRequest.FromAddress, maxQueuedTransactions), "txIDs", droppedIDs)
+		}
+		return nil
+	})
+	return
+}
+
+func (s *PostgresStore) FetchUnconfirmedTransactionAtNonceWithCount(ctx context.Context, latestNonce uint64, fromAddress common.Address) (tx *types.Transaction, unconfirmedCount int, err error) {
+	if err = s.checkAddress(fromAddress); err != nil {
+		return nil, 0, err
+	}
+	err = s.ds.GetContext(ctx, &unconfirmedCount, `SELECT COUNT(*) FROM evm.txm_v2_txes
+		WHERE chain_id = $1 AND from_address = $2 AND state = $3`, ubig.New(s.chainID), fromAddress, txmgr.TxUnconfirmed)
+	if err != nil {
+		return nil, 0, err
+	}
+	dbtx, err := s.findUnconfirmedTx(ctx, s.ds, latestNonce, fromAddress)
+	if err != nil || dbtx == nil {
+		return nil, unconfirmedCount, err
+	}
+	txs, err := s.loadAttempts(ctx, s.ds, []dbTx{*dbtx})
+	if err != nil {
+		return nil, 0, err
+	}
+	return txs[0], unconfirmedCount, nil
+}
+
+func (s *PostgresStore) MarkConfirmedAndReorgedTransactions(ctx context.Context, latestNonce uint64, fromAddress common.Address) (confirmedTransactions []*types.Transaction, unconfirmedTransactionIDs []uint64, err error) {
+	if err = s.checkAddress(fromAddress); err != nil {
+		return nil, nil, err
+	}
+	err = sqlutil.TransactDataSource(ctx, s.ds, nil, func(ds sqlutil.DataSource) error {
+		var confirmed []dbTx
+		err := ds.SelectContext(ctx, &confirmed, `UPDATE evm.txm_v2_txes SET state = $1
+			WHERE chain_id = $2 AND from_address = $3 AND state = $4 AND nonce < $5 RETURNING *`,
+			txmgr.TxConfirmed, ubig.New(s.chainID), fromAddress, txmgr.TxUnconfirmed, latestNonce)
+		if err != nil {
+			return fmt.Errorf("failed to mark transactions as confirmed: %w", err)
+		}
+		if confirmedTransactions, err = s.loadAttempts(ctx, ds, confirmed); err != nil {
+			return err
+		}
+
+		// A re-orged transaction takes precedence over an unconfirmed transaction with the same nonce.
+		var overwrittenIDs []int64
+		err = ds.SelectContext(ctx, &overwrittenIDs, `UPDATE evm.txm_v2_txes u SET state = $1
+			WHERE u.chain_id = $2 AND u.from_address = $3 AND u.state = $4 AND EXISTS (
+				SELECT 1 FROM evm.txm_v2_txes c WHERE c.chain_id = u.chain_id AND c.from_address = u.from_address
+				AND c.state = $5 AND c.nonce = u.nonce AND c.nonce >= $6) RETURNING u.id`,
+			txmgr.TxFatalError, ubig.New(s.chainID), fromAddress, txmgr.TxUnconfirmed, txmgr.TxConfirmed, latestNonce)
+		if err != nil {
+			return fmt.Errorf("failed to drop unconfirmed transactions overwritten by re-orged transactions: %w", err)
+		}
+		if len(overwrittenIDs) > 0 {
+			s.lggr.Errorw("Another confirmed transaction with the same nonce exists. Unconfirmed transactions will be overwritten.",
+				"txIDs", overwrittenIDs)
+		}
+
+		var reorgedIDs []int64
+		err = ds.SelectContext(ctx, &reorgedIDs, `UPDATE evm.txm_v2_txes SET state = $1, last_broadcast_at = NULL
+			WHERE chain_id = $2 AND from_address = $3 AND state = $4 AND nonce >= $5 RETURNING id`,
+			txmgr.TxUnconfirmed, ubig.New(s.chainID), fromAddress, txmgr.TxConfirmed, latestNonce)
+		if err != nil {
+			return fmt.Errorf("failed to mark re-orged transactions as unconfirmed: %w", err)
+		}
+		for _, id := range reorgedIDs {
+			unconfirmedTransactionIDs = append(unconfirmedTransactionIDs, uint64(id)) //nolint:gosec // BIGSERIAL is always positive
+		}
+
+		var prunedIDs []int64
+		err = ds.SelectContext(ctx, &prunedIDs, `DELETE FROM evm.txm_v2_txes WHERE id IN (
+			SELECT id FROM (
+				SELECT id, ROW_NUMBER() OVER (ORDER BY nonce ASC) AS rn, COUNT(*) OVER () AS total
+				FROM evm.txm_v2_txes WHERE chain_id = $1 AND from_address = $2 AND state = $3
+			) c WHERE c.total > $4 AND c.rn <= c.total / $5) RETURNING id`,
+			ubig.New(s.chainID), fromAddress, txmgr.TxConfirmed, maxQueuedTransactions, pruneSubset)
+		if err != nil {
+			return fmt.Errorf("failed to prune confirmed transactions: %w", err)
+		}
+		if len(prunedIDs) > 0 {
+			sort.Slice(prunedIDs, func(i, j int) bool { return prunedIDs[i] < prunedIDs[j] })
+			s.lggr.Debugf("Confirmed transactions for address: %v reached max limit of: %d. Pruned 1/%d of the oldest confirmed transactions. TxIDs: %v",
+				fromAddress, maxQueuedTransactions, pruneSubset, prunedIDs)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Slice(confirmedTransactions, func(i, j int) bool { return confirmedTransactions[i].ID < confirmedTransactions[j].ID })
+	sort.Slice(unconfirmedTransactionIDs, func(i, j int) bool { return unconfirmedTransactionIDs[i] < unconfirmedTransactionIDs[j] })
+	return confirmedTransactions, unconfirmedTransactionIDs, nil
+}
+
+func (s *PostgresStore) MarkUnconfirmedTransactionPurgeable(ctx context.Context, nonce uint64, fromAddress common.Address) error {
+	if err := s.checkAddress(fromAddress); err != nil {
+		return err
+	}
+	res, err := s.ds.ExecContext(ctx, `UPDATE evm.txm_v2_txes SET is_purgeable = TRUE
+		WHERE chain_id = $1 AND from_address = $2 AND state = $3 AND nonce = $4`,
+		ubig.New(s.chainID), fromAddress, txmgr.TxUnconfirmed, nonce)
+	if err != nil {
+		return err
+	}
+	if rows, err := res.RowsAffected(); err != nil {
+		return err
+	} else if rows == 0 {
+		return fmt.Errorf("unconfirmed tx with nonce: %d was not found", nonce)
+	}
+	return nil
+}
+
+func (s *PostgresStore) UpdateTransactionBroadcast(ctx context.Context, txID uint64, txNonce uint64, attemptHash common.Hash, fromAddress common.Address) error {
+	if err := s.checkAddress(fromAddress); err != nil {
+		return err
+	}
+	return sqlutil.TransactDataSource(ctx, s.ds, nil, func(ds sqlutil.DataSource) error {
+		tx, err := s.findUnconfirmedTx(ctx, ds, txNonce, fromAddress)
+		if err != nil {
+			return err
+		}
+		if tx == nil {
+			return fmt.Errorf("unconfirmed tx was not found for nonce: %d - txID: %v", txNonce, txID)
+		}
+
+		// Set the same time for both the tx and its attempt
+		now := time.Now()
+		res, err := ds.ExecContext(ctx, `UPDATE evm.txm_v2_tx_attempts SET broadcast_at = $1 WHERE tx_id = $2 AND hash = $3`,
+			now, tx.ID, attemptHash)
+		if err != nil {
+			return err
+		}
+		if rows, err := res.RowsAffected(); err != nil {
+			return err
+		} else if rows == 0 {
+			return fmt.Errorf("UpdateTransactionBroadcast failed to find attempt. attempt with hash: %v was not found", attemptHash)
+		}
+		_, err = ds.ExecContext(ctx, `UPDATE evm.txm_v2_txes SET last_broadcast_at = $1,
+			initial_broadcast_at = COALESCE(initial_broadcast_at, $1) WHERE id = $2`, now, tx.ID)
+		return err
+	})
+}
+
+func (s *PostgresStore) UpdateUnstartedTransactionWithNonce(ctx context.Context, fromAddress common.Address, nonce uint64) (tx *types.Transaction, err error) {
+	if err = s.checkAddress(fromAddress); err != nil {
+		return nil, err
+	}
+	err = sqlutil.TransactDataSource(ctx, s.ds, nil, func(ds sqlutil.DataSource) error {
+		var unstarted dbTx
+		err := ds.GetContext(ctx, &unstarted, `SELECT * FROM evm.txm_v2_txes
+			WHERE chain_id = $1 AND from_address = $2 AND state = $3 ORDER BY id ASC LIMIT 1 FOR UPDATE`,
+			ubig.New(s.chainID), fromAddress, txmgr.TxUnstarted)
+		if errors.Is(err, sql.ErrNoRows) {
+			s.lggr.Debugf("Unstarted transactions queue is empty for address: %v", fromAddress)
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		existing, err := s.findUnconfirmedTx(ctx, ds, nonce, fromAddress)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return fmt.Errorf("an unconfirmed tx with the same nonce already exists: %v", existing.toTransaction())
+		}
+
+		var updated dbTx
+		err = ds.GetContext(ctx, &updated, `UPDATE evm.txm_v2_txes SET state = $1, nonce = $2 WHERE id = $3 RETURNING *`,
+			txmgr.TxUnconfirmed, nonce, unstarted.ID)
+		if err != nil {
+			return err
+		}
+		tx = updated.toTransaction()
+		return nil
+	})
+	return
+}
+
+// FindNextNonce returns the nonce following the highest nonce assigned to a transaction of the address, or nil if
+// no transaction has been assigned a nonce yet.
+func (s *PostgresStore) FindNextNonce(ctx context.Context, fromAddress common.Address) (*uint64, error) {
+	if err := s.checkAddress(fromAddress); err != nil {
+		return nil, err
+	}
+	var maxNonce *int64
+	err := s.ds.GetContext(ctx, &maxNonce, `SELECT MAX(nonce) FROM evm.txm_v2_txes
+		WHERE chain_id = $1 AND from_address = $2 AND state IN ($3, $4)`,
+		ubig.New(s.chainID), fromAddress, txmgr.TxUnconfirmed, txmgr.TxConfirmed)
+	if err != nil || maxNonce == nil {
+		return nil, err
+	}
+	next := uint64(*maxNonce) + 1 //nolint:gosec // nonces are stored from an uint64
+	return &next, nil
+}
+
+// Error Handler
+func (s *PostgresStore) DeleteAttemptForUnconfirmedTx(ctx context.Context, transactionNonce uint64, attempt *types.Attempt, fromAddress common.Address) error {
+	if err := s.checkAddress(fromAddress); err != nil {
+		return err
+	}
+	return sqlutil.TransactDataSource(ctx, s.ds, nil, func(ds sqlutil.DataSource) error {
+		tx, err := s.findUnconfirmedTx(ctx, ds, transactionNonce, fromAddress)
+		if err != nil {
+			return err
+		}
+		if tx == nil {
+			return fmt.Errorf("unconfirmed tx was not found for nonce: %d - txID: %v", transactionNonce, attempt.TxID)
+		}
+		res, err := ds.ExecContext(ctx, `DELETE FROM evm.txm_v2_tx_attempts WHERE id = (
+			SELECT id FROM evm.txm_v2_tx_attempts WHERE tx_id = $1 AND hash = $2 ORDER BY id ASC LIMIT 1)`, tx.ID, attempt.Hash)
+		if err != nil {
+			return err
+		}
+		if rows, err := res.RowsAffected(); err != nil {
+			return err
+		} else if rows == 0 {
+			return fmt.Errorf("attempt with hash: %v for txID: %v was not found", attempt.Hash, attempt.TxID)
+		}
+		return nil
+	})
+}
+
+func (s *PostgresStore) MarkTxFatal(ctx context.Context, tx *types.Transaction, fromAddress common.Address) error {
+	if err := s.checkAddress(fromAddress); err != nil {
+		return err
+	}
+	res, err := s.ds.ExecContext(ctx, `UPDATE evm.txm_v2_txes SET state = $1 WHERE id = $2 AND chain_id = $3 AND from_address = $4`,
+		txmgr.TxFatalError, tx.ID, ubig.New(s.chainID), fromAddress)
+	if err != nil {
+		return err
+	}
+	if rows, err := res.RowsAffected(); err != nil {
+		return err
+	} else if rows == 0 {
+		return fmt.Errorf("tx with txID: %v was not found", tx.ID)
+	}
+	tx.State = txmgr.TxFatalError
+	return nil
+}
+
+// Orchestrator
+func (s *PostgresStore) FindTxWithIdempotencyKey(ctx context.Context, idempotencyKey string) (*types.Transaction, error) {
+	var dbtx dbTx
+	err := s.ds.GetContext(ctx, &dbtx, `SELECT * FROM evm.txm_v2_txes WHERE chain_id = $1 AND idempotency_key = $2`,
+		ubig.New(s.chainID), idempotencyKey)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	txs, err := s.loadAttempts(ctx, s.ds, []dbTx{dbtx})
+	if err != nil {
+		return nil, err
+	}
+	return txs[0], nil
+}
+
+func (s *PostgresStore) findUnconfirmedTx(ctx context.Context, ds sqlutil.DataSource, nonce uint64, fromAddress common.Address) (*dbTx, error) {
+	var tx dbTx
+	err := ds.GetContext(ctx, &tx, `SELECT * FROM evm.txm_v2_txes WHERE chain_id = $1 AND from_address = $2 AND state = $3 AND nonce = $4`,
+		ubig.New(s.chainID), fromAddress, txmgr.TxUnconfirmed, nonce)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return &tx, err
+}
+
+func (s *PostgresStore) loadAttempts(ctx context.Context, ds sqlutil.DataSource, dbtxs []dbTx) ([]*types.Transaction, error) {
+	if len(dbtxs) == 0 {
+		return nil, nil
+	}
+	txs := make([]*types.Transaction, len(dbtxs))
+	txsByID := make(map[int64]*types.Transaction, len(dbtxs))
+	ids := make([]int64, len(dbtxs))
+	for i, dbtx := range dbtxs {
+		txs[i] = dbtx.toTransaction()
+		txsByID[dbtx.ID] = txs[i]
+		ids[i] = dbtx.ID
+	}
+
+	var dbAttempts []dbAttempt
+	if err := ds.SelectContext(ctx, &dbAttempts, `SELECT * FROM evm.txm_v2_tx_attempts WHERE tx_id = ANY($1) ORDER BY id ASC`,
+		pq.Array(ids)); err != nil {
+		return nil, fmt.Errorf("failed to load attempts: %w", err)
+	}
+	for _, dba := range dbAttempts {
+		attempt, err := dba.toAttempt()
+		if err != nil {
+			return nil, err
+		}
+		tx := txsByID[dba.TxID]
+		tx.Attempts = append(tx.Attempts, attempt)
+	}
+	return txs, nil
+}
+
+func addressesToBytes(addresses []common.Address) [][]byte {
+	b := make([][]byte, len(addresses))
+	for i, address := range addresses {
+		b[i] = address.Bytes()
+	}
+	return b
+}
@@ -0,0 +1,162 @@
+package storage
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	evmtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-framework/chains/txmgr"
+	"github.com/smartcontractkit/chainlink-integrations/evm/assets"
+	"github.com/smartcontractkit/chainlink-integrations/evm/gas"
+	"github.com/smartcontractkit/chainlink-integrations/evm/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txm/types"
+)
+
+func TestPostgresStore_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db := testutils.NewSqlxDB(t)
+	fromAddress := testutils.NewAddress()
+	s := NewPostgresStore(logger.Test(t), db, testutils.FixtureChainID)
+
+	_, err := s.CreateTransaction(ctx, &types.TxRequest{FromAddress: fromAddress})
+	require.ErrorContains(t, err, "not found")
+
+	require.NoError(t, s.Add(fromAddress))
+	require.Error(t, s.Add(fromAddress))
+
+	// Unstarted
+	tx1, err := s.CreateTransaction(ctx, &types.TxRequest{FromAddress: fromAddress, ToAddress: testutils.NewAddress(), Value: big.NewInt(10)})
+	require.NoError(t, err)
+	assert.Equal(t, txmgr.TxUnstarted, tx1.State)
+	assert.Equal(t, big.NewInt(10), tx1.Value)
+	_, err = s.CreateTransaction(ctx, &types.TxRequest{FromAddress: fromAddress})
+	require.NoError(t, err)
+
+	// Unconfirmed
+	tx, err := s.UpdateUnstartedTransactionWithNonce(ctx, fromAddress, 0)
+	require.NoError(t, err)
+	require.NotNil(t, tx)
+	assert.Equal(t, tx1.ID, tx.ID)
+	assert.Equal(t, txmgr.TxUnconfirmed, tx.State)
+	_, err = s.UpdateUnstartedTransactionWithNonce(ctx, fromAddress, 0)
+	require.ErrorContains(t, err, "an unconfirmed tx with the same nonce already exists")
+
+	signedTx := evmtypes.NewTx(&evmtypes.LegacyTx{Nonce: 0, GasPrice: big.NewInt(1), Gas: 21000})
+	attempt := &types.Attempt{
+		TxID:              tx.ID,
+		Hash:              signedTx.Hash(),
+		Fee:               gas.EvmFee{GasPrice: assets.NewWeiI(1)},
+		GasLimit:          21000,
+		SignedTransaction: signedTx,
+	}
+	require.NoError(t, s.AppendAttemptToTransaction(ctx, 0, fromAddress, attempt))
+	assert.False(t, attempt.CreatedAt.IsZero())
+	require.NoError(t, s.UpdateTransactionBroadcast(ctx, tx.ID, 0, attempt.Hash, fromAddress))
+	require.Error(t, s.UpdateTransactionBroadcast(ctx, tx.ID, 0, common.Hash{}, fromAddress))
+
+	tx, count, err := s.FetchUnconfirmedTransactionAtNonceWithCount(ctx, 0, fromAddress)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.Len(t, tx.Attempts, 1)
+	assert.Equal(t, uint16(1), tx.AttemptCount)
+	assert.NotNil(t, tx.LastBroadcastAt)
+	assert.NotNil(t, tx.InitialBroadcastAt)
+	assert.Equal(t, signedTx.Hash(), tx.Attempts[0].SignedTransaction.Hash())
+	assert.Equal(t, assets.NewWeiI(1), tx.Attempts[0].Fee.GasPrice)
+
+	nextNonce, err := s.FindNextNonce(ctx, fromAddress)
+	require.NoError(t, err)
+	require.NotNil(t, nextNonce)
+	assert.Equal(t, uint64(1), *nextNonce)
+
+	// Restart resets attempt counts and keeps the state
+	restarted := NewPostgresStore(logger.Test(t), db, testutils.FixtureChainID)
+	require.NoError(t, restarted.Add(fromAddress))
+	tx, count, err = restarted.FetchUnconfirmedTransactionAtNonceWithCount(ctx, 0, fromAddress)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, uint16(0), tx.AttemptCount)
+	require.Len(t, tx.Attempts, 1)
+
+	// Confirmed
+	confirmed, reorged, err := restarted.MarkConfirmedAndReorgedTransactions(ctx, 1, fromAddress)
+	require.NoError(t, err)
+	require.Len(t, confirmed, 1)
+	assert.Equal(t, tx1.ID, confirmed[0].ID)
+	assert.Equal(t, txmgr.TxConfirmed, confirmed[0].State)
+	assert.Empty(t, reorged)
+
+	// Re-orged
+	confirmed, reorged, err = restarted.MarkConfirmedAndReorgedTransactions(ctx, 0, fromAddress)
+	require.NoError(t, err)
+	assert.Empty(t, confirmed)
+	assert.Equal(t, []uint64{tx1.ID}, reorged)
+	tx, _, err = restarted.FetchUnconfirmedTransactionAtNonceWithCount(ctx, 0, fromAddress)
+	require.NoError(t, err)
+	assert.Nil(t, tx.LastBroadcastAt)
+
+	// Abandon
+	require.NoError(t, restarted.AbandonPendingTransactions(ctx, fromAddress))
+	tx, count, err = restarted.FetchUnconfirmedTransactionAtNonceWithCount(ctx, 0, fromAddress)
+	require.NoError(t, err)
+	assert.Nil(t, tx)
+	assert.Equal(t, 0, count)
+	tx, err = restarted.UpdateUnstartedTransactionWithNonce(ctx, fromAddress, 1)
+	require.NoError(t, err)
+	assert.Nil(t, tx)
+}
+
+func TestPostgresStore_CreateEmptyUnconfirmedTransaction(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	fromAddress := testutils.NewAddress()
+	s := NewPostgresStore(logger.Test(t), testutils.NewSqlxDB(t), testutils.FixtureChainID)
+	require.NoError(t, s.Add(fromAddress))
+
+	tx, err := s.CreateEmptyUnconfirmedTransaction(ctx, fromAddress, 5, 21000)
+	require.NoError(t, err)
+	assert.Equal(t, txmgr.TxUnconfirmed, tx.State)
+	assert.Equal(t, uint64(21000), tx.SpecifiedGasLimit)
+
+	_, err = s.CreateEmptyUnconfirmedTransaction(ctx, fromAddress, 5, 21000)
+	require.ErrorContains(t, err, "tx with the same nonce already exists")
+
+	require.NoError(t, s.MarkUnconfirmedTransactionPurgeable(ctx, 5, fromAddress))
+	require.Error(t, s.MarkUnconfirmedTransactionPurgeable(ctx, 6, fromAddress))
+	tx, _, err = s.FetchUnconfirmedTransactionAtNonceWithCount(ctx, 5, fromAddress)
+	require.NoError(t, err)
+	assert.True(t, tx.IsPurgeable)
+
+	require.NoError(t, s.MarkTxFatal(ctx, tx, fromAddress))
+	assert.Equal(t, txmgr.TxFatalError, tx.State)
+}
+
+func TestPostgresStore_FindTxWithIdempotencyKey(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	fromAddress := testutils.NewAddress()
+	s := NewPostgresStore(logger.Test(t), testutils.NewSqlxDB(t), testutils.FixtureChainID)
+	require.NoError(t, s.Add(fromAddress))
+
+	idempotencyKey := "1"
+	tx, err := s.FindTxWithIdempotencyKey(ctx, idempotencyKey)
+	require.NoError(t, err)
+	assert.Nil(t, tx)
+
+	created, err := s.CreateTransaction(ctx, &types.TxRequest{FromAddress: fromAddress, IdempotencyKey: &idempotencyKey})
+	require.NoError(t, err)
+	tx, err = s.FindTxWithIdempotencyKey(ctx, idempotencyKey)
+	require.NoError(t, err)
+	require.NotNil(t, tx)
+	assert.Equal(t, created.ID, tx.ID)
+}
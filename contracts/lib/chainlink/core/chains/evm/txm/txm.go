@@ -49,6 +49,12 @@ type TxStore interface {
 	MarkTxFatal(context.Context, *types.Transaction, common.Address) error
 }
 
+// PersistentTxStore is implemented by TxStores that survive restarts. The Txm uses it to recover the nonce after the
+// last one it assigned, so in-flight transactions of a previous run don't get their nonces reused.
+type PersistentTxStore interface {
+	FindNextNonce(context.Context, common.Address) (*uint64, error)
+}
+
 type AttemptBuilder interface {
 	NewAttempt(context.Context, logger.Logger, *types.Transaction, bool) (*types.Attempt, error)
 	NewBumpAttempt(context.Context, logger.Logger, *types.Transaction, types.Attempt) (*types.Attempt, error)
@@ -153,6 +159,23 @@ func (t *Txm) initializeNonce(ctx context.Context, address common.Address) {
 			}
 			continue
 		}
+		if persistentStore, ok := t.txStore.(PersistentTxStore); ok {
+			nextNonce, err := persistentStore.FindNextNonce(ctxWithTimeout, address)
+			if err != nil {
+				t.lggr.Errorw("Error when fetching stored nonce", "address", address, "err", err)
+				select {
+				case <-time.After(pendingNonceRecheckInterval):
+				case <-ctx.Done():
+					t.lggr.Errorw("context error", "err", context.Cause(ctx))
+					return
+				}
+				continue
+			}
+			if nextNonce != nil && *nextNonce > pendingNonce {
+				t.lggr.Infof("Stored nonce for address: %v is ahead of pending nonce: %d. Resuming from stored nonce: %d", address, pendingNonce, *nextNonce)
+				pendingNonce = *nextNonce
+			}
+		}
 		t.setNonce(address, pendingNonce)
 		t.lggr.Debugf("Set initial nonce for address: %v to %d", address, pendingNonce)
 		return
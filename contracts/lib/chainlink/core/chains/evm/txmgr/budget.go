@@ -0,0 +1,324 @@
+package txmgr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-framework/chains/fees"
+
+	"github.com/smartcontractkit/chainlink-integrations/evm/assets"
+	"github.com/smartcontractkit/chainlink-integrations/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txm/storage"
+	txmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// ErrBudgetExceeded is returned when a transaction would take a sending key or a job over its spend budget
+var ErrBudgetExceeded = errors.New("spend budget exceeded")
+
+var promBudgetRemaining = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "tx_manager_budget_remaining_wei",
+	Help: "Spend budget left to a sending key or a job within a rolling window, in wei.",
+}, []string{"evmChainID", "scope", "id", "window"})
+
+// BudgetConfig holds the spend limits of sending keys and jobs. Nil or zero limits are not enforced.
+type BudgetConfig interface {
+	KeyMaxPerHour(address common.Address) *assets.Wei
+	KeyMaxPerDay(address common.Address) *assets.Wei
+	JobMaxPerHour(jobID int32) *assets.Wei
+	JobMaxPerDay(jobID int32) *assets.Wei
+}
+
+type budgetWindow struct {
+	name     string
+	duration time.Duration
+	keyMax   func(common.Address) *assets.Wei
+	jobMax   func(int32) *assets.Wei
+}
+
+// SpendBudget enforces rolling spend limits per sending key and per job. Spend is the value plus the highest attempt
+// fee of every transaction created within the window, by either transaction manager, so a transaction counts once no
+// matter how often it was bumped. Transactions without an attempt yet count with their fee limit at the current gas
+// price.
+type SpendBudget struct {
+	lggr      logger.SugaredLogger
+	ds        sqlutil.DataSource
+	chainID   *big.Int
+	windows   []budgetWindow
+	estimator gas.EvmFeeEstimator
+	priceMax  func(common.Address) *assets.Wei
+
+	// keyLocks and jobLocks serialize checks with the creation of the transactions they allow, per sending key and
+	// per job, so concurrent sends can't overspend. The key is always locked before the job.
+	keyLocks utils.KeyedMutex
+	jobLocks utils.KeyedMutex
+}
+
+// NewSpendBudget returns a SpendBudget for chainID. The estimator prices the initial attempt of new transactions, up
+// to the priceMax of their sending key. Without an estimator, initial attempts count once they are created.
+func NewSpendBudget(lggr logger.Logger, ds sqlutil.DataSource, chainID *big.Int, cfg BudgetConfig, estimator gas.EvmFeeEstimator, priceMax func(common.Address) *assets.Wei) *SpendBudget {
+	return &SpendBudget{
+		lggr:    logger.Sugared(logger.Named(lggr, "SpendBudget")),
+		ds:      ds,
+		chainID: chainID,
+		windows: []budgetWindow{
+			{name: "hour", duration: time.Hour, keyMax: cfg.KeyMaxPerHour, jobMax: cfg.JobMaxPerHour},
+			{name: "day", duration: 24 * time.Hour, keyMax: cfg.KeyMaxPerDay, jobMax: cfg.JobMaxPerDay},
+		},
+		estimator: estimator,
+		priceMax:  priceMax,
+	}
+}
+
+// CreateWithinBudget calls create if the value of a new transaction, and the fee of its initial attempt, fit in the
+// budgets of its sending key or job. Otherwise, it returns ErrBudgetExceeded.
+func (b *SpendBudget) CreateWithinBudget(ctx context.Context, fromAddress common.Address, jobID *int32, value *big.Int, feeLimit uint64, create func() error) error {
+	defer b.lock(fromAddress, jobID)()
+
+	price, err := b.gasPrice(ctx, fromAddress)
+	if err != nil {
+		return err
+	}
+	amount := new(big.Int).Mul(price, new(big.Int).SetUint64(feeLimit))
+	if value != nil {
+		amount.Add(amount, value)
+	}
+	if err = b.check(ctx, fromAddress, jobID, amount, price); err != nil {
+		return err
+	}
+	return create()
+}
+
+// CheckBump returns ErrBudgetExceeded if replacing the attempts of etx with the bumped attempt does not fit in the
+// budgets of its sending key or job.
+func (b *SpendBudget) CheckBump(ctx context.Context, etx Tx, bumped TxAttempt) error {
+	var jobID *int32
+	meta, err := etx.GetMeta()
+	if err != nil {
+		return fmt.Errorf("failed to decode tx meta: %w", err)
+	}
+	if meta != nil {
+		jobID = meta.JobID
+	}
+
+	// Only the increase over the highest fee already accounted for counts against the budget
+	increase := new(big.Int).Sub(attemptFee(bumped), maxAttemptFee(etx.TxAttempts))
+	if increase.Sign() <= 0 {
+		return nil
+	}
+
+	defer b.lock(etx.FromAddress, jobID)()
+	price, err := b.gasPrice(ctx, etx.FromAddress)
+	if err != nil {
+		return err
+	}
+	return b.check(ctx, etx.FromAddress, jobID, increase, price)
+}
+
+// lock locks the budgets of the sending key and of the job, if any, and returns the function unlocking them.
+func (b *SpendBudget) lock(fromAddress common.Address, jobID *int32) (unlock func()) {
+	unlockKey := b.keyLocks.Lock(fromAddress)
+	if jobID == nil {
+		return unlockKey
+	}
+	unlockJob := b.jobLocks.Lock(*jobID)
+	return func() {
+		unlockJob()
+		unlockKey()
+	}
+}
+
+// gasPrice returns the price the initial attempt of a transaction sent from fromAddress would pay now.
+func (b *SpendBudget) gasPrice(ctx context.Context, fromAddress common.Address) (*big.Int, error) {
+	if b.estimator == nil {
+		return new(big.Int), nil
+	}
+	var maxPrice *assets.Wei
+	if b.priceMax != nil {
+		maxPrice = b.priceMax(fromAddress)
+	}
+	fee, _, err := b.estimator.GetFee(ctx, nil, 0, maxPrice, &fromAddress, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to estimate the fee of the initial attempt: %w", err)
+	}
+	switch {
+	case fee.GasPrice != nil:
+		return fee.GasPrice.ToInt(), nil
+	case fee.GasFeeCap != nil:
+		return fee.GasFeeCap.ToInt(), nil
+	default:
+		return new(big.Int), nil
+	}
+}
+
+func (b *SpendBudget) check(ctx context.Context, fromAddress common.Address, jobID *int32, amount, price *big.Int) error {
+	now := time.Now()
+	for _, w := range b.windows {
+		since := now.Add(-w.duration)
+		if limit := w.keyMax(fromAddress); isLimited(limit) {
+			spent, err := b.keySpend(ctx, fromAddress, since, price)
+			if err != nil {
+				return err
+			}
+			if err = b.checkLimit("key", fromAddress.String(), w.name, limit, spent, amount); err != nil {
+				return err
+			}
+		}
+		if jobID == nil {
+			continue
+		}
+		if limit := w.jobMax(*jobID); isLimited(limit) {
+			spent, err := b.jobSpend(ctx, *jobID, since, price)
+			if err != nil {
+				return err
+			}
+			if err = b.checkLimit("job", strconv.Itoa(int(*jobID)), w.name, limit, spent, amount); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (b *SpendBudget) checkLimit(scope, id, window string, limit *assets.Wei, spent, amount *big.Int) error {
+	remaining := new(big.Int).Sub(limit.ToInt(), spent)
+	f, _ := new(big.Float).SetInt(remaining).Float64()
+	promBudgetRemaining.WithLabelValues(b.chainID.String(), scope, id, window).Set(f)
+
+	if remaining.Cmp(amount) < 0 {
+		b.lggr.Warnw("Transaction rejected by spend budget", "scope", scope, "id", id, "window", window, "limit", limit, "spent", spent, "amount", amount)
+		return fmt.Errorf("%w: %s %s has %s wei left of its %s per %s", ErrBudgetExceeded, scope, id, remaining, limit, window)
+	}
+	return nil
+}
+
+// spendQuery sums the spend of the transactions of both transaction managers which match the filter %[1]s. $3 is the
+// current gas price, for the transactions without an attempt yet. The filter is applied to both tables, so that it can
+// use their from_address and meta->>'JobID' indexes.
+const spendQuery = `SELECT COALESCE(SUM(value + COALESCE(fee, gas_limit * $3::numeric)), 0) FROM (
+	SELECT t.value, t.gas_limit, (
+		SELECT MAX(a.chain_specific_gas_limit * COALESCE(a.gas_price, a.gas_fee_cap)) FROM evm.tx_attempts a WHERE a.eth_tx_id = t.id
+	) AS fee
+	FROM evm.txes t WHERE t.evm_chain_id = $1 AND t.created_at > $2 AND t.state <> 'fatal_error' AND %[1]s
+	UNION ALL
+	SELECT t.value, t.specified_gas_limit, (
+		SELECT MAX(a.gas_limit * COALESCE(a.gas_price, a.gas_fee_cap)) FROM evm.txm_v2_tx_attempts a WHERE a.tx_id = t.id
+	) AS fee
+	FROM evm.txm_v2_txes t WHERE t.chain_id = $1 AND t.created_at > $2 AND t.state <> 'fatal_error' AND %[1]s
+) spend`
+
+var (
+	keySpendQuery = fmt.Sprintf(spendQuery, "t.from_address = $4")
+	jobSpendQuery = fmt.Sprintf(spendQuery, "t.meta->>'JobID' = $4")
+)
+
+func (b *SpendBudget) keySpend(ctx context.Context, fromAddress common.Address, since time.Time, price *big.Int) (*big.Int, error) {
+	var spent assets.Wei
+	if err := b.ds.GetContext(ctx, &spent, keySpendQuery, b.chainID.String(), since, price.String(), fromAddress); err != nil {
+		return nil, fmt.Errorf("failed to load spend of key %s: %w", fromAddress, err)
+	}
+	return spent.ToInt(), nil
+}
+
+func (b *SpendBudget) jobSpend(ctx context.Context, jobID int32, since time.Time, price *big.Int) (*big.Int, error) {
+	var spent assets.Wei
+	if err := b.ds.GetContext(ctx, &spent, jobSpendQuery, b.chainID.String(), since, price.String(), strconv.Itoa(int(jobID))); err != nil {
+		return nil, fmt.Errorf("failed to load spend of job %d: %w", jobID, err)
+	}
+	return spent.ToInt(), nil
+}
+
+func isLimited(limit *assets.Wei) bool {
+	return limit != nil && limit.Cmp(assets.NewWeiI(0)) > 0
+}
+
+func attemptFee(attempt TxAttempt) *big.Int {
+	price := attempt.TxFee.GasPrice
+	if price == nil {
+		price = attempt.TxFee.GasFeeCap
+	}
+	if price == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Mul(price.ToInt(), new(big.Int).SetUint64(attempt.ChainSpecificFeeLimit))
+}
+
+func maxAttemptFee(attempts []TxAttempt) *big.Int {
+	highest := new(big.Int)
+	for _, attempt := range attempts {
+		if fee := attemptFee(attempt); fee.Cmp(highest) > 0 {
+			highest = fee
+		}
+	}
+	return highest
+}
+
+// budgetedTxStore enforces the spend budget when transactions are created, which both CreateTransaction and
+// SendNativeToken of the Txm go through.
+type budgetedTxStore struct {
+	TxStore
+	budget *SpendBudget
+}
+
+func (s *budgetedTxStore) CreateTransaction(ctx context.Context, txRequest TxRequest, chainID *big.Int) (tx Tx, err error) {
+	var jobID *int32
+	if txRequest.Meta != nil {
+		jobID = txRequest.Meta.JobID
+	}
+	err = s.budget.CreateWithinBudget(ctx, txRequest.FromAddress, jobID, &txRequest.Value, txRequest.FeeLimit, func() error {
+		tx, err = s.TxStore.CreateTransaction(ctx, txRequest, chainID)
+		return err
+	})
+	return tx, err
+}
+
+// budgetedPostgresStore enforces the spend budget when TxmV2 transactions are created.
+type budgetedPostgresStore struct {
+	*storage.PostgresStore
+	budget *SpendBudget
+}
+
+func (s *budgetedPostgresStore) CreateTransaction(ctx context.Context, txRequest *txmtypes.TxRequest) (tx *txmtypes.Transaction, err error) {
+	var jobID *int32
+	if txRequest.Meta != nil {
+		var meta txmtypes.TxMeta
+		if err = json.Unmarshal(*txRequest.Meta, &meta); err != nil {
+			return nil, fmt.Errorf("failed to decode tx meta: %w", err)
+		}
+		jobID = meta.JobID
+	}
+	err = s.budget.CreateWithinBudget(ctx, txRequest.FromAddress, jobID, txRequest.Value, txRequest.SpecifiedGasLimit, func() error {
+		tx, err = s.PostgresStore.CreateTransaction(ctx, txRequest)
+		return err
+	})
+	return tx, err
+}
+
+// budgetedTxAttemptBuilder refuses fee bumps exceeding a spend budget. The error wraps fees.ErrBumpFeeExceedsLimit so
+// the confirmer keeps rebroadcasting the previous attempt instead.
+type budgetedTxAttemptBuilder struct {
+	TxAttemptBuilder
+	budget *SpendBudget
+}
+
+func (b *budgetedTxAttemptBuilder) NewBumpTxAttempt(ctx context.Context, etx Tx, previousAttempt TxAttempt, priorAttempts []TxAttempt, lggr logger.Logger) (attempt TxAttempt, bumpedFee gas.EvmFee, bumpedFeeLimit uint64, retryable bool, err error) {
+	attempt, bumpedFee, bumpedFeeLimit, retryable, err = b.TxAttemptBuilder.NewBumpTxAttempt(ctx, etx, previousAttempt, priorAttempts, lggr)
+	if err != nil {
+		return attempt, bumpedFee, bumpedFeeLimit, retryable, err
+	}
+	if err = b.budget.CheckBump(ctx, etx, attempt); err != nil {
+		return attempt, bumpedFee, bumpedFeeLimit, false, fmt.Errorf("%w: %w", fees.ErrBumpFeeExceedsLimit, err)
+	}
+	return attempt, bumpedFee, bumpedFeeLimit, retryable, nil
+}
@@ -0,0 +1,116 @@
+package txmgr_test
+
+import (
+	"math/big"
+	"testing"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-integrations/evm/assets"
+	"github.com/smartcontractkit/chainlink-integrations/evm/gas"
+	gasmocks "github.com/smartcontractkit/chainlink-integrations/evm/gas/mocks"
+	"github.com/smartcontractkit/chainlink-integrations/evm/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+)
+
+type testBudgetConfig struct {
+	keyMaxPerHour *assets.Wei
+	jobMaxPerHour *assets.Wei
+}
+
+func (c testBudgetConfig) KeyMaxPerHour(gethCommon.Address) *assets.Wei { return c.keyMaxPerHour }
+func (c testBudgetConfig) KeyMaxPerDay(gethCommon.Address) *assets.Wei  { return nil }
+func (c testBudgetConfig) JobMaxPerDay(int32) *assets.Wei               { return nil }
+
+func (c testBudgetConfig) JobMaxPerHour(jobID int32) *assets.Wei {
+	if jobID == 1 {
+		return c.jobMaxPerHour
+	}
+	return nil
+}
+
+func TestSpendBudget(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	db := testutils.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	// Spends a value of 142 wei plus an attempt fee of 42 wei
+	etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 0, fromAddress)
+
+	created := 0
+	create := func() error {
+		created++
+		return nil
+	}
+
+	t.Run("key budget", func(t *testing.T) {
+		budget := txmgr.NewSpendBudget(logger.Test(t), db, testutils.FixtureChainID, testBudgetConfig{keyMaxPerHour: assets.NewWeiI(200)}, nil, nil)
+
+		created = 0
+		require.NoError(t, budget.CreateWithinBudget(ctx, fromAddress, nil, big.NewInt(16), 0, create))
+		require.ErrorIs(t, budget.CreateWithinBudget(ctx, fromAddress, nil, big.NewInt(17), 0, create), txmgr.ErrBudgetExceeded)
+		require.NoError(t, budget.CreateWithinBudget(ctx, testutils.NewAddress(), nil, big.NewInt(1000), 0, create))
+		require.Equal(t, 2, created, "transactions over budget must not be created")
+	})
+
+	t.Run("initial attempt fee", func(t *testing.T) {
+		estimator := gasmocks.NewEvmFeeEstimator(t)
+		estimator.On("GetFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(gas.EvmFee{GasPrice: assets.NewWeiI(1)}, uint64(0), nil)
+		budget := txmgr.NewSpendBudget(logger.Test(t), db, testutils.FixtureChainID, testBudgetConfig{keyMaxPerHour: assets.NewWeiI(200)}, estimator, nil)
+
+		require.NoError(t, budget.CreateWithinBudget(ctx, fromAddress, nil, big.NewInt(6), 10, create))
+		require.ErrorIs(t, budget.CreateWithinBudget(ctx, fromAddress, nil, big.NewInt(6), 11, create), txmgr.ErrBudgetExceeded)
+	})
+
+	t.Run("TxmV2 transactions", func(t *testing.T) {
+		v2Address := testutils.NewAddress()
+		_, err := db.ExecContext(ctx, `INSERT INTO evm.txm_v2_txes (chain_id, from_address, to_address, value, specified_gas_limit, created_at, state)
+			VALUES ($1, $2, $3, 50, 10, NOW(), 'unstarted')`, testutils.FixtureChainID.String(), v2Address, testutils.NewAddress())
+		require.NoError(t, err)
+
+		budget := txmgr.NewSpendBudget(logger.Test(t), db, testutils.FixtureChainID, testBudgetConfig{keyMaxPerHour: assets.NewWeiI(60)}, nil, nil)
+		require.NoError(t, budget.CreateWithinBudget(ctx, v2Address, nil, big.NewInt(10), 0, create))
+		require.ErrorIs(t, budget.CreateWithinBudget(ctx, v2Address, nil, big.NewInt(11), 0, create), txmgr.ErrBudgetExceeded)
+
+		// without an attempt yet, the fee limit counts at the current price
+		estimator := gasmocks.NewEvmFeeEstimator(t)
+		estimator.On("GetFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(gas.EvmFee{GasPrice: assets.NewWeiI(1)}, uint64(0), nil)
+		budget = txmgr.NewSpendBudget(logger.Test(t), db, testutils.FixtureChainID, testBudgetConfig{keyMaxPerHour: assets.NewWeiI(60)}, estimator, nil)
+		require.ErrorIs(t, budget.CreateWithinBudget(ctx, v2Address, nil, big.NewInt(1), 0, create), txmgr.ErrBudgetExceeded)
+	})
+
+	t.Run("bumps only count the fee increase", func(t *testing.T) {
+		budget := txmgr.NewSpendBudget(logger.Test(t), db, testutils.FixtureChainID, testBudgetConfig{keyMaxPerHour: assets.NewWeiI(200)}, nil, nil)
+
+		bumped := etx.TxAttempts[0]
+		bumped.ChainSpecificFeeLimit = 50
+		require.NoError(t, budget.CheckBump(ctx, etx, bumped))
+		bumped.TxFee = gas.EvmFee{GasPrice: assets.NewWeiI(2)}
+		require.ErrorIs(t, budget.CheckBump(ctx, etx, bumped), txmgr.ErrBudgetExceeded)
+	})
+
+	t.Run("job budget", func(t *testing.T) {
+		budget := txmgr.NewSpendBudget(logger.Test(t), db, testutils.FixtureChainID, testBudgetConfig{jobMaxPerHour: assets.NewWeiI(100)}, nil, nil)
+		jobID := int32(1)
+
+		require.NoError(t, budget.CreateWithinBudget(ctx, fromAddress, &jobID, big.NewInt(100), 0, create))
+		require.ErrorIs(t, budget.CreateWithinBudget(ctx, fromAddress, &jobID, big.NewInt(101), 0, create), txmgr.ErrBudgetExceeded)
+		require.NoError(t, budget.CreateWithinBudget(ctx, fromAddress, nil, big.NewInt(101), 0, create))
+
+		// budgets are per job
+		otherJobID := int32(2)
+		require.NoError(t, budget.CreateWithinBudget(ctx, fromAddress, &otherJobID, big.NewInt(101), 0, create))
+	})
+}
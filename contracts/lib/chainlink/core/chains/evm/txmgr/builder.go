@@ -45,6 +45,7 @@ func NewTxm(
 	estimator gas.EvmFeeEstimator,
 	headTracker latestAndFinalizedBlockHeadTracker,
 	txmv2wrapper TxManager,
+	budget *SpendBudget,
 ) (txm TxManager,
 	err error,
 ) {
@@ -66,14 +67,25 @@ func NewTxm(
 	evmBroadcaster := NewEvmBroadcaster(txStore, txmClient, txmCfg, feeCfg, txConfig, listenerConfig, keyStore, txAttemptBuilder, lggr, checker, chainConfig.NonceAutoSync(), chainConfig.ChainType())
 	evmTracker := NewEvmTracker(txStore, keyStore, chainID, lggr)
 	stuckTxDetector := NewStuckTxDetector(lggr, client.ConfiguredChainID(), chainConfig.ChainType(), fCfg.PriceMax(), txConfig.AutoPurge(), estimator, txStore, client)
-	evmConfirmer := NewEvmConfirmer(txStore, txmClient, feeCfg, txConfig, dbConfig, keyStore, txAttemptBuilder, lggr, stuckTxDetector, headTracker)
+	var confirmerAttemptBuilder TxAttemptBuilder = txAttemptBuilder
+	if budget != nil {
+		confirmerAttemptBuilder = &budgetedTxAttemptBuilder{TxAttemptBuilder: txAttemptBuilder, budget: budget}
+	}
+	// cancellations and speed ups are created by the confirmer, so they can't race its own bumps
+	replacer := NewEvmTxReplacer(lggr, txStore, txAttemptBuilder, budget, fCfg.BumpThreshold())
+	confirmerAttemptBuilder = replacer.ConfirmerAttemptBuilder(confirmerAttemptBuilder)
+	evmConfirmer := NewEvmConfirmer(replacer.ConfirmerTxStore(txStore), txmClient, feeCfg, txConfig, dbConfig, keyStore, confirmerAttemptBuilder, lggr, stuckTxDetector, headTracker)
 	evmFinalizer := NewEvmFinalizer(lggr, client.ConfiguredChainID(), chainConfig.RPCDefaultBatchSize(), txConfig.ForwardersEnabled(), txStore, txmClient, headTracker)
 	var evmResender *Resender
 	if txConfig.ResendAfterThreshold() > 0 {
 		evmResender = NewEvmResender(lggr, txStore, txmClient, evmTracker, keyStore, txmgr.DefaultResenderPollInterval, chainConfig, txConfig)
 	}
-	txm = NewEvmTxm(chainID, txmCfg, txConfig, keyStore, lggr, checker, fwdMgr, txAttemptBuilder, txStore, evmBroadcaster, evmConfirmer, evmResender, evmTracker, evmFinalizer, txmv2wrapper)
-	return txm, nil
+	var txmStore TxStore = txStore
+	if budget != nil {
+		txmStore = &budgetedTxStore{TxStore: txStore, budget: budget}
+	}
+	txm = NewEvmTxm(chainID, txmCfg, txConfig, keyStore, lggr, checker, fwdMgr, txAttemptBuilder, txmStore, evmBroadcaster, evmConfirmer, evmResender, evmTracker, evmFinalizer, txmv2wrapper)
+	return &evmTxm{TxManager: txm, EvmTxReplacer: replacer}, nil
 }
 
 // NewEvmTxm creates a new concrete EvmTxm
@@ -108,6 +120,7 @@ func NewTxmV2(
 	logPoller logpoller.LogPoller,
 	keyStore keystore.Eth,
 	estimator gas.EvmFeeEstimator,
+	budget *SpendBudget,
 ) (TxManager, error) {
 	var fwdMgr *forwarders.FwdMgr
 	if txConfig.ForwardersEnabled() {
@@ -129,7 +142,17 @@ func NewTxmV2(
 	}
 
 	attemptBuilder := txm.NewAttemptBuilder(chainID, fCfg.PriceMaxKey, estimator, keyStore)
-	inMemoryStoreManager := storage.NewInMemoryStoreManager(lggr, chainID)
+	var txStore interface {
+		txm.TxStore
+		txm.OrchestratorTxStore
+	}
+	if ds != nil && budget != nil {
+		txStore = &budgetedPostgresStore{PostgresStore: storage.NewPostgresStore(lggr, ds, chainID), budget: budget}
+	} else if ds != nil {
+		txStore = storage.NewPostgresStore(lggr, ds, chainID)
+	} else {
+		txStore = storage.NewInMemoryStoreManager(lggr, chainID)
+	}
 	config := txm.Config{
 		EIP1559:   fCfg.EIP1559DynamicFees(),
 		BlockTime: *txmV2Config.BlockTime(),
@@ -143,8 +166,8 @@ func NewTxmV2(
 	} else {
 		c = clientwrappers.NewChainClient(client)
 	}
-	t := txm.NewTxm(lggr, chainID, c, attemptBuilder, inMemoryStoreManager, stuckTxDetector, config, keyStore)
-	return txm.NewTxmOrchestrator(lggr, chainID, t, inMemoryStoreManager, fwdMgr, keyStore, attemptBuilder), nil
+	t := txm.NewTxm(lggr, chainID, c, attemptBuilder, txStore, stuckTxDetector, config, keyStore)
+	return txm.NewTxmOrchestrator(lggr, chainID, t, txStore, fwdMgr, keyStore, attemptBuilder), nil
 }
 
 // NewEvmResender creates a new concrete EvmResender
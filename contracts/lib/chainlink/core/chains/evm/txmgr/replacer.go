@@ -0,0 +1,281 @@
+package txmgr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-framework/chains/txmgr"
+
+	"github.com/smartcontractkit/chainlink-integrations/evm/gas"
+	evmtypes "github.com/smartcontractkit/chainlink-integrations/evm/types"
+)
+
+var (
+	// ErrTxNotReplaceable is returned when a cancellation or speed up is requested for a transaction that is not in flight
+	ErrTxNotReplaceable = errors.New("only unconfirmed transactions can be replaced")
+	// ErrReplacementPending is returned when a cancellation or speed up is requested for a transaction which already
+	// has one pending
+	ErrReplacementPending = errors.New("a replacement of this transaction is already pending")
+	// ErrReplacementNotSupported is returned by chains whose transaction manager can not replace transactions
+	ErrReplacementNotSupported = errors.New("transaction replacement is only supported by the EVM transaction manager, not by TXMv2, and requires gas bumping to be enabled")
+)
+
+// TxReplacer creates replacement attempts for in-flight transactions. Replacements are stored as regular attempts of
+// the original transaction, so the confirmer tracks them and keeps bumping them if they get stuck.
+type TxReplacer interface {
+	// CancelTransaction broadcasts a zero value self-transfer at the nonce of the transaction, with bumped fees.
+	CancelTransaction(ctx context.Context, txHash common.Hash) (TxAttempt, error)
+	// SpeedUpTransaction re-broadcasts the transaction with the given fees. A zero gasLimit keeps the original limit.
+	SpeedUpTransaction(ctx context.Context, txHash common.Hash, fee gas.EvmFee, gasLimit uint64) (TxAttempt, error)
+}
+
+var _ TxReplacer = (*EvmTxReplacer)(nil)
+
+// replacement is a cancellation or speed up waiting for the confirmer.
+type replacement struct {
+	fromAddress common.Address
+	cancel      bool
+	fee         gas.EvmFee
+	gasLimit    uint64
+	done        chan replacementResult
+}
+
+type replacementResult struct {
+	attempt TxAttempt
+	err     error
+}
+
+// EvmTxReplacer queues the cancellations and speed ups of transactions for the confirmer, which creates them instead of
+// its next fee bump of the transaction. Attempts are then only ever created by the confirmer, one at a time.
+type EvmTxReplacer struct {
+	lggr           logger.SugaredLogger
+	txStore        EvmTxStore
+	attemptBuilder TxAttemptBuilder
+	budget         *SpendBudget
+	enabled        bool
+
+	mu      sync.Mutex
+	pending map[int64]*replacement
+}
+
+// NewEvmTxReplacer returns an EvmTxReplacer, whose replacements are created by the confirmer using the TxStore and
+// TxAttemptBuilder it wraps. Replacements are rejected when gas bumping is disabled, as the confirmer never bumps then.
+func NewEvmTxReplacer(lggr logger.Logger, txStore EvmTxStore, attemptBuilder TxAttemptBuilder, budget *SpendBudget, gasBumpThreshold uint64) *EvmTxReplacer {
+	return &EvmTxReplacer{
+		lggr:           logger.Sugared(logger.Named(lggr, "TxReplacer")),
+		txStore:        txStore,
+		attemptBuilder: attemptBuilder,
+		budget:         budget,
+		enabled:        gasBumpThreshold > 0,
+		pending:        make(map[int64]*replacement),
+	}
+}
+
+func (r *EvmTxReplacer) CancelTransaction(ctx context.Context, txHash common.Hash) (TxAttempt, error) {
+	return r.replace(ctx, txHash, &replacement{cancel: true})
+}
+
+func (r *EvmTxReplacer) SpeedUpTransaction(ctx context.Context, txHash common.Hash, fee gas.EvmFee, gasLimit uint64) (TxAttempt, error) {
+	return r.replace(ctx, txHash, &replacement{fee: fee, gasLimit: gasLimit})
+}
+
+// replace queues the replacement of the transaction with txHash, and waits for the confirmer to create its attempt.
+func (r *EvmTxReplacer) replace(ctx context.Context, txHash common.Hash, rp *replacement) (attempt TxAttempt, err error) {
+	if !r.enabled {
+		return attempt, ErrReplacementNotSupported
+	}
+	etx, err := r.findReplaceableTx(ctx, txHash)
+	if err != nil {
+		return attempt, err
+	}
+	rp.fromAddress = etx.FromAddress
+	rp.done = make(chan replacementResult, 1)
+
+	r.mu.Lock()
+	if _, ok := r.pending[etx.ID]; ok {
+		r.mu.Unlock()
+		return attempt, fmt.Errorf("%w: transaction %d", ErrReplacementPending, etx.ID)
+	}
+	r.pending[etx.ID] = rp
+	r.mu.Unlock()
+
+	select {
+	case res := <-rp.done:
+		return res.attempt, res.err
+	case <-ctx.Done():
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if r.pending[etx.ID] == rp {
+			delete(r.pending, etx.ID)
+			return attempt, fmt.Errorf("replacement of transaction %d was not picked up by the confirmer: %w", etx.ID, ctx.Err())
+		}
+		// the confirmer took the replacement concurrently
+		res := <-rp.done
+		return res.attempt, res.err
+	}
+}
+
+func (r *EvmTxReplacer) findReplaceableTx(ctx context.Context, txHash common.Hash) (etx Tx, err error) {
+	tx, err := r.txStore.FindTxByHash(ctx, txHash)
+	if err != nil {
+		return etx, err
+	}
+	etx, err = r.txStore.FindTxWithAttempts(ctx, tx.ID)
+	if err != nil {
+		return etx, err
+	}
+	if etx.State != txmgr.TxUnconfirmed || etx.Sequence == nil || len(etx.TxAttempts) == 0 {
+		return etx, fmt.Errorf("%w: transaction %d is %s", ErrTxNotReplaceable, etx.ID, etx.State)
+	}
+	return etx, nil
+}
+
+// pendingFrom returns the IDs of the transactions sent from address with a pending replacement.
+func (r *EvmTxReplacer) pendingFrom(address common.Address) (ids []int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for id, rp := range r.pending {
+		if rp.fromAddress == address {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// take removes and returns the pending replacement of the transaction with etxID, if any.
+func (r *EvmTxReplacer) take(etxID int64) *replacement {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rp, ok := r.pending[etxID]
+	if ok {
+		delete(r.pending, etxID)
+	}
+	return rp
+}
+
+// newAttempt creates the replacement attempt of etx, whose latest attempt is previousAttempt.
+func (r *EvmTxReplacer) newAttempt(ctx context.Context, rp *replacement, etx Tx, previousAttempt TxAttempt, lggr logger.Logger) (attempt TxAttempt, err error) {
+	if rp.cancel {
+		// The purge attempt clears the payload and value, pointing it to the sender turns it into a self-transfer
+		cancelTx := etx
+		cancelTx.ToAddress = etx.FromAddress
+		attempt, err = r.attemptBuilder.NewPurgeTxAttempt(ctx, cancelTx, lggr)
+		if err != nil {
+			return attempt, fmt.Errorf("failed to create cancellation attempt: %w", err)
+		}
+		r.lggr.Infow("Cancelling transaction", "txID", etx.ID, "nonce", etx.Sequence, "fee", attempt.TxFee)
+	} else {
+		gasLimit := rp.gasLimit
+		if gasLimit == 0 {
+			gasLimit = etx.FeeLimit
+		}
+		// Keep a cancellation cancelled, as the confirmer does when bumping purge attempts
+		speedUpTx := etx
+		if previousAttempt.IsPurgeAttempt {
+			speedUpTx.ToAddress = etx.FromAddress
+			speedUpTx.EncodedPayload = []byte{}
+			speedUpTx.Value = *big.NewInt(0)
+		}
+		attempt, _, err = r.attemptBuilder.NewCustomTxAttempt(ctx, speedUpTx, rp.fee, gasLimit, previousAttempt.TxType, lggr)
+		if err != nil {
+			return attempt, fmt.Errorf("failed to create speed up attempt: %w", err)
+		}
+		attempt.IsPurgeAttempt = previousAttempt.IsPurgeAttempt
+		r.lggr.Infow("Speeding up transaction", "txID", etx.ID, "nonce", etx.Sequence, "fee", attempt.TxFee, "gasLimit", gasLimit)
+	}
+	if r.budget != nil {
+		if err = r.budget.CheckBump(ctx, etx, attempt); err != nil {
+			return attempt, err
+		}
+	}
+	return attempt, nil
+}
+
+// ConfirmerTxStore wraps the TxStore of the confirmer, so that the transactions with a pending replacement are bumped
+// on the next head, no matter how recent their latest attempt is.
+func (r *EvmTxReplacer) ConfirmerTxStore(txStore TxStore) TxStore {
+	return &replacingTxStore{TxStore: txStore, replacer: r}
+}
+
+// ConfirmerAttemptBuilder wraps the TxAttemptBuilder of the confirmer, so that the next bump of a transaction with a
+// pending replacement creates the replacement instead.
+func (r *EvmTxReplacer) ConfirmerAttemptBuilder(attemptBuilder TxAttemptBuilder) TxAttemptBuilder {
+	return &replacingTxAttemptBuilder{TxAttemptBuilder: attemptBuilder, replacer: r}
+}
+
+type replacingTxStore struct {
+	TxStore
+	replacer *EvmTxReplacer
+}
+
+func (s *replacingTxStore) FindTxsRequiringGasBump(ctx context.Context, address common.Address, blockNum, gasBumpThreshold, depth int64, chainID *big.Int) ([]*Tx, error) {
+	etxs, err := s.TxStore.FindTxsRequiringGasBump(ctx, address, blockNum, gasBumpThreshold, depth, chainID)
+	if err != nil {
+		return etxs, err
+	}
+	for _, id := range s.replacer.pendingFrom(address) {
+		if slices.ContainsFunc(etxs, func(etx *Tx) bool { return etx.ID == id }) {
+			continue
+		}
+		etx, err := s.replacer.txStore.FindTxWithAttempts(ctx, id)
+		if err != nil {
+			return etxs, err
+		}
+		if etx.State != txmgr.TxUnconfirmed || len(etx.TxAttempts) == 0 {
+			if rp := s.replacer.take(id); rp != nil {
+				rp.done <- replacementResult{err: fmt.Errorf("%w: transaction %d is %s", ErrTxNotReplaceable, etx.ID, etx.State)}
+			}
+			continue
+		}
+		etxs = append(etxs, &etx)
+	}
+	slices.SortFunc(etxs, func(a, b *Tx) int { return compareSequence(a.Sequence, b.Sequence) })
+	return etxs, nil
+}
+
+func compareSequence(a, b *evmtypes.Nonce) int {
+	switch {
+	case a == nil || b == nil:
+		return 0
+	case *a < *b:
+		return -1
+	case *a > *b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+type replacingTxAttemptBuilder struct {
+	TxAttemptBuilder
+	replacer *EvmTxReplacer
+}
+
+func (b *replacingTxAttemptBuilder) NewBumpTxAttempt(ctx context.Context, etx Tx, previousAttempt TxAttempt, priorAttempts []TxAttempt, lggr logger.Logger) (attempt TxAttempt, bumpedFee gas.EvmFee, bumpedFeeLimit uint64, retryable bool, err error) {
+	rp := b.replacer.take(etx.ID)
+	if rp == nil {
+		return b.TxAttemptBuilder.NewBumpTxAttempt(ctx, etx, previousAttempt, priorAttempts, lggr)
+	}
+	attempt, err = b.replacer.newAttempt(ctx, rp, etx, previousAttempt, lggr)
+	if err != nil {
+		// the transaction is bumped as usual instead
+		rp.done <- replacementResult{err: err}
+		return b.TxAttemptBuilder.NewBumpTxAttempt(ctx, etx, previousAttempt, priorAttempts, lggr)
+	}
+	attempt.Tx = etx
+	rp.done <- replacementResult{attempt: attempt}
+	return attempt, attempt.TxFee, attempt.ChainSpecificFeeLimit, false, nil
+}
+
+// evmTxm is the Txm of a chain, which replaces its transactions through its confirmer.
+type evmTxm struct {
+	TxManager
+	*EvmTxReplacer
+}
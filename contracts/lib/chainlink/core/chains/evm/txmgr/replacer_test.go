@@ -0,0 +1,152 @@
+package txmgr_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-framework/multinode"
+
+	"github.com/smartcontractkit/chainlink-integrations/evm/assets"
+	"github.com/smartcontractkit/chainlink-integrations/evm/client"
+	"github.com/smartcontractkit/chainlink-integrations/evm/client/clienttest"
+	evmconfig "github.com/smartcontractkit/chainlink-integrations/evm/config"
+	"github.com/smartcontractkit/chainlink-integrations/evm/config/configtest"
+	"github.com/smartcontractkit/chainlink-integrations/evm/gas"
+	"github.com/smartcontractkit/chainlink-integrations/evm/keystore"
+	"github.com/smartcontractkit/chainlink-integrations/evm/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+)
+
+func TestTxReplacer(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	db := testutils.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	ethClient := clienttest.NewClientWithDefaultChainID(t)
+	replacer, ec := newReplacingConfirmer(t, txStore, ethClient, configtest.NewChainScopedConfig(t, nil), ethKeyStore)
+	currentHead := int64(30)
+
+	// replace requests a replacement, and lets the confirmer process heads until it created the replacement
+	replace := func(t *testing.T, request func() (txmgr.TxAttempt, error)) (txmgr.TxAttempt, error) {
+		type result struct {
+			attempt txmgr.TxAttempt
+			err     error
+		}
+		done := make(chan result, 1)
+		go func() {
+			attempt, err := request()
+			done <- result{attempt, err}
+		}()
+		var res result
+		require.Eventually(t, func() bool {
+			assert.NoError(t, ec.RebroadcastWhereNecessary(ctx, currentHead))
+			select {
+			case res = <-done:
+				return true
+			default:
+				return false
+			}
+		}, tests.WaitTimeout(t), 10*time.Millisecond)
+		return res.attempt, res.err
+	}
+
+	t.Run("cancels an unconfirmed transaction with a self-transfer", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 0, fromAddress)
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == 0 && *tx.To() == fromAddress && tx.Value().Sign() == 0 && len(tx.Data()) == 0
+		}), fromAddress).Return(multinode.Successful, nil).Once()
+
+		attempt, err := replace(t, func() (txmgr.TxAttempt, error) {
+			return replacer.CancelTransaction(ctx, etx.TxAttempts[0].Hash)
+		})
+		require.NoError(t, err)
+		assert.True(t, attempt.IsPurgeAttempt)
+		assert.Equal(t, etx.ID, attempt.Tx.ID)
+
+		etx, err = txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		require.Len(t, etx.TxAttempts, 2)
+		assert.Equal(t, attempt.Hash, etx.TxAttempts[0].Hash)
+	})
+
+	t.Run("speeds up an unconfirmed transaction with the given fee", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, fromAddress)
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == 1 && tx.GasPrice().Cmp(big.NewInt(1000)) == 0 && tx.Gas() == 50_000
+		}), fromAddress).Return(multinode.Successful, nil).Once()
+
+		attempt, err := replace(t, func() (txmgr.TxAttempt, error) {
+			return replacer.SpeedUpTransaction(ctx, etx.TxAttempts[0].Hash, gas.EvmFee{GasPrice: assets.NewWeiI(1000)}, 50_000)
+		})
+		require.NoError(t, err)
+		assert.False(t, attempt.IsPurgeAttempt)
+		assert.Equal(t, uint64(50_000), attempt.ChainSpecificFeeLimit)
+
+		etx, err = txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		require.Len(t, etx.TxAttempts, 2)
+		assert.Equal(t, attempt.Hash, etx.TxAttempts[0].Hash)
+	})
+
+	t.Run("gives up if the confirmer does not pick up the replacement", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 2, fromAddress)
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer cancel()
+		_, err := replacer.SpeedUpTransaction(timeoutCtx, etx.TxAttempts[0].Hash, gas.EvmFee{GasPrice: assets.NewWeiI(2)}, 0)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		// the replacement is no longer pending
+		require.NoError(t, ec.RebroadcastWhereNecessary(ctx, currentHead))
+		etx, err = txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		require.Len(t, etx.TxAttempts, 1)
+	})
+
+	t.Run("rejects transactions that are not in flight", func(t *testing.T) {
+		etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 3, 1, fromAddress)
+
+		_, err := replacer.CancelTransaction(ctx, etx.TxAttempts[0].Hash)
+		require.ErrorIs(t, err, txmgr.ErrTxNotReplaceable)
+	})
+
+	t.Run("rejects replacements without gas bumping", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 4, fromAddress)
+
+		disabled := txmgr.NewEvmTxReplacer(logger.Test(t), txStore, nil, nil, 0)
+		_, err := disabled.CancelTransaction(ctx, etx.TxAttempts[0].Hash)
+		require.ErrorIs(t, err, txmgr.ErrReplacementNotSupported)
+	})
+}
+
+// newReplacingConfirmer returns a confirmer which creates the replacements of the returned replacer, as the Txm does.
+func newReplacingConfirmer(t *testing.T, txStore txmgr.EvmTxStore, ethClient client.Client, config evmconfig.ChainScopedConfig, ks keystore.Eth) (*txmgr.EvmTxReplacer, *txmgr.Confirmer) {
+	lggr := logger.Test(t)
+	ge := config.EVM().GasEstimator()
+	estimator := gas.NewEvmFeeEstimator(lggr, func(lggr logger.Logger) gas.EvmEstimator {
+		return gas.NewFixedPriceEstimator(ge, nil, ge.BlockHistory(), lggr, nil)
+	}, ge.EIP1559DynamicFees(), ge, ethClient)
+	txBuilder := txmgr.NewEvmTxAttemptBuilder(*ethClient.ConfiguredChainID(), ge, ks, estimator)
+	replacer := txmgr.NewEvmTxReplacer(lggr, txStore, txBuilder, nil, ge.BumpThreshold())
+	stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), config.EVM().Transactions().AutoPurge(), estimator, txStore, ethClient)
+	ht := headtracker.NewSimulatedHeadTracker(ethClient, true, 0)
+	ec := txmgr.NewEvmConfirmer(replacer.ConfirmerTxStore(txStore), txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmFeeConfig(ge), config.EVM().Transactions(), confirmerConfig{}, ks, replacer.ConfirmerAttemptBuilder(txBuilder), lggr, stuckTxDetector, ht)
+	servicetest.Run(t, ec)
+	return replacer, ec
+}
@@ -85,6 +85,7 @@ func makeTestEvmTxm(
 		keyStore,
 		estimator,
 		ht,
+		nil,
 		nil)
 }
 
@@ -129,6 +129,11 @@ type FeatureConfig interface {
 	LogPoller() bool
 }
 
+type TxmBudgetConfig interface {
+	Enabled() bool
+	txmgr.BudgetConfig
+}
+
 type ChainRelayOpts struct {
 	Logger   logger.Logger
 	KeyStore keystore.Eth
@@ -140,6 +145,8 @@ type ChainOpts struct {
 	DatabaseConfig txmgr.DatabaseConfig
 	FeatureConfig  FeatureConfig
 	ListenerConfig txmgr.ListenerConfig
+	// TxmBudgetConfig is optional, spend budgets are not enforced without it
+	TxmBudgetConfig TxmBudgetConfig
 
 	MailMon      *mailbox.Monitor
 	GasEstimator gas.EvmFeeEstimator
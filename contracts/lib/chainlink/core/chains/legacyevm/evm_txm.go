@@ -40,6 +40,10 @@ func newEvmTxm(
 	)
 
 	if opts.GenTxManager == nil {
+		var budget *txmgr.SpendBudget
+		if opts.TxmBudgetConfig != nil && opts.TxmBudgetConfig.Enabled() {
+			budget = txmgr.NewSpendBudget(lggr, ds, chainID, opts.TxmBudgetConfig, estimator, cfg.GasEstimator().PriceMaxKey)
+		}
 		var txmv2 txmgr.TxManager
 		if cfg.Transactions().TransactionManagerV2().Enabled() {
 			txmv2, err = txmgr.NewTxmV2(
@@ -53,6 +57,7 @@ func newEvmTxm(
 				logPoller,
 				opts.KeyStore,
 				estimator,
+				budget,
 			)
 			if cfg.Transactions().TransactionManagerV2().DualBroadcast() == nil || !*cfg.Transactions().TransactionManagerV2().DualBroadcast() {
 				return txmv2, err
@@ -72,7 +77,8 @@ func newEvmTxm(
 			opts.KeyStore,
 			estimator,
 			headTracker,
-			txmv2)
+			txmv2,
+			budget)
 	} else {
 		txm = opts.GenTxManager(chainID)
 	}
@@ -98,3 +104,12 @@ func newGasEstimator(
 	}
 	return
 }
+
+// TxReplacer returns the TxReplacer of the chain's transaction manager, which cancels and speeds up its transactions,
+// or txmgr.ErrReplacementNotSupported if it can't, like TXMv2.
+func TxReplacer(chain Chain) (txmgr.TxReplacer, error) {
+	if replacer, ok := chain.TxManager().(txmgr.TxReplacer); ok {
+		return replacer, nil
+	}
+	return nil, txmgr.ErrReplacementNotSupported
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
@@ -19,13 +20,32 @@ import (
 
 	cutils "github.com/smartcontractkit/chainlink-common/pkg/utils"
 
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
 func initAdminSubCmds(s *Shell) []cli.Command {
 	return []cli.Command{
+		{
+			Name:  "audit",
+			Usage: "Commands for the local audit log",
+			Subcommands: cli.Commands{
+				{
+					Name:   "verify",
+					Usage:  "Verify the hash chain of an audit log file",
+					Action: s.VerifyAuditLog,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "password, p",
+							Usage: "text file holding the keystore password of the node which wrote the log",
+						},
+					},
+				},
+			},
+		},
 		{
 			Name:   "chpass",
 			Usage:  "Change your API password remotely",
@@ -68,6 +88,108 @@ func initAdminSubCmds(s *Shell) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:  "roles",
+			Usage: "Create, edit, delete or assign custom roles limiting what API users can do",
+			Subcommands: cli.Commands{
+				{
+					Name:   "list",
+					Usage:  "Lists all custom roles and their permissions",
+					Action: s.ListCustomRoles,
+				},
+				{
+					Name:   "create",
+					Usage:  "Create a new custom role",
+					Action: s.CreateCustomRole,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "name",
+							Usage:    "Name of new custom role to create",
+							Required: true,
+						},
+						cli.StringFlag{
+							Name:  "description",
+							Usage: "Description of new custom role",
+						},
+						cli.StringSliceFlag{
+							Name:     "permission",
+							Usage:    "Permission of the role, as [!]<action>:<resource>[/<id>], e.g. 'edit:bridge_types', 'run:jobs/42' or '!view:config'. Can be repeated.",
+							Required: true,
+						},
+					},
+				},
+				{
+					Name:   "update",
+					Usage:  "Replaces the description and permissions of a custom role",
+					Action: s.UpdateCustomRole,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "name",
+							Usage:    "Name of custom role to update",
+							Required: true,
+						},
+						cli.StringFlag{
+							Name:  "description",
+							Usage: "New description of custom role",
+						},
+						cli.StringSliceFlag{
+							Name:     "permission",
+							Usage:    "New permission of the role, as [!]<action>:<resource>[/<id>]. Can be repeated.",
+							Required: true,
+						},
+					},
+				},
+				{
+					Name:   "delete",
+					Usage:  "Delete a custom role not assigned to any API user",
+					Action: s.DeleteCustomRole,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "name",
+							Usage:    "Name of custom role to delete",
+							Required: true,
+						},
+					},
+				},
+				{
+					Name:   "assign",
+					Usage:  "Assign a custom role to an API user, or their API token",
+					Action: s.AssignCustomRole,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "email",
+							Usage:    "Email of API user to assign the role to",
+							Required: true,
+						},
+						cli.StringFlag{
+							Name:     "role",
+							Usage:    "Name of custom role to assign",
+							Required: true,
+						},
+						cli.BoolFlag{
+							Name:  "token",
+							Usage: "Assign the role to the API token of the user, further limiting requests authenticated by it",
+						},
+					},
+				},
+				{
+					Name:   "unassign",
+					Usage:  "Remove the custom role of an API user, or their API token",
+					Action: s.UnassignCustomRole,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "email",
+							Usage:    "Email of API user to remove the role from",
+							Required: true,
+						},
+						cli.BoolFlag{
+							Name:  "token",
+							Usage: "Remove the role of the API token of the user",
+						},
+					},
+				},
+			},
+		},
 		{
 			Name:   "status",
 			Usage:  "Displays the health of various services running inside the node.",
@@ -139,13 +261,15 @@ type AdminUsersPresenter struct {
 	presenters.UserResource
 }
 
-var adminUsersTableHeaders = []string{"Email", "Role", "Has API token", "Created at", "Updated at"}
+var adminUsersTableHeaders = []string{"Email", "Role", "Has API token", "Custom role", "API token custom role", "Created at", "Updated at"}
 
 func (p *AdminUsersPresenter) ToRow() []string {
 	row := []string{
 		p.ID,
 		string(p.Role),
 		p.HasActiveApiToken,
+		p.CustomRole,
+		p.TokenCustomRole,
 		p.CreatedAt.String(),
 		p.UpdatedAt.String(),
 	}
@@ -302,6 +426,160 @@ func (s *Shell) DeleteUser(c *cli.Context) (err error) {
 	return s.renderAPIResponse(response, &AdminUsersPresenter{}, "Successfully deleted API user")
 }
 
+type AdminCustomRolesPresenter struct {
+	JAID
+	presenters.CustomRoleResource
+}
+
+var adminCustomRolesTableHeaders = []string{"Name", "Description", "Permissions", "Created at", "Updated at"}
+
+func (p *AdminCustomRolesPresenter) ToRow() []string {
+	return []string{
+		p.ID,
+		p.Description,
+		strings.Join(p.Permissions, "\n"),
+		p.CreatedAt.String(),
+		p.UpdatedAt.String(),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *AdminCustomRolesPresenter) RenderTable(rt RendererTable) error {
+	renderList(adminCustomRolesTableHeaders, [][]string{p.ToRow()}, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+type AdminCustomRolesPresenters []AdminCustomRolesPresenter
+
+// RenderTable implements TableRenderer
+func (ps AdminCustomRolesPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("Custom Roles\n")); err != nil {
+		return err
+	}
+	renderList(adminCustomRolesTableHeaders, rows, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+// ListCustomRoles renders all custom roles and their permissions
+func (s *Shell) ListCustomRoles(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/roles", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &AdminCustomRolesPresenters{})
+}
+
+// CreateCustomRole creates a new custom role
+func (s *Shell) CreateCustomRole(c *cli.Context) (err error) {
+	request := web.CustomRoleRequest{
+		Name:        c.String("name"),
+		Description: c.String("description"),
+		Permissions: c.StringSlice("permission"),
+	}
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/roles", bytes.NewBuffer(requestData))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &AdminCustomRolesPresenter{}, "Successfully created new custom role")
+}
+
+// UpdateCustomRole replaces the description and permissions of a custom role
+func (s *Shell) UpdateCustomRole(c *cli.Context) (err error) {
+	request := web.CustomRoleRequest{
+		Description: c.String("description"),
+		Permissions: c.StringSlice("permission"),
+	}
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Patch(s.ctx(), "/v2/roles/"+url.PathEscape(c.String("name")), bytes.NewBuffer(requestData))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &AdminCustomRolesPresenter{}, "Successfully updated custom role")
+}
+
+// DeleteCustomRole deletes a custom role by name
+func (s *Shell) DeleteCustomRole(c *cli.Context) (err error) {
+	resp, err := s.HTTP.Delete(s.ctx(), "/v2/roles/"+url.PathEscape(c.String("name")))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &AdminCustomRolesPresenter{}, "Successfully deleted custom role")
+}
+
+// AssignCustomRole assigns a custom role to an API user, or their API token
+func (s *Shell) AssignCustomRole(c *cli.Context) error {
+	return s.setCustomRole(c.String("email"), c.String("role"), c.Bool("token"), "Successfully assigned custom role")
+}
+
+// UnassignCustomRole removes the custom role of an API user, or their API token
+func (s *Shell) UnassignCustomRole(c *cli.Context) error {
+	return s.setCustomRole(c.String("email"), "", c.Bool("token"), "Successfully removed custom role")
+}
+
+func (s *Shell) setCustomRole(email, role string, token bool, msg string) (err error) {
+	request := web.AssignCustomRoleRequest{
+		CustomRole: role,
+		Token:      token,
+	}
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Patch(s.ctx(), fmt.Sprintf("/v2/users/%s/custom_role", url.PathEscape(email)), bytes.NewBuffer(requestData))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &AdminUsersPresenter{}, msg)
+}
+
 // Status will display the health of various services
 func (s *Shell) Status(c *cli.Context) error {
 	resp, err := s.HTTP.Get(s.ctx(), "/health?full=1", nil)
@@ -317,6 +595,53 @@ func (s *Shell) Status(c *cli.Context) error {
 	return s.renderAPIResponse(resp, &HealthCheckPresenters{})
 }
 
+// VerifyAuditLog checks the hash chain of an audit log file written with AuditLogger.HashChain enabled, and that
+// the log ends with its signed head, if the file has one.
+func (s *Shell) VerifyAuditLog(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the path of the audit log file"))
+	}
+	if c.String("password") == "" {
+		return s.errorOut(errors.New("must pass the keystore password file with --password"))
+	}
+	password, err := utils.PasswordFromFile(c.String("password"))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	key := audit.ChainKey(password)
+
+	path := c.Args().First()
+	f, err := os.Open(path)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := f.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	v, err := audit.VerifyChain(f, key)
+	if err != nil {
+		return s.errorOut(fmt.Errorf("audit log verification failed after %d valid records: %w", v.Records, err))
+	}
+	head, err := audit.ReadHead(path+audit.HeadSuffix, key)
+	if err != nil {
+		return s.errorOut(fmt.Errorf("audit log verification failed: %w", err))
+	}
+	if head != "" && head != v.LastHash {
+		return s.errorOut(fmt.Errorf("audit log verification failed: the last record does not match the signed head %s, records have been removed", head))
+	}
+	fmt.Printf("Verified %d audit log records\n", v.Records)
+	if v.FirstPrevHash != "" {
+		fmt.Printf("First record continues the chain of hash %s\n", v.FirstPrevHash)
+	}
+	if v.LastHash != "" {
+		fmt.Printf("Last hash: %s\n", v.LastHash)
+	}
+	return nil
+}
+
 // Profile will collect pprof metrics and store them in a folder.
 func (s *Shell) Profile(c *cli.Context) error {
 	ctx := s.ctx()
@@ -5,6 +5,8 @@ import (
 	"flag"
 	"fmt"
 	"math/rand"
+	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 	"time"
@@ -17,6 +19,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/cmd"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
@@ -165,6 +168,35 @@ func TestShell_ListUsers(t *testing.T) {
 	assert.Truef(t, userPresenterFound, "expected to find user %s in presenter list", user.Email)
 }
 
+func TestShell_VerifyAuditLog(t *testing.T) {
+	t.Parallel()
+
+	client := cmd.Shell{}
+	dir := t.TempDir()
+
+	set := flag.NewFlagSet("test", 0)
+	require.ErrorContains(t, client.VerifyAuditLog(cli.NewContext(nil, set, nil)), "must pass the path of the audit log file")
+
+	path := filepath.Join(dir, "audit.jsonl")
+	passwordFile := filepath.Join(dir, "password.txt")
+	require.NoError(t, os.WriteFile(passwordFile, []byte("password"), 0o600))
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.VerifyAuditLog, set, "")
+	require.NoError(t, set.Parse([]string{path}))
+	require.ErrorContains(t, client.VerifyAuditLog(cli.NewContext(nil, set, nil)), "must pass the keystore password file")
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"eventID":"AUTH_LOGIN_SUCCESS_NO_2FA"}`+"\n"), 0o600))
+	require.NoError(t, set.Set("password", passwordFile))
+	require.ErrorContains(t, client.VerifyAuditLog(cli.NewContext(nil, set, nil)), "line 1: record has no hash")
+
+	require.NoError(t, os.WriteFile(path, nil, 0o600))
+	require.NoError(t, client.VerifyAuditLog(cli.NewContext(nil, set, nil)))
+
+	// an empty log does not match a signed head
+	require.NoError(t, os.WriteFile(path+audit.HeadSuffix, []byte(`{"hash":"00","mac":"00"}`), 0o600))
+	require.ErrorContains(t, client.VerifyAuditLog(cli.NewContext(nil, set, nil)), "head signature mismatch")
+}
+
 func TestAdminUsersPresenter_RenderTable(t *testing.T) {
 	user := sessions.User{
 		Email:     "foo@bar.com",
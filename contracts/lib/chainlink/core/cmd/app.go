@@ -137,6 +137,73 @@ func NewApp(s *Shell) *cli.App {
 		}
 		return nil
 	}
+
+	// localFlags and localBefore load the node's configuration for commands which must be run locally
+	localFlags := []cli.Flag{
+		cli.StringSliceFlag{
+			Name:  "config, c",
+			Usage: "TOML configuration file(s) via flag, or raw TOML via env var. If used, legacy env vars must not be set. Multiple files can be used (-c configA.toml -c configB.toml), and they are applied in order with duplicated fields overriding any earlier values. If the 'CL_CONFIG' env var is specified, it is always processed last with the effect of being the final override. [$CL_CONFIG]",
+		},
+		cli.StringSliceFlag{
+			Name:  "secrets, s",
+			Usage: "TOML configuration file for secrets. Must be set if and only if config is set. Multiple files can be used (-s secretsA.toml -s secretsB.toml), and fields from the files will be merged. No overrides are allowed.",
+		},
+	}
+	localBefore := func(c *cli.Context) error {
+		errNoDuplicateFlags := fmt.Errorf("multiple commands with --config or --secrets flags. only one command may specify these flags. when secrets are used, they must be specific together in the same command")
+		if c.IsSet("config") {
+			if s.configFilesIsSet || s.secretsFileIsSet {
+				return errNoDuplicateFlags
+			}
+			s.configFiles = c.StringSlice("config")
+		}
+
+		if c.IsSet("secrets") {
+			if s.configFilesIsSet || s.secretsFileIsSet {
+				return errNoDuplicateFlags
+			}
+			s.secretsFiles = c.StringSlice("secrets")
+		}
+
+		// flags here, or ENV VAR only
+		cfg, err := initServerConfig(&opts, s.configFiles, s.secretsFiles)
+		if err != nil {
+			return err
+		}
+		s.Config = cfg
+
+		logFileMaxSizeMB := s.Config.Log().File().MaxSize() / utils.MB
+		if logFileMaxSizeMB > 0 {
+			err = utils.EnsureDirAndMaxPerms(s.Config.Log().File().Dir(), os.FileMode(0700))
+			if err != nil {
+				return err
+			}
+		}
+
+		// Swap out the logger, replacing the old one.
+		err = s.CloseLogger()
+		if err != nil {
+			return err
+		}
+
+		lggrCfg := logger.Config{
+			LogLevel:       s.Config.Log().Level(),
+			Dir:            s.Config.Log().File().Dir(),
+			JsonConsole:    s.Config.Log().JSONConsole(),
+			UnixTS:         s.Config.Log().UnixTimestamps(),
+			FileMaxSizeMB:  int(logFileMaxSizeMB),
+			FileMaxAgeDays: int(s.Config.Log().File().MaxAgeDays()),
+			FileMaxBackups: int(s.Config.Log().File().MaxBackups()),
+			SentryEnabled:  s.Config.Sentry().DSN() != "",
+		}
+		l, closeFn := lggrCfg.New()
+
+		s.Logger = l
+		s.CloseLogger = closeFn
+
+		return nil
+	}
+
 	app.Commands = removeHidden([]cli.Command{
 		{
 			Name:        "admin",
@@ -188,7 +255,7 @@ func NewApp(s *Shell) *cli.App {
 		{
 			Name:  "keys",
 			Usage: "Commands for managing various types of keys used by the Chainlink node",
-			Subcommands: []cli.Command{
+			Subcommands: append([]cli.Command{
 				// TODO unify init vs keysCommand
 				// out of scope for initial refactor because it breaks usage messages.
 				initEthKeysSubCmd(s),
@@ -204,7 +271,32 @@ func NewApp(s *Shell) *cli.App {
 				keysCommand("Tron", NewTronKeysClient(s)),
 
 				initVRFKeysSubCmd(s),
-			},
+				{
+					Name:        "rotate-password",
+					Usage:       "Re-encrypt the keystore with a new password",
+					Description: "Must be run on the same machine as the Chainlink node, while the node is stopped. Password.Keystore must be set to the new password before the node is started again. The secrets of webhook trigger tokens are re-encrypted, and a hash chained audit log file is rotated, so that every file verifies with a single password. Encrypted database backups taken before the rotation are restored with node db restore --old-password.",
+					Flags: append([]cli.Flag{
+						cli.StringFlag{
+							Name:  "password, p",
+							Usage: "text file holding the current keystore password. Defaults to Password.Keystore",
+						},
+						cli.StringFlag{
+							Name:  "new-password",
+							Usage: "text file holding the new keystore password. Prompted for if not set",
+						},
+						cli.IntFlag{
+							Name:  "scrypt-n",
+							Usage: "scrypt N (CPU/memory cost) parameter to encrypt the keystore with, a power of two. Defaults to the standard parameter, or the insecure fast one if Insecure.InsecureFastScrypt is set",
+						},
+						cli.IntFlag{
+							Name:  "scrypt-p",
+							Usage: "scrypt p (parallelization) parameter to encrypt the keystore with. Defaults like --scrypt-n",
+						},
+					}, localFlags...),
+					Before: localBefore,
+					Action: s.RotateKeystorePassword,
+				},
+			}, initKeyBundleSubCmds(s)...),
 		},
 		{
 			Name:        "node",
@@ -212,70 +304,8 @@ func NewApp(s *Shell) *cli.App {
 			Usage:       "Commands for admin actions that must be run locally",
 			Description: "Commands can only be run from on the same machine as the Chainlink node.",
 			Subcommands: initLocalSubCmds(s, build.IsProd()),
-			Flags: []cli.Flag{
-				cli.StringSliceFlag{
-					Name:  "config, c",
-					Usage: "TOML configuration file(s) via flag, or raw TOML via env var. If used, legacy env vars must not be set. Multiple files can be used (-c configA.toml -c configB.toml), and they are applied in order with duplicated fields overriding any earlier values. If the 'CL_CONFIG' env var is specified, it is always processed last with the effect of being the final override. [$CL_CONFIG]",
-				},
-				cli.StringSliceFlag{
-					Name:  "secrets, s",
-					Usage: "TOML configuration file for secrets. Must be set if and only if config is set. Multiple files can be used (-s secretsA.toml -s secretsB.toml), and fields from the files will be merged. No overrides are allowed.",
-				},
-			},
-			Before: func(c *cli.Context) error {
-				errNoDuplicateFlags := fmt.Errorf("multiple commands with --config or --secrets flags. only one command may specify these flags. when secrets are used, they must be specific together in the same command")
-				if c.IsSet("config") {
-					if s.configFilesIsSet || s.secretsFileIsSet {
-						return errNoDuplicateFlags
-					}
-					s.configFiles = c.StringSlice("config")
-				}
-
-				if c.IsSet("secrets") {
-					if s.configFilesIsSet || s.secretsFileIsSet {
-						return errNoDuplicateFlags
-					}
-					s.secretsFiles = c.StringSlice("secrets")
-				}
-
-				// flags here, or ENV VAR only
-				cfg, err := initServerConfig(&opts, s.configFiles, s.secretsFiles)
-				if err != nil {
-					return err
-				}
-				s.Config = cfg
-
-				logFileMaxSizeMB := s.Config.Log().File().MaxSize() / utils.MB
-				if logFileMaxSizeMB > 0 {
-					err = utils.EnsureDirAndMaxPerms(s.Config.Log().File().Dir(), os.FileMode(0700))
-					if err != nil {
-						return err
-					}
-				}
-
-				// Swap out the logger, replacing the old one.
-				err = s.CloseLogger()
-				if err != nil {
-					return err
-				}
-
-				lggrCfg := logger.Config{
-					LogLevel:       s.Config.Log().Level(),
-					Dir:            s.Config.Log().File().Dir(),
-					JsonConsole:    s.Config.Log().JSONConsole(),
-					UnixTS:         s.Config.Log().UnixTimestamps(),
-					FileMaxSizeMB:  int(logFileMaxSizeMB),
-					FileMaxAgeDays: int(s.Config.Log().File().MaxAgeDays()),
-					FileMaxBackups: int(s.Config.Log().File().MaxBackups()),
-					SentryEnabled:  s.Config.Sentry().DSN() != "",
-				}
-				l, closeFn := lggrCfg.New()
-
-				s.Logger = l
-				s.CloseLogger = closeFn
-
-				return nil
-			},
+			Flags:       localFlags,
+			Before:      localBefore,
 		},
 		{
 			Name:        "initiators",
@@ -306,6 +336,16 @@ func NewApp(s *Shell) *cli.App {
 			Usage:       "Commands for managing forwarder addresses.",
 			Subcommands: initFowardersSubCmds(s),
 		},
+		{
+			Name:        "functions",
+			Usage:       "Commands for inspecting Functions requests",
+			Subcommands: initFunctionsSubCmds(s),
+		},
+		{
+			Name:        "s4",
+			Usage:       "Commands for inspecting S4 storage",
+			Subcommands: initS4SubCmds(s),
+		},
 		{
 			Name:  "help-all",
 			Usage: "Shows a list of all commands and sub-commands",
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
 
 	"github.com/urfave/cli"
@@ -50,16 +51,38 @@ func (p *BridgePresenter) FriendlyConfirmations() string {
 	return strconv.FormatUint(uint64(p.Confirmations), 10)
 }
 
+// FriendlyCachePolicy summarises the cache policy of the bridge
+func (p *BridgePresenter) FriendlyCachePolicy() string {
+	if p.CacheFreshTTL == 0 && p.CacheStaleTTL == 0 && !p.CoalesceRequests {
+		return "none"
+	}
+	return fmt.Sprintf("fresh %s, stale %s, coalesce %t", p.CacheFreshTTL.Duration(), p.CacheStaleTTL.Duration(), p.CoalesceRequests)
+}
+
 // RenderTable implements TableRenderer
 func (p *BridgePresenter) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"Name", "URL", "Default Confirmations", "Outgoing Token"})
+	table := rt.newTable([]string{"Name", "URL", "Default Confirmations", "Outgoing Token", "Cache Policy"})
 	table.Append([]string{
 		p.Name,
 		p.URL,
 		p.FriendlyConfirmations(),
 		p.OutgoingToken,
+		p.FriendlyCachePolicy(),
 	})
 	render("Bridge", table)
+
+	if len(p.Health) > 0 {
+		healthTable := rt.newTable([]string{"URL", "Circuit", "Consecutive Failures", "Last Error"})
+		for _, h := range p.Health {
+			healthTable.Append([]string{
+				h.URL,
+				string(h.State),
+				strconv.FormatUint(uint64(h.ConsecutiveFailures), 10),
+				h.LastError,
+			})
+		}
+		render("Bridge Health", healthTable)
+	}
 	return nil
 }
 
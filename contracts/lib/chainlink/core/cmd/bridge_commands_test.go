@@ -15,6 +15,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/cmd"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -49,6 +50,23 @@ func TestBridgePresenter_RenderTable(t *testing.T) {
 	assert.Contains(t, output, url)
 	assert.Contains(t, output, "10")
 	assert.Contains(t, output, outgoingToken)
+	assert.Contains(t, output, "none")
+
+	buffer.Reset()
+	withPolicy := p
+	withPolicy.CacheFreshTTL = models.Interval(time.Minute)
+	withPolicy.CoalesceRequests = true
+	require.NoError(t, withPolicy.RenderTable(r))
+	assert.Contains(t, buffer.String(), "fresh 1m0s, stale 0s, coalesce true")
+
+	buffer.Reset()
+	withHealth := p
+	withHealth.Health = []bridges.URLHealth{{URL: "http://backup.example.com", State: bridges.CircuitOpen, ConsecutiveFailures: 3, LastError: "connection refused"}}
+	require.NoError(t, withHealth.RenderTable(r))
+	output = buffer.String()
+	assert.Contains(t, output, "http://backup.example.com")
+	assert.Contains(t, output, "open")
+	assert.Contains(t, output, "connection refused")
 
 	// Render many resources
 	buffer.Reset()
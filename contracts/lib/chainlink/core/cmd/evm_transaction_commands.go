@@ -62,6 +62,34 @@ func initEVMTxSubCmd(s *Shell) cli.Command {
 				Usage:  "get information on a specific Ethereum Transaction",
 				Action: s.ShowTransaction,
 			},
+			{
+				Name:   "cancel",
+				Usage:  "Cancel an unconfirmed Ethereum Transaction by replacing it with a zero value self-transfer",
+				Action: s.CancelTransaction,
+			},
+			{
+				Name:   "speedup",
+				Usage:  "Re-send an unconfirmed Ethereum Transaction with higher fees",
+				Action: s.SpeedUpTransaction,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "gas-price",
+						Usage: "gas price for legacy transactions, e.g. 20gwei",
+					},
+					cli.StringFlag{
+						Name:  "gas-tip-cap",
+						Usage: "gas tip cap for dynamic fee transactions, e.g. 2gwei",
+					},
+					cli.StringFlag{
+						Name:  "gas-fee-cap",
+						Usage: "gas fee cap for dynamic fee transactions, e.g. 100gwei",
+					},
+					cli.Uint64Flag{
+						Name:  "gas-limit",
+						Usage: "gas limit of the replacement, defaults to the original limit",
+					},
+				},
+			},
 		},
 	}
 }
@@ -211,3 +239,68 @@ func (s *Shell) SendEther(c *cli.Context) (err error) {
 	err = s.renderAPIResponse(resp, &EthTxPresenter{})
 	return err
 }
+
+// CancelTransaction replaces the given unconfirmed transaction with a zero
+// value self-transfer at the same nonce
+func (s *Shell) CancelTransaction(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the hash of the transaction"))
+	}
+	hash := c.Args().First()
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/transactions/evm/"+hash+"/cancel", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	err = s.renderAPIResponse(resp, &EthTxPresenter{})
+	return err
+}
+
+// SpeedUpTransaction re-sends the given unconfirmed transaction with the
+// specified fees
+func (s *Shell) SpeedUpTransaction(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the hash of the transaction"))
+	}
+	hash := c.Args().First()
+
+	request := models.SpeedUpTransactionRequest{GasLimit: c.Uint64("gas-limit")}
+	for flag, fee := range map[string]**assets.Wei{
+		"gas-price":   &request.GasPrice,
+		"gas-tip-cap": &request.GasTipCap,
+		"gas-fee-cap": &request.GasFeeCap,
+	} {
+		if !c.IsSet(flag) {
+			continue
+		}
+		wei := new(assets.Wei)
+		if err = wei.UnmarshalText([]byte(c.String(flag))); err != nil {
+			return s.errorOut(multierr.Combine(
+				fmt.Errorf("while parsing %s", flag), err))
+		}
+		*fee = wei
+	}
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/transactions/evm/"+hash+"/speedup", bytes.NewBuffer(requestData))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	err = s.renderAPIResponse(resp, &EthTxPresenter{})
+	return err
+}
@@ -0,0 +1,203 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initFunctionsSubCmds(s *Shell) []cli.Command {
+	filterFlags := []cli.Flag{
+		cli.StringFlag{
+			Name:  "state",
+			Usage: "only include requests in these comma separated states (InProgress, ResultReady, TimedOut, Finalized, Confirmed)",
+		},
+		cli.StringFlag{
+			Name:  "from",
+			Usage: "only include requests received at or after this time (RFC3339)",
+		},
+		cli.StringFlag{
+			Name:  "to",
+			Usage: "only include requests received before this time (RFC3339)",
+		},
+	}
+	contractFlag := cli.StringFlag{
+		Name:     "contract",
+		Usage:    "address of the Functions contract which received the requests",
+		Required: true,
+	}
+
+	return []cli.Command{
+		{
+			Name:  "requests",
+			Usage: "Commands for inspecting the lifecycle of Functions requests",
+			Subcommands: cli.Commands{
+				{
+					Name:   "list",
+					Usage:  "List the requests of a contract, most recently received first",
+					Action: s.ListFunctionsRequests,
+					Flags: append([]cli.Flag{
+						contractFlag,
+						cli.IntFlag{
+							Name:  "page",
+							Usage: "page of results to display",
+						},
+					}, filterFlags...),
+				},
+				{
+					Name:   "show",
+					Usage:  "Show a request, and the time spent between its state transitions",
+					Action: s.ShowFunctionsRequest,
+					Flags:  []cli.Flag{contractFlag},
+				},
+				{
+					Name:   "errors",
+					Usage:  "Count the failed requests of a contract per error type",
+					Action: s.CountFunctionsRequestErrors,
+					Flags:  append([]cli.Flag{contractFlag}, filterFlags...),
+				},
+			},
+		},
+	}
+}
+
+// FunctionsRequestPresenter wraps the JSONAPI Functions Request Resource and adds rendering functionality
+type FunctionsRequestPresenter struct {
+	JAID
+	presenters.FunctionsRequestResource
+}
+
+// ToRow presents the FunctionsRequestResource as a slice of strings.
+func (p *FunctionsRequestPresenter) ToRow() []string {
+	return []string{
+		p.RequestID,
+		p.State,
+		p.ErrorType,
+		p.ReceivedAt.Format(time.RFC3339),
+		p.ResultDuration,
+		p.FinalizeDuration,
+		p.ConfirmDuration,
+		p.TimeoutDuration,
+	}
+}
+
+var functionsRequestHeaders = []string{"Request ID", "State", "Error Type", "Received At", "Result", "Finalize", "Confirm", "Timeout"}
+
+// RenderTable implements TableRenderer
+func (p *FunctionsRequestPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Transition", "At", "Duration"})
+	table.Append([]string{"Received", p.ReceivedAt.Format(time.RFC3339), ""})
+	for _, t := range []struct {
+		name     string
+		at       *time.Time
+		duration string
+	}{
+		{"ResultReady", p.ResultReadyAt, p.ResultDuration},
+		{"Finalized", p.FinalizedAt, p.FinalizeDuration},
+		{"Confirmed", p.ConfirmedAt, p.ConfirmDuration},
+		{"TimedOut", p.TimedOutAt, p.TimeoutDuration},
+	} {
+		if t.at != nil {
+			table.Append([]string{t.name, t.at.Format(time.RFC3339), t.duration})
+		}
+	}
+	render(fmt.Sprintf("Functions Request %s (%s)", p.RequestID, p.State), table)
+
+	if p.ErrorType != "" {
+		errTable := rt.newTable([]string{"Error Type", "Error"})
+		errTable.Append([]string{p.ErrorType, p.Error})
+		render("Error", errTable)
+	}
+	return nil
+}
+
+// FunctionsRequestPresenters implements TableRenderer for a slice of FunctionsRequestPresenter
+type FunctionsRequestPresenters []FunctionsRequestPresenter
+
+// RenderTable implements TableRenderer
+func (ps FunctionsRequestPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable(functionsRequestHeaders)
+	for _, p := range ps {
+		table.Append(p.ToRow())
+	}
+	render("Functions Requests", table)
+	return nil
+}
+
+// FunctionsRequestErrorPresenter wraps the JSONAPI Functions Request Error Resource
+type FunctionsRequestErrorPresenter struct {
+	JAID
+	presenters.FunctionsRequestErrorResource
+}
+
+// FunctionsRequestErrorPresenters implements TableRenderer for a slice of FunctionsRequestErrorPresenter
+type FunctionsRequestErrorPresenters []FunctionsRequestErrorPresenter
+
+// RenderTable implements TableRenderer
+func (ps FunctionsRequestErrorPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Error Type", "Count"})
+	for _, p := range ps {
+		table.Append([]string{p.ErrorType, fmt.Sprintf("%d", p.Count)})
+	}
+	render("Functions Request Errors", table)
+	return nil
+}
+
+// ListFunctionsRequests lists the Functions requests of a contract
+func (s *Shell) ListFunctionsRequests(c *cli.Context) (err error) {
+	return s.getPage("/v2/functions/requests?"+functionsRequestsQuery(c).Encode(), c.Int("page"), &FunctionsRequestPresenters{})
+}
+
+// ShowFunctionsRequest displays a Functions request of a contract
+func (s *Shell) ShowFunctionsRequest(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the ID of the request to show"))
+	}
+	q := url.Values{}
+	q.Set("contract", c.String("contract"))
+
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/functions/requests/"+c.Args().First()+"?"+q.Encode())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &FunctionsRequestPresenter{})
+}
+
+// CountFunctionsRequestErrors displays the number of failed Functions requests of a contract per error type
+func (s *Shell) CountFunctionsRequestErrors(c *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/functions/request_errors?"+functionsRequestsQuery(c).Encode())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &FunctionsRequestErrorPresenters{})
+}
+
+// functionsRequestsQuery returns the query selecting the requests of the contract matching the filter flags.
+func functionsRequestsQuery(c *cli.Context) url.Values {
+	q := url.Values{}
+	q.Set("contract", c.String("contract"))
+	for _, name := range []string{"state", "from", "to"} {
+		if v := c.String(name); v != "" {
+			q.Set(name, v)
+		}
+	}
+	return q
+}
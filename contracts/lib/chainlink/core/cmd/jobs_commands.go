@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"os"
 	"strings"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
@@ -50,6 +52,70 @@ func initJobsSubCmds(s *Shell) []cli.Command {
 			Usage:  "Trigger a job run",
 			Action: s.TriggerPipelineRun,
 		},
+		{
+			Name:   "simulate",
+			Usage:  "Dry-run a job against the given pipeline variables and show a trace of every task",
+			Action: s.SimulateJob,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "vars",
+					Usage: "path to a JSON file of pipeline variables",
+				},
+				cli.StringFlag{
+					Name:  "stubs",
+					Usage: "path to a JSON file of canned {\"value\", \"error\"} task responses keyed by task dot ID",
+				},
+			},
+		},
+		{
+			Name:  "runs",
+			Usage: "Commands for managing the runs of a job",
+			Subcommands: cli.Commands{
+				{
+					Name:   "prune",
+					Usage:  "Delete the runs of a job which are outside of its run retention policy",
+					Action: s.PruneJobRuns,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "job",
+							Usage:    "ID of the job whose runs to prune",
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:  "webhook-token",
+			Usage: "Commands for managing the trigger tokens of a webhook job",
+			Subcommands: cli.Commands{
+				{
+					Name:   "create",
+					Usage:  "Create a trigger token for a webhook job, whose secret is only shown once",
+					Action: s.CreateWebhookTriggerToken,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "job",
+							Usage:    "ID of the webhook job the token may run",
+							Required: true,
+						},
+						cli.StringSliceFlag{
+							Name:  "allowed-ips",
+							Usage: "IP or CIDR range requests are accepted from, can be repeated. Requests are accepted from any source if not set",
+						},
+						cli.UintFlag{
+							Name:  "rate-limit",
+							Usage: "number of requests accepted per minute, 0 is unlimited",
+						},
+					},
+				},
+				{
+					Name:   "revoke",
+					Usage:  "Revoke a trigger token by its access key",
+					Action: s.RevokeWebhookTriggerToken,
+				},
+			},
+		},
 	}
 }
 
@@ -313,3 +379,179 @@ func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	err = s.renderAPIResponse(resp, &run, "Pipeline run successfully triggered")
 	return err
 }
+
+// SimulationPresenter wraps the JSONAPI Simulation Resource and adds rendering functionality
+type SimulationPresenter struct {
+	JAID
+	presenters.SimulationResource
+}
+
+// RenderTable implements TableRenderer
+func (p *SimulationPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Task", "Type", "Params", "Output", "Error", "Duration", "Retries", "Stubbed"})
+	for _, t := range p.Trace {
+		params, _ := t.Params.MarshalJSON()
+		output, _ := t.Output.MarshalJSON()
+		var errStr string
+		if t.Error != nil {
+			errStr = *t.Error
+		}
+		table.Append([]string{
+			t.DotID,
+			string(t.Type),
+			string(params),
+			string(output),
+			errStr,
+			t.Duration,
+			fmt.Sprintf("%d", t.Retries),
+			fmt.Sprintf("%t", t.Stubbed),
+		})
+	}
+
+	render(fmt.Sprintf("Simulation of job %s", p.ID), table)
+	return nil
+}
+
+// SimulateJob dry-runs a job without persisting anything
+func (s *Shell) SimulateJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the job id to simulate"))
+	}
+
+	var req pipeline.SimulateRequest
+	if path := c.String("vars"); path != "" {
+		if err = readJSONFile(path, &req.Vars); err != nil {
+			return s.errorOut(errors.Wrap(err, "invalid vars file"))
+		}
+	}
+	if path := c.String("stubs"); path != "" {
+		if err = readJSONFile(path, &req.Stubs); err != nil {
+			return s.errorOut(errors.Wrap(err, "invalid stubs file"))
+		}
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/"+c.Args().First()+"/simulate", bytes.NewReader(body))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &SimulationPresenter{})
+}
+
+// PrunedRunsPresenter wraps the JSONAPI Pruned Runs Resource and adds rendering functionality
+type PrunedRunsPresenter struct {
+	JAID
+	presenters.PrunedRunsResource
+}
+
+// RenderTable implements TableRenderer
+func (p *PrunedRunsPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Job ID", "Deleted Runs"})
+	table.Append([]string{p.ID, fmt.Sprintf("%d", p.Deleted)})
+	render("Pruned Runs", table)
+	return nil
+}
+
+// PruneJobRuns deletes the runs of a job which are outside of its run retention policy
+func (s *Shell) PruneJobRuns(c *cli.Context) (err error) {
+	jobID := c.String("job")
+	if jobID == "" {
+		return s.errorOut(errors.New("must pass the job id with --job"))
+	}
+
+	resp, err := s.HTTP.Delete(s.ctx(), "/v2/jobs/"+jobID+"/runs")
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &PrunedRunsPresenter{})
+}
+
+// WebhookTriggerTokenPresenter wraps the JSONAPI Webhook Trigger Token Resource and adds rendering functionality
+type WebhookTriggerTokenPresenter struct {
+	JAID
+	presenters.WebhookTriggerTokenResource
+}
+
+// RenderTable implements TableRenderer
+func (p *WebhookTriggerTokenPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Job ID", "Access Key", "Secret", "Allowed IPs", "Rate Limit"})
+	table.Append([]string{
+		fmt.Sprintf("%d", p.JobID),
+		p.AccessKey,
+		p.Secret,
+		strings.Join(p.AllowedIPs, ", "),
+		fmt.Sprintf("%d", p.RateLimit),
+	})
+	render("Webhook Trigger Token", table)
+	return nil
+}
+
+// CreateWebhookTriggerToken creates a trigger token for a webhook job
+func (s *Shell) CreateWebhookTriggerToken(c *cli.Context) (err error) {
+	jobID := c.String("job")
+	if jobID == "" {
+		return s.errorOut(errors.New("must pass the job id with --job"))
+	}
+
+	body, err := json.Marshal(webhook.TriggerTokenRequest{
+		AllowedIPs: c.StringSlice("allowed-ips"),
+		RateLimit:  uint32(c.Uint("rate-limit")),
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/"+jobID+"/webhook_tokens", bytes.NewReader(body))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &WebhookTriggerTokenPresenter{}, "Webhook trigger token created. The secret is only shown once.")
+}
+
+// RevokeWebhookTriggerToken revokes a webhook trigger token
+func (s *Shell) RevokeWebhookTriggerToken(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the access key of the token to revoke"))
+	}
+
+	resp, err := s.HTTP.Delete(s.ctx(), "/v2/webhook_tokens/"+c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+	_, err = s.parseResponse(resp)
+	return err
+}
+
+func readJSONFile(path string, dst interface{}) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, dst)
+}
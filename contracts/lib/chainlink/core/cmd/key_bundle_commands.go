@@ -0,0 +1,187 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	cutils "github.com/smartcontractkit/chainlink-common/pkg/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initKeyBundleSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "export-all",
+			Usage: format(`Exports every key of the node, of all types, into a single encrypted bundle.`),
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "new-password, newpassword, p",
+					Usage: "`FILE` containing the password to encrypt the bundle (required)",
+				},
+				cli.StringFlag{
+					Name:  "output, o",
+					Usage: "`FILE` where the bundle will be saved (required)",
+				},
+			},
+			Action: s.ExportAllKeys,
+		},
+		{
+			Name:  "import-all",
+			Usage: format(`Imports every key of a bundle created with export-all. Its CSA and Workflow keys replace those of the node. Nothing is imported if any key conflicts with an existing key.`),
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "old-password, oldpassword, p",
+					Usage: "`FILE` containing the password used to encrypt the bundle (required)",
+				},
+				cli.BoolFlag{
+					Name:  "dry-run",
+					Usage: "list the keys which would be imported, and whether they conflict with existing keys, without importing them",
+				},
+			},
+			Action: s.ImportAllKeys,
+		},
+	}
+}
+
+type KeyBundleEntryPresenter struct {
+	JAID
+	presenters.KeyBundleEntryResource
+}
+
+func (p *KeyBundleEntryPresenter) ToRow() []string {
+	return []string{p.KeyType, p.ID, strconv.FormatBool(p.Conflict), strconv.FormatBool(p.Exists), p.Replaces}
+}
+
+type KeyBundleEntryPresenters []KeyBundleEntryPresenter
+
+// RenderTable implements TableRenderer
+func (ps KeyBundleEntryPresenters) RenderTable(rt RendererTable) error {
+	headers := []string{"Type", "ID", "Conflict", "Exists", "Replaces"}
+	rows := [][]string{}
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("🔑 Key Bundle\n")); err != nil {
+		return err
+	}
+	renderList(headers, rows, rt.Writer)
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+// ExportAllKeys exports every key of the node into a single bundle.
+func (s *Shell) ExportAllKeys(c *cli.Context) (err error) {
+	newPasswordFile := c.String("new-password")
+	if len(newPasswordFile) == 0 {
+		return s.errorOut(errors.New("Must specify --new-password/-p flag"))
+	}
+
+	newPassword, err := os.ReadFile(newPasswordFile)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "Could not read password file"))
+	}
+
+	filepath := c.String("output")
+	if len(filepath) == 0 {
+		return s.errorOut(errors.New("Must specify --output/-o flag"))
+	}
+
+	exportUrl := url.URL{
+		Path: "/v2/keys/export-all",
+	}
+
+	query := exportUrl.Query()
+	query.Set("newpassword", normalizePassword(string(newPassword)))
+
+	exportUrl.RawQuery = query.Encode()
+	resp, err := s.HTTP.Post(s.ctx(), exportUrl.String(), nil)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "Could not make HTTP request"))
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return s.errorOut(fmt.Errorf("error exporting: %w", httpError(resp)))
+	}
+
+	bundleJSON, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "Could not read response body"))
+	}
+
+	err = utils.WriteFileWithMaxPerms(filepath, bundleJSON, 0o600)
+	if err != nil {
+		return s.errorOut(errors.Wrapf(err, "Could not write %v", filepath))
+	}
+
+	_, err = os.Stderr.WriteString(fmt.Sprintf("🔑 Exported all keys to %s\n", filepath))
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	return nil
+}
+
+// ImportAllKeys imports every key of a bundle. Path to the bundle must be passed.
+func (s *Shell) ImportAllKeys(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("Must pass the filepath of the bundle to be imported"))
+	}
+
+	oldPasswordFile := c.String("old-password")
+	if len(oldPasswordFile) == 0 {
+		return s.errorOut(errors.New("Must specify --old-password/-p flag"))
+	}
+	oldPassword, err := os.ReadFile(oldPasswordFile)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "Could not read password file"))
+	}
+
+	filepath := c.Args().Get(0)
+	bundleJSON, err := os.ReadFile(filepath)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	importUrl := url.URL{
+		Path: "/v2/keys/import-all",
+	}
+
+	query := importUrl.Query()
+	query.Set("oldpassword", normalizePassword(string(oldPassword)))
+	dryRun := c.Bool("dry-run")
+	if dryRun {
+		query.Set("dryRun", "true")
+	}
+
+	importUrl.RawQuery = query.Encode()
+	resp, err := s.HTTP.Post(s.ctx(), importUrl.String(), bytes.NewReader(bundleJSON))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if dryRun {
+		return s.renderAPIResponse(resp, &KeyBundleEntryPresenters{}, "🔑 Dry run, no keys were imported")
+	}
+	return s.renderAPIResponse(resp, &KeyBundleEntryPresenters{}, "🔑 Imported all keys")
+}
@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initS4SubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:   "list",
+			Usage:  "List the S4 rows of a namespace, without their payloads",
+			Action: s.ListS4Rows,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "namespace",
+					Usage: "namespace of the S4 rows to list, shared by all the jobs storing data in it",
+					Value: "functions",
+				},
+				cli.IntFlag{
+					Name:  "page",
+					Usage: "page of results to display",
+				},
+				cli.StringFlag{
+					Name:  "expiration-from",
+					Usage: "only list rows expiring at or after this unix time in milliseconds",
+				},
+				cli.StringFlag{
+					Name:  "expiration-to",
+					Usage: "only list rows expiring before this unix time in milliseconds",
+				},
+			},
+		},
+		{
+			Name:   "usage",
+			Usage:  "Show the S4 storage used by an address in the namespace of a job, and the limits of the job",
+			Action: s.ShowS4Usage,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:     "job",
+					Usage:    "ID of the job whose S4 limits to show",
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+// S4RowPresenter wraps the JSONAPI S4 Row Resource and adds rendering functionality
+type S4RowPresenter struct {
+	JAID
+	presenters.S4RowResource
+}
+
+// ToRow presents the S4RowResource as a slice of strings.
+func (p *S4RowPresenter) ToRow() []string {
+	return []string{
+		p.Address,
+		fmt.Sprintf("%d", p.SlotID),
+		fmt.Sprintf("%d", p.Version),
+		time.UnixMilli(p.Expiration).UTC().Format(time.RFC3339),
+		strconv.FormatBool(p.Confirmed),
+		fmt.Sprintf("%d", p.PayloadSize),
+	}
+}
+
+var s4RowHeaders = []string{"Address", "Slot ID", "Version", "Expiration", "Confirmed", "Payload Size"}
+
+// S4RowPresenters implements TableRenderer for a slice of S4RowPresenter
+type S4RowPresenters []S4RowPresenter
+
+// RenderTable implements TableRenderer
+func (ps S4RowPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable(s4RowHeaders)
+	for _, p := range ps {
+		table.Append(p.ToRow())
+	}
+	render("S4 Rows", table)
+	return nil
+}
+
+// ListS4Rows lists the S4 rows of a namespace
+func (s *Shell) ListS4Rows(c *cli.Context) (err error) {
+	namespace := c.String("namespace")
+	if namespace == "" {
+		return s.errorOut(errors.New("must pass the namespace with --namespace"))
+	}
+	q := url.Values{}
+	if from := c.String("expiration-from"); from != "" {
+		q.Set("expirationFrom", from)
+	}
+	if to := c.String("expiration-to"); to != "" {
+		q.Set("expirationTo", to)
+	}
+	requestURI := "/v2/s4/" + url.PathEscape(namespace)
+	if len(q) > 0 {
+		requestURI += "?" + q.Encode()
+	}
+	return s.getPage(requestURI, c.Int("page"), &S4RowPresenters{})
+}
+
+// S4UsagePresenter wraps the JSONAPI S4 Usage Resource and adds rendering functionality
+type S4UsagePresenter struct {
+	JAID
+	presenters.S4UsageResource
+}
+
+// RenderTable implements TableRenderer
+func (p *S4UsagePresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Address", "Slots Used", "Max Slots", "Payload Bytes", "Max Payload Bytes"})
+	table.Append([]string{
+		p.ID,
+		fmt.Sprintf("%d", p.SlotsUsed),
+		fmt.Sprintf("%d", p.MaxSlots),
+		fmt.Sprintf("%d", p.PayloadBytes),
+		fmt.Sprintf("%d", p.MaxPayloadBytes),
+	})
+	render("S4 Usage", table)
+	return nil
+}
+
+// ShowS4Usage displays the S4 storage used by an address
+func (s *Shell) ShowS4Usage(c *cli.Context) (err error) {
+	jobID := c.String("job")
+	if jobID == "" {
+		return s.errorOut(errors.New("must pass the job id with --job"))
+	}
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the address to show the usage of"))
+	}
+
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/jobs/"+jobID+"/s4/usage/"+c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &S4UsagePresenter{})
+}
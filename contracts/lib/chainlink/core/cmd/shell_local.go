@@ -23,6 +23,7 @@ import (
 	gethCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/fatih/color"
+	"github.com/jackc/pgx/v4"
 	"github.com/jmoiron/sqlx"
 	"github.com/kylelemons/godebug/diff"
 	"github.com/lib/pq"
@@ -42,8 +43,12 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/build"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/periodicbackup"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/shutdown"
 	"github.com/smartcontractkit/chainlink/v2/core/static"
@@ -223,6 +228,27 @@ func initLocalSubCmds(s *Shell, safe bool) []cli.Command {
 					Before: s.validateDB,
 					Flags:  []cli.Flag{},
 				},
+				{
+					Name:   "restore",
+					Usage:  "Restore the database from a backup taken by the node. WARNING: This will REPLACE ALL DATA in the database, referred to by CL_DATABASE_URL env variable or by the Database.URL field in a secrets TOML config.",
+					Action: s.RestoreDatabase,
+					Before: s.validateDB,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "from",
+							Usage:    "path of the backup file, or the name of a backup stored at Database.Backup.Destination",
+							Required: true,
+						},
+						cli.StringFlag{
+							Name:  "old-password",
+							Usage: "file containing the keystore password the backup was encrypted with, if the keystore password has been rotated since",
+						},
+						cli.BoolFlag{
+							Name:  "yes, y",
+							Usage: "skip the confirmation prompt",
+						},
+					},
+				},
 				{
 					Name:   "create-migration",
 					Usage:  "Create a new migration.",
@@ -998,6 +1024,107 @@ func (s *Shell) VersionDatabase(_ *cli.Context) error {
 	return nil
 }
 
+// RestoreDatabase replaces the data in the database with a backup taken by the node, after migrating the database
+// to the migration the backup was taken at.
+func (s *Shell) RestoreDatabase(c *cli.Context) error {
+	ctx := s.ctx()
+	cfg := s.Config.Database()
+	parsed := cfg.URL()
+	if parsed.String() == "" {
+		return s.errorOut(errDBURLMissing)
+	}
+
+	from := c.String("from")
+	path := from
+	if _, err := os.Stat(from); err != nil {
+		// not a local file, so it is the name of a stored backup
+		dir, err := periodicbackup.OutputDir(s.Config.RootDir(), cfg.Backup())
+		if err != nil {
+			return s.errorOut(err)
+		}
+		destination, err := periodicbackup.NewDestination(cfg.Backup(), dir)
+		if err != nil {
+			return s.errorOut(err)
+		}
+		tmp, err := os.CreateTemp("", "cl_restore_")
+		if err != nil {
+			return s.errorOut(err)
+		}
+		path = tmp.Name()
+		defer os.Remove(path)
+		if err = tmp.Close(); err != nil {
+			return s.errorOut(err)
+		}
+		s.Logger.Infof("Downloading backup %s", destination.Location(from))
+		if err = destination.Get(ctx, from, path); err != nil {
+			return s.errorOut(errors.Wrapf(err, "failed to get backup %s", destination.Location(from)))
+		}
+	}
+
+	header, err := periodicbackup.ReadHeader(path)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	if header.NodeVersion != static.Version {
+		return s.errorOut(fmt.Errorf("backup was taken by node version %s, but this node is version %s: restore it with the node version which took it", header.NodeVersion, static.Version))
+	}
+	fmt.Printf("Restoring backup taken by node version %s at %s, at migration %d, in %s mode, to database %s\n",
+		header.NodeVersion, header.CreatedAt.Format(time.RFC3339), header.Migration, header.Mode, parsed.Redacted())
+	if !confirmAction(c) {
+		return nil
+	}
+
+	var password string
+	if header.Encrypted() {
+		if c.IsSet("old-password") {
+			password, err = utils.PasswordFromFile(c.String("old-password"))
+			if err != nil {
+				return s.errorOut(errors.Wrap(err, "error reading old password"))
+			}
+		} else {
+			password = s.Config.Password().Keystore()
+			if password == "" {
+				password = s.PasswordPrompter.Prompt()
+			}
+		}
+	}
+
+	if err = migrate.SetMigrationENVVars(s.Config); err != nil {
+		return s.errorOut(err)
+	}
+	db, err := newConnection(ctx, cfg)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "error connecting to the database"))
+	}
+	defer db.Close()
+	if err = migrate.Migrate(ctx, db.DB); err != nil {
+		return s.errorOut(fmt.Errorf("migrateDB failed: %v", err))
+	}
+	migration, err := migrate.Current(ctx, db.DB)
+	if err != nil {
+		return s.errorOut(fmt.Errorf("migrateDB failed: %v", err))
+	}
+
+	conn, err := pgx.Connect(ctx, parsed.String())
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "error connecting to the database"))
+	}
+	defer conn.Close(context.Background()) //nolint:errcheck
+	_, err = periodicbackup.Restore(ctx, conn, path, periodicbackup.RestoreOptions{
+		NodeVersion: static.Version,
+		Migration:   migration,
+		Password:    password,
+	})
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "restore failed"))
+	}
+	s.Logger.Infof("Restored database from %s", from)
+	if c.IsSet("old-password") {
+		fmt.Println("The restored keystore is encrypted with the old password. Start the node with it, or rotate it with chainlink keys rotate-password.")
+	}
+	return nil
+}
+
 // StatusDatabase displays the database migration status
 func (s *Shell) StatusDatabase(_ *cli.Context) error {
 	ctx := s.ctx()
@@ -1295,3 +1422,108 @@ func (s *Shell) RemoveBlocks(c *cli.Context) error {
 
 	return nil
 }
+
+// RotateKeystorePassword re-encrypts the keystore, and the secrets of webhook trigger tokens, with a new password. The
+// node must be stopped.
+func (s *Shell) RotateKeystorePassword(c *cli.Context) error {
+	cfg := s.Config
+	err := cfg.Validate()
+	if err != nil {
+		return s.errorOut(fmt.Errorf("error validating configuration: %+v", err))
+	}
+
+	password := cfg.Password().Keystore()
+	if c.IsSet("password") {
+		password, err = utils.PasswordFromFile(c.String("password"))
+		if err != nil {
+			return s.errorOut(errors.Wrap(err, "error reading password"))
+		}
+	}
+	if password == "" {
+		if !s.KeyStoreAuthenticator.Prompter.IsTerminal() {
+			return s.errorOut(errors.New("no password provided"))
+		}
+		password = s.KeyStoreAuthenticator.promptExistingPassword()
+	}
+
+	var newPassword string
+	if c.IsSet("new-password") {
+		newPassword, err = utils.PasswordFromFile(c.String("new-password"))
+		if err != nil {
+			return s.errorOut(errors.Wrap(err, "error reading new password"))
+		}
+		if err = s.KeyStoreAuthenticator.validatePasswordStrength(newPassword); err != nil {
+			return s.errorOut(err)
+		}
+	} else {
+		if !s.KeyStoreAuthenticator.Prompter.IsTerminal() {
+			return s.errorOut(errors.New("Must specify --new-password flag"))
+		}
+		newPassword, err = s.KeyStoreAuthenticator.promptNewPassword()
+		if err != nil {
+			return s.errorOut(err)
+		}
+	}
+	if newPassword == password {
+		return s.errorOut(errors.New("the new password must differ from the current password"))
+	}
+
+	scryptParams := utils.GetScryptParams(cfg)
+	newScryptParams := scryptParams
+	if c.IsSet("scrypt-n") {
+		newScryptParams.N = c.Int("scrypt-n")
+	}
+	if c.IsSet("scrypt-p") {
+		newScryptParams.P = c.Int("scrypt-p")
+	}
+	if n := newScryptParams.N; n <= 1 || n&(n-1) != 0 {
+		return s.errorOut(errors.Errorf("--scrypt-n must be a power of two greater than 1, got %d", n))
+	}
+	if newScryptParams.P < 1 {
+		return s.errorOut(errors.Errorf("--scrypt-p must be at least 1, got %d", newScryptParams.P))
+	}
+
+	lggr := logger.Sugared(s.Logger.Named("RotateKeystorePassword"))
+	ldb := pg.NewLockedDB(cfg.AppID(), cfg.Database(), cfg.Database().Lock(), lggr)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go shutdown.HandleShutdown(func(sig string) {
+		cancel()
+		lggr.Info("received signal to stop - closing the database and releasing lock")
+
+		if cErr := ldb.Close(); cErr != nil {
+			lggr.Criticalf("Failed to close LockedDB: %v", cErr)
+		}
+
+		if cErr := s.CloseLogger(); cErr != nil {
+			log.Printf("Failed to close Logger: %v", cErr)
+		}
+	})
+
+	if err = ldb.Open(ctx); err != nil {
+		return s.errorOut(errors.Wrap(err, "opening db"))
+	}
+	defer lggr.ErrorIfFn(ldb.Close, "Error closing db")
+
+	keyStore := keystore.New(ldb.DB(), scryptParams, lggr)
+	if err = keyStore.Unlock(ctx, password); err != nil {
+		return s.errorOut(errors.Wrap(err, "error authenticating keystore with the current password"))
+	}
+	if err = keyStore.RotatePassword(ctx, newPassword, newScryptParams, webhook.ReencryptSecrets); err != nil {
+		return s.errorOut(err)
+	}
+
+	fmt.Println("Keystore password rotated. Set Password.Keystore to the new password before starting the node.")
+	if auditCfg := cfg.AuditLogger(); auditCfg.Enabled() && auditCfg.HashChain() && auditCfg.File().Enabled() {
+		file := auditCfg.File()
+		if err = audit.RekeyFile(file.Dir(), file.MaxSize(), file.MaxBackups(), audit.ChainKey(password), audit.ChainKey(newPassword)); err != nil {
+			lggr.Errorw("Failed to rotate the audit log, its records written before and after now verify with different passwords", "err", err)
+		} else {
+			fmt.Println("The audit log was rotated. Records written before now verify with the old password.")
+		}
+	}
+	if cfg.Database().Backup().Encrypt() {
+		fmt.Println("Database backups taken before now are encrypted with the old password, restore them with chainlink node db restore --old-password.")
+	}
+	return nil
+}
@@ -56,6 +56,8 @@ type AppConfig interface {
 	WebServer() WebServer
 	Tracing() Tracing
 	Telemetry() Telemetry
+	TxmBudget() TxmBudget
+	RemoteSigner() RemoteSigner
 }
 
 type DatabaseBackupMode string
@@ -65,3 +67,10 @@ var (
 	DatabaseBackupModeLite DatabaseBackupMode = "lite"
 	DatabaseBackupModeFull DatabaseBackupMode = "full"
 )
+
+type DatabaseBackupDestination string
+
+var (
+	DatabaseBackupDestinationLocal DatabaseBackupDestination = "local"
+	DatabaseBackupDestinationS3    DatabaseBackupDestination = "s3"
+)
@@ -3,6 +3,7 @@ package config
 import (
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 type AuditLogger interface {
@@ -11,4 +12,21 @@ type AuditLogger interface {
 	Environment() string
 	JsonWrapperKey() string
 	Headers() (models.ServiceHeaders, error)
+	HashChain() bool
+	File() AuditLoggerFile
+	Syslog() AuditLoggerSyslog
+}
+
+type AuditLoggerFile interface {
+	Enabled() bool
+	Dir() string
+	MaxSize() utils.FileSize
+	MaxBackups() int64
+}
+
+type AuditLoggerSyslog interface {
+	Enabled() bool
+	Network() string
+	Address() string
+	Tag() string
 }
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
@@ -13,9 +15,31 @@ type AutoPprof interface {
 	GatherTraceDuration() commonconfig.Duration
 	GoroutineThreshold() int
 	MaxProfileSize() utils.FileSize
+	MaxProfileSizePerType() utils.FileSize
 	MemProfileRate() int
 	MemThreshold() utils.FileSize
 	MutexProfileFraction() int
 	PollInterval() commonconfig.Duration
 	ProfileRoot() string
+	Triggers() AutoPprofTriggers
+}
+
+type AutoPprofTriggers interface {
+	DBPoolSaturation() AutoPprofCountTrigger
+	PipelineRunQueueDepth() AutoPprofCountTrigger
+	HeadTrackerLag() AutoPprofDurationTrigger
+	GCPauseP99() AutoPprofDurationTrigger
+	OpenFileDescriptors() AutoPprofCountTrigger
+}
+
+type AutoPprofCountTrigger interface {
+	Enabled() bool
+	Threshold() uint32
+	Cooldown() time.Duration
+}
+
+type AutoPprofDurationTrigger interface {
+	Enabled() bool
+	Threshold() time.Duration
+	Cooldown() time.Duration
 }
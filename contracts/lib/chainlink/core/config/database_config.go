@@ -11,6 +11,19 @@ type Backup interface {
 	Mode() DatabaseBackupMode
 	OnVersionUpgrade() bool
 	URL() *url.URL
+	Destination() DatabaseBackupDestination
+	Encrypt() bool
+	Generations() uint32
+	S3() BackupS3
+}
+
+type BackupS3 interface {
+	Endpoint() *url.URL
+	Bucket() string
+	Region() string
+	Prefix() string
+	AccessKeyID() string
+	SecretAccessKey() string
 }
 
 type Lock interface {
@@ -0,0 +1,20 @@
+package config
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// RemoteSigner configures the external signers backing EVM sending keys.
+type RemoteSigner interface {
+	Timeout() time.Duration
+	Keys() []RemoteSignerKey
+}
+
+// RemoteSignerKey is a sending key held by the signer at URL.
+type RemoteSignerKey interface {
+	Address() common.Address
+	URL() *url.URL
+}
@@ -16,6 +16,7 @@ import (
 	ocrcommontypes "github.com/smartcontractkit/libocr/commontypes"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-integrations/evm/assets"
 	"github.com/smartcontractkit/chainlink-integrations/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/build"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
@@ -57,6 +58,8 @@ type Core struct {
 	Mercury          Mercury          `toml:",omitempty"`
 	Capabilities     Capabilities     `toml:",omitempty"`
 	Telemetry        Telemetry        `toml:",omitempty"`
+	TxmBudget        TxmBudget        `toml:",omitempty"`
+	RemoteSigner     RemoteSigner     `toml:",omitempty"`
 }
 
 // SetFrom updates c with any non-nil values from f. (currently TOML field only!)
@@ -94,6 +97,8 @@ func (c *Core) SetFrom(f *Core) {
 	c.Insecure.setFrom(&f.Insecure)
 	c.Tracing.setFrom(&f.Tracing)
 	c.Telemetry.setFrom(&f.Telemetry)
+	c.TxmBudget.setFrom(&f.TxmBudget)
+	c.RemoteSigner.setFrom(&f.RemoteSigner)
 }
 
 func (c *Core) ValidateConfig() (err error) {
@@ -130,9 +135,11 @@ func dbURLPasswordComplexity(err error) string {
 }
 
 type DatabaseSecrets struct {
-	URL                  *models.SecretURL
-	BackupURL            *models.SecretURL
-	AllowSimplePasswords *bool
+	URL                     *models.SecretURL
+	BackupURL               *models.SecretURL
+	BackupS3AccessKeyID     *models.Secret
+	BackupS3SecretAccessKey *models.Secret
+	AllowSimplePasswords    *bool
 }
 
 func validateDBURL(dbURI url.URL) error {
@@ -194,6 +201,12 @@ func (d *DatabaseSecrets) SetFrom(f *DatabaseSecrets) (err error) {
 	if v := f.BackupURL; v != nil {
 		d.BackupURL = v
 	}
+	if v := f.BackupS3AccessKeyID; v != nil {
+		d.BackupS3AccessKeyID = v
+	}
+	if v := f.BackupS3SecretAccessKey; v != nil {
+		d.BackupS3SecretAccessKey = v
+	}
 	if v := f.URL; v != nil {
 		d.URL = v
 	}
@@ -209,6 +222,14 @@ func (d *DatabaseSecrets) validateMerge(f *DatabaseSecrets) (err error) {
 		err = multierr.Append(err, configutils.ErrOverride{Name: "BackupURL"})
 	}
 
+	if d.BackupS3AccessKeyID != nil && f.BackupS3AccessKeyID != nil {
+		err = multierr.Append(err, configutils.ErrOverride{Name: "BackupS3AccessKeyID"})
+	}
+
+	if d.BackupS3SecretAccessKey != nil && f.BackupS3SecretAccessKey != nil {
+		err = multierr.Append(err, configutils.ErrOverride{Name: "BackupS3SecretAccessKey"})
+	}
+
 	if d.URL != nil && f.URL != nil {
 		err = multierr.Append(err, configutils.ErrOverride{Name: "URL"})
 	}
@@ -434,6 +455,10 @@ type DatabaseBackup struct {
 	Frequency        *commonconfig.Duration
 	Mode             *config.DatabaseBackupMode
 	OnVersionUpgrade *bool
+	Destination      *config.DatabaseBackupDestination
+	Encrypt          *bool
+	Generations      *uint32
+	S3               DatabaseBackupS3
 }
 
 func (d *DatabaseBackup) setFrom(f *DatabaseBackup) {
@@ -449,6 +474,60 @@ func (d *DatabaseBackup) setFrom(f *DatabaseBackup) {
 	if v := f.OnVersionUpgrade; v != nil {
 		d.OnVersionUpgrade = v
 	}
+	if v := f.Destination; v != nil {
+		d.Destination = v
+	}
+	if v := f.Encrypt; v != nil {
+		d.Encrypt = v
+	}
+	if v := f.Generations; v != nil {
+		d.Generations = v
+	}
+	d.S3.setFrom(&f.S3)
+}
+
+func (d *DatabaseBackup) ValidateConfig() (err error) {
+	if d.Generations != nil && *d.Generations == 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "Generations", Value: *d.Generations, Msg: "must be at least 1"})
+	}
+	if d.Destination == nil {
+		return
+	}
+	switch *d.Destination {
+	case config.DatabaseBackupDestinationLocal:
+	case config.DatabaseBackupDestinationS3:
+		if d.S3.Endpoint == nil || d.S3.Endpoint.IsZero() {
+			err = multierr.Append(err, configutils.ErrMissing{Name: "S3.Endpoint", Msg: "must be set when Destination is 's3'"})
+		}
+		if d.S3.Bucket == nil || *d.S3.Bucket == "" {
+			err = multierr.Append(err, configutils.ErrMissing{Name: "S3.Bucket", Msg: "must be set when Destination is 's3'"})
+		}
+	default:
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "Destination", Value: *d.Destination, Msg: "must be one of 'local' or 's3'"})
+	}
+	return
+}
+
+type DatabaseBackupS3 struct {
+	Endpoint *commonconfig.URL
+	Bucket   *string
+	Region   *string
+	Prefix   *string
+}
+
+func (d *DatabaseBackupS3) setFrom(f *DatabaseBackupS3) {
+	if v := f.Endpoint; v != nil {
+		d.Endpoint = v
+	}
+	if v := f.Bucket; v != nil {
+		d.Bucket = v
+	}
+	if v := f.Region; v != nil {
+		d.Region = v
+	}
+	if v := f.Prefix; v != nil {
+		d.Prefix = v
+	}
 }
 
 type TelemetryIngress struct {
@@ -501,6 +580,10 @@ type AuditLogger struct {
 	ForwardToUrl   *commonconfig.URL
 	JsonWrapperKey *string
 	Headers        *[]models.ServiceHeader
+	HashChain      *bool
+
+	File   AuditLoggerFile   `toml:",omitempty"`
+	Syslog AuditLoggerSyslog `toml:",omitempty"`
 }
 
 func (p *AuditLogger) SetFrom(f *AuditLogger) {
@@ -516,6 +599,88 @@ func (p *AuditLogger) SetFrom(f *AuditLogger) {
 	if v := f.Headers; v != nil {
 		p.Headers = v
 	}
+	if v := f.HashChain; v != nil {
+		p.HashChain = v
+	}
+	p.File.setFrom(&f.File)
+	p.Syslog.setFrom(&f.Syslog)
+}
+
+type AuditLoggerFile struct {
+	Enabled    *bool
+	Dir        *string
+	MaxSize    *utils.FileSize
+	MaxBackups *int64
+}
+
+func (a *AuditLoggerFile) setFrom(f *AuditLoggerFile) {
+	if v := f.Enabled; v != nil {
+		a.Enabled = v
+	}
+	if v := f.Dir; v != nil {
+		a.Dir = v
+	}
+	if v := f.MaxSize; v != nil {
+		a.MaxSize = v
+	}
+	if v := f.MaxBackups; v != nil {
+		a.MaxBackups = v
+	}
+}
+
+func (a *AuditLoggerFile) ValidateConfig() (err error) {
+	if a.Enabled == nil || !*a.Enabled {
+		return
+	}
+	if a.MaxSize != nil && *a.MaxSize < utils.MB {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "MaxSize", Value: a.MaxSize.String(), Msg: "must be at least 1mb"})
+	}
+	if a.MaxBackups != nil && *a.MaxBackups < 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "MaxBackups", Value: *a.MaxBackups, Msg: "must not be negative"})
+	}
+	return
+}
+
+type AuditLoggerSyslog struct {
+	Enabled *bool
+	Network *string
+	Address *string
+	Tag     *string
+}
+
+func (a *AuditLoggerSyslog) setFrom(f *AuditLoggerSyslog) {
+	if v := f.Enabled; v != nil {
+		a.Enabled = v
+	}
+	if v := f.Network; v != nil {
+		a.Network = v
+	}
+	if v := f.Address; v != nil {
+		a.Address = v
+	}
+	if v := f.Tag; v != nil {
+		a.Tag = v
+	}
+}
+
+func (a *AuditLoggerSyslog) ValidateConfig() (err error) {
+	if a.Enabled == nil || !*a.Enabled {
+		return
+	}
+	if a.Network != nil {
+		switch *a.Network {
+		case "udp", "tcp", "unix":
+		default:
+			err = multierr.Append(err, configutils.ErrInvalid{Name: "Network", Value: *a.Network, Msg: "must be one of 'udp', 'tcp' or 'unix'"})
+		}
+	}
+	if a.Address == nil || *a.Address == "" {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "Address", Msg: "must be set when Syslog is enabled"})
+	}
+	if a.Tag != nil && (len(*a.Tag) == 0 || len(*a.Tag) > 48) {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "Tag", Value: *a.Tag, Msg: "must be between 1 and 48 characters"})
+	}
+	return
 }
 
 // LogLevel replaces dpanic with crit/CRIT
@@ -1156,18 +1321,21 @@ func (k *KeeperRegistry) setFrom(f *KeeperRegistry) {
 }
 
 type AutoPprof struct {
-	Enabled              *bool
-	ProfileRoot          *string
-	PollInterval         *commonconfig.Duration
-	GatherDuration       *commonconfig.Duration
-	GatherTraceDuration  *commonconfig.Duration
-	MaxProfileSize       *utils.FileSize
-	CPUProfileRate       *int64 // runtime.SetCPUProfileRate
-	MemProfileRate       *int64 // runtime.MemProfileRate
-	BlockProfileRate     *int64 // runtime.SetBlockProfileRate
-	MutexProfileFraction *int64 // runtime.SetMutexProfileFraction
-	MemThreshold         *utils.FileSize
-	GoroutineThreshold   *int64
+	Enabled               *bool
+	ProfileRoot           *string
+	PollInterval          *commonconfig.Duration
+	GatherDuration        *commonconfig.Duration
+	GatherTraceDuration   *commonconfig.Duration
+	MaxProfileSize        *utils.FileSize
+	MaxProfileSizePerType *utils.FileSize
+	CPUProfileRate        *int64 // runtime.SetCPUProfileRate
+	MemProfileRate        *int64 // runtime.MemProfileRate
+	BlockProfileRate      *int64 // runtime.SetBlockProfileRate
+	MutexProfileFraction  *int64 // runtime.SetMutexProfileFraction
+	MemThreshold          *utils.FileSize
+	GoroutineThreshold    *int64
+
+	Triggers AutoPprofTriggers `toml:",omitempty"`
 }
 
 func (p *AutoPprof) setFrom(f *AutoPprof) {
@@ -1189,6 +1357,9 @@ func (p *AutoPprof) setFrom(f *AutoPprof) {
 	if v := f.MaxProfileSize; v != nil {
 		p.MaxProfileSize = v
 	}
+	if v := f.MaxProfileSizePerType; v != nil {
+		p.MaxProfileSizePerType = v
+	}
 	if v := f.CPUProfileRate; v != nil {
 		p.CPUProfileRate = v
 	}
@@ -1207,6 +1378,66 @@ func (p *AutoPprof) setFrom(f *AutoPprof) {
 	if v := f.GoroutineThreshold; v != nil {
 		p.GoroutineThreshold = v
 	}
+	p.Triggers.setFrom(&f.Triggers)
+}
+
+type AutoPprofTriggers struct {
+	DBPoolSaturation      AutoPprofCountTrigger    `toml:",omitempty"`
+	PipelineRunQueueDepth AutoPprofCountTrigger    `toml:",omitempty"`
+	HeadTrackerLag        AutoPprofDurationTrigger `toml:",omitempty"`
+	GCPauseP99            AutoPprofDurationTrigger `toml:",omitempty"`
+	OpenFileDescriptors   AutoPprofCountTrigger    `toml:",omitempty"`
+}
+
+func (t *AutoPprofTriggers) setFrom(f *AutoPprofTriggers) {
+	t.DBPoolSaturation.setFrom(&f.DBPoolSaturation)
+	t.PipelineRunQueueDepth.setFrom(&f.PipelineRunQueueDepth)
+	t.HeadTrackerLag.setFrom(&f.HeadTrackerLag)
+	t.GCPauseP99.setFrom(&f.GCPauseP99)
+	t.OpenFileDescriptors.setFrom(&f.OpenFileDescriptors)
+}
+
+func (t *AutoPprofTriggers) ValidateConfig() (err error) {
+	if t.DBPoolSaturation.Threshold != nil && *t.DBPoolSaturation.Threshold > 100 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "DBPoolSaturation.Threshold", Value: *t.DBPoolSaturation.Threshold, Msg: "must be a percentage between 0 and 100"})
+	}
+	return
+}
+
+type AutoPprofCountTrigger struct {
+	Enabled   *bool
+	Threshold *uint32
+	Cooldown  *commonconfig.Duration
+}
+
+func (t *AutoPprofCountTrigger) setFrom(f *AutoPprofCountTrigger) {
+	if v := f.Enabled; v != nil {
+		t.Enabled = v
+	}
+	if v := f.Threshold; v != nil {
+		t.Threshold = v
+	}
+	if v := f.Cooldown; v != nil {
+		t.Cooldown = v
+	}
+}
+
+type AutoPprofDurationTrigger struct {
+	Enabled   *bool
+	Threshold *commonconfig.Duration
+	Cooldown  *commonconfig.Duration
+}
+
+func (t *AutoPprofDurationTrigger) setFrom(f *AutoPprofDurationTrigger) {
+	if v := f.Enabled; v != nil {
+		t.Enabled = v
+	}
+	if v := f.Threshold; v != nil {
+		t.Threshold = v
+	}
+	if v := f.Cooldown; v != nil {
+		t.Cooldown = v
+	}
 }
 
 type Pyroscope struct {
@@ -1792,6 +2023,124 @@ func (b *Telemetry) ValidateConfig() (err error) {
 	return err
 }
 
+type TxmBudget struct {
+	Enabled       *bool
+	KeyMaxPerHour *assets.Wei
+	KeyMaxPerDay  *assets.Wei
+	JobMaxPerHour *assets.Wei
+	JobMaxPerDay  *assets.Wei
+
+	Keys []TxmBudgetKey `toml:",omitempty"`
+	Jobs []TxmBudgetJob `toml:",omitempty"`
+}
+
+type TxmBudgetKey struct {
+	Address    *types.EIP55Address
+	MaxPerHour *assets.Wei
+	MaxPerDay  *assets.Wei
+}
+
+type TxmBudgetJob struct {
+	JobID      *int32
+	MaxPerHour *assets.Wei
+	MaxPerDay  *assets.Wei
+}
+
+func (b *TxmBudget) setFrom(f *TxmBudget) {
+	if v := f.Enabled; v != nil {
+		b.Enabled = v
+	}
+	if v := f.KeyMaxPerHour; v != nil {
+		b.KeyMaxPerHour = v
+	}
+	if v := f.KeyMaxPerDay; v != nil {
+		b.KeyMaxPerDay = v
+	}
+	if v := f.JobMaxPerHour; v != nil {
+		b.JobMaxPerHour = v
+	}
+	if v := f.JobMaxPerDay; v != nil {
+		b.JobMaxPerDay = v
+	}
+	if v := f.Keys; v != nil {
+		b.Keys = v
+	}
+	if v := f.Jobs; v != nil {
+		b.Jobs = v
+	}
+}
+
+func (b *TxmBudget) ValidateConfig() (err error) {
+	addresses := make(map[types.EIP55Address]struct{}, len(b.Keys))
+	for i, k := range b.Keys {
+		if k.Address == nil {
+			err = multierr.Append(err, configutils.ErrMissing{Name: fmt.Sprintf("Keys.%d.Address", i), Msg: "required for key budget"})
+			continue
+		}
+		if _, ok := addresses[*k.Address]; ok {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: fmt.Sprintf("Keys.%d.Address", i), Value: k.Address.String(), Msg: "duplicate key budget"})
+		}
+		addresses[*k.Address] = struct{}{}
+	}
+	jobIDs := make(map[int32]struct{}, len(b.Jobs))
+	for i, j := range b.Jobs {
+		if j.JobID == nil {
+			err = multierr.Append(err, configutils.ErrMissing{Name: fmt.Sprintf("Jobs.%d.JobID", i), Msg: "required for job budget"})
+			continue
+		}
+		if _, ok := jobIDs[*j.JobID]; ok {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: fmt.Sprintf("Jobs.%d.JobID", i), Value: *j.JobID, Msg: "duplicate job budget"})
+		}
+		jobIDs[*j.JobID] = struct{}{}
+	}
+
+	return err
+}
+
+type RemoteSigner struct {
+	Timeout *commonconfig.Duration
+
+	Keys []RemoteSignerKey `toml:",omitempty"`
+}
+
+type RemoteSignerKey struct {
+	Address *types.EIP55Address
+	URL     *commonconfig.URL
+}
+
+func (r *RemoteSigner) setFrom(f *RemoteSigner) {
+	if v := f.Timeout; v != nil {
+		r.Timeout = v
+	}
+	if v := f.Keys; v != nil {
+		r.Keys = v
+	}
+}
+
+func (r *RemoteSigner) ValidateConfig() (err error) {
+	if r.Timeout != nil && r.Timeout.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "Timeout", Value: r.Timeout.String(), Msg: "must be greater than zero"})
+	}
+	addresses := make(map[types.EIP55Address]struct{}, len(r.Keys))
+	for i, k := range r.Keys {
+		if k.URL == nil || k.URL.IsZero() {
+			err = multierr.Append(err, configutils.ErrMissing{Name: fmt.Sprintf("Keys.%d.URL", i), Msg: "required for remote signer"})
+		} else if u := k.URL.URL(); u.Scheme != "http" && u.Scheme != "https" {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: fmt.Sprintf("Keys.%d.URL", i), Value: k.URL.String(), Msg: "must be an http or https URL"})
+		}
+		if k.Address == nil {
+			err = multierr.Append(err, configutils.ErrMissing{Name: fmt.Sprintf("Keys.%d.Address", i), Msg: "required for remote signer"})
+			continue
+		}
+		if _, ok := addresses[*k.Address]; ok {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: fmt.Sprintf("Keys.%d.Address", i), Value: k.Address.String(), Msg: "duplicate remote signer"})
+		}
+		addresses[*k.Address] = struct{}{}
+	}
+
+	return err
+}
+
 var hostnameRegex = regexp.MustCompile(`^[a-zA-Z0-9-]+(\.[a-zA-Z0-9-]+)*$`)
 
 // Validates uri is valid external or local URI
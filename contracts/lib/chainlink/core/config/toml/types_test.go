@@ -10,6 +10,7 @@ import (
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink/v2/core/build"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
@@ -182,6 +183,56 @@ func TestDatabaseSecrets_ValidateConfig(t *testing.T) {
 		})
 	}
 }
+func TestDatabaseBackup_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		backup  DatabaseBackup
+		wantErr string
+	}{
+		{
+			name:   "local",
+			backup: DatabaseBackup{Destination: ptr(config.DatabaseBackupDestinationLocal), Generations: ptr[uint32](1)},
+		},
+		{
+			name: "s3",
+			backup: DatabaseBackup{Destination: ptr(config.DatabaseBackupDestinationS3), S3: DatabaseBackupS3{
+				Endpoint: commonconfig.MustParseURL("https://s3.example.com"),
+				Bucket:   ptr("backups"),
+			}},
+		},
+		{
+			name:    "s3 without endpoint",
+			backup:  DatabaseBackup{Destination: ptr(config.DatabaseBackupDestinationS3), S3: DatabaseBackupS3{Bucket: ptr("backups")}},
+			wantErr: "S3.Endpoint: missing: must be set when Destination is 's3'",
+		},
+		{
+			name:    "s3 without bucket",
+			backup:  DatabaseBackup{Destination: ptr(config.DatabaseBackupDestinationS3), S3: DatabaseBackupS3{Endpoint: commonconfig.MustParseURL("https://s3.example.com")}},
+			wantErr: "S3.Bucket: missing: must be set when Destination is 's3'",
+		},
+		{
+			name:    "unknown destination",
+			backup:  DatabaseBackup{Destination: ptr(config.DatabaseBackupDestination("ftp"))},
+			wantErr: "Destination: invalid value (ftp): must be one of 'local' or 's3'",
+		},
+		{
+			name:    "no generations",
+			backup:  DatabaseBackup{Generations: ptr[uint32](0)},
+			wantErr: "Generations: invalid value (0): must be at least 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.backup.ValidateConfig()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestTracing_ValidateCollectorTarget(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -0,0 +1,16 @@
+package config
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-integrations/evm/assets"
+)
+
+// TxmBudget holds the spend limits for EVM sending keys and jobs. A zero limit means unlimited.
+type TxmBudget interface {
+	Enabled() bool
+	KeyMaxPerHour(address common.Address) *assets.Wei
+	KeyMaxPerDay(address common.Address) *assets.Wei
+	JobMaxPerHour(jobID int32) *assets.Wei
+	JobMaxPerDay(jobID int32) *assets.Wei
+}
@@ -202,6 +202,54 @@ func (_c *Application_BasicAdminUsersORM_Call) RunAndReturn(run func() sessions.
 	return _c
 }
 
+// BridgeHealth provides a mock function with given fields: bt
+func (_m *Application) BridgeHealth(bt bridges.BridgeType) []bridges.URLHealth {
+	ret := _m.Called(bt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BridgeHealth")
+	}
+
+	var r0 []bridges.URLHealth
+	if rf, ok := ret.Get(0).(func(bridges.BridgeType) []bridges.URLHealth); ok {
+		r0 = rf(bt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]bridges.URLHealth)
+		}
+	}
+
+	return r0
+}
+
+// Application_BridgeHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BridgeHealth'
+type Application_BridgeHealth_Call struct {
+	*mock.Call
+}
+
+// BridgeHealth is a helper method to define mock.On call
+//   - bt bridges.BridgeType
+func (_e *Application_Expecter) BridgeHealth(bt interface{}) *Application_BridgeHealth_Call {
+	return &Application_BridgeHealth_Call{Call: _e.mock.On("BridgeHealth", bt)}
+}
+
+func (_c *Application_BridgeHealth_Call) Run(run func(bt bridges.BridgeType)) *Application_BridgeHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bridges.BridgeType))
+	})
+	return _c
+}
+
+func (_c *Application_BridgeHealth_Call) Return(_a0 []bridges.URLHealth) *Application_BridgeHealth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_BridgeHealth_Call) RunAndReturn(run func(bridges.BridgeType) []bridges.URLHealth) *Application_BridgeHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BridgeORM provides a mock function with no fields
 func (_m *Application) BridgeORM() bridges.ORM {
 	ret := _m.Called()
@@ -1012,6 +1060,53 @@ func (_c *Application_GetWebAuthnConfiguration_Call) RunAndReturn(run func() ses
 	return _c
 }
 
+// GetWebhookTriggerTokenManager provides a mock function with no fields
+func (_m *Application) GetWebhookTriggerTokenManager() webhook.TriggerTokenManager {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhookTriggerTokenManager")
+	}
+
+	var r0 webhook.TriggerTokenManager
+	if rf, ok := ret.Get(0).(func() webhook.TriggerTokenManager); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(webhook.TriggerTokenManager)
+		}
+	}
+
+	return r0
+}
+
+// Application_GetWebhookTriggerTokenManager_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhookTriggerTokenManager'
+type Application_GetWebhookTriggerTokenManager_Call struct {
+	*mock.Call
+}
+
+// GetWebhookTriggerTokenManager is a helper method to define mock.On call
+func (_e *Application_Expecter) GetWebhookTriggerTokenManager() *Application_GetWebhookTriggerTokenManager_Call {
+	return &Application_GetWebhookTriggerTokenManager_Call{Call: _e.mock.On("GetWebhookTriggerTokenManager")}
+}
+
+func (_c *Application_GetWebhookTriggerTokenManager_Call) Run(run func()) *Application_GetWebhookTriggerTokenManager_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_GetWebhookTriggerTokenManager_Call) Return(_a0 webhook.TriggerTokenManager) *Application_GetWebhookTriggerTokenManager_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_GetWebhookTriggerTokenManager_Call) RunAndReturn(run func() webhook.TriggerTokenManager) *Application_GetWebhookTriggerTokenManager_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ID provides a mock function with no fields
 func (_m *Application) ID() uuid.UUID {
 	ret := _m.Called()
@@ -1354,9 +1449,9 @@ func (_c *Application_RunJobV2_Call) RunAndReturn(run func(context.Context, int3
 	return _c
 }
 
-// RunWebhookJobV2 provides a mock function with given fields: ctx, jobUUID, requestBody, meta
-func (_m *Application) RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable) (int64, error) {
-	ret := _m.Called(ctx, jobUUID, requestBody, meta)
+// RunWebhookJobV2 provides a mock function with given fields: ctx, jobUUID, requestBody, meta, idempotencyKey
+func (_m *Application) RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable, idempotencyKey string) (int64, error) {
+	ret := _m.Called(ctx, jobUUID, requestBody, meta, idempotencyKey)
 
 	if len(ret) == 0 {
 		panic("no return value specified for RunWebhookJobV2")
@@ -1364,17 +1459,17 @@ func (_m *Application) RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, r
 
 	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, jsonserializable.JSONSerializable) (int64, error)); ok {
-		return rf(ctx, jobUUID, requestBody, meta)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, jsonserializable.JSONSerializable, string) (int64, error)); ok {
+		return rf(ctx, jobUUID, requestBody, meta, idempotencyKey)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, jsonserializable.JSONSerializable) int64); ok {
-		r0 = rf(ctx, jobUUID, requestBody, meta)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, jsonserializable.JSONSerializable, string) int64); ok {
+		r0 = rf(ctx, jobUUID, requestBody, meta, idempotencyKey)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, jsonserializable.JSONSerializable) error); ok {
-		r1 = rf(ctx, jobUUID, requestBody, meta)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, jsonserializable.JSONSerializable, string) error); ok {
+		r1 = rf(ctx, jobUUID, requestBody, meta, idempotencyKey)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1392,13 +1487,14 @@ type Application_RunWebhookJobV2_Call struct {
 //   - jobUUID uuid.UUID
 //   - requestBody string
 //   - meta jsonserializable.JSONSerializable
-func (_e *Application_Expecter) RunWebhookJobV2(ctx interface{}, jobUUID interface{}, requestBody interface{}, meta interface{}) *Application_RunWebhookJobV2_Call {
-	return &Application_RunWebhookJobV2_Call{Call: _e.mock.On("RunWebhookJobV2", ctx, jobUUID, requestBody, meta)}
+//   - idempotencyKey string
+func (_e *Application_Expecter) RunWebhookJobV2(ctx interface{}, jobUUID interface{}, requestBody interface{}, meta interface{}, idempotencyKey interface{}) *Application_RunWebhookJobV2_Call {
+	return &Application_RunWebhookJobV2_Call{Call: _e.mock.On("RunWebhookJobV2", ctx, jobUUID, requestBody, meta, idempotencyKey)}
 }
 
-func (_c *Application_RunWebhookJobV2_Call) Run(run func(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable)) *Application_RunWebhookJobV2_Call {
+func (_c *Application_RunWebhookJobV2_Call) Run(run func(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable, idempotencyKey string)) *Application_RunWebhookJobV2_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(jsonserializable.JSONSerializable))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(jsonserializable.JSONSerializable), args[4].(string))
 	})
 	return _c
 }
@@ -1408,7 +1504,7 @@ func (_c *Application_RunWebhookJobV2_Call) Return(_a0 int64, _a1 error) *Applic
 	return _c
 }
 
-func (_c *Application_RunWebhookJobV2_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, jsonserializable.JSONSerializable) (int64, error)) *Application_RunWebhookJobV2_Call {
+func (_c *Application_RunWebhookJobV2_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, jsonserializable.JSONSerializable, string) (int64, error)) *Application_RunWebhookJobV2_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1506,6 +1602,67 @@ func (_c *Application_SetLogLevel_Call) RunAndReturn(run func(zapcore.Level) err
 	return _c
 }
 
+// SimulateJobV2 provides a mock function with given fields: ctx, jobID, vars, stubs
+func (_m *Application) SimulateJobV2(ctx context.Context, jobID int32, vars map[string]interface{}, stubs map[string]pipeline.TaskStub) (*pipeline.Simulation, error) {
+	ret := _m.Called(ctx, jobID, vars, stubs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateJobV2")
+	}
+
+	var r0 *pipeline.Simulation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, map[string]interface{}, map[string]pipeline.TaskStub) (*pipeline.Simulation, error)); ok {
+		return rf(ctx, jobID, vars, stubs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32, map[string]interface{}, map[string]pipeline.TaskStub) *pipeline.Simulation); ok {
+		r0 = rf(ctx, jobID, vars, stubs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Simulation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32, map[string]interface{}, map[string]pipeline.TaskStub) error); ok {
+		r1 = rf(ctx, jobID, vars, stubs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_SimulateJobV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimulateJobV2'
+type Application_SimulateJobV2_Call struct {
+	*mock.Call
+}
+
+// SimulateJobV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+//   - vars map[string]interface{}
+//   - stubs map[string]pipeline.TaskStub
+func (_e *Application_Expecter) SimulateJobV2(ctx interface{}, jobID interface{}, vars interface{}, stubs interface{}) *Application_SimulateJobV2_Call {
+	return &Application_SimulateJobV2_Call{Call: _e.mock.On("SimulateJobV2", ctx, jobID, vars, stubs)}
+}
+
+func (_c *Application_SimulateJobV2_Call) Run(run func(ctx context.Context, jobID int32, vars map[string]interface{}, stubs map[string]pipeline.TaskStub)) *Application_SimulateJobV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32), args[2].(map[string]interface{}), args[3].(map[string]pipeline.TaskStub))
+	})
+	return _c
+}
+
+func (_c *Application_SimulateJobV2_Call) Return(_a0 *pipeline.Simulation, _a1 error) *Application_SimulateJobV2_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_SimulateJobV2_Call) RunAndReturn(run func(context.Context, int32, map[string]interface{}, map[string]pipeline.TaskStub) (*pipeline.Simulation, error)) *Application_SimulateJobV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: ctx
 func (_m *Application) Start(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -1,28 +1,29 @@
 package audit
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"net"
 	"net/http"
 	"net/url"
 	"os"
+	"path/filepath"
 	"time"
 
-	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
-	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
 const bufferCapacity = 2048
 const webRequestTimeout = 10
 
+// headFlushInterval bounds how long the signed head of the audit log lags behind its last record while logs keep
+// coming in. Otherwise it is persisted whenever the buffered logs have been written.
+const headFlushInterval = time.Second
+
 type Data = map[string]any
 
 type AuditLogger interface {
@@ -36,15 +37,13 @@ type HTTPAuditLoggerInterface interface {
 }
 
 type AuditLoggerService struct {
-	logger          logger.Logger            // The standard logger configured in the node
-	enabled         bool                     // Whether the audit logger is enabled or not
-	forwardToUrl    commonconfig.URL         // Location we are going to send logs to
-	headers         []models.ServiceHeader   // Headers to be sent along with logs for identification/authentication
-	jsonWrapperKey  string                   // Wrap audit data as a map under this key if present
-	environmentName string                   // Decorate the environment this is coming from
-	hostname        string                   // The self-reported hostname of the machine
-	localIP         string                   // A non-loopback IP address as reported by the machine
-	loggingClient   HTTPAuditLoggerInterface // Abstract type for sending logs onward
+	logger          logger.Logger // The standard logger configured in the node
+	enabled         bool          // Whether the audit logger is enabled or not
+	environmentName string        // Decorate the environment this is coming from
+	hostname        string        // The self-reported hostname of the machine
+	localIP         string        // A non-loopback IP address as reported by the machine
+	sinks           []Sink        // Destinations every log is written to
+	chains          []*hashChain  // Link the logs written to each sink to each other, if set
 
 	loggingChannel chan wrappedAuditLog
 	chStop         services.StopChan
@@ -54,16 +53,19 @@ type AuditLoggerService struct {
 type wrappedAuditLog struct {
 	eventID EventID
 	data    Data
+	time    time.Time
 }
 
 var NoopLogger AuditLogger = &AuditLoggerService{}
 
 // NewAuditLogger returns a buffer push system that ingests audit log events and
-// asynchronously pushes them up to an HTTP log service.
-// Parses and validates the AUDIT_LOGS_* environment values and returns an enabled
-// AuditLogger instance. If the environment variables are not set, the logger
-// is disabled and short circuits execution via enabled flag.
-func NewAuditLogger(logger logger.Logger, config config.AuditLogger) (AuditLogger, error) {
+// asynchronously writes them to the configured sinks: an HTTP log service, a
+// local file and a syslog server.
+// If the logger is not enabled, or no sink is configured, the logger is
+// disabled and short circuits execution via enabled flag.
+// chainKey keys the hash chain, see ChainKey. It is required if the hash
+// chain is enabled.
+func NewAuditLogger(logger logger.Logger, config config.AuditLogger, chainKey []byte) (AuditLogger, error) {
 	// If the unverified config is nil, then we assume this came from the
 	// configuration system and return a nil logger.
 	if config == nil || !config.Enabled() {
@@ -75,13 +77,73 @@ func NewAuditLogger(logger logger.Logger, config config.AuditLogger) (AuditLogge
 		return nil, fmt.Errorf("initialization error - unable to get hostname: %w", err)
 	}
 
+	var sinks []Sink
 	forwardToUrl, err := config.ForwardToUrl()
 	if err != nil {
 		return &AuditLoggerService{}, nil
 	}
+	if (*url.URL)(&forwardToUrl).String() != "" {
+		headers, err := config.Headers()
+		if err != nil {
+			return &AuditLoggerService{}, nil
+		}
+		sinks = append(sinks, NewHTTPSink(forwardToUrl, headers, config.JsonWrapperKey()))
+	}
 
-	headers, err := config.Headers()
-	if err != nil {
+	// every sink has its own chain, which only moves on to the records written to it, so that failing to write to
+	// one sink leaves no gap in the chains of the others
+	var chains []*hashChain
+	if config.HashChain() {
+		if len(chainKey) == 0 {
+			return nil, errors.New("initialization error - the audit log hash chain requires a key")
+		}
+		for range sinks {
+			chains = append(chains, &hashChain{key: chainKey})
+		}
+	}
+
+	if file := config.File(); file.Enabled() {
+		sink, err := NewFileSink(file.Dir(), file.MaxSize(), file.MaxBackups())
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+
+		if chains != nil {
+			// continue the chain of the existing log
+			chain := &hashChain{key: chainKey}
+			chains = append(chains, chain)
+			path := filepath.Join(file.Dir(), FileName)
+			chain.headPath = path + HeadSuffix
+			chain.prev, err = lastHash(path)
+			if err != nil {
+				logger.Warnw("Unable to continue the audit log hash chain, starting a new one", "err", err)
+			}
+			head, err := ReadHead(chain.headPath, chainKey)
+			if err != nil {
+				logger.Warnw("Unable to read the signed head of the audit log", "err", err)
+			} else if head != "" && chain.prev == "" {
+				// the log was rotated by RekeyFile, and the chain continues from the last file
+				logger.Infow("Continuing the audit log hash chain from its signed head", "head", head)
+				chain.prev = head
+			} else if head != "" && head != chain.prev {
+				// continuing from the head leaves the next record unlinked from the last one in the file, so
+				// that verification points at the tampering
+				logger.Errorw("The last audit log record does not match the signed head, records have been removed", "head", head, "lastHash", chain.prev)
+				chain.prev = head
+			}
+		}
+	}
+
+	if syslog := config.Syslog(); syslog.Enabled() {
+		sinks = append(sinks, NewSyslogSink(syslog.Network(), syslog.Address(), syslog.Tag(), hostname))
+		if chains != nil {
+			chains = append(chains, &hashChain{key: chainKey})
+		}
+	}
+
+	if len(sinks) == 0 {
+		logger.Warn("The audit logger is enabled but has no sinks configured")
 		return &AuditLoggerService{}, nil
 	}
 
@@ -91,13 +153,11 @@ func NewAuditLogger(logger logger.Logger, config config.AuditLogger) (AuditLogge
 	auditLogger := AuditLoggerService{
 		logger:          logger.Helper(1),
 		enabled:         true,
-		forwardToUrl:    forwardToUrl,
-		headers:         headers,
-		jsonWrapperKey:  config.JsonWrapperKey(),
 		environmentName: config.Environment(),
 		hostname:        hostname,
 		localIP:         getLocalIP(),
-		loggingClient:   &http.Client{Timeout: time.Second * webRequestTimeout},
+		sinks:           sinks,
+		chains:          chains,
 
 		loggingChannel: loggingChannel,
 		chStop:         make(chan struct{}),
@@ -107,8 +167,13 @@ func NewAuditLogger(logger logger.Logger, config config.AuditLogger) (AuditLogge
 	return &auditLogger, nil
 }
 
+// SetLoggingClient replaces the client of the HTTP sink.
 func (l *AuditLoggerService) SetLoggingClient(newClient HTTPAuditLoggerInterface) {
-	l.loggingClient = newClient
+	for _, sink := range l.sinks {
+		if s, ok := sink.(*httpSink); ok {
+			s.client = newClient
+		}
+	}
 }
 
 // Entrypoint for new audit logs. This buffers all logs that come in they will
@@ -124,6 +189,7 @@ func (l *AuditLoggerService) Audit(eventID EventID, data Data) {
 	wrappedLog := wrappedAuditLog{
 		eventID: eventID,
 		data:    data,
+		time:    time.Now(),
 	}
 
 	select {
@@ -153,7 +219,11 @@ func (l *AuditLoggerService) Close() error {
 	close(l.chStop)
 	<-l.chDone
 
-	return nil
+	var err error
+	for _, sink := range l.sinks {
+		err = errors.Join(err, sink.Close())
+	}
+	return err
 }
 
 func (l *AuditLoggerService) Name() string {
@@ -181,76 +251,84 @@ func (l *AuditLoggerService) Ready() error {
 // Entrypoint for our log handling goroutine. This waits on the channel and sends out
 // logs as they come in.
 //
-// This function calls postLogToLogService which blocks.
+// This function calls writeLog which blocks.
 func (l *AuditLoggerService) runLoop() {
 	defer close(l.chDone)
 
+	flushedAt := time.Now()
 	for {
 		select {
 		case <-l.chStop:
 			l.logger.Warn("The audit logger is shutting down")
+			l.flushHeads()
 			return
 		case event := <-l.loggingChannel:
-			l.postLogToLogService(event.eventID, event.data)
+			l.writeLog(event)
+			if len(l.loggingChannel) == 0 || time.Since(flushedAt) >= headFlushInterval {
+				l.flushHeads()
+				flushedAt = time.Now()
+			}
 		}
 	}
 }
 
-// Takes an EventID and associated data and sends it to the configured logging
-// endpoint. This function blocks on the send by timesout after a period of
-// several seconds. This helps us prevent getting stuck on a single log
-// due to transient network errors.
+// flushHeads persists the signed heads of the chains which moved on since they were last persisted.
+func (l *AuditLoggerService) flushHeads() {
+	for _, chain := range l.chains {
+		if err := chain.flushHead(); err != nil {
+			l.logger.Errorw("failed to persist the audit log head", "err", err)
+		}
+	}
+}
+
+// Takes an audit log event, serializes it, links it to the previous log
+// written to each sink if the hash chain is enabled, and writes it to every
+// sink.
 //
 // This function blocks when called.
-func (l *AuditLoggerService) postLogToLogService(eventID EventID, data Data) {
+func (l *AuditLoggerService) writeLog(event wrappedAuditLog) {
 	// Audit log JSON data
 	logItem := map[string]interface{}{
-		"eventID":  eventID,
+		"eventID":  event.eventID,
 		"hostname": l.hostname,
 		"localIP":  l.localIP,
 		"env":      l.environmentName,
-		"data":     data,
+		"time":     event.time.UTC().Format(time.RFC3339Nano),
+		"data":     event.data,
 	}
 
-	// Optionally wrap audit log data into JSON object to help dynamically structure for an HTTP log service call
-	if l.jsonWrapperKey != "" {
-		logItem = map[string]interface{}{l.jsonWrapperKey: logItem}
-	}
-
-	serializedLog, err := json.Marshal(logItem)
-	if err != nil {
-		l.logger.Errorw("unable to serialize wrapped audit log item to JSON", "err", err, "logItem", logItem)
-		return
-	}
 	ctx, cancel := l.chStop.NewCtx()
 	defer cancel()
-
-	// Send to remote service
-	req, err := http.NewRequestWithContext(ctx, "POST", (*url.URL)(&l.forwardToUrl).String(), bytes.NewReader(serializedLog))
-	if err != nil {
-		l.logger.Error("failed to create request to remote logging service!")
-	}
-	for _, header := range l.headers {
-		req.Header.Add(header.Header, header.Value)
-	}
-	resp, err := l.loggingClient.Do(req)
-	if err != nil {
-		l.logger.Errorw("failed to send audit log to HTTP log service", "err", err, "logItem", logItem)
-		return
-	}
-	if resp.StatusCode != 200 {
-		if resp.Body == nil {
-			l.logger.Errorw("no body to read. Possibly an error occurred sending", "logItem", logItem)
-			return
+	var serializedLog []byte
+	for i, sink := range l.sinks {
+		var chain *hashChain
+		if l.chains != nil {
+			chain = l.chains[i]
 		}
-
-		bodyBytes, err := io.ReadAll(resp.Body)
-		if err != nil {
-			l.logger.Errorw("error reading errored HTTP log service webhook response body", "err", err, "logItem", logItem)
-			return
+		var hash string
+		if chain != nil || serializedLog == nil {
+			if chain != nil {
+				logItem["prevHash"] = chain.prev
+			}
+			var err error
+			serializedLog, err = json.Marshal(logItem)
+			if err != nil {
+				l.logger.Errorw("unable to serialize audit log item to JSON", "err", err, "logItem", logItem)
+				return
+			}
+			if chain != nil {
+				serializedLog, hash = chain.link(serializedLog)
+			}
+		}
+		record := Record{EventID: event.eventID, Time: event.time, Line: serializedLog}
+		if err := sink.Write(ctx, record); err != nil {
+			l.logger.Errorw("failed to write audit log", "sink", sink.Name(), "err", err, "eventID", event.eventID)
+			continue
+		}
+		// the next record written to the sink links to this one only once it has been written
+		if chain != nil {
+			chain.advance(hash)
 		}
-		l.logger.Errorw("error sending log to HTTP log service", "statusCode", resp.StatusCode, "bodyString", string(bodyBytes))
-		return
 	}
 }
 
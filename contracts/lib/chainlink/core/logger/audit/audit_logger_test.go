@@ -13,11 +13,13 @@ import (
 	"github.com/urfave/cli"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 type MockedHTTPEvent struct {
@@ -82,6 +84,36 @@ func (c Config) JsonWrapperKey() string {
 	return ""
 }
 
+func (c Config) HashChain() bool {
+	return false
+}
+
+func (c Config) File() config.AuditLoggerFile {
+	return FileConfig{}
+}
+
+func (c Config) Syslog() config.AuditLoggerSyslog {
+	return SyslogConfig{}
+}
+
+type FileConfig struct {
+	dir string
+}
+
+func (f FileConfig) Enabled() bool           { return f.dir != "" }
+func (f FileConfig) Dir() string             { return f.dir }
+func (f FileConfig) MaxSize() utils.FileSize { return 10 * utils.MB }
+func (f FileConfig) MaxBackups() int64       { return 1 }
+
+type SyslogConfig struct {
+	network, address string
+}
+
+func (s SyslogConfig) Enabled() bool   { return s.address != "" }
+func (s SyslogConfig) Network() string { return s.network }
+func (s SyslogConfig) Address() string { return s.address }
+func (s SyslogConfig) Tag() string     { return "chainlink" }
+
 func TestCheckLoginAuditLog(t *testing.T) {
 	t.Parallel()
 
@@ -100,7 +132,7 @@ func TestCheckLoginAuditLog(t *testing.T) {
 	auditLoggerTestConfig := Config{}
 
 	// Create new AuditLoggerService
-	auditLogger, err := audit.NewAuditLogger(logger.Named("AuditLogger"), &auditLoggerTestConfig)
+	auditLogger, err := audit.NewAuditLogger(logger.Named("AuditLogger"), &auditLoggerTestConfig, nil)
 	assert.NoError(t, err)
 
 	// Cast to concrete type so we can swap out the internals
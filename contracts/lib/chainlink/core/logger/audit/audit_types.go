@@ -20,6 +20,15 @@ const (
 	APITokenDeleteAttemptPasswordMismatch EventID = "API_TOKEN_DELETE_ATTEMPT_PASSWORD_MISMATCH"
 	APITokenDeleted                       EventID = "API_TOKEN_DELETED"
 
+	UserCreated     EventID = "USER_CREATED"
+	UserRoleUpdated EventID = "USER_ROLE_UPDATED"
+	UserDeleted     EventID = "USER_DELETED"
+
+	CustomRoleCreated  EventID = "CUSTOM_ROLE_CREATED"
+	CustomRoleUpdated  EventID = "CUSTOM_ROLE_UPDATED"
+	CustomRoleDeleted  EventID = "CUSTOM_ROLE_DELETED"
+	CustomRoleAssigned EventID = "CUSTOM_ROLE_ASSIGNED"
+
 	FeedsManCreated EventID = "FEEDS_MAN_CREATED"
 	FeedsManUpdated EventID = "FEEDS_MAN_UPDATED"
 
@@ -51,7 +60,12 @@ const (
 	KeyExported EventID = "KEY_EXPORTED"
 	KeyDeleted  EventID = "KEY_DELETED"
 
+	KeyBundleExported EventID = "KEY_BUNDLE_EXPORTED"
+	KeyBundleImported EventID = "KEY_BUNDLE_IMPORTED"
+
 	EthTransactionCreated    EventID = "ETH_TRANSACTION_CREATED"
+	EthTransactionCancelled  EventID = "ETH_TRANSACTION_CANCELLED"
+	EthTransactionSpedUp     EventID = "ETH_TRANSACTION_SPED_UP"
 	CosmosTransactionCreated EventID = "COSMOS_TRANSACTION_CREATED"
 	SolanaTransactionCreated EventID = "SOLANA_TRANSACTION_CREATED"
 
@@ -65,6 +79,8 @@ const (
 	ChainRpcNodeAdded   EventID = "CHAIN_RPC_NODE_ADDED"
 	ChainRpcNodeDeleted EventID = "CHAIN_RPC_NODE_DELETED"
 
+	ReplayFromBlockStarted EventID = "REPLAY_FROM_BLOCK_STARTED"
+
 	BridgeCreated EventID = "BRIDGE_CREATED"
 	BridgeUpdated EventID = "BRIDGE_UPDATED"
 	BridgeDeleted EventID = "BRIDGE_DELETED"
@@ -75,6 +91,11 @@ const (
 	ExternalInitiatorCreated EventID = "EXTERNAL_INITIATOR_CREATED"
 	ExternalInitiatorDeleted EventID = "EXTERNAL_INITIATOR_DELETED"
 
+	WebhookTriggerTokenCreated EventID = "WEBHOOK_TRIGGER_TOKEN_CREATED"
+	WebhookTriggerTokenRevoked EventID = "WEBHOOK_TRIGGER_TOKEN_REVOKED"
+	WebhookTriggerAccepted     EventID = "WEBHOOK_TRIGGER_ACCEPTED"
+	WebhookTriggerRejected     EventID = "WEBHOOK_TRIGGER_REJECTED"
+
 	JobProposalSpecApproved EventID = "JOB_PROPOSAL_SPEC_APPROVED"
 	JobProposalSpecUpdated  EventID = "JOB_PROPOSAL_SPEC_UPDATED"
 	JobProposalSpecCanceled EventID = "JOB_PROPOSAL_SPEC_CANCELED"
@@ -87,8 +108,12 @@ const (
 
 	JobErrorDismissed EventID = "JOB_ERROR_DISMISSED"
 	JobRunSet         EventID = "JOB_RUN_SET"
+	JobSimulated      EventID = "JOB_SIMULATED"
+	JobRunsPruned     EventID = "JOB_RUNS_PRUNED"
 
 	EnvNoncriticalEnvDumped EventID = "ENV_NONCRITICAL_ENV_DUMPED"
 
+	ProfileDeleted EventID = "PROFILE_DELETED"
+
 	UnauthedRunResumed EventID = "UNAUTHED_RUN_RESUMED"
 )
@@ -0,0 +1,93 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+)
+
+type actorKey struct{}
+type requestIDKey struct{}
+
+// Actor is the authenticated user or service which made a request.
+type Actor struct {
+	// Name is the email of a user, or the name of an external initiator.
+	Name string
+	Role string
+}
+
+// WithActor returns a copy of ctx which carries actor.
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor carried by ctx.
+func ActorFromContext(ctx context.Context) (Actor, bool) {
+	actor, ok := ctx.Value(actorKey{}).(Actor)
+	return actor, ok
+}
+
+// WithRequestID returns a copy of ctx which carries the ID of the request it belongs to.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext returns the request ID carried by ctx.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	requestID, ok := ctx.Value(requestIDKey{}).(string)
+	return requestID, ok
+}
+
+// WithContext returns a copy of data with the actor, role and request ID carried by ctx, so that every event
+// records who made a change, and which request made it.
+func WithContext(ctx context.Context, data Data) Data {
+	out := make(Data, len(data)+3)
+	for k, v := range data {
+		out[k] = v
+	}
+	if actor, ok := ActorFromContext(ctx); ok {
+		out["actor"] = actor.Name
+		out["role"] = actor.Role
+	}
+	if requestID, ok := RequestIDFromContext(ctx); ok {
+		out["requestID"] = requestID
+	}
+	return out
+}
+
+// Change is the before and after value of a changed field.
+type Change struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// Diff returns the fields of the JSON encodings of before and after which differ, by field name. Fields
+// which only exist on one side have a nil value on the other.
+func Diff(before, after any) map[string]Change {
+	b, a := toFields(before), toFields(after)
+	diff := map[string]Change{}
+	for k, bv := range b {
+		if av, ok := a[k]; !ok || !reflect.DeepEqual(av, bv) {
+			diff[k] = Change{Before: bv, After: a[k]}
+		}
+	}
+	for k, av := range a {
+		if _, ok := b[k]; !ok {
+			diff[k] = Change{After: av}
+		}
+	}
+	return diff
+}
+
+func toFields(v any) map[string]any {
+	fields := map[string]any{}
+	if v == nil {
+		return fields
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fields
+	}
+	_ = json.Unmarshal(b, &fields)
+	return fields
+}
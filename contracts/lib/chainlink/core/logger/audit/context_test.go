@@ -0,0 +1,58 @@
+package audit_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+)
+
+func TestWithContext(t *testing.T) {
+	t.Parallel()
+
+	data := audit.Data{"name": "bridge"}
+
+	ctx := testutils.Context(t)
+	assert.Equal(t, data, audit.WithContext(ctx, data))
+
+	ctx = audit.WithActor(ctx, audit.Actor{Name: "user@example.com", Role: "admin"})
+	ctx = audit.WithRequestID(ctx, "request-id")
+	assert.Equal(t, audit.Data{
+		"name":      "bridge",
+		"actor":     "user@example.com",
+		"role":      "admin",
+		"requestID": "request-id",
+	}, audit.WithContext(ctx, data))
+	// data is not modified
+	assert.Equal(t, audit.Data{"name": "bridge"}, data)
+}
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	type bridge struct {
+		Name          string `json:"name"`
+		URL           string `json:"url"`
+		Confirmations uint32 `json:"confirmations"`
+		Token         string `json:"token,omitempty"`
+	}
+	before := bridge{Name: "bridge", URL: "https://a.example.com", Confirmations: 1}
+	after := bridge{Name: "bridge", URL: "https://b.example.com", Confirmations: 1, Token: "token"}
+
+	assert.Empty(t, audit.Diff(before, before))
+	assert.Equal(t, map[string]audit.Change{
+		"url":   {Before: "https://a.example.com", After: "https://b.example.com"},
+		"token": {After: "token"},
+	}, audit.Diff(before, after))
+	assert.Equal(t, map[string]audit.Change{
+		"url":   {Before: "https://b.example.com", After: "https://a.example.com"},
+		"token": {Before: "token"},
+	}, audit.Diff(after, before))
+	assert.Equal(t, map[string]audit.Change{
+		"name":          {After: "bridge"},
+		"url":           {After: "https://a.example.com"},
+		"confirmations": {After: float64(1)},
+	}, audit.Diff(nil, before))
+}
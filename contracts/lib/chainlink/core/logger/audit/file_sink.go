@@ -0,0 +1,72 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// FileName is the name of the audit log file written by the file sink.
+const FileName = "audit.jsonl"
+
+type fileSink struct {
+	w *lumberjack.Logger
+}
+
+// NewFileSink returns a Sink that appends records as JSON lines to dir/audit.jsonl, rotating the file when it
+// reaches maxSize and keeping at most maxBackups rotated files.
+func NewFileSink(dir string, maxSize utils.FileSize, maxBackups int64) (Sink, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create audit log directory: %w", err)
+	}
+	return &fileSink{w: &lumberjack.Logger{
+		Filename:   filepath.Join(dir, FileName),
+		MaxSize:    int(maxSize / utils.MB),
+		MaxBackups: int(maxBackups),
+	}}, nil
+}
+
+func (s *fileSink) Name() string { return "file" }
+
+func (s *fileSink) Write(_ context.Context, r Record) error {
+	line := make([]byte, 0, len(r.Line)+1)
+	line = append(line, r.Line...)
+	line = append(line, '\n')
+	_, err := s.w.Write(line)
+	return err
+}
+
+func (s *fileSink) Close() error { return s.w.Close() }
+
+// RekeyFile moves the hash chain of the audit log in dir to newKey, when the keystore password, which oldKey and
+// newKey are derived from, is rotated. The log file is rotated, so that every file verifies with a single key, and
+// its signed head is re-signed with newKey, so that the next record written links to the last record written with
+// oldKey. It does nothing if the log has no records.
+func RekeyFile(dir string, maxSize utils.FileSize, maxBackups int64, oldKey, newKey []byte) error {
+	path := filepath.Join(dir, FileName)
+	hash, err := ReadHead(path+HeadSuffix, oldKey)
+	if err != nil {
+		return fmt.Errorf("failed to read the signed head of the audit log: %w", err)
+	}
+	if hash == "" {
+		if hash, err = lastHash(path); err != nil {
+			return fmt.Errorf("failed to read the last audit log record: %w", err)
+		}
+	}
+	if hash == "" {
+		return nil
+	}
+	w := &lumberjack.Logger{Filename: path, MaxSize: int(maxSize / utils.MB), MaxBackups: int(maxBackups)}
+	if err = w.Rotate(); err != nil {
+		return fmt.Errorf("failed to rotate the audit log: %w", err)
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+	return writeHead(path+HeadSuffix, newKey, hash)
+}
@@ -0,0 +1,218 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+const (
+	hashSuffixPrefix = `,"hash":"`
+	hashLen          = sha256.Size * 2
+	maxRecordSize    = 16 * 1024 * 1024
+
+	// HeadSuffix is appended to the path of an audit log file to name the file holding its signed head.
+	HeadSuffix = ".head"
+
+	chainKeyLabel = "chainlink audit log hash chain"
+	headLabel     = "head:"
+)
+
+// ChainKey derives the key of the audit log hash chain from the keystore password of the node, so that only the
+// node, and operators knowing the password, can produce and verify the hashes of its records.
+func ChainKey(keystorePassword string) []byte {
+	mac := hmac.New(sha256.New, []byte(keystorePassword))
+	mac.Write([]byte(chainKeyLabel))
+	return mac.Sum(nil)
+}
+
+// hashChain links audit log records. Each record carries the hash of the previous record in prevHash, and
+// its own hash is appended as the last field. The hash is an HMAC-SHA256, keyed with the chain key, of the exact
+// bytes of the record without it. If headPath is set, the hash of the last written record is persisted there
+// with its own HMAC, by flushHead, so that removing records from the end of the log can be detected too.
+type hashChain struct {
+	key      []byte
+	headPath string
+	prev     string
+	// dirty is set when prev has not been persisted as the signed head yet
+	dirty bool
+}
+
+// link returns the record with its hash appended, and the hash. The chain only moves on to the record once it
+// has been written, with advance.
+func (c *hashChain) link(record []byte) (line []byte, hash string) {
+	hash = sum(c.key, record)
+	line = make([]byte, 0, len(record)+len(hashSuffixPrefix)+hashLen+1)
+	line = append(line, record[:len(record)-1]...)
+	line = append(line, hashSuffixPrefix...)
+	line = append(line, hash...)
+	return append(line, `"}`...), hash
+}
+
+// advance makes hash the previous hash of the next record.
+func (c *hashChain) advance(hash string) {
+	c.prev = hash
+	c.dirty = c.headPath != ""
+}
+
+// flushHead persists the previous hash as the signed head, if it moved on since it was last persisted.
+func (c *hashChain) flushHead() error {
+	if !c.dirty {
+		return nil
+	}
+	if err := writeHead(c.headPath, c.key, c.prev); err != nil {
+		return err
+	}
+	c.dirty = false
+	return nil
+}
+
+func sum(key, b []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(b)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+type head struct {
+	Hash string `json:"hash"`
+	MAC  string `json:"mac"`
+}
+
+// writeHead atomically replaces the signed head at path.
+func writeHead(path string, key []byte, hash string) error {
+	b, err := json.Marshal(head{Hash: hash, MAC: sum(key, []byte(headLabel+hash))})
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err = os.WriteFile(tmp, b, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// ReadHead returns the hash of the last record written to an audit log, from its signed head at path. It returns
+// an empty hash if the head does not exist.
+func ReadHead(path string, key []byte) (string, error) {
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+	var h head
+	if err = json.Unmarshal(b, &h); err != nil {
+		return "", fmt.Errorf("invalid head: %w", err)
+	}
+	if !hmac.Equal([]byte(sum(key, []byte(headLabel+h.Hash))), []byte(h.MAC)) {
+		return "", errors.New("head signature mismatch, the head has been modified or the key is wrong")
+	}
+	return h.Hash, nil
+}
+
+// unlink splits a linked line into the record that was hashed and its hash.
+func unlink(line []byte) (record []byte, hash string, err error) {
+	n := len(hashSuffixPrefix) + hashLen + 2
+	if len(line) < n+1 || !bytes.HasSuffix(line, []byte(`"}`)) || !bytes.HasPrefix(line[len(line)-n:], []byte(hashSuffixPrefix)) {
+		return nil, "", errors.New("record has no hash")
+	}
+	hash = string(line[len(line)-hashLen-2 : len(line)-2])
+	record = make([]byte, 0, len(line)-n+1)
+	record = append(record, line[:len(line)-n]...)
+	return append(record, '}'), hash, nil
+}
+
+// ChainVerification is the result of verifying the hash chain of an audit log.
+type ChainVerification struct {
+	Records int
+	// FirstPrevHash is the prevHash of the first record. It is empty if the log starts the chain, and otherwise
+	// links the log to the last record of the previous, rotated, log file.
+	FirstPrevHash string
+	LastHash      string
+}
+
+// VerifyChain checks that every record read from r carries its own hash, computed with key, and the hash of the
+// record before it.
+func VerifyChain(r io.Reader, key []byte) (v ChainVerification, err error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxRecordSize)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := scanner.Bytes()
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		record, hash, err := unlink(line)
+		if err != nil {
+			return v, fmt.Errorf("line %d: %w", lineNo, err)
+		}
+		if !hmac.Equal([]byte(sum(key, record)), []byte(hash)) {
+			return v, fmt.Errorf("line %d: hash mismatch, the record has been modified or the key is wrong", lineNo)
+		}
+		var fields struct {
+			PrevHash *string `json:"prevHash"`
+		}
+		if err = json.Unmarshal(record, &fields); err != nil {
+			return v, fmt.Errorf("line %d: invalid record: %w", lineNo, err)
+		}
+		if fields.PrevHash == nil {
+			return v, fmt.Errorf("line %d: record has no prevHash", lineNo)
+		}
+		if v.Records == 0 {
+			v.FirstPrevHash = *fields.PrevHash
+		} else if *fields.PrevHash != v.LastHash {
+			return v, fmt.Errorf("line %d: prevHash does not match the previous record, records have been removed or reordered", lineNo)
+		}
+		v.Records++
+		v.LastHash = hash
+	}
+	return v, scanner.Err()
+}
+
+// lastHash returns the hash of the last record of the audit log at path, so that a restarted node continues
+// its chain. It returns an empty hash if the file does not exist or is empty.
+func lastHash(path string) (string, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return "", err
+	}
+
+	// read increasingly large chunks from the end of the file until the last line is complete
+	size := info.Size()
+	for chunk := int64(64 * 1024); ; chunk *= 2 {
+		if chunk > size {
+			chunk = size
+		}
+		buf := make([]byte, chunk)
+		if _, err = f.ReadAt(buf, size-chunk); err != nil && !errors.Is(err, io.EOF) {
+			return "", err
+		}
+		buf = bytes.TrimRight(buf, "\n")
+		i := bytes.LastIndexByte(buf, '\n')
+		if i < 0 && chunk < size {
+			continue
+		}
+		line := buf[i+1:]
+		if len(line) == 0 {
+			return "", nil
+		}
+		_, hash, err := unlink(line)
+		if err != nil {
+			return "", fmt.Errorf("last record of %s: %w", path, err)
+		}
+		return hash, nil
+	}
+}
@@ -0,0 +1,90 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+// Record is a serialized audit log event.
+type Record struct {
+	EventID EventID
+	Time    time.Time
+	// Line is the JSON encoded record, without a trailing newline.
+	Line []byte
+}
+
+// Sink is a destination for audit log records. Records are written to sinks one at a time, in order.
+type Sink interface {
+	Name() string
+	Write(ctx context.Context, r Record) error
+	Close() error
+}
+
+type httpSink struct {
+	forwardToUrl   commonconfig.URL         // Location we are going to send logs to
+	headers        []models.ServiceHeader   // Headers to be sent along with logs for identification/authentication
+	jsonWrapperKey string                   // Wrap audit data as a map under this key if present
+	client         HTTPAuditLoggerInterface // Abstract type for sending logs onward
+}
+
+// NewHTTPSink returns a Sink that POSTs each record to forwardToUrl.
+func NewHTTPSink(forwardToUrl commonconfig.URL, headers []models.ServiceHeader, jsonWrapperKey string) Sink {
+	return &httpSink{
+		forwardToUrl:   forwardToUrl,
+		headers:        headers,
+		jsonWrapperKey: jsonWrapperKey,
+		client:         &http.Client{Timeout: time.Second * webRequestTimeout},
+	}
+}
+
+func (s *httpSink) Name() string { return "http" }
+
+// Write sends the record to the configured logging endpoint. This blocks on
+// the send but times out after a period of several seconds, so that a single
+// log can't get stuck on transient network errors.
+func (s *httpSink) Write(ctx context.Context, r Record) error {
+	body := r.Line
+	// Optionally wrap audit log data into JSON object to help dynamically structure for an HTTP log service call
+	if s.jsonWrapperKey != "" {
+		var err error
+		body, err = json.Marshal(map[string]json.RawMessage{s.jsonWrapperKey: r.Line})
+		if err != nil {
+			return fmt.Errorf("unable to wrap audit log item: %w", err)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", (*url.URL)(&s.forwardToUrl).String(), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request to remote logging service: %w", err)
+	}
+	for _, header := range s.headers {
+		req.Header.Add(header.Header, header.Value)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send audit log to HTTP log service: %w", err)
+	}
+	if resp.StatusCode != 200 {
+		if resp.Body == nil {
+			return fmt.Errorf("HTTP log service responded with status %d and no body", resp.StatusCode)
+		}
+		defer resp.Body.Close()
+		bodyBytes, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return fmt.Errorf("error reading errored HTTP log service response body: %w", err)
+		}
+		return fmt.Errorf("HTTP log service responded with status %d: %s", resp.StatusCode, string(bodyBytes))
+	}
+	return nil
+}
+
+func (s *httpSink) Close() error { return nil }
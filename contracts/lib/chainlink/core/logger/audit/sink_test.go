@@ -0,0 +1,346 @@
+package audit_test
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+)
+
+type sinkConfig struct {
+	Config
+
+	file      FileConfig
+	syslog    SyslogConfig
+	hashChain bool
+}
+
+func (c sinkConfig) ForwardToUrl() (commonconfig.URL, error) { return commonconfig.URL{}, nil }
+func (c sinkConfig) HashChain() bool                         { return c.hashChain }
+func (c sinkConfig) File() config.AuditLoggerFile            { return c.file }
+func (c sinkConfig) Syslog() config.AuditLoggerSyslog        { return c.syslog }
+
+var chainKey = audit.ChainKey("password")
+
+func auditN(t *testing.T, cfg sinkConfig, n int, wait func() bool) {
+	auditLogger, err := audit.NewAuditLogger(logger.TestLogger(t), cfg, chainKey)
+	require.NoError(t, err)
+	require.NoError(t, auditLogger.Start(testutils.Context(t)))
+	for i := 0; i < n; i++ {
+		auditLogger.Audit(audit.AuthLoginSuccessNo2FA, audit.Data{"email": "user@example.com", "n": i})
+	}
+	require.Eventually(t, wait, testutils.WaitTimeout(t), 10*time.Millisecond)
+	require.NoError(t, auditLogger.Close())
+}
+
+func readLines(t *testing.T, path string) []string {
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return strings.Split(strings.TrimRight(string(b), "\n"), "\n")
+}
+
+func TestFileSink_HashChain(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, audit.FileName)
+	cfg := sinkConfig{file: FileConfig{dir: dir}, hashChain: true}
+	countLines := func(n int) func() bool {
+		return func() bool {
+			b, err := os.ReadFile(path)
+			return err == nil && bytes.Count(b, []byte("\n")) == n
+		}
+	}
+
+	auditN(t, cfg, 3, countLines(3))
+	// a restarted logger continues the chain
+	auditN(t, cfg, 2, countLines(5))
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	v, err := audit.VerifyChain(f, chainKey)
+	require.NoError(t, f.Close())
+	require.NoError(t, err)
+	assert.Equal(t, 5, v.Records)
+	assert.Empty(t, v.FirstPrevHash)
+	assert.Len(t, v.LastHash, 64)
+
+	head, err := audit.ReadHead(path+audit.HeadSuffix, chainKey)
+	require.NoError(t, err)
+	assert.Equal(t, v.LastHash, head)
+	_, err = audit.ReadHead(path+audit.HeadSuffix, audit.ChainKey("other"))
+	require.ErrorContains(t, err, "head signature mismatch")
+
+	lines := readLines(t, path)
+	assert.Contains(t, lines[0], `"eventID":"AUTH_LOGIN_SUCCESS_NO_2FA"`)
+
+	t.Run("modified record", func(t *testing.T) {
+		tampered := append([]string{}, lines...)
+		tampered[2] = strings.Replace(tampered[2], "user@example.com", "attacker@example.com", 1)
+		_, err := audit.VerifyChain(strings.NewReader(strings.Join(tampered, "\n")), chainKey)
+		require.ErrorContains(t, err, "line 3: hash mismatch")
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		_, err := audit.VerifyChain(strings.NewReader(strings.Join(lines, "\n")), audit.ChainKey("other"))
+		require.ErrorContains(t, err, "line 1: hash mismatch")
+	})
+
+	t.Run("removed record", func(t *testing.T) {
+		tampered := append(append([]string{}, lines[:2]...), lines[3:]...)
+		_, err := audit.VerifyChain(strings.NewReader(strings.Join(tampered, "\n")), chainKey)
+		require.ErrorContains(t, err, "line 3: prevHash does not match")
+	})
+
+	t.Run("rotated log", func(t *testing.T) {
+		v, err := audit.VerifyChain(strings.NewReader(strings.Join(lines[2:], "\n")), chainKey)
+		require.NoError(t, err)
+		assert.Equal(t, 3, v.Records)
+		assert.NotEmpty(t, v.FirstPrevHash)
+	})
+
+	t.Run("unchained record", func(t *testing.T) {
+		_, err := audit.VerifyChain(strings.NewReader(`{"eventID":"AUTH_LOGIN_SUCCESS_NO_2FA"}`), chainKey)
+		require.ErrorContains(t, err, "line 1: record has no hash")
+	})
+}
+
+func TestFileSink_HashChain_Truncated(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, audit.FileName)
+	cfg := sinkConfig{file: FileConfig{dir: dir}, hashChain: true}
+	countLines := func(n int) func() bool {
+		return func() bool {
+			b, err := os.ReadFile(path)
+			return err == nil && bytes.Count(b, []byte("\n")) == n
+		}
+	}
+
+	auditN(t, cfg, 3, countLines(3))
+	lines := readLines(t, path)
+	require.NoError(t, os.WriteFile(path, []byte(strings.Join(lines[:2], "\n")+"\n"), 0o600))
+
+	// the restarted logger continues from the signed head, so the removed record is detected
+	auditN(t, cfg, 1, countLines(3))
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	_, err = audit.VerifyChain(f, chainKey)
+	require.NoError(t, f.Close())
+	require.ErrorContains(t, err, "line 3: prevHash does not match")
+}
+
+func TestFileSink_HashChain_Rekeyed(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, audit.FileName)
+	cfg := sinkConfig{file: FileConfig{dir: dir}, hashChain: true}
+	countLines := func(n int) func() bool {
+		return func() bool {
+			b, err := os.ReadFile(path)
+			return err == nil && bytes.Count(b, []byte("\n")) == n
+		}
+	}
+
+	auditN(t, cfg, 2, countLines(2))
+	lines := readLines(t, path)
+	oldHead, err := audit.ReadHead(path+audit.HeadSuffix, chainKey)
+	require.NoError(t, err)
+
+	newKey := audit.ChainKey("new password")
+	require.NoError(t, audit.RekeyFile(dir, cfg.file.MaxSize(), cfg.file.MaxBackups(), chainKey, newKey))
+	backups, err := filepath.Glob(filepath.Join(dir, "audit-*.jsonl"))
+	require.NoError(t, err)
+	require.Len(t, backups, 1)
+	assert.Equal(t, lines, readLines(t, backups[0]))
+	head, err := audit.ReadHead(path+audit.HeadSuffix, newKey)
+	require.NoError(t, err)
+	assert.Equal(t, oldHead, head)
+
+	// the logger of the restarted node continues the chain in the new file, with the new key
+	auditLogger, err := audit.NewAuditLogger(logger.TestLogger(t), cfg, newKey)
+	require.NoError(t, err)
+	require.NoError(t, auditLogger.Start(testutils.Context(t)))
+	auditLogger.Audit(audit.AuthLoginSuccessNo2FA, audit.Data{"email": "user@example.com"})
+	require.Eventually(t, countLines(1), testutils.WaitTimeout(t), 10*time.Millisecond)
+	require.NoError(t, auditLogger.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	v, err := audit.VerifyChain(f, newKey)
+	require.NoError(t, f.Close())
+	require.NoError(t, err)
+	assert.Equal(t, 1, v.Records)
+	assert.Equal(t, oldHead, v.FirstPrevHash)
+
+	// a log without records is left as it is
+	empty := t.TempDir()
+	require.NoError(t, audit.RekeyFile(empty, cfg.file.MaxSize(), cfg.file.MaxBackups(), chainKey, newKey))
+	entries, err := os.ReadDir(empty)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+// forwardingSinkConfig also forwards logs to the HTTP sink.
+type forwardingSinkConfig struct {
+	sinkConfig
+}
+
+func (c forwardingSinkConfig) ForwardToUrl() (commonconfig.URL, error) {
+	return c.Config.ForwardToUrl()
+}
+
+// flakyHTTPClient fails the first request, and records the bodies of the others.
+type flakyHTTPClient struct {
+	mu     sync.Mutex
+	calls  int
+	bodies []string
+}
+
+func (c *flakyHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls++
+	if c.calls == 1 {
+		return nil, errors.New("unavailable")
+	}
+	b, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	c.bodies = append(c.bodies, string(b))
+	return &http.Response{StatusCode: http.StatusOK}, nil
+}
+
+func TestHashChain_PerSink(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, audit.FileName)
+	cfg := forwardingSinkConfig{sinkConfig{file: FileConfig{dir: dir}, hashChain: true}}
+	client := &flakyHTTPClient{}
+
+	auditLogger, err := audit.NewAuditLogger(logger.TestLogger(t), cfg, chainKey)
+	require.NoError(t, err)
+	auditLogger.(*audit.AuditLoggerService).SetLoggingClient(client)
+	require.NoError(t, auditLogger.Start(testutils.Context(t)))
+	for i := 0; i < 3; i++ {
+		auditLogger.Audit(audit.AuthLoginSuccessNo2FA, audit.Data{"email": "user@example.com", "n": i})
+	}
+	require.Eventually(t, func() bool {
+		client.mu.Lock()
+		defer client.mu.Unlock()
+		return len(client.bodies) == 2
+	}, testutils.WaitTimeout(t), 10*time.Millisecond)
+	require.NoError(t, auditLogger.Close())
+
+	// the HTTP sink failed to write the first record, so its chain starts at the second one
+	v, err := audit.VerifyChain(strings.NewReader(strings.Join(client.bodies, "\n")), chainKey)
+	require.NoError(t, err)
+	assert.Equal(t, 2, v.Records)
+	assert.Empty(t, v.FirstPrevHash)
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	v, err = audit.VerifyChain(f, chainKey)
+	require.NoError(t, f.Close())
+	require.NoError(t, err)
+	assert.Equal(t, 3, v.Records)
+	head, err := audit.ReadHead(path+audit.HeadSuffix, chainKey)
+	require.NoError(t, err)
+	assert.Equal(t, v.LastHash, head)
+}
+
+func TestSyslogSink(t *testing.T) {
+	t.Parallel()
+
+	t.Run("udp", func(t *testing.T) {
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		require.NoError(t, err)
+		t.Cleanup(func() { assert.NoError(t, conn.Close()) })
+
+		msgs := make(chan string, 1)
+		go func() {
+			buf := make([]byte, 64*1024)
+			n, _, err := conn.ReadFrom(buf)
+			if err == nil {
+				msgs <- string(buf[:n])
+			}
+		}()
+
+		var msg string
+		auditN(t, sinkConfig{syslog: SyslogConfig{network: "udp", address: conn.LocalAddr().String()}}, 1, func() bool {
+			select {
+			case msg = <-msgs:
+				return true
+			default:
+				return false
+			}
+		})
+		assert.True(t, strings.HasPrefix(msg, "<109>1 "), msg)
+		assert.Contains(t, msg, " chainlink ")
+		assert.Contains(t, msg, " AUTH_LOGIN_SUCCESS_NO_2FA - {")
+		assert.Contains(t, msg, `"email":"user@example.com"`)
+	})
+
+	t.Run("tcp", func(t *testing.T) {
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		t.Cleanup(func() { assert.NoError(t, ln.Close()) })
+
+		msgs := make(chan string, 1)
+		go func() {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+			r := bufio.NewReader(conn)
+			// octet counting framing
+			length, err := r.ReadString(' ')
+			if err != nil {
+				return
+			}
+			n, err := strconv.Atoi(strings.TrimSpace(length))
+			if err != nil {
+				return
+			}
+			msg := make([]byte, n)
+			if _, err = io.ReadFull(r, msg); err == nil {
+				msgs <- string(msg)
+			}
+		}()
+
+		var msg string
+		auditN(t, sinkConfig{syslog: SyslogConfig{network: "tcp", address: ln.Addr().String()}}, 1, func() bool {
+			select {
+			case msg = <-msgs:
+				return true
+			default:
+				return false
+			}
+		})
+		assert.True(t, strings.HasPrefix(msg, "<109>1 "), msg)
+		assert.Contains(t, msg, `"email":"user@example.com"`)
+	})
+}
@@ -0,0 +1,107 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"time"
+)
+
+const (
+	// syslogPriority is the "log audit" facility (13) with the "notice" severity (5).
+	syslogPriority  = 13*8 + 5
+	syslogMaxMsgID  = 32
+	syslogDialLimit = 5 * time.Second
+	// syslogWriteLimit bounds each write, so that a stalled server cannot block the audit logger.
+	syslogWriteLimit = 5 * time.Second
+)
+
+type syslogSink struct {
+	network  string
+	address  string
+	tag      string
+	hostname string
+
+	conn   net.Conn
+	framed bool // stream transports use octet counting framing (RFC 6587)
+}
+
+// NewSyslogSink returns a Sink that sends records as RFC 5424 messages to the syslog server at address. network
+// is one of "udp", "tcp" or "unix". The connection is established on the first write, and re-established when a
+// write fails.
+func NewSyslogSink(network, address, tag, hostname string) Sink {
+	if hostname == "" {
+		hostname = "-"
+	}
+	return &syslogSink{network: network, address: address, tag: tag, hostname: hostname}
+}
+
+func (s *syslogSink) Name() string { return "syslog" }
+
+func (s *syslogSink) Write(ctx context.Context, r Record) error {
+	msg := s.format(r)
+	if s.conn != nil {
+		if err := s.send(msg); err == nil {
+			return nil
+		}
+		_ = s.conn.Close()
+		s.conn = nil
+	}
+	if err := s.connect(ctx); err != nil {
+		return err
+	}
+	return s.send(msg)
+}
+
+func (s *syslogSink) Close() error {
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
+
+// format returns r as an RFC 5424 message, with the event ID as MSGID.
+func (s *syslogSink) format(r Record) []byte {
+	msgID := string(r.EventID)
+	if len(msgID) > syslogMaxMsgID {
+		msgID = msgID[:syslogMaxMsgID]
+	}
+	return []byte(fmt.Sprintf("<%d>1 %s %s %s %d %s - %s", syslogPriority, r.Time.UTC().Format(time.RFC3339Nano), s.hostname, s.tag, os.Getpid(), msgID, r.Line))
+}
+
+func (s *syslogSink) send(msg []byte) error {
+	if s.framed {
+		msg = append([]byte(fmt.Sprintf("%d ", len(msg))), msg...)
+	}
+	if err := s.conn.SetWriteDeadline(time.Now().Add(syslogWriteLimit)); err != nil {
+		return err
+	}
+	_, err := s.conn.Write(msg)
+	return err
+}
+
+func (s *syslogSink) connect(ctx context.Context) error {
+	d := net.Dialer{Timeout: syslogDialLimit}
+	switch s.network {
+	case "unix":
+		// local syslog daemons usually listen on a datagram socket, fall back to a stream socket
+		for _, network := range []string{"unixgram", "unix"} {
+			conn, err := d.DialContext(ctx, network, s.address)
+			if err == nil {
+				s.conn, s.framed = conn, network == "unix"
+				return nil
+			}
+		}
+		return fmt.Errorf("failed to connect to syslog socket %s", s.address)
+	default:
+		conn, err := d.DialContext(ctx, s.network, s.address)
+		if err != nil {
+			return fmt.Errorf("failed to connect to syslog server: %w", err)
+		}
+		s.conn, s.framed = conn, s.network == "tcp"
+		return nil
+	}
+}
@@ -3,6 +3,7 @@ package chainlink
 import (
 	"bytes"
 	"context"
+	"database/sql"
 	"fmt"
 	"math/big"
 	"net/http"
@@ -102,6 +103,7 @@ type Application interface {
 	GetWebAuthnConfiguration() sessions.WebAuthnConfiguration
 
 	GetExternalInitiatorManager() webhook.ExternalInitiatorManager
+	GetWebhookTriggerTokenManager() webhook.TriggerTokenManager
 	GetRelayers() RelayerChainInteroperators
 	GetLoopRegistry() *plugins.LoopRegistry
 	GetLoopRegistrarConfig() plugins.RegistrarConfig
@@ -112,13 +114,19 @@ type Application interface {
 	EVMORM() evmtypes.Configs
 	PipelineORM() pipeline.ORM
 	BridgeORM() bridges.ORM
+	// BridgeHealth returns the circuit breaker state of every URL of the bridge.
+	BridgeHealth(bt bridges.BridgeType) []bridges.URLHealth
 	BasicAdminUsersORM() sessions.BasicAdminUsersORM
 	AuthenticationProvider() sessions.AuthenticationProvider
 	TxmStorageService() txmgr.EvmTxStore
 	AddJobV2(ctx context.Context, job *job.Job) error
 	DeleteJob(ctx context.Context, jobID int32) error
-	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable) (int64, error)
+	// RunWebhookJobV2 runs a webhook job. Repeated requests with the same non-empty idempotencyKey return the run of
+	// the first one.
+	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable, idempotencyKey string) (int64, error)
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
+	// SimulateJobV2 dry-runs the pipeline of a job against vars without persisting anything.
+	SimulateJobV2(ctx context.Context, jobID int32, vars map[string]interface{}, stubs map[string]pipeline.TaskStub) (*pipeline.Simulation, error)
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error)
 
@@ -158,6 +166,7 @@ type ChainlinkApplication struct {
 	Config                   GeneralConfig
 	KeyStore                 keystore.Master
 	ExternalInitiatorManager webhook.ExternalInitiatorManager
+	webhookTriggerTokens     webhook.TriggerTokenManager
 	SessionReaper            *utils.SleeperTask
 	shutdownOnce             sync.Once
 	srvcs                    []services.ServiceCtx
@@ -180,6 +189,7 @@ type ApplicationOpts struct {
 	Logger                     logger.Logger
 	MailMon                    *mailbox.Monitor
 	DS                         sqlutil.DataSource
+	DBStats                    func() sql.DBStats // optional, for the DBPoolSaturation trigger of the nurse
 	KeyStore                   keystore.Master
 	RelayerChainInteroperators *CoreRelayerChainInteroperators
 	AuditLogger                audit.AuditLogger
@@ -304,6 +314,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	}
 
 	var externalPeerWrapper p2ptypes.PeerWrapper
+	var registrySyncer registrysyncer.RegistrySyncer
 	if cfg.Capabilities().Peering().Enabled() {
 		var dispatcher remotetypes.Dispatcher
 		if opts.CapabilitiesDispatcher == nil {
@@ -329,7 +340,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 			if err != nil {
 				return nil, fmt.Errorf("could not fetch relayer %s configured for capabilities registry: %w", rid, err)
 			}
-			registrySyncer, err := registrysyncer.New(
+			registrySyncer, err = registrysyncer.New(
 				globalLogger,
 				func() (p2ptypes.PeerID, error) {
 					p := externalPeerWrapper.GetPeer()
@@ -463,14 +474,6 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		globalLogger.Debug("Pyroscope (automatic pprof profiling) is disabled")
 	}
 
-	ap := cfg.AutoPprof()
-	if ap.Enabled() {
-		globalLogger.Info("Nurse service (automatic pprof profiling) is enabled")
-		srvcs = append(srvcs, services.NewNurse(ap, globalLogger))
-	} else {
-		globalLogger.Info("Nurse service (automatic pprof profiling) is disabled")
-	}
-
 	telemetryManager := telemetry.NewManager(cfg.TelemetryIngress(), keyStore.CSA(), globalLogger)
 	srvcs = append(srvcs, telemetryManager)
 
@@ -478,7 +481,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	if backupCfg.Mode() != config.DatabaseBackupModeNone && backupCfg.Frequency() > 0 {
 		globalLogger.Infow("DatabaseBackup: periodic database backups are enabled", "frequency", backupCfg.Frequency())
 
-		databaseBackup, err := periodicbackup.NewDatabaseBackup(cfg.Database().URL(), cfg.RootDir(), backupCfg, globalLogger)
+		databaseBackup, err := periodicbackup.NewDatabaseBackup(cfg.Database().URL(), cfg.RootDir(), backupCfg, cfg.Password().Keystore(), globalLogger)
 		if err != nil {
 			return nil, errors.Wrap(err, "NewApplication: failed to initialize database backup")
 		}
@@ -541,9 +544,10 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	var (
 		pipelineORM    = pipeline.NewORM(opts.DS, globalLogger, cfg.JobPipeline().MaxSuccessfulRuns())
 		bridgeORM      = bridges.NewORM(opts.DS)
+		bridgeCache    = bridges.NewCache(bridgeORM, globalLogger, bridges.DefaultUpsertInterval)
 		mercuryORM     = mercury.NewORM(opts.DS)
-		pipelineRunner = pipeline.NewRunner(pipelineORM, bridgeORM, cfg.JobPipeline(), cfg.WebServer(), legacyEVMChains, keyStore.Eth(), keyStore.VRF(), globalLogger, restrictedHTTPClient, unrestrictedHTTPClient)
-		jobORM         = job.NewORM(opts.DS, pipelineORM, bridgeORM, keyStore, globalLogger)
+		pipelineRunner = pipeline.NewRunner(pipelineORM, bridgeCache, cfg.JobPipeline(), cfg.WebServer(), legacyEVMChains, keyStore.Eth(), keyStore.VRF(), globalLogger, restrictedHTTPClient, unrestrictedHTTPClient)
+		jobORM         = job.NewORM(opts.DS, pipelineORM, bridgeCache, keyStore, globalLogger)
 		txmORM         = txmgr.NewTxStore(opts.DS, globalLogger)
 		streamRegistry = streams.NewRegistry(globalLogger, pipelineRunner)
 		workflowORM    = workflowstore.NewDBStore(opts.DS, globalLogger, clockwork.NewRealClock())
@@ -565,6 +569,25 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 
 	srvcs = append(srvcs, pipelineORM)
 
+	ap := cfg.AutoPprof()
+	if ap.Enabled() {
+		globalLogger.Info("Nurse service (automatic pprof profiling) is enabled")
+		srvcs = append(srvcs, services.NewNurse(ap, services.Vitals{
+			DBStats:                opts.DBStats,
+			PipelineRunsInProgress: pipelineRunner.RunsInProgress,
+			HeadTrackerLag: func() (lag time.Duration) {
+				for _, chain := range legacyEVMChains.Slice() {
+					if head := chain.HeadTracker().LatestChain(); head != nil {
+						lag = max(lag, time.Since(head.Timestamp))
+					}
+				}
+				return
+			},
+		}, globalLogger))
+	} else {
+		globalLogger.Info("Nurse service (automatic pprof profiling) is disabled")
+	}
+
 	loopRegistrarConfig := plugins.NewRegistrarConfig(opts.GRPCOpts, opts.LoopRegistry.Register, opts.LoopRegistry.Unregister)
 
 	var (
@@ -594,9 +617,11 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 			job.Webhook: webhook.NewDelegate(
 				pipelineRunner,
 				externalInitiatorManager,
+				webhook.NewORM(opts.DS),
 				globalLogger),
 			job.Cron: cron.NewDelegate(
 				pipelineRunner,
+				cron.NewORM(opts.DS),
 				globalLogger),
 			job.BlockhashStore: blockhashstore.NewDelegate(
 				cfg,
@@ -623,6 +648,11 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		webhookJobRunner = delegates[job.Webhook].(*webhook.Delegate).WebhookJobRunner()
 	)
 
+	if registrySyncer != nil {
+		// gateway DONs can follow the membership of DONs in the capabilities registry
+		registrySyncer.AddLauncher(delegates[job.Gateway].(*gateway.Delegate))
+	}
+
 	delegates[job.Workflow] = workflows.NewDelegate(
 		globalLogger,
 		opts.CapabilitiesRegistry,
@@ -701,7 +731,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 			ocr2.DelegateOpts{
 				Ds:                    opts.DS,
 				JobORM:                jobORM,
-				BridgeORM:             bridgeORM,
+				BridgeORM:             bridgeCache,
 				MercuryORM:            mercuryORM,
 				PipelineRunner:        pipelineRunner,
 				StreamRegistry:        streamRegistry,
@@ -799,7 +829,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		jobSpawner:               jobSpawner,
 		pipelineRunner:           pipelineRunner,
 		pipelineORM:              pipelineORM,
-		bridgeORM:                bridgeORM,
+		bridgeORM:                bridgeCache,
 		localAdminUsersORM:       localAdminUsersORM,
 		authenticationProvider:   authenticationProvider,
 		txmStorageService:        txmORM,
@@ -809,6 +839,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		KeyStore:                 keyStore,
 		SessionReaper:            sessionReaper,
 		ExternalInitiatorManager: externalInitiatorManager,
+		webhookTriggerTokens:     webhook.NewTriggerTokenManager(opts.DS, auditLogger, webhook.SecretKey(cfg.Password().Keystore())),
 		HealthChecker:            healthChecker,
 		logger:                   globalLogger,
 		AuditLogger:              auditLogger,
@@ -977,10 +1008,16 @@ func (app *ChainlinkApplication) JobORM() job.ORM {
 	return app.jobORM
 }
 
+// BridgeORM returns the bridge cache shared with the pipeline runner, so that changes made through it purge the cached
+// responses and circuit breakers of the bridge.
 func (app *ChainlinkApplication) BridgeORM() bridges.ORM {
 	return app.bridgeORM
 }
 
+func (app *ChainlinkApplication) BridgeHealth(bt bridges.BridgeType) []bridges.URLHealth {
+	return app.pipelineRunner.BridgeHealth(bt)
+}
+
 func (app *ChainlinkApplication) BasicAdminUsersORM() sessions.BasicAdminUsersORM {
 	return app.localAdminUsersORM
 }
@@ -1006,6 +1043,10 @@ func (app *ChainlinkApplication) GetExternalInitiatorManager() webhook.ExternalI
 	return app.ExternalInitiatorManager
 }
 
+func (app *ChainlinkApplication) GetWebhookTriggerTokenManager() webhook.TriggerTokenManager {
+	return app.webhookTriggerTokens
+}
+
 func (app *ChainlinkApplication) SecretGenerator() SecretGenerator {
 	return app.secretGenerator
 }
@@ -1033,8 +1074,8 @@ func (app *ChainlinkApplication) DeleteJob(ctx context.Context, jobID int32) err
 	return app.jobSpawner.DeleteJob(ctx, nil, jobID)
 }
 
-func (app *ChainlinkApplication) RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable) (int64, error) {
-	return app.webhookJobRunner.RunJob(ctx, jobUUID, requestBody, meta)
+func (app *ChainlinkApplication) RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable, idempotencyKey string) (int64, error) {
+	return app.webhookJobRunner.RunJob(ctx, jobUUID, requestBody, meta, idempotencyKey)
 }
 
 // Only used for local testing, not supported by the UI.
@@ -1102,6 +1143,34 @@ func (app *ChainlinkApplication) RunJobV2(
 	return runID, err
 }
 
+// SimulateJobV2 implements the Application interface. The jobSpec variables are provided unless vars overrides them.
+func (app *ChainlinkApplication) SimulateJobV2(
+	ctx context.Context,
+	jobID int32,
+	vars map[string]interface{},
+	stubs map[string]pipeline.TaskStub,
+) (*pipeline.Simulation, error) {
+	jb, err := app.jobORM.FindJob(ctx, jobID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "job ID %v", jobID)
+	}
+	if jb.PipelineSpec == nil || jb.PipelineSpec.DotDagSource == "" {
+		return nil, errors.Errorf("job ID %v has no pipeline to simulate", jobID)
+	}
+
+	simVars := map[string]interface{}{
+		"jobSpec": map[string]interface{}{
+			"databaseID":    jb.ID,
+			"externalJobID": jb.ExternalJobID,
+			"name":          jb.Name.ValueOrZero(),
+		},
+	}
+	for k, v := range vars {
+		simVars[k] = v
+	}
+	return app.pipelineRunner.SimulateRun(ctx, *jb.PipelineSpec, pipeline.NewVarsFrom(simVars), stubs)
+}
+
 func (app *ChainlinkApplication) ResumeJobV2(
 	ctx context.Context,
 	taskID uuid.UUID,
@@ -1,14 +1,19 @@
 package chainlink
 
 import (
+	"path/filepath"
+
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink/v2/core/build"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 type auditLoggerConfig struct {
-	c toml.AuditLogger
+	c       toml.AuditLogger
+	rootDir func() string
 }
 
 func (a auditLoggerConfig) Enabled() bool {
@@ -33,3 +38,60 @@ func (a auditLoggerConfig) JsonWrapperKey() string {
 func (a auditLoggerConfig) Headers() (models.ServiceHeaders, error) {
 	return *a.c.Headers, nil
 }
+
+func (a auditLoggerConfig) HashChain() bool {
+	return *a.c.HashChain
+}
+
+func (a auditLoggerConfig) File() config.AuditLoggerFile {
+	return auditLoggerFileConfig{c: a.c.File, rootDir: a.rootDir}
+}
+
+func (a auditLoggerConfig) Syslog() config.AuditLoggerSyslog {
+	return auditLoggerSyslogConfig{c: a.c.Syslog}
+}
+
+type auditLoggerFileConfig struct {
+	c       toml.AuditLoggerFile
+	rootDir func() string
+}
+
+func (f auditLoggerFileConfig) Enabled() bool {
+	return *f.c.Enabled
+}
+
+func (f auditLoggerFileConfig) Dir() string {
+	s := *f.c.Dir
+	if s == "" {
+		s = filepath.Join(f.rootDir(), "audit")
+	}
+	return s
+}
+
+func (f auditLoggerFileConfig) MaxSize() utils.FileSize {
+	return *f.c.MaxSize
+}
+
+func (f auditLoggerFileConfig) MaxBackups() int64 {
+	return *f.c.MaxBackups
+}
+
+type auditLoggerSyslogConfig struct {
+	c toml.AuditLoggerSyslog
+}
+
+func (s auditLoggerSyslogConfig) Enabled() bool {
+	return *s.c.Enabled
+}
+
+func (s auditLoggerSyslogConfig) Network() string {
+	return *s.c.Network
+}
+
+func (s auditLoggerSyslogConfig) Address() string {
+	return *s.c.Address
+}
+
+func (s auditLoggerSyslogConfig) Tag() string {
+	return *s.c.Tag
+}
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 func TestAuditLoggerConfig(t *testing.T) {
@@ -30,4 +32,18 @@ func TestAuditLoggerConfig(t *testing.T) {
 	require.Equal(t, "token", headers[0].Value)
 	require.Equal(t, "X-SomeOther-Header", headers[1].Header)
 	require.Equal(t, "value with spaces | and a bar+*", headers[1].Value)
+
+	require.True(t, auditConfig.HashChain())
+
+	file := auditConfig.File()
+	require.True(t, file.Enabled())
+	require.Equal(t, "audit/dir", file.Dir())
+	require.Equal(t, utils.FileSize(200*utils.MB), file.MaxSize())
+	require.Equal(t, int64(5), file.MaxBackups())
+
+	syslog := auditConfig.Syslog()
+	require.True(t, syslog.Enabled())
+	require.Equal(t, "tcp", syslog.Network())
+	require.Equal(t, "syslog.example.com:6514", syslog.Address())
+	require.Equal(t, "chainlink-node", syslog.Tag())
 }
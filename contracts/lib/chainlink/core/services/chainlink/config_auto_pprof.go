@@ -2,6 +2,7 @@ package chainlink
 
 import (
 	"path/filepath"
+	"time"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
@@ -44,6 +45,10 @@ func (a *autoPprofConfig) MaxProfileSize() utils.FileSize {
 	return *a.c.MaxProfileSize
 }
 
+func (a *autoPprofConfig) MaxProfileSizePerType() utils.FileSize {
+	return *a.c.MaxProfileSizePerType
+}
+
 func (a *autoPprofConfig) MemProfileRate() int {
 	return int(*a.c.MemProfileRate)
 }
@@ -67,3 +72,63 @@ func (a *autoPprofConfig) ProfileRoot() string {
 	}
 	return s
 }
+
+func (a *autoPprofConfig) Triggers() config.AutoPprofTriggers {
+	return &autoPprofTriggersConfig{c: a.c.Triggers}
+}
+
+type autoPprofTriggersConfig struct {
+	c toml.AutoPprofTriggers
+}
+
+func (t *autoPprofTriggersConfig) DBPoolSaturation() config.AutoPprofCountTrigger {
+	return &autoPprofCountTriggerConfig{c: t.c.DBPoolSaturation}
+}
+
+func (t *autoPprofTriggersConfig) PipelineRunQueueDepth() config.AutoPprofCountTrigger {
+	return &autoPprofCountTriggerConfig{c: t.c.PipelineRunQueueDepth}
+}
+
+func (t *autoPprofTriggersConfig) HeadTrackerLag() config.AutoPprofDurationTrigger {
+	return &autoPprofDurationTriggerConfig{c: t.c.HeadTrackerLag}
+}
+
+func (t *autoPprofTriggersConfig) GCPauseP99() config.AutoPprofDurationTrigger {
+	return &autoPprofDurationTriggerConfig{c: t.c.GCPauseP99}
+}
+
+func (t *autoPprofTriggersConfig) OpenFileDescriptors() config.AutoPprofCountTrigger {
+	return &autoPprofCountTriggerConfig{c: t.c.OpenFileDescriptors}
+}
+
+type autoPprofCountTriggerConfig struct {
+	c toml.AutoPprofCountTrigger
+}
+
+func (t *autoPprofCountTriggerConfig) Enabled() bool {
+	return *t.c.Enabled
+}
+
+func (t *autoPprofCountTriggerConfig) Threshold() uint32 {
+	return *t.c.Threshold
+}
+
+func (t *autoPprofCountTriggerConfig) Cooldown() time.Duration {
+	return t.c.Cooldown.Duration()
+}
+
+type autoPprofDurationTriggerConfig struct {
+	c toml.AutoPprofDurationTrigger
+}
+
+func (t *autoPprofDurationTriggerConfig) Enabled() bool {
+	return *t.c.Enabled
+}
+
+func (t *autoPprofDurationTriggerConfig) Threshold() time.Duration {
+	return t.c.Threshold.Duration()
+}
+
+func (t *autoPprofDurationTriggerConfig) Cooldown() time.Duration {
+	return t.c.Cooldown.Duration()
+}
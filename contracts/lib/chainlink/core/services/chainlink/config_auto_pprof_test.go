@@ -30,4 +30,16 @@ func TestAutoPprofTest(t *testing.T) {
 	assert.Equal(t, 2, ap.MutexProfileFraction())
 	assert.Equal(t, utils.FileSize(1*utils.GB), ap.MemThreshold())
 	assert.Equal(t, 999, ap.GoroutineThreshold())
+	assert.Equal(t, utils.FileSize(10*utils.MB), ap.MaxProfileSizePerType())
+
+	triggers := ap.Triggers()
+	assert.True(t, triggers.DBPoolSaturation().Enabled())
+	assert.Equal(t, uint32(80), triggers.DBPoolSaturation().Threshold())
+	assert.Equal(t, 5*time.Minute, triggers.DBPoolSaturation().Cooldown())
+	assert.Equal(t, uint32(500), triggers.PipelineRunQueueDepth().Threshold())
+	assert.Equal(t, 2*time.Minute, triggers.HeadTrackerLag().Threshold())
+	assert.Equal(t, 7*time.Minute, triggers.HeadTrackerLag().Cooldown())
+	assert.Equal(t, 50*time.Millisecond, triggers.GCPauseP99().Threshold())
+	assert.Equal(t, uint32(2048), triggers.OpenFileDescriptors().Threshold())
+	assert.Equal(t, 9*time.Minute, triggers.OpenFileDescriptors().Cooldown())
 }
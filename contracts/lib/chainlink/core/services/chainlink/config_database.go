@@ -33,6 +33,66 @@ func (b *backupConfig) URL() *url.URL {
 	return b.s.BackupURL.URL()
 }
 
+func (b *backupConfig) Destination() config.DatabaseBackupDestination {
+	return *b.c.Destination
+}
+
+func (b *backupConfig) Encrypt() bool {
+	return *b.c.Encrypt
+}
+
+func (b *backupConfig) Generations() uint32 {
+	return *b.c.Generations
+}
+
+func (b *backupConfig) S3() config.BackupS3 {
+	return &backupS3Config{c: b.c.S3, s: b.s}
+}
+
+type backupS3Config struct {
+	c toml.DatabaseBackupS3
+	s toml.DatabaseSecrets
+}
+
+func (b *backupS3Config) Endpoint() *url.URL {
+	if b.c.Endpoint == nil {
+		return nil
+	}
+	return b.c.Endpoint.URL()
+}
+
+func (b *backupS3Config) Bucket() string {
+	if b.c.Bucket == nil {
+		return ""
+	}
+	return *b.c.Bucket
+}
+
+func (b *backupS3Config) Region() string {
+	return *b.c.Region
+}
+
+func (b *backupS3Config) Prefix() string {
+	if b.c.Prefix == nil {
+		return ""
+	}
+	return *b.c.Prefix
+}
+
+func (b *backupS3Config) AccessKeyID() string {
+	if b.s.BackupS3AccessKeyID == nil {
+		return ""
+	}
+	return string(*b.s.BackupS3AccessKeyID)
+}
+
+func (b *backupS3Config) SecretAccessKey() string {
+	if b.s.BackupS3SecretAccessKey == nil {
+		return ""
+	}
+	return string(*b.s.BackupS3SecretAccessKey)
+}
+
 type lockConfig struct {
 	c toml.DatabaseLock
 }
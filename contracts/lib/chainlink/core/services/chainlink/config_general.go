@@ -497,7 +497,7 @@ func (g *generalConfig) TelemetryIngress() coreconfig.TelemetryIngress {
 }
 
 func (g *generalConfig) AuditLogger() coreconfig.AuditLogger {
-	return auditLoggerConfig{c: g.c.AuditLogger}
+	return auditLoggerConfig{c: g.c.AuditLogger, rootDir: g.RootDir}
 }
 
 func (g *generalConfig) Insecure() config.Insecure {
@@ -531,4 +531,12 @@ func (g *generalConfig) Telemetry() coreconfig.Telemetry {
 	return &telemetryConfig{s: g.c.Telemetry}
 }
 
+func (g *generalConfig) TxmBudget() coreconfig.TxmBudget {
+	return &txmBudgetConfig{c: g.c.TxmBudget}
+}
+
+func (g *generalConfig) RemoteSigner() coreconfig.RemoteSigner {
+	return &remoteSignerConfig{c: g.c.RemoteSigner}
+}
+
 var zeroSha256Hash = models.Sha256Hash{}
@@ -0,0 +1,41 @@
+package chainlink
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+var _ config.RemoteSigner = (*remoteSignerConfig)(nil)
+
+type remoteSignerConfig struct {
+	c toml.RemoteSigner
+}
+
+func (r *remoteSignerConfig) Timeout() time.Duration {
+	return r.c.Timeout.Duration()
+}
+
+func (r *remoteSignerConfig) Keys() []config.RemoteSignerKey {
+	keys := make([]config.RemoteSignerKey, len(r.c.Keys))
+	for i, k := range r.c.Keys {
+		keys[i] = &remoteSignerKeyConfig{c: k}
+	}
+	return keys
+}
+
+type remoteSignerKeyConfig struct {
+	c toml.RemoteSignerKey
+}
+
+func (k *remoteSignerKeyConfig) Address() common.Address {
+	return k.c.Address.Address()
+}
+
+func (k *remoteSignerKeyConfig) URL() *url.URL {
+	return k.c.URL.URL()
+}
@@ -0,0 +1,26 @@
+package chainlink
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteSignerConfig(t *testing.T) {
+	opts := GeneralConfigOpts{
+		ConfigStrings:  []string{fullTOML},
+		SecretsStrings: []string{secretsFullTOML},
+	}
+	cfg, err := opts.New()
+	require.NoError(t, err)
+
+	r := cfg.RemoteSigner()
+	assert.Equal(t, time.Minute, r.Timeout())
+	require.Len(t, r.Keys(), 1)
+	key := r.Keys()[0]
+	assert.Equal(t, common.HexToAddress("0x2a3e23c6f242F5345320814aC8a1b4E58707D292"), key.Address())
+	assert.Equal(t, "http://signer.example.com:9000", key.URL().String())
+}
@@ -266,6 +266,19 @@ func TestConfig_Marshal(t *testing.T) {
 		ForwardToUrl:   mustURL("http://localhost:9898"),
 		Headers:        ptr(serviceHeaders),
 		JsonWrapperKey: ptr("event"),
+		HashChain:      ptr(true),
+		File: toml.AuditLoggerFile{
+			Enabled:    ptr(true),
+			Dir:        ptr("audit/dir"),
+			MaxSize:    ptr[utils.FileSize](200 * utils.MB),
+			MaxBackups: ptr[int64](5),
+		},
+		Syslog: toml.AuditLoggerSyslog{
+			Enabled: ptr(true),
+			Network: ptr("tcp"),
+			Address: ptr("syslog.example.com:6514"),
+			Tag:     ptr("chainlink-node"),
+		},
 	}
 
 	full.Feature = toml.Feature{
@@ -298,6 +311,15 @@ func TestConfig_Marshal(t *testing.T) {
 			Frequency:        &hour,
 			Mode:             &config.DatabaseBackupModeFull,
 			OnVersionUpgrade: ptr(true),
+			Destination:      &config.DatabaseBackupDestinationS3,
+			Encrypt:          ptr(true),
+			Generations:      ptr[uint32](3),
+			S3: toml.DatabaseBackupS3{
+				Endpoint: commoncfg.MustParseURL("https://s3.example.com"),
+				Bucket:   ptr("chainlink-backups"),
+				Region:   ptr("eu-west-1"),
+				Prefix:   ptr("node-1/"),
+			},
 		},
 	}
 	full.TelemetryIngress = toml.TelemetryIngress{
@@ -516,18 +538,46 @@ func TestConfig_Marshal(t *testing.T) {
 		},
 	}
 	full.AutoPprof = toml.AutoPprof{
-		Enabled:              ptr(true),
-		ProfileRoot:          ptr("prof/root"),
-		PollInterval:         commoncfg.MustNewDuration(time.Minute),
-		GatherDuration:       commoncfg.MustNewDuration(12 * time.Second),
-		GatherTraceDuration:  commoncfg.MustNewDuration(13 * time.Second),
-		MaxProfileSize:       ptr[utils.FileSize](utils.GB),
-		CPUProfileRate:       ptr[int64](7),
-		MemProfileRate:       ptr[int64](9),
-		BlockProfileRate:     ptr[int64](5),
-		MutexProfileFraction: ptr[int64](2),
-		MemThreshold:         ptr[utils.FileSize](utils.GB),
-		GoroutineThreshold:   ptr[int64](999),
+		Enabled:               ptr(true),
+		ProfileRoot:           ptr("prof/root"),
+		PollInterval:          commoncfg.MustNewDuration(time.Minute),
+		GatherDuration:        commoncfg.MustNewDuration(12 * time.Second),
+		GatherTraceDuration:   commoncfg.MustNewDuration(13 * time.Second),
+		MaxProfileSize:        ptr[utils.FileSize](utils.GB),
+		MaxProfileSizePerType: ptr[utils.FileSize](10 * utils.MB),
+		CPUProfileRate:        ptr[int64](7),
+		MemProfileRate:        ptr[int64](9),
+		BlockProfileRate:      ptr[int64](5),
+		MutexProfileFraction:  ptr[int64](2),
+		MemThreshold:          ptr[utils.FileSize](utils.GB),
+		GoroutineThreshold:    ptr[int64](999),
+		Triggers: toml.AutoPprofTriggers{
+			DBPoolSaturation: toml.AutoPprofCountTrigger{
+				Enabled:   ptr(true),
+				Threshold: ptr[uint32](80),
+				Cooldown:  commoncfg.MustNewDuration(5 * time.Minute),
+			},
+			PipelineRunQueueDepth: toml.AutoPprofCountTrigger{
+				Enabled:   ptr(true),
+				Threshold: ptr[uint32](500),
+				Cooldown:  commoncfg.MustNewDuration(6 * time.Minute),
+			},
+			HeadTrackerLag: toml.AutoPprofDurationTrigger{
+				Enabled:   ptr(true),
+				Threshold: commoncfg.MustNewDuration(2 * time.Minute),
+				Cooldown:  commoncfg.MustNewDuration(7 * time.Minute),
+			},
+			GCPauseP99: toml.AutoPprofDurationTrigger{
+				Enabled:   ptr(true),
+				Threshold: commoncfg.MustNewDuration(50 * time.Millisecond),
+				Cooldown:  commoncfg.MustNewDuration(8 * time.Minute),
+			},
+			OpenFileDescriptors: toml.AutoPprofCountTrigger{
+				Enabled:   ptr(true),
+				Threshold: ptr[uint32](2048),
+				Cooldown:  commoncfg.MustNewDuration(9 * time.Minute),
+			},
+		},
 	}
 	full.Pyroscope = toml.Pyroscope{
 		ServerAddress: ptr("http://localhost:4040"),
@@ -549,6 +599,30 @@ func TestConfig_Marshal(t *testing.T) {
 		EmitterBatchProcessor: ptr(true),
 		EmitterExportTimeout:  commoncfg.MustNewDuration(1 * time.Second),
 	}
+	full.TxmBudget = toml.TxmBudget{
+		Enabled:       ptr(true),
+		KeyMaxPerHour: assets.GWei(100),
+		KeyMaxPerDay:  assets.GWei(200),
+		JobMaxPerHour: assets.GWei(10),
+		JobMaxPerDay:  assets.GWei(20),
+		Keys: []toml.TxmBudgetKey{{
+			Address:    ptr(types.MustEIP55Address("0x2a3e23c6f242F5345320814aC8a1b4E58707D292")),
+			MaxPerHour: assets.GWei(300),
+			MaxPerDay:  assets.GWei(400),
+		}},
+		Jobs: []toml.TxmBudgetJob{{
+			JobID:      ptr[int32](1),
+			MaxPerHour: assets.GWei(30),
+			MaxPerDay:  assets.GWei(40),
+		}},
+	}
+	full.RemoteSigner = toml.RemoteSigner{
+		Timeout: commoncfg.MustNewDuration(time.Minute),
+		Keys: []toml.RemoteSignerKey{{
+			Address: ptr(types.MustEIP55Address("0x2a3e23c6f242F5345320814aC8a1b4E58707D292")),
+			URL:     mustURL("http://signer.example.com:9000"),
+		}},
+	}
 	full.EVM = []*evmcfg.EVMConfig{
 		{
 			ChainID: ubig.NewI(1),
@@ -828,6 +902,19 @@ Enabled = true
 ForwardToUrl = 'http://localhost:9898'
 JsonWrapperKey = 'event'
 Headers = ['Authorization: token', 'X-SomeOther-Header: value with spaces | and a bar+*']
+HashChain = true
+
+[AuditLogger.File]
+Enabled = true
+Dir = 'audit/dir'
+MaxSize = '200.00mb'
+MaxBackups = 5
+
+[AuditLogger.Syslog]
+Enabled = true
+Network = 'tcp'
+Address = 'syslog.example.com:6514'
+Tag = 'chainlink-node'
 `},
 		{"Feature", Config{Core: toml.Core{Feature: full.Feature}}, `[Feature]
 FeedsManager = true
@@ -850,6 +937,15 @@ Dir = 'test/backup/dir'
 Frequency = '1h0m0s'
 Mode = 'full'
 OnVersionUpgrade = true
+Destination = 's3'
+Encrypt = true
+Generations = 3
+
+[Database.Backup.S3]
+Endpoint = 'https://s3.example.com'
+Bucket = 'chainlink-backups'
+Region = 'eu-west-1'
+Prefix = 'node-1/'
 
 [Database.Listener]
 MaxReconnectDuration = '1m0s'
@@ -1021,12 +1117,39 @@ PollInterval = '1m0s'
 GatherDuration = '12s'
 GatherTraceDuration = '13s'
 MaxProfileSize = '1.00gb'
+MaxProfileSizePerType = '10.00mb'
 CPUProfileRate = 7
 MemProfileRate = 9
 BlockProfileRate = 5
 MutexProfileFraction = 2
 MemThreshold = '1.00gb'
 GoroutineThreshold = 999
+
+[AutoPprof.Triggers]
+[AutoPprof.Triggers.DBPoolSaturation]
+Enabled = true
+Threshold = 80
+Cooldown = '5m0s'
+
+[AutoPprof.Triggers.PipelineRunQueueDepth]
+Enabled = true
+Threshold = 500
+Cooldown = '6m0s'
+
+[AutoPprof.Triggers.HeadTrackerLag]
+Enabled = true
+Threshold = '2m0s'
+Cooldown = '7m0s'
+
+[AutoPprof.Triggers.GCPauseP99]
+Enabled = true
+Threshold = '50ms'
+Cooldown = '8m0s'
+
+[AutoPprof.Triggers.OpenFileDescriptors]
+Enabled = true
+Threshold = 2048
+Cooldown = '9m0s'
 `},
 		{"Pyroscope", Config{Core: toml.Core{Pyroscope: full.Pyroscope}}, `[Pyroscope]
 ServerAddress = 'http://localhost:4040'
@@ -1037,6 +1160,30 @@ Debug = true
 DSN = 'sentry-dsn'
 Environment = 'dev'
 Release = 'v1.2.3'
+`},
+		{"TxmBudget", Config{Core: toml.Core{TxmBudget: full.TxmBudget}}, `[TxmBudget]
+Enabled = true
+KeyMaxPerHour = '100 gwei'
+KeyMaxPerDay = '200 gwei'
+JobMaxPerHour = '10 gwei'
+JobMaxPerDay = '20 gwei'
+
+[[TxmBudget.Keys]]
+Address = '0x2a3e23c6f242F5345320814aC8a1b4E58707D292'
+MaxPerHour = '300 gwei'
+MaxPerDay = '400 gwei'
+
+[[TxmBudget.Jobs]]
+JobID = 1
+MaxPerHour = '30 gwei'
+MaxPerDay = '40 gwei'
+`},
+		{"RemoteSigner", Config{Core: toml.Core{RemoteSigner: full.RemoteSigner}}, `[RemoteSigner]
+Timeout = '1m0s'
+
+[[RemoteSigner.Keys]]
+Address = '0x2a3e23c6f242F5345320814aC8a1b4E58707D292'
+URL = 'http://signer.example.com:9000'
 `},
 		{"EVM", Config{EVM: full.EVM}, `[[EVM]]
 ChainID = '1'
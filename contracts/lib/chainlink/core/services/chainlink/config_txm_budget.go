@@ -0,0 +1,62 @@
+package chainlink
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-integrations/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+type txmBudgetConfig struct {
+	c toml.TxmBudget
+}
+
+func (t *txmBudgetConfig) Enabled() bool {
+	return *t.c.Enabled
+}
+
+func (t *txmBudgetConfig) KeyMaxPerHour(address common.Address) *assets.Wei {
+	if k := t.key(address); k != nil && k.MaxPerHour != nil {
+		return k.MaxPerHour
+	}
+	return t.c.KeyMaxPerHour
+}
+
+func (t *txmBudgetConfig) KeyMaxPerDay(address common.Address) *assets.Wei {
+	if k := t.key(address); k != nil && k.MaxPerDay != nil {
+		return k.MaxPerDay
+	}
+	return t.c.KeyMaxPerDay
+}
+
+func (t *txmBudgetConfig) JobMaxPerHour(jobID int32) *assets.Wei {
+	if j := t.job(jobID); j != nil && j.MaxPerHour != nil {
+		return j.MaxPerHour
+	}
+	return t.c.JobMaxPerHour
+}
+
+func (t *txmBudgetConfig) JobMaxPerDay(jobID int32) *assets.Wei {
+	if j := t.job(jobID); j != nil && j.MaxPerDay != nil {
+		return j.MaxPerDay
+	}
+	return t.c.JobMaxPerDay
+}
+
+func (t *txmBudgetConfig) key(address common.Address) *toml.TxmBudgetKey {
+	for i := range t.c.Keys {
+		if k := &t.c.Keys[i]; k.Address != nil && k.Address.Address() == address {
+			return k
+		}
+	}
+	return nil
+}
+
+func (t *txmBudgetConfig) job(jobID int32) *toml.TxmBudgetJob {
+	for i := range t.c.Jobs {
+		if j := &t.c.Jobs[i]; j.JobID != nil && *j.JobID == jobID {
+			return j
+		}
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package chainlink
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-integrations/evm/assets"
+)
+
+func TestTxmBudgetConfig(t *testing.T) {
+	opts := GeneralConfigOpts{
+		ConfigStrings:  []string{fullTOML},
+		SecretsStrings: []string{secretsFullTOML},
+	}
+	cfg, err := opts.New()
+	require.NoError(t, err)
+
+	b := cfg.TxmBudget()
+	assert.True(t, b.Enabled())
+	assert.Equal(t, assets.GWei(30), b.JobMaxPerHour(1))
+	assert.Equal(t, assets.GWei(40), b.JobMaxPerDay(1))
+	assert.Equal(t, assets.GWei(10), b.JobMaxPerHour(2))
+	assert.Equal(t, assets.GWei(20), b.JobMaxPerDay(2))
+
+	key := common.HexToAddress("0x2a3e23c6f242F5345320814aC8a1b4E58707D292")
+	assert.Equal(t, assets.GWei(300), b.KeyMaxPerHour(key))
+	assert.Equal(t, assets.GWei(400), b.KeyMaxPerDay(key))
+
+	other := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	assert.Equal(t, assets.GWei(100), b.KeyMaxPerHour(other))
+	assert.Equal(t, assets.GWei(200), b.KeyMaxPerDay(other))
+}
@@ -3,6 +3,9 @@ package cron
 import (
 	"context"
 	"fmt"
+	"math/rand"
+	"sync"
+	"time"
 
 	"github.com/robfig/cron/v3"
 
@@ -13,19 +16,30 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 )
 
+// DefaultMaxMissedRuns is the number of missed runs caught up by the runAll policy when maxMissedRuns is not set.
+const DefaultMaxMissedRuns = 10
+
 // Cron runs a cron jobSpec from a CronSpec
 type Cron struct {
 	cronRunner     *cron.Cron
 	logger         logger.Logger
 	jobSpec        job.Job
 	pipelineRunner pipeline.Runner
+	orm            ORM
 	chStop         services.StopChan
+	wg             sync.WaitGroup
+	now            func() time.Time
+
+	mu        sync.Mutex
+	running   map[uint64]context.CancelFunc
+	nextRunID uint64
 }
 
 // NewCronFromJobSpec instantiates a job that executes on a predefined schedule.
 func NewCronFromJobSpec(
 	jobSpec job.Job,
 	pipelineRunner pipeline.Runner,
+	orm ORM,
 	logger logger.Logger,
 ) (*Cron, error) {
 	cronLogger := logger.Named("Cron").With(
@@ -41,7 +55,10 @@ func NewCronFromJobSpec(
 		logger:         cronLogger,
 		jobSpec:        jobSpec,
 		pipelineRunner: pipelineRunner,
+		orm:            orm,
 		chStop:         make(chan struct{}),
+		now:            time.Now,
+		running:        make(map[uint64]context.CancelFunc),
 	}, nil
 }
 
@@ -49,12 +66,38 @@ func NewCronFromJobSpec(
 func (cr *Cron) Start(context.Context) error {
 	cr.logger.Debug("Starting")
 
-	_, err := cr.cronRunner.AddFunc(cr.jobSpec.CronSpec.CronSchedule, cr.runPipeline)
+	schedule, err := parseSchedule(*cr.jobSpec.CronSpec)
 	if err != nil {
 		cr.logger.Errorw(fmt.Sprintf("Error running cron job %d", cr.jobSpec.ID), "err", err)
 		return err
 	}
-	cr.cronRunner.Start()
+	cr.cronRunner.Schedule(schedule, cron.FuncJob(func() { cr.fire(cr.now()) }))
+
+	missed := missedRuns(schedule, *cr.jobSpec.CronSpec, cr.now())
+	if len(missed) == 0 {
+		cr.cronRunner.Start()
+		return nil
+	}
+
+	cr.logger.Infow("Catching up on missed runs", "missedRunPolicy", cr.jobSpec.CronSpec.MissedRunPolicy, "runs", len(missed))
+	cr.wg.Add(1)
+	go func() {
+		defer cr.wg.Done()
+		// missed runs are caught up one after another, oldest first, before the schedule resumes
+		for _, firedAt := range missed {
+			select {
+			case <-cr.chStop:
+				return
+			default:
+			}
+			cr.fire(firedAt)
+		}
+		select {
+		case <-cr.chStop:
+		default:
+			cr.cronRunner.Start()
+		}
+	}()
 	return nil
 }
 
@@ -62,14 +105,74 @@ func (cr *Cron) Start(context.Context) error {
 // running and cleans up resources.
 func (cr *Cron) Close() error {
 	cr.logger.Debug("Closing")
-	cr.cronRunner.Stop()
+	close(cr.chStop)
+	// the catch-up starts the cron runner once done, so it must have returned before the runner is stopped
+	cr.wg.Wait()
+	<-cr.cronRunner.Stop().Done()
 	return nil
 }
 
-func (cr *Cron) runPipeline() {
+// fire runs the pipeline for the tick at firedAt according to the concurrency policy and jitter of the job, and
+// returns once the run is done or skipped.
+func (cr *Cron) fire(firedAt time.Time) {
+	spec := cr.jobSpec.CronSpec
+
+	// skipped ticks are recorded as well, so that they are not caught up after a restart
+	ctx, cancel := cr.chStop.NewCtx()
+	if err := cr.orm.UpdateLastFiredAt(ctx, spec.ID, firedAt); err != nil {
+		cr.logger.Errorw("Failed to record last fired time", "err", err)
+	}
+	cancel()
+
+	ctx, done, ok := cr.startRun()
+	if !ok {
+		cr.logger.Warnw("Skipping run, previous run is still in progress", "concurrencyPolicy", spec.ConcurrencyPolicy)
+		return
+	}
+	defer done()
+
+	if jitter := spec.Jitter.Duration(); jitter > 0 {
+		select {
+		case <-time.After(time.Duration(rand.Int63n(int64(jitter)))):
+		case <-ctx.Done():
+			return
+		}
+	}
+	cr.runPipeline(ctx)
+}
+
+// startRun registers a new run according to the concurrency policy of the job, and returns its context and a func to
+// call once it is done. It returns false if the run must be skipped.
+func (cr *Cron) startRun() (context.Context, func(), bool) {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+
+	switch cr.jobSpec.CronSpec.ConcurrencyPolicy {
+	case job.ConcurrencyForbid:
+		if len(cr.running) > 0 {
+			return nil, nil, false
+		}
+	case job.ConcurrencyReplace:
+		for id, cancel := range cr.running {
+			cr.logger.Warnw("Cancelling previous run, which is still in progress", "concurrencyPolicy", job.ConcurrencyReplace)
+			cancel()
+			delete(cr.running, id)
+		}
+	}
+
 	ctx, cancel := cr.chStop.NewCtx()
-	defer cancel()
+	id := cr.nextRunID
+	cr.nextRunID++
+	cr.running[id] = cancel
+	return ctx, func() {
+		cancel()
+		cr.mu.Lock()
+		defer cr.mu.Unlock()
+		delete(cr.running, id)
+	}, true
+}
 
+func (cr *Cron) runPipeline(ctx context.Context) {
 	jobSpec := map[string]interface{}{
 		"databaseID":    cr.jobSpec.ID,
 		"externalJobID": cr.jobSpec.ExternalJobID,
@@ -94,6 +197,66 @@ func (cr *Cron) runPipeline() {
 	}
 }
 
+// missedRuns returns the ticks of schedule between the last fired time of spec and now which are caught up according
+// to its missed run policy, oldest first.
+func missedRuns(schedule cron.Schedule, spec job.CronSpec, now time.Time) []time.Time {
+	if spec.LastFiredAt == nil {
+		return nil
+	}
+	var limit int
+	switch spec.MissedRunPolicy {
+	case job.MissedRunOnce:
+		limit = 1
+	case job.MissedRunAll:
+		limit = int(spec.MaxMissedRuns)
+		if limit == 0 {
+			limit = DefaultMaxMissedRuns
+		}
+	default:
+		return nil
+	}
+
+	// Only the latest ticks are caught up, so instead of walking every tick since the last fired time, the ticks are
+	// searched in a window before now which doubles until it holds enough of them.
+	lastFiredAt := *spec.LastFiredAt
+	since := now.Sub(lastFiredAt)
+	for window := time.Second; window < since; window *= 2 {
+		if missed := latestTicks(schedule, now.Add(-window), now, limit); len(missed) == limit {
+			return missed
+		}
+		if window > since/2 {
+			break
+		}
+	}
+	return latestTicks(schedule, lastFiredAt, now, limit)
+}
+
+// latestTicks returns up to limit of the latest ticks of schedule after from and not after to, oldest first.
+func latestTicks(schedule cron.Schedule, from, to time.Time, limit int) []time.Time {
+	var ticks []time.Time
+	for t := schedule.Next(from); !t.IsZero() && !t.After(to); t = schedule.Next(t) {
+		if len(ticks) == limit {
+			ticks = append(ticks[:0], ticks[1:]...)
+		}
+		ticks = append(ticks, t)
+	}
+	return ticks
+}
+
+// scheduleWithTimeZone returns the schedule of spec, prefixed with the time zone of spec if it has one.
+func scheduleWithTimeZone(spec job.CronSpec) string {
+	if spec.TimeZone == "" {
+		return spec.CronSchedule
+	}
+	return "CRON_TZ=" + spec.TimeZone + " " + spec.CronSchedule
+}
+
+// parseSchedule parses the schedule of spec the same way as the cron runner does.
+func parseSchedule(spec job.CronSpec) (cron.Schedule, error) {
+	return cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor).
+		Parse(scheduleWithTimeZone(spec))
+}
+
 func cronRunner() *cron.Cron {
 	return cron.New(cron.WithSeconds())
 }
@@ -1,7 +1,11 @@
 package cron_test
 
 import (
+	"context"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -38,7 +42,7 @@ func TestCronV2Pipeline(t *testing.T) {
 		PipelineSpec:  &pipeline.Spec{},
 		ExternalJobID: uuid.New(),
 	}
-	delegate := cron.NewDelegate(runner, lggr)
+	delegate := cron.NewDelegate(runner, cron.NewORM(db), lggr)
 
 	require.NoError(t, jobORM.CreateJob(testutils.Context(t), jb))
 	serviceArray, err := delegate.ServicesForSpec(testutils.Context(t), *jb)
@@ -67,7 +71,7 @@ func TestCronV2Schedule(t *testing.T) {
 		Return(false, nil).
 		Once()
 
-	service, err := cron.NewCronFromJobSpec(spec, runner, logger.TestLogger(t))
+	service, err := cron.NewCronFromJobSpec(spec, runner, &lastFiredORM{}, logger.TestLogger(t))
 	require.NoError(t, err)
 	err = service.Start(testutils.Context(t))
 	require.NoError(t, err)
@@ -75,3 +79,175 @@ func TestCronV2Schedule(t *testing.T) {
 
 	awaiter.AwaitOrFail(t)
 }
+
+type lastFiredORM struct {
+	mu      sync.Mutex
+	firedAt []time.Time
+}
+
+func (o *lastFiredORM) UpdateLastFiredAt(_ context.Context, _ int32, firedAt time.Time) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.firedAt = append(o.firedAt, firedAt)
+	return nil
+}
+
+func (o *lastFiredORM) fired() []time.Time {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return append([]time.Time(nil), o.firedAt...)
+}
+
+func TestCronV2MissedRuns(t *testing.T) {
+	t.Parallel()
+
+	// five hourly runs were missed
+	lastFiredAt := time.Now().Add(-5*time.Hour - 30*time.Minute)
+
+	for _, tc := range []struct {
+		name          string
+		policy        job.MissedRunPolicy
+		maxMissedRuns uint32
+		expectedRuns  int
+	}{
+		{"default", "", 0, 0},
+		{"skip", job.MissedRunSkip, 0, 0},
+		{"run once", job.MissedRunOnce, 0, 1},
+		{"run all", job.MissedRunAll, 10, 5},
+		{"run all up to max", job.MissedRunAll, 3, 3},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			spec := job.Job{
+				Type:          job.Cron,
+				SchemaVersion: 1,
+				CronSpec: &job.CronSpec{
+					CronSchedule:    "@every 1h",
+					MissedRunPolicy: tc.policy,
+					MaxMissedRuns:   tc.maxMissedRuns,
+					LastFiredAt:     &lastFiredAt,
+				},
+				PipelineSpec: &pipeline.Spec{},
+			}
+			runner := pipelinemocks.NewRunner(t)
+			var runs atomic.Int32
+			if tc.expectedRuns > 0 {
+				runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+					Run(func(args mock.Arguments) { runs.Add(1) }).
+					Return(false, nil).
+					Times(tc.expectedRuns)
+			}
+			orm := &lastFiredORM{}
+
+			service, err := cron.NewCronFromJobSpec(spec, runner, orm, logger.TestLogger(t))
+			require.NoError(t, err)
+			require.NoError(t, service.Start(testutils.Context(t)))
+			require.Eventually(t, func() bool { return int(runs.Load()) == tc.expectedRuns }, testutils.WaitTimeout(t), 10*time.Millisecond)
+			require.NoError(t, service.Close())
+
+			fired := orm.fired()
+			require.Len(t, fired, tc.expectedRuns)
+			for i, firedAt := range fired {
+				// the most recent missed runs are caught up, oldest first
+				expected := lastFiredAt.Add(time.Duration(5-tc.expectedRuns+i+1) * time.Hour)
+				assert.WithinDuration(t, expected, firedAt, time.Second)
+			}
+		})
+	}
+}
+
+func TestCronV2MissedRuns_LongOutage(t *testing.T) {
+	t.Parallel()
+
+	// decades of per-second ticks were missed, only the latest ones must be looked at
+	lastFiredAt := time.Now().AddDate(-30, 0, 0)
+	spec := job.Job{
+		Type:          job.Cron,
+		SchemaVersion: 1,
+		CronSpec: &job.CronSpec{
+			CronSchedule:    "* * * * * *",
+			MissedRunPolicy: job.MissedRunAll,
+			MaxMissedRuns:   3,
+			LastFiredAt:     &lastFiredAt,
+		},
+		PipelineSpec: &pipeline.Spec{},
+	}
+	runner := pipelinemocks.NewRunner(t)
+	var runs atomic.Int32
+	runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { runs.Add(1) }).
+		Return(false, nil)
+	orm := &lastFiredORM{}
+
+	started := time.Now()
+	service, err := cron.NewCronFromJobSpec(spec, runner, orm, logger.TestLogger(t))
+	require.NoError(t, err)
+	require.NoError(t, service.Start(testutils.Context(t)))
+	require.Eventually(t, func() bool { return runs.Load() >= 4 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+	require.NoError(t, service.Close())
+
+	fired := orm.fired()
+	require.GreaterOrEqual(t, len(fired), 4)
+	for i, firedAt := range fired[:3] {
+		// the catch-up runs are the three ticks before the start, oldest first
+		assert.WithinDuration(t, started.Truncate(time.Second).Add(time.Duration(i-2)*time.Second), firedAt, time.Second)
+	}
+	// the schedule only resumes once the catch-up is done
+	assert.True(t, fired[3].After(fired[2]))
+}
+
+func TestCronV2ConcurrencyPolicy(t *testing.T) {
+	t.Parallel()
+
+	newService := func(t *testing.T, policy job.ConcurrencyPolicy, runner pipeline.Runner) *cron.Cron {
+		spec := job.Job{
+			Type:          job.Cron,
+			SchemaVersion: 1,
+			CronSpec:      &job.CronSpec{CronSchedule: "@every 1s", ConcurrencyPolicy: policy},
+			PipelineSpec:  &pipeline.Spec{},
+		}
+		service, err := cron.NewCronFromJobSpec(spec, runner, &lastFiredORM{}, logger.TestLogger(t))
+		require.NoError(t, err)
+		return service
+	}
+
+	t.Run("forbid skips runs while a run is in progress", func(t *testing.T) {
+		t.Parallel()
+
+		runner := pipelinemocks.NewRunner(t)
+		var runs atomic.Int32
+		runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				runs.Add(1)
+				<-args.Get(0).(context.Context).Done()
+			}).
+			Return(false, nil)
+
+		service := newService(t, job.ConcurrencyForbid, runner)
+		require.NoError(t, service.Start(testutils.Context(t)))
+		time.Sleep(3500 * time.Millisecond)
+		require.NoError(t, service.Close())
+		assert.Equal(t, int32(1), runs.Load())
+	})
+
+	t.Run("replace cancels the run in progress", func(t *testing.T) {
+		t.Parallel()
+
+		runner := pipelinemocks.NewRunner(t)
+		var runs, cancelled atomic.Int32
+		runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				runs.Add(1)
+				<-args.Get(0).(context.Context).Done()
+				cancelled.Add(1)
+			}).
+			Return(false, nil)
+
+		service := newService(t, job.ConcurrencyReplace, runner)
+		require.NoError(t, service.Start(testutils.Context(t)))
+		require.Eventually(t, func() bool { return cancelled.Load() >= 1 && runs.Load() >= 2 }, testutils.WaitTimeout(t), 100*time.Millisecond)
+		require.NoError(t, service.Close())
+	})
+}
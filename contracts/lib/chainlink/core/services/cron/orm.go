@@ -0,0 +1,29 @@
+package cron
+
+import (
+	"context"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+type ORM interface {
+	// UpdateLastFiredAt records the time of the latest tick of the cron spec, from which missed runs are counted
+	// after a restart. Times before the recorded one are ignored, so that catching up cannot move it backwards.
+	UpdateLastFiredAt(ctx context.Context, specID int32, firedAt time.Time) error
+}
+
+type orm struct {
+	ds sqlutil.DataSource
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(ds sqlutil.DataSource) ORM {
+	return &orm{ds: ds}
+}
+
+func (o *orm) UpdateLastFiredAt(ctx context.Context, specID int32, firedAt time.Time) error {
+	_, err := o.ds.ExecContext(ctx, `UPDATE cron_specs SET last_fired_at = GREATEST(last_fired_at, $1), updated_at = NOW() WHERE id = $2`, firedAt, specID)
+	return err
+}
@@ -0,0 +1,72 @@
+package cron_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/cron"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+func TestORM_UpdateLastFiredAt(t *testing.T) {
+	ctx := testutils.Context(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+
+	keyStore := cltest.NewKeyStore(t, db)
+	lggr := logger.TestLogger(t)
+	pipelineORM := pipeline.NewORM(db, lggr, cfg.JobPipeline().MaxSuccessfulRuns())
+	jobORM := job.NewORM(db, pipelineORM, bridges.NewORM(db), keyStore, lggr)
+
+	jb := &job.Job{
+		Type:          job.Cron,
+		SchemaVersion: 1,
+		CronSpec: &job.CronSpec{
+			CronSchedule:      "0 0 * * * *",
+			TimeZone:          "Europe/Berlin",
+			MissedRunPolicy:   job.MissedRunAll,
+			MaxMissedRuns:     3,
+			ConcurrencyPolicy: job.ConcurrencyForbid,
+			Jitter:            models.Interval(time.Minute),
+		},
+		PipelineSpec:  &pipeline.Spec{},
+		ExternalJobID: uuid.New(),
+	}
+	require.NoError(t, jobORM.CreateJob(ctx, jb))
+
+	found, err := jobORM.FindJob(ctx, jb.ID)
+	require.NoError(t, err)
+	assert.Nil(t, found.CronSpec.LastFiredAt)
+	assert.Equal(t, "Europe/Berlin", found.CronSpec.TimeZone)
+	assert.Equal(t, job.MissedRunAll, found.CronSpec.MissedRunPolicy)
+	assert.Equal(t, uint32(3), found.CronSpec.MaxMissedRuns)
+	assert.Equal(t, job.ConcurrencyForbid, found.CronSpec.ConcurrencyPolicy)
+	assert.Equal(t, time.Minute, found.CronSpec.Jitter.Duration())
+
+	firedAt := time.Now().Truncate(time.Second)
+	require.NoError(t, cron.NewORM(db).UpdateLastFiredAt(ctx, *jb.CronSpecID, firedAt))
+
+	found, err = jobORM.FindJob(ctx, jb.ID)
+	require.NoError(t, err)
+	require.NotNil(t, found.CronSpec.LastFiredAt)
+	assert.True(t, firedAt.Equal(*found.CronSpec.LastFiredAt))
+
+	// an older tick, e.g. a missed run caught up concurrently with the schedule, does not move it backwards
+	require.NoError(t, cron.NewORM(db).UpdateLastFiredAt(ctx, *jb.CronSpecID, firedAt.Add(-time.Hour)))
+
+	found, err = jobORM.FindJob(ctx, jb.ID)
+	require.NoError(t, err)
+	assert.True(t, firedAt.Equal(*found.CronSpec.LastFiredAt))
+}
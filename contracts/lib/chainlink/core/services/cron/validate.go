@@ -1,6 +1,9 @@
 package cron
 
 import (
+	"strings"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
@@ -34,9 +37,59 @@ func ValidatedCronSpec(tomlString string) (job.Job, error) {
 	if jb.Type != job.Cron {
 		return jb, errors.Errorf("unsupported type %s", jb.Type)
 	}
-	if err := utils.ValidateCronSchedule(spec.CronSchedule); err != nil {
+	if spec.TimeZone != "" {
+		if strings.HasPrefix(spec.CronSchedule, "CRON_TZ=") {
+			return jb, errors.New("timeZone cannot be combined with a CRON_TZ schedule")
+		}
+		if _, err := time.LoadLocation(spec.TimeZone); err != nil {
+			return jb, errors.Wrapf(err, "invalid timeZone '%v'", spec.TimeZone)
+		}
+	}
+	if err := utils.ValidateCronSchedule(scheduleWithTimeZone(spec)); err != nil {
 		return jb, errors.Wrapf(err, "while validating cron schedule '%v'", spec.CronSchedule)
 	}
+	if err := validatePolicies(&spec); err != nil {
+		return jb, err
+	}
 
 	return jb, nil
 }
+
+// validatePolicies checks the missed run and concurrency policies and the jitter of spec, and fills in their defaults.
+func validatePolicies(spec *job.CronSpec) error {
+	switch spec.MissedRunPolicy {
+	case "":
+		spec.MissedRunPolicy = job.MissedRunSkip
+	case job.MissedRunSkip, job.MissedRunOnce:
+	case job.MissedRunAll:
+		if spec.MaxMissedRuns == 0 {
+			spec.MaxMissedRuns = DefaultMaxMissedRuns
+		}
+	default:
+		return errors.Errorf("invalid missedRunPolicy '%v', must be one of %v, %v or %v", spec.MissedRunPolicy, job.MissedRunSkip, job.MissedRunOnce, job.MissedRunAll)
+	}
+	if spec.MaxMissedRuns > 0 && spec.MissedRunPolicy != job.MissedRunAll {
+		return errors.Errorf("maxMissedRuns requires missedRunPolicy %v", job.MissedRunAll)
+	}
+
+	switch spec.ConcurrencyPolicy {
+	case "":
+		spec.ConcurrencyPolicy = job.ConcurrencyAllow
+	case job.ConcurrencyAllow, job.ConcurrencyForbid, job.ConcurrencyReplace:
+	default:
+		return errors.Errorf("invalid concurrencyPolicy '%v', must be one of %v, %v or %v", spec.ConcurrencyPolicy, job.ConcurrencyAllow, job.ConcurrencyForbid, job.ConcurrencyReplace)
+	}
+
+	if jitter := spec.Jitter.Duration(); jitter > 0 {
+		schedule, err := parseSchedule(*spec)
+		if err != nil {
+			return errors.Wrapf(err, "while validating cron schedule '%v'", spec.CronSchedule)
+		}
+		// the jitter must be shorter than the interval between runs, or a delayed run could start after the next one
+		next := schedule.Next(time.Now())
+		if interval := schedule.Next(next).Sub(next); jitter >= interval {
+			return errors.Errorf("jitter %v must be shorter than the interval between runs of %v", jitter, interval)
+		}
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package cron_test
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/stretchr/testify/assert"
@@ -77,6 +78,168 @@ ds -> ds_parse -> ds_multiply;
 				assert.True(t, strings.Contains(err.Error(), "invalid cron schedule"))
 			},
 		},
+		{
+			name: "defaults policies",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "CRON_TZ=UTC 0 0 1 1 * *"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, job.MissedRunSkip, s.CronSpec.MissedRunPolicy)
+				assert.Zero(t, s.CronSpec.MaxMissedRuns)
+				assert.Equal(t, job.ConcurrencyAllow, s.CronSpec.ConcurrencyPolicy)
+				assert.Zero(t, s.CronSpec.Jitter)
+			},
+		},
+		{
+			name: "time zone and policies",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "0 0 * * * *"
+timeZone        = "America/New_York"
+missedRunPolicy = "runAll"
+concurrencyPolicy = "replace"
+jitter          = "5m"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "America/New_York", s.CronSpec.TimeZone)
+				assert.Equal(t, job.MissedRunAll, s.CronSpec.MissedRunPolicy)
+				assert.Equal(t, uint32(cron.DefaultMaxMissedRuns), s.CronSpec.MaxMissedRuns)
+				assert.Equal(t, job.ConcurrencyReplace, s.CronSpec.ConcurrencyPolicy)
+				assert.Equal(t, 5*time.Minute, s.CronSpec.Jitter.Duration())
+			},
+		},
+		{
+			name: "time zone with CRON_TZ",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "CRON_TZ=UTC 0 0 * * * *"
+timeZone        = "America/New_York"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "timeZone cannot be combined with a CRON_TZ schedule")
+			},
+		},
+		{
+			name: "invalid time zone",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "0 0 * * * *"
+timeZone        = "Mars/Olympus_Mons"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "invalid timeZone 'Mars/Olympus_Mons'")
+			},
+		},
+		{
+			name: "invalid missed run policy",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "CRON_TZ=UTC 0 0 * * * *"
+missedRunPolicy = "runTwice"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "invalid missedRunPolicy 'runTwice'")
+			},
+		},
+		{
+			name: "max missed runs without runAll",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "CRON_TZ=UTC 0 0 * * * *"
+missedRunPolicy = "runOnce"
+maxMissedRuns   = 5
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "maxMissedRuns requires missedRunPolicy runAll")
+			},
+		},
+		{
+			name: "invalid concurrency policy",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "CRON_TZ=UTC 0 0 * * * *"
+concurrencyPolicy = "queue"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "invalid concurrencyPolicy 'queue'")
+			},
+		},
+		{
+			name: "jitter longer than interval",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "@every 1m"
+jitter          = "1m"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "jitter 1m0s must be shorter than the interval between runs of 1m0s")
+			},
+		},
 	}
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
@@ -24,6 +24,65 @@ func (_m *ORM) EXPECT() *ORM_Expecter {
 	return &ORM_Expecter{mock: &_m.Mock}
 }
 
+// CountErrorTypes provides a mock function with given fields: ctx, filter
+func (_m *ORM) CountErrorTypes(ctx context.Context, filter functions.RequestFilter) (map[functions.ErrType]int, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountErrorTypes")
+	}
+
+	var r0 map[functions.ErrType]int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, functions.RequestFilter) (map[functions.ErrType]int, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, functions.RequestFilter) map[functions.ErrType]int); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[functions.ErrType]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, functions.RequestFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_CountErrorTypes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountErrorTypes'
+type ORM_CountErrorTypes_Call struct {
+	*mock.Call
+}
+
+// CountErrorTypes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter functions.RequestFilter
+func (_e *ORM_Expecter) CountErrorTypes(ctx interface{}, filter interface{}) *ORM_CountErrorTypes_Call {
+	return &ORM_CountErrorTypes_Call{Call: _e.mock.On("CountErrorTypes", ctx, filter)}
+}
+
+func (_c *ORM_CountErrorTypes_Call) Run(run func(ctx context.Context, filter functions.RequestFilter)) *ORM_CountErrorTypes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(functions.RequestFilter))
+	})
+	return _c
+}
+
+func (_c *ORM_CountErrorTypes_Call) Return(_a0 map[functions.ErrType]int, _a1 error) *ORM_CountErrorTypes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_CountErrorTypes_Call) RunAndReturn(run func(context.Context, functions.RequestFilter) (map[functions.ErrType]int, error)) *ORM_CountErrorTypes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateRequest provides a mock function with given fields: ctx, request
 func (_m *ORM) CreateRequest(ctx context.Context, request *functions.Request) error {
 	ret := _m.Called(ctx, request)
@@ -190,6 +249,74 @@ func (_c *ORM_FindOldestEntriesByState_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// FindRequests provides a mock function with given fields: ctx, filter, offset, limit
+func (_m *ORM) FindRequests(ctx context.Context, filter functions.RequestFilter, offset int, limit int) ([]functions.Request, int, error) {
+	ret := _m.Called(ctx, filter, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindRequests")
+	}
+
+	var r0 []functions.Request
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, functions.RequestFilter, int, int) ([]functions.Request, int, error)); ok {
+		return rf(ctx, filter, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, functions.RequestFilter, int, int) []functions.Request); ok {
+		r0 = rf(ctx, filter, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]functions.Request)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, functions.RequestFilter, int, int) int); ok {
+		r1 = rf(ctx, filter, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, functions.RequestFilter, int, int) error); ok {
+		r2 = rf(ctx, filter, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ORM_FindRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRequests'
+type ORM_FindRequests_Call struct {
+	*mock.Call
+}
+
+// FindRequests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter functions.RequestFilter
+//   - offset int
+//   - limit int
+func (_e *ORM_Expecter) FindRequests(ctx interface{}, filter interface{}, offset interface{}, limit interface{}) *ORM_FindRequests_Call {
+	return &ORM_FindRequests_Call{Call: _e.mock.On("FindRequests", ctx, filter, offset, limit)}
+}
+
+func (_c *ORM_FindRequests_Call) Run(run func(ctx context.Context, filter functions.RequestFilter, offset int, limit int)) *ORM_FindRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(functions.RequestFilter), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *ORM_FindRequests_Call) Return(_a0 []functions.Request, _a1 int, _a2 error) *ORM_FindRequests_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ORM_FindRequests_Call) RunAndReturn(run func(context.Context, functions.RequestFilter, int, int) ([]functions.Request, int, error)) *ORM_FindRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PruneOldestRequests provides a mock function with given fields: ctx, maxRequestsInDB, batchSize
 func (_m *ORM) PruneOldestRequests(ctx context.Context, maxRequestsInDB uint32, batchSize uint32) (uint32, uint32, error) {
 	ret := _m.Called(ctx, maxRequestsInDB, batchSize)
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -30,6 +31,48 @@ type Request struct {
 	CoordinatorContractAddress *common.Address
 	OnchainMetadata            []byte
 	ProcessingMetadata         []byte
+	FinalizedAt                *time.Time
+	ConfirmedAt                *time.Time
+	TimedOutAt                 *time.Time
+}
+
+// RequestFilter selects requests by state and by when they were received.
+// Zero values match all requests.
+type RequestFilter struct {
+	States         []RequestState
+	ReceivedAfter  time.Time
+	ReceivedBefore time.Time
+}
+
+// TransitionDurations are the time spent between the state transitions of a request, each measured from the latest
+// transition before it. They are nil until the transition happened.
+type TransitionDurations struct {
+	// Result is the time from receiving the request to its result, or error, being ready.
+	Result *time.Duration
+	// Finalize is the time to the DON finalizing the report.
+	Finalize *time.Duration
+	// Confirm is the time to the response being confirmed on-chain.
+	Confirm *time.Duration
+	// Timeout is the time to the request timing out.
+	Timeout *time.Duration
+}
+
+// TransitionDurations returns the time spent between the state transitions of the request.
+func (r Request) TransitionDurations() (d TransitionDurations) {
+	prev := r.ReceivedAt
+	since := func(t *time.Time) *time.Duration {
+		if t == nil {
+			return nil
+		}
+		duration := t.Sub(prev)
+		prev = *t
+		return &duration
+	}
+	d.Result = since(r.ResultReadyAt)
+	d.Finalize = since(r.FinalizedAt)
+	d.Confirm = since(r.ConfirmedAt)
+	d.Timeout = since(r.TimedOutAt)
+	return d
 }
 
 type RequestState int8
@@ -182,6 +225,26 @@ func (e ErrType) String() string {
 	return "unknown"
 }
 
+// ParseRequestState parses a RequestState from its String form.
+func ParseRequestState(s string) (RequestState, error) {
+	for _, state := range []RequestState{IN_PROGRESS, RESULT_READY, TIMED_OUT, FINALIZED, CONFIRMED} {
+		if state.String() == s {
+			return state, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown request state %q", s)
+}
+
+// ParseRequestID parses a RequestID from its hex form, with or without the 0x prefix.
+func ParseRequestID(s string) (id RequestID, err error) {
+	b, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil || len(b) != RequestIDLength {
+		return id, fmt.Errorf("bad request ID %q", s)
+	}
+	copy(id[:], b)
+	return id, nil
+}
+
 func (r RequestID) String() string {
 	return hex.EncodeToString(r[:])
 }
@@ -3,10 +3,12 @@ package functions
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
@@ -24,6 +26,11 @@ type ORM interface {
 
 	FindOldestEntriesByState(ctx context.Context, state RequestState, limit uint32) ([]Request, error)
 	FindById(ctx context.Context, requestID RequestID) (*Request, error)
+	// FindRequests returns the requests matching filter, most recently received first, starting at offset and up to
+	// limit requests, along with the total number of matching requests.
+	FindRequests(ctx context.Context, filter RequestFilter, offset, limit int) ([]Request, int, error)
+	// CountErrorTypes returns the number of requests matching filter per ErrType, for the requests which failed.
+	CountErrorTypes(ctx context.Context, filter RequestFilter) (map[ErrType]int, error)
 
 	PruneOldestRequests(ctx context.Context, maxRequestsInDB uint32, batchSize uint32) (total uint32, pruned uint32, err error)
 }
@@ -43,7 +50,8 @@ const (
 	requestFields       = "request_id, received_at, request_tx_hash, " +
 		"state, result_ready_at, result, error_type, error, " +
 		"transmitted_result, transmitted_error, flags, aggregation_method, " +
-		"callback_gas_limit, coordinator_contract_address, onchain_metadata, processing_metadata"
+		"callback_gas_limit, coordinator_contract_address, onchain_metadata, processing_metadata, " +
+		"finalized_at, confirmed_at, timed_out_at"
 )
 
 func NewORM(ds sqlutil.DataSource, contractAddress common.Address) ORM {
@@ -138,7 +146,7 @@ func (o *orm) SetFinalized(ctx context.Context, requestID RequestID, reportedRes
 	err := o.setWithStateTransitionCheck(ctx, requestID, newState, func(tx sqlutil.DataSource) error {
 		stmt := fmt.Sprintf(`
 			UPDATE %s
-			SET transmitted_result=$3, transmitted_error=$4, state=$5
+			SET transmitted_result=$3, transmitted_error=$4, state=$5, finalized_at=NOW()
 			WHERE request_id=$1 AND contract_address=$2;
 		`, tableName)
 		_, err2 := tx.ExecContext(ctx, stmt, requestID, o.contractAddress, reportedResult, reportedError, newState)
@@ -150,7 +158,7 @@ func (o *orm) SetFinalized(ctx context.Context, requestID RequestID, reportedRes
 func (o *orm) SetConfirmed(ctx context.Context, requestID RequestID) error {
 	newState := CONFIRMED
 	err := o.setWithStateTransitionCheck(ctx, requestID, newState, func(tx sqlutil.DataSource) error {
-		stmt := fmt.Sprintf(`UPDATE %s SET state=$3 WHERE request_id=$1 AND contract_address=$2;`, tableName)
+		stmt := fmt.Sprintf(`UPDATE %s SET state=$3, confirmed_at=NOW() WHERE request_id=$1 AND contract_address=$2;`, tableName)
 		_, err2 := tx.ExecContext(ctx, stmt, requestID, o.contractAddress, newState)
 		return err2
 	})
@@ -188,7 +196,7 @@ func (o *orm) TimeoutExpiredResults(ctx context.Context, cutoff time.Time, limit
 		}
 		updateStmt, args, err2 := sqlx.Named(fmt.Sprintf(`
 			UPDATE %s
-			SET state = :nextState
+			SET state = :nextState, timed_out_at = NOW()
 			WHERE contract_address = :contractAddr AND request_id IN (:ids);`, tableName), a)
 		if err2 != nil {
 			return err2
@@ -225,6 +233,62 @@ func (o *orm) FindById(ctx context.Context, requestID RequestID) (*Request, erro
 	return &request, nil
 }
 
+func (o *orm) FindRequests(ctx context.Context, filter RequestFilter, offset, limit int) (requests []Request, count int, err error) {
+	where, args := o.filterConditions(filter)
+	err = sqlutil.TransactDataSource(ctx, o.ds, nil, func(tx sqlutil.DataSource) error {
+		stmt := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE %s;`, tableName, where)
+		if err2 := tx.GetContext(ctx, &count, stmt, args...); err2 != nil {
+			return errors.Wrap(err2, "failed to count requests")
+		}
+		stmt = fmt.Sprintf(`SELECT %s FROM %s WHERE %s ORDER BY received_at DESC, request_id OFFSET $%d LIMIT $%d;`,
+			requestFields, tableName, where, len(args)+1, len(args)+2)
+		return tx.SelectContext(ctx, &requests, stmt, append(args, offset, limit)...)
+	})
+	return
+}
+
+func (o *orm) CountErrorTypes(ctx context.Context, filter RequestFilter) (map[ErrType]int, error) {
+	where, args := o.filterConditions(filter)
+	var rows []struct {
+		ErrorType ErrType `db:"error_type"`
+		Count     int     `db:"count"`
+	}
+	stmt := fmt.Sprintf(`SELECT error_type, COUNT(*) AS count FROM %s WHERE %s AND error_type IS NOT NULL AND error_type != $%d GROUP BY error_type;`,
+		tableName, where, len(args)+1)
+	if err := o.ds.SelectContext(ctx, &rows, stmt, append(args, NONE)...); err != nil {
+		return nil, err
+	}
+	counts := make(map[ErrType]int, len(rows))
+	for _, row := range rows {
+		counts[row.ErrorType] = row.Count
+	}
+	return counts, nil
+}
+
+// filterConditions returns the WHERE conditions selecting the requests of the contract matching filter, and their
+// arguments.
+func (o *orm) filterConditions(filter RequestFilter) (string, []any) {
+	conditions := []string{"contract_address = $1"}
+	args := []any{o.contractAddress}
+	if len(filter.States) > 0 {
+		states := make(pq.Int64Array, len(filter.States))
+		for i, state := range filter.States {
+			states[i] = int64(state)
+		}
+		args = append(args, states)
+		conditions = append(conditions, fmt.Sprintf("state = ANY($%d)", len(args)))
+	}
+	if !filter.ReceivedAfter.IsZero() {
+		args = append(args, filter.ReceivedAfter)
+		conditions = append(conditions, fmt.Sprintf("received_at >= $%d", len(args)))
+	}
+	if !filter.ReceivedBefore.IsZero() {
+		args = append(args, filter.ReceivedBefore)
+		conditions = append(conditions, fmt.Sprintf("received_at < $%d", len(args)))
+	}
+	return strings.Join(conditions, " AND "), args
+}
+
 func (o *orm) PruneOldestRequests(ctx context.Context, maxStoredRequests uint32, batchSize uint32) (total uint32, pruned uint32, err error) {
 	err = sqlutil.TransactDataSource(ctx, o.ds, nil, func(tx sqlutil.DataSource) error {
 		stmt := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE contract_address=$1`, tableName)
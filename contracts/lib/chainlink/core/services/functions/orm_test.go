@@ -183,6 +183,8 @@ func TestORM_SetFinalized(t *testing.T) {
 	require.Equal(t, []byte("result"), req.TransmittedResult)
 	require.Equal(t, []byte("error"), req.TransmittedError)
 	require.Equal(t, functions.FINALIZED, req.State)
+	require.NotNil(t, req.FinalizedAt)
+	require.Nil(t, req.ConfirmedAt)
 }
 
 func TestORM_SetConfirmed(t *testing.T) {
@@ -198,6 +200,8 @@ func TestORM_SetConfirmed(t *testing.T) {
 	req, err := orm.FindById(ctx, id)
 	require.NoError(t, err)
 	require.Equal(t, functions.CONFIRMED, req.State)
+	require.NotNil(t, req.ConfirmedAt)
+	require.Nil(t, req.FinalizedAt)
 }
 
 func TestORM_StateTransitions(t *testing.T) {
@@ -321,9 +325,92 @@ func TestORM_TimeoutExpiredResults(t *testing.T) {
 		req, err := orm.FindById(ctx, ids[i])
 		require.NoError(t, err)
 		require.Equal(t, req.State, expectedState, "incorrect state")
+		require.Equal(t, expectedState == functions.TIMED_OUT, req.TimedOutAt != nil, "incorrect timed out at")
 	}
 }
 
+func TestORM_FindRequests(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	orm := setupORM(t)
+	now := time.Now().Round(time.Second)
+	var ids []functions.RequestID
+	for offset := -40; offset <= -10; offset += 10 {
+		id, _ := createRequestWithTimestamp(t, orm, now.Add(time.Duration(offset)*time.Minute))
+		ids = append(ids, id)
+	}
+	require.NoError(t, orm.SetFinalized(ctx, ids[0], []byte("result"), nil))
+	require.NoError(t, orm.SetConfirmed(ctx, ids[1]))
+
+	t.Run("all", func(t *testing.T) {
+		requests, count, err := orm.FindRequests(ctx, functions.RequestFilter{}, 0, 10)
+		require.NoError(t, err)
+		require.Equal(t, 4, count)
+		require.Len(t, requests, 4)
+		require.Equal(t, ids[3], requests[0].RequestID, "incorrect results order")
+		require.Equal(t, ids[0], requests[3].RequestID, "incorrect results order")
+		require.NotNil(t, requests[3].FinalizedAt)
+	})
+
+	t.Run("paginated", func(t *testing.T) {
+		requests, count, err := orm.FindRequests(ctx, functions.RequestFilter{}, 1, 2)
+		require.NoError(t, err)
+		require.Equal(t, 4, count)
+		require.Len(t, requests, 2)
+		require.Equal(t, ids[2], requests[0].RequestID)
+		require.Equal(t, ids[1], requests[1].RequestID)
+	})
+
+	t.Run("by state", func(t *testing.T) {
+		filter := functions.RequestFilter{States: []functions.RequestState{functions.FINALIZED, functions.CONFIRMED}}
+		requests, count, err := orm.FindRequests(ctx, filter, 0, 10)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+		require.Equal(t, ids[1], requests[0].RequestID)
+		require.Equal(t, ids[0], requests[1].RequestID)
+	})
+
+	t.Run("by time window", func(t *testing.T) {
+		filter := functions.RequestFilter{
+			ReceivedAfter:  now.Add(-30 * time.Minute),
+			ReceivedBefore: now.Add(-10 * time.Minute),
+		}
+		requests, count, err := orm.FindRequests(ctx, filter, 0, 10)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+		require.Equal(t, ids[2], requests[0].RequestID)
+		require.Equal(t, ids[1], requests[1].RequestID)
+	})
+}
+
+func TestORM_CountErrorTypes(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	orm := setupORM(t)
+	now := time.Now().Round(time.Second)
+	errTypes := []functions.ErrType{functions.USER_ERROR, functions.USER_ERROR, functions.INTERNAL_ERROR}
+	for i, errType := range errTypes {
+		id, _ := createRequestWithTimestamp(t, orm, now.Add(-time.Duration(i)*time.Minute))
+		require.NoError(t, orm.SetError(ctx, id, errType, []byte("error"), now, true))
+	}
+	id, _, _ := createRequest(t, orm)
+	require.NoError(t, orm.SetResult(ctx, id, []byte("result"), now))
+
+	counts, err := orm.CountErrorTypes(ctx, functions.RequestFilter{})
+	require.NoError(t, err)
+	require.Equal(t, map[functions.ErrType]int{functions.USER_ERROR: 2, functions.INTERNAL_ERROR: 1}, counts)
+
+	counts, err = orm.CountErrorTypes(ctx, functions.RequestFilter{ReceivedBefore: now.Add(-90 * time.Second)})
+	require.NoError(t, err)
+	require.Equal(t, map[functions.ErrType]int{functions.INTERNAL_ERROR: 1}, counts)
+
+	counts, err = orm.CountErrorTypes(ctx, functions.RequestFilter{States: []functions.RequestState{functions.CONFIRMED}})
+	require.NoError(t, err)
+	require.Empty(t, counts)
+}
+
 func TestORM_PruneOldestRequests(t *testing.T) {
 	t.Parallel()
 	ctx := testutils.Context(t)
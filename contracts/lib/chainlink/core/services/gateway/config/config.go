@@ -20,6 +20,9 @@ type ConnectionManagerConfig struct {
 	AuthTimestampToleranceSec uint32
 	AuthChallengeLen          uint32
 	HeartbeatIntervalSec      uint32
+	// NodeDrainTimeoutSec is how long responses are still read from nodes removed from a DON, before closing their
+	// connections.
+	NodeDrainTimeoutSec uint32
 }
 
 type DONConfig struct {
@@ -28,9 +31,26 @@ type DONConfig struct {
 	HandlerConfig json.RawMessage
 	Members       []NodeConfig
 	F             int
+	// CapabilitiesRegistryDonId is the ID of a DON in the capabilities registry. When set, the members are the nodes of
+	// the registry DON, and F its F, updated whenever the registry changes. Members then maps the peer IDs of the nodes
+	// to their gateway addresses, which the registry does not hold.
+	CapabilitiesRegistryDonId uint32
+	// UserAuth authenticates user requests, and enforces per-user quotas, before they reach the handler. Handlers
+	// still validate message signatures.
+	UserAuth *UserAuthConfig
+}
+
+type UserAuthConfig struct {
+	// Plugin authenticating users: "apiKey" or "jwt".
+	Plugin       string
+	PluginConfig json.RawMessage
+	// DailyQuota is the number of requests each user can send per UTC day. Zero disables the quota.
+	DailyQuota uint32
 }
 
 type NodeConfig struct {
 	Name    string
 	Address string
+	// PeerID names the node in the capabilities registry, for DONs following it.
+	PeerID string
 }
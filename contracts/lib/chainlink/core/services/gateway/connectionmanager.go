@@ -6,14 +6,20 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 	"sync"
 	"time"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/gorilla/websocket"
 	"github.com/jonboulle/clockwork"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
@@ -32,12 +38,54 @@ var promKeepalivesSent = promauto.NewGaugeVec(prometheus.GaugeOpts{
 	Help: "Metric to track the number of successful keepalive ping messages per DON",
 }, []string{"don_id"})
 
+var promNodeConnections = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "gateway_node_connections",
+	Help: "Metric to track the number of websocket connections established by nodes per DON",
+}, []string{"don_id"})
+
+var promNodeDisconnections = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "gateway_node_disconnections",
+	Help: "Metric to track the number of websocket connections of nodes closed per DON",
+}, []string{"don_id"})
+
+var promHandshakeFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "gateway_handshake_failures",
+	Help: "Metric to track failed node handshakes per stage (start or finalize) and reason",
+}, []string{"stage", "reason"})
+
+// handshakeErrors are the reasons handshakes fail for, any other error is reported as "other".
+var handshakeErrors = []error{
+	network.ErrAuthHeaderParse,
+	network.ErrAuthInvalidDonId,
+	network.ErrAuthInvalidNode,
+	network.ErrAuthInvalidGateway,
+	network.ErrAuthInvalidTimestamp,
+	network.ErrChallengeAttemptNotFound,
+	network.ErrChallengeInvalidSignature,
+}
+
+func recordHandshakeFailure(stage string, err error) {
+	reason := "other"
+	for _, handshakeErr := range handshakeErrors {
+		if errors.Is(err, handshakeErr) {
+			reason = handshakeErr.Error()
+			break
+		}
+	}
+	promHandshakeFailures.WithLabelValues(stage, reason).Inc()
+}
+
 // ConnectionManager holds all connections between Gateway and Nodes.
 type ConnectionManager interface {
 	job.ServiceCtx
 	network.ConnectionAcceptor
 
+	services.HealthReporter
+
 	DONConnectionManager(donId string) *donConnectionManager
+	// UpdateDONMembership replaces the members and F of a DON without restarting the connections of the nodes it
+	// keeps. New nodes are admitted right away, and removed nodes are drained before being disconnected.
+	UpdateDONMembership(donId string, members []config.NodeConfig, f int) error
 	GetPort() int
 }
 
@@ -57,9 +105,7 @@ type connectionManager struct {
 func (m *connectionManager) HealthReport() map[string]error {
 	hr := map[string]error{m.Name(): m.Healthy()}
 	for _, d := range m.dons {
-		for _, n := range d.nodes {
-			services.CopyHealth(hr, n.conn.HealthReport())
-		}
+		services.CopyHealth(hr, d.HealthReport())
 	}
 	return hr
 }
@@ -67,18 +113,65 @@ func (m *connectionManager) HealthReport() map[string]error {
 func (m *connectionManager) Name() string { return m.lggr.Name() }
 
 type donConnectionManager struct {
-	donConfig  *config.DONConfig
-	nodes      map[string]*nodeState
-	handler    handlers.Handler
-	codec      api.Codec
-	closeWait  sync.WaitGroup
-	shutdownCh services.StopChan
-	lggr       logger.Logger
+	// donConfig is replaced, never modified, when the membership changes
+	donConfig    *config.DONConfig
+	nodes        map[string]*nodeState
+	running      bool
+	mu           sync.RWMutex
+	drainTimeout time.Duration
+	handler      handlers.Handler
+	codec        api.Codec
+	closeWait    sync.WaitGroup
+	shutdownCh   services.StopChan
+	lggr         logger.Logger
 }
 
+var _ handlers.MembershipProvider = (*donConnectionManager)(nil)
+
 type nodeState struct {
-	name string
-	conn network.WSConnectionWrapper
+	donId  string
+	name   string
+	conn   network.WSConnectionWrapper
+	stopCh services.StopChan
+
+	mu sync.Mutex
+	// closeCh of the current connection, nil while disconnected
+	closeCh <-chan error
+	removed bool
+}
+
+func newNodeState(donId string, name string, lggr logger.Logger) (*nodeState, error) {
+	connWrapper := network.NewWSConnectionWrapper(lggr)
+	if connWrapper == nil {
+		return nil, errors.New("error creating WSConnectionWrapper")
+	}
+	return &nodeState{donId: donId, name: name, conn: connWrapper, stopCh: make(chan struct{})}, nil
+}
+
+// setConnection records that conn is the current connection of the node, until it is closed.
+func (n *nodeState) setConnection(closeCh <-chan error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.closeCh = closeCh
+	if closeCh == nil {
+		return
+	}
+	promNodeConnections.WithLabelValues(n.donId).Inc()
+	go func() {
+		<-closeCh
+		promNodeDisconnections.WithLabelValues(n.donId).Inc()
+		n.mu.Lock()
+		defer n.mu.Unlock()
+		if n.closeCh == closeCh {
+			n.closeCh = nil
+		}
+	}()
+}
+
+func (n *nodeState) connected() bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.closeCh != nil
 }
 
 // immutable
@@ -102,27 +195,28 @@ func NewConnectionManager(gwConfig *config.GatewayConfig, clock clockwork.Clock,
 			return nil, fmt.Errorf("duplicate DON ID %s", donConfig.DonId)
 		}
 		nodes := make(map[string]*nodeState)
+		members := make([]config.NodeConfig, 0, len(donConfig.Members))
 		for _, nodeConfig := range donConfig.Members {
 			nodeAddress := strings.ToLower(nodeConfig.Address)
 			_, ok := nodes[nodeAddress]
 			if ok {
 				return nil, fmt.Errorf("duplicate node address %s in DON %s", nodeAddress, donConfig.DonId)
 			}
-			connWrapper := network.NewWSConnectionWrapper(lggr)
-			if connWrapper == nil {
-				return nil, fmt.Errorf("error creating WSConnectionWrapper for node %s", nodeAddress)
-			}
-			nodes[nodeAddress] = &nodeState{
-				name: nodeConfig.Name,
-				conn: connWrapper,
+			nodeState, err := newNodeState(donConfig.DonId, nodeConfig.Name, lggr)
+			if err != nil {
+				return nil, fmt.Errorf("%w for node %s", err, nodeAddress)
 			}
+			nodes[nodeAddress] = nodeState
+			members = append(members, config.NodeConfig{Name: nodeConfig.Name, Address: nodeAddress})
 		}
+		donConfig.Members = members
 		dons[donConfig.DonId] = &donConnectionManager{
-			donConfig:  &donConfig,
-			codec:      codec,
-			nodes:      nodes,
-			shutdownCh: make(chan struct{}),
-			lggr:       lggr.Named("DONConnectionManager." + donConfig.DonId),
+			donConfig:    &donConfig,
+			codec:        codec,
+			nodes:        nodes,
+			drainTimeout: time.Duration(gwConfig.ConnectionManagerConfig.NodeDrainTimeoutSec) * time.Second,
+			shutdownCh:   make(chan struct{}),
+			lggr:         lggr.Named("DONConnectionManager." + donConfig.DonId),
 		}
 	}
 	connMgr := &connectionManager{
@@ -145,12 +239,8 @@ func (m *connectionManager) Start(ctx context.Context) error {
 	return m.StartOnce("ConnectionManager", func() error {
 		m.lggr.Info("starting connection manager")
 		for _, donConnMgr := range m.dons {
-			donConnMgr.closeWait.Add(len(donConnMgr.nodes))
-			for nodeAddress, nodeState := range donConnMgr.nodes {
-				if err := nodeState.conn.Start(ctx); err != nil {
-					return err
-				}
-				go donConnMgr.readLoop(nodeAddress, nodeState)
+			if err := donConnMgr.start(ctx); err != nil {
+				return err
 			}
 			donConnMgr.closeWait.Add(1)
 			go donConnMgr.keepaliveLoop(m.config.HeartbeatIntervalSec)
@@ -164,10 +254,7 @@ func (m *connectionManager) Close() error {
 		m.lggr.Info("closing connection manager")
 		err = multierr.Combine(err, m.wsServer.Close())
 		for _, donConnMgr := range m.dons {
-			close(donConnMgr.shutdownCh)
-			for _, nodeState := range donConnMgr.nodes {
-				nodeState.conn.Close()
-			}
+			donConnMgr.close()
 		}
 		for _, donConnMgr := range m.dons {
 			donConnMgr.closeWait.Wait()
@@ -176,8 +263,24 @@ func (m *connectionManager) Close() error {
 	})
 }
 
+func (m *connectionManager) UpdateDONMembership(donId string, members []config.NodeConfig, f int) error {
+	donConnMgr, ok := m.dons[donId]
+	if !ok {
+		return fmt.Errorf("DON %s not found", donId)
+	}
+	return donConnMgr.updateMembership(members, f)
+}
+
 func (m *connectionManager) StartHandshake(authHeader []byte) (attemptId string, challenge []byte, err error) {
 	m.lggr.Debug("StartHandshake")
+	attemptId, challenge, err = m.startHandshake(authHeader)
+	if err != nil {
+		recordHandshakeFailure("start", err)
+	}
+	return attemptId, challenge, err
+}
+
+func (m *connectionManager) startHandshake(authHeader []byte) (attemptId string, challenge []byte, err error) {
 	authHeaderElems, signer, err := network.UnpackSignedAuthHeader(authHeader)
 	if err != nil {
 		return "", nil, multierr.Append(network.ErrAuthHeaderParse, err)
@@ -187,8 +290,8 @@ func (m *connectionManager) StartHandshake(authHeader []byte) (attemptId string,
 	if !ok {
 		return "", nil, network.ErrAuthInvalidDonId
 	}
-	nodeState, ok := donConnMgr.nodes[nodeAddress]
-	if !ok {
+	nodeState := donConnMgr.node(nodeAddress)
+	if nodeState == nil {
 		return "", nil, network.ErrAuthInvalidNode
 	}
 	if authHeaderElems.GatewayId != m.config.AuthGatewayId {
@@ -223,6 +326,14 @@ func (m *connectionManager) newAttempt(nodeSt *nodeState, nodeAddress string, ti
 
 func (m *connectionManager) FinalizeHandshake(attemptId string, response []byte, conn *websocket.Conn) error {
 	m.lggr.Debugw("FinalizeHandshake", "attemptId", attemptId)
+	err := m.finalizeHandshake(attemptId, response, conn)
+	if err != nil {
+		recordHandshakeFailure("finalize", err)
+	}
+	return err
+}
+
+func (m *connectionManager) finalizeHandshake(attemptId string, response []byte, conn *websocket.Conn) error {
 	m.connAttemptsMu.Lock()
 	attempt, ok := m.connAttempts[attemptId]
 	delete(m.connAttempts, attemptId)
@@ -240,7 +351,14 @@ func (m *connectionManager) FinalizeHandshake(attemptId string, response []byte,
 			return nil
 		})
 	}
-	attempt.nodeState.conn.Reset(conn)
+	attempt.nodeState.mu.Lock()
+	removed := attempt.nodeState.removed
+	attempt.nodeState.mu.Unlock()
+	if removed {
+		// the node left the DON during the handshake
+		return network.ErrAuthInvalidNode
+	}
+	attempt.nodeState.setConnection(attempt.nodeState.conn.Reset(conn))
 	m.lggr.Infof("node %s connected", attempt.nodeAddress)
 	return nil
 }
@@ -260,21 +378,192 @@ func (m *donConnectionManager) SetHandler(handler handlers.Handler) {
 	m.handler = handler
 }
 
-func (m *donConnectionManager) SendToNode(ctx context.Context, nodeAddress string, msg *api.Message) error {
+func (m *donConnectionManager) SendToNode(ctx context.Context, nodeAddress string, msg *api.Message) (err error) {
 	if msg == nil {
 		return errors.New("nil message")
 	}
+	ctx, span := tracer.Start(ctx, "gateway.SendToNode", trace.WithAttributes(
+		attribute.String("message_id", msg.Body.MessageId),
+		attribute.String("don_id", msg.Body.DonId),
+		attribute.String("method", msg.Body.Method),
+		attribute.String("node_address", nodeAddress),
+	))
+	defer func() {
+		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
 	data, err := m.codec.EncodeRequest(msg)
 	if err != nil {
 		return fmt.Errorf("error encoding request for node %s: %v", nodeAddress, err)
 	}
-	nodeState := m.nodes[nodeAddress]
+	nodeState := m.node(nodeAddress)
 	if nodeState == nil {
 		return fmt.Errorf("node %s not found", nodeAddress)
 	}
 	return nodeState.conn.Write(ctx, websocket.BinaryMessage, data)
 }
 
+// Membership returns the current members and F of the DON.
+func (m *donConnectionManager) Membership() ([]config.NodeConfig, int) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.donConfig.Members, m.donConfig.F
+}
+
+// node returns the state of a current member of the DON, or nil.
+func (m *donConnectionManager) node(nodeAddress string) *nodeState {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.nodes[nodeAddress]
+}
+
+func (m *donConnectionManager) start(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.running = true
+	for nodeAddress, nodeState := range m.nodes {
+		if err := m.startNode(ctx, nodeAddress, nodeState); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// startNode must be called with mu held.
+func (m *donConnectionManager) startNode(ctx context.Context, nodeAddress string, nodeState *nodeState) error {
+	if err := nodeState.conn.Start(ctx); err != nil {
+		return err
+	}
+	m.closeWait.Add(1)
+	go m.readLoop(nodeAddress, nodeState)
+	return nil
+}
+
+func (m *donConnectionManager) close() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.running = false
+	close(m.shutdownCh)
+	for _, nodeState := range m.nodes {
+		nodeState.conn.Close()
+	}
+}
+
+// validateMembership returns the members of a DON, with their addresses normalized, if they are valid for f.
+func validateMembership(donId string, members []config.NodeConfig, f int) ([]config.NodeConfig, error) {
+	if f < 0 || len(members) <= f {
+		return nil, fmt.Errorf("invalid F %d for %d members of DON %s", f, len(members), donId)
+	}
+	normalized := make([]config.NodeConfig, len(members))
+	for i, nodeConfig := range members {
+		if !gethcommon.IsHexAddress(nodeConfig.Address) {
+			return nil, fmt.Errorf("invalid node address %s", nodeConfig.Address)
+		}
+		nodeConfig.Address = strings.ToLower(gethcommon.HexToAddress(nodeConfig.Address).Hex())
+		if slices.ContainsFunc(normalized[:i], func(n config.NodeConfig) bool { return n.Address == nodeConfig.Address }) {
+			return nil, fmt.Errorf("duplicate node address %s in DON %s", nodeConfig.Address, donId)
+		}
+		normalized[i] = nodeConfig
+	}
+	return normalized, nil
+}
+
+func (m *donConnectionManager) updateMembership(members []config.NodeConfig, f int) error {
+	ctx, cancel := m.shutdownCh.NewCtx()
+	defer cancel()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	newConfig := *m.donConfig
+	newConfig.F = f
+	var err error
+	if newConfig.Members, err = validateMembership(m.donConfig.DonId, members, f); err != nil {
+		return err
+	}
+
+	nodes := make(map[string]*nodeState, len(members))
+	for _, nodeConfig := range newConfig.Members {
+		if nodeState, ok := m.nodes[nodeConfig.Address]; ok {
+			nodes[nodeConfig.Address] = nodeState
+			continue
+		}
+		nodeState, err := newNodeState(m.donConfig.DonId, nodeConfig.Name, m.lggr)
+		if err != nil {
+			return fmt.Errorf("%w for node %s", err, nodeConfig.Address)
+		}
+		if m.running {
+			if err = m.startNode(ctx, nodeConfig.Address, nodeState); err != nil {
+				return err
+			}
+		}
+		nodes[nodeConfig.Address] = nodeState
+		m.lggr.Infow("node joined DON", "nodeAddress", nodeConfig.Address, "name", nodeConfig.Name)
+	}
+	for nodeAddress, nodeState := range m.nodes {
+		if _, ok := nodes[nodeAddress]; ok {
+			continue
+		}
+		nodeState.mu.Lock()
+		nodeState.removed = true
+		nodeState.mu.Unlock()
+		m.lggr.Infow("node left DON", "nodeAddress", nodeAddress, "name", nodeState.name, "drainTimeout", m.drainTimeout)
+		if m.running {
+			m.closeWait.Add(1)
+			go m.drainNode(nodeAddress, nodeState)
+		} else {
+			nodeState.conn.Close()
+		}
+	}
+	m.nodes = nodes
+	m.donConfig = &newConfig
+	return nil
+}
+
+// drainNode keeps reading responses to requests already sent to a node removed from the DON, until the drain timeout
+// expires, then closes its connection.
+func (m *donConnectionManager) drainNode(nodeAddress string, nodeState *nodeState) {
+	defer m.closeWait.Done()
+	if m.drainTimeout > 0 {
+		timer := time.NewTimer(m.drainTimeout)
+		defer timer.Stop()
+		select {
+		case <-m.shutdownCh:
+		case <-timer.C:
+		}
+	}
+	close(nodeState.stopCh)
+	nodeState.conn.Close()
+	m.lggr.Infow("node drained", "nodeAddress", nodeAddress, "name", nodeState.name)
+}
+
+// HealthReport reports the DON unhealthy when fewer than F+1 of its nodes are connected, and each node unhealthy
+// while it is disconnected.
+func (m *donConnectionManager) HealthReport() map[string]error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	hr := make(map[string]error, len(m.nodes)+1)
+	connected := 0
+	for nodeAddress, nodeState := range m.nodes {
+		name := m.lggr.Name() + "." + nodeAddress
+		switch err := nodeState.conn.Ready(); {
+		case err != nil:
+			hr[name] = err
+		case !nodeState.connected():
+			hr[name] = fmt.Errorf("node %s (%s) is not connected", nodeState.name, nodeAddress)
+		default:
+			hr[name] = nil
+			connected++
+		}
+	}
+	hr[m.lggr.Name()] = nil
+	if connected < m.donConfig.F+1 {
+		hr[m.lggr.Name()] = fmt.Errorf("%d of %d nodes connected, need at least %d", connected, len(m.nodes), m.donConfig.F+1)
+	}
+	return hr
+}
+
 func (m *donConnectionManager) readLoop(nodeAddress string, nodeState *nodeState) {
 	ctx, _ := m.shutdownCh.NewCtx()
 	for {
@@ -282,6 +571,9 @@ func (m *donConnectionManager) readLoop(nodeAddress string, nodeState *nodeState
 		case <-m.shutdownCh:
 			m.closeWait.Done()
 			return
+		case <-nodeState.stopCh:
+			m.closeWait.Done()
+			return
 		case item := <-nodeState.conn.ReadChannel():
 			msg, err := m.codec.DecodeResponse(item.Data)
 			if err != nil {
@@ -296,23 +588,40 @@ func (m *donConnectionManager) readLoop(nodeAddress string, nodeState *nodeState
 				m.lggr.Errorw("message sender mismatch when reading from node", "nodeAddress", nodeAddress, "sender", msg.Body.Sender)
 				break
 			}
-			err = m.handler.HandleNodeMessage(ctx, msg, nodeAddress)
-			if err != nil {
-				m.lggr.Error("error when calling HandleNodeMessage ", err)
-			}
+			m.handleNodeMessage(ctx, msg, nodeAddress)
 		}
 	}
 }
 
+// handleNodeMessage passes a node's response to the handler, in a span carrying the message ID of the user request it
+// answers. The node does not return the context of the request, so handlers link the span to the request's span they
+// cached (see common.RequestCache).
+func (m *donConnectionManager) handleNodeMessage(ctx context.Context, msg *api.Message, nodeAddress string) {
+	ctx, span := tracer.Start(ctx, "gateway.HandleNodeMessage", trace.WithAttributes(
+		attribute.String("message_id", msg.Body.MessageId),
+		attribute.String("don_id", msg.Body.DonId),
+		attribute.String("method", msg.Body.Method),
+		attribute.String("node_address", nodeAddress),
+	))
+	defer span.End()
+	if err := m.handler.HandleNodeMessage(ctx, msg, nodeAddress); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		m.lggr.Error("error when calling HandleNodeMessage ", err)
+	}
+}
+
 func (m *donConnectionManager) keepaliveLoop(intervalSec uint32) {
 	ctx, _ := m.shutdownCh.NewCtx()
 	defer m.closeWait.Done()
 
+	m.mu.RLock()
+	donId := m.donConfig.DonId
+	m.mu.RUnlock()
 	if intervalSec == 0 {
-		m.lggr.Errorw("keepalive interval is 0, keepalive disabled", "donID", m.donConfig.DonId)
+		m.lggr.Errorw("keepalive interval is 0, keepalive disabled", "donID", donId)
 		return
 	}
-	m.lggr.Infow("starting keepalive loop", "donID", m.donConfig.DonId)
+	m.lggr.Infow("starting keepalive loop", "donID", donId)
 
 	keepaliveTicker := time.NewTicker(time.Duration(intervalSec) * time.Second)
 	defer keepaliveTicker.Stop()
@@ -322,16 +631,19 @@ func (m *donConnectionManager) keepaliveLoop(intervalSec uint32) {
 		case <-m.shutdownCh:
 			return
 		case <-keepaliveTicker.C:
+			m.mu.RLock()
+			nodes := maps.Clone(m.nodes)
+			m.mu.RUnlock()
 			errorCount := 0
-			for nodeAddress, nodeState := range m.nodes {
+			for nodeAddress, nodeState := range nodes {
 				err := nodeState.conn.Write(ctx, websocket.PingMessage, []byte{})
 				if err != nil {
-					m.lggr.Debugw("unable to send keepalive ping to node", "nodeAddress", nodeAddress, "name", nodeState.name, "donID", m.donConfig.DonId, "err", err)
+					m.lggr.Debugw("unable to send keepalive ping to node", "nodeAddress", nodeAddress, "name", nodeState.name, "donID", donId, "err", err)
 					errorCount++
 				}
 			}
-			promKeepalivesSent.WithLabelValues(m.donConfig.DonId).Set(float64(len(m.nodes) - errorCount))
-			m.lggr.Infow("sent keepalive pings to nodes", "donID", m.donConfig.DonId, "errCount", errorCount)
+			promKeepalivesSent.WithLabelValues(donId).Set(float64(len(nodes) - errorCount))
+			m.lggr.Infow("sent keepalive pings to nodes", "donID", donId, "errCount", errorCount)
 		}
 	}
 }
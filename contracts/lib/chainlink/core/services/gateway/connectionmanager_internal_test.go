@@ -0,0 +1,30 @@
+package gateway
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
+)
+
+// not parallel, as the handshakes of other tests are counted in the same metrics
+func TestConnectionManager_RecordHandshakeFailure(t *testing.T) {
+	for _, tc := range []struct {
+		stage  string
+		err    error
+		reason string
+	}{
+		{"start", multierr.Append(network.ErrAuthHeaderParse, errors.New("bad header")), "unable to parse auth header"},
+		{"start", network.ErrAuthInvalidTimestamp, "timestamp outside of tolerance range"},
+		{"finalize", network.ErrChallengeInvalidSignature, "invalid challenge signature"},
+		{"finalize", errors.New("unexpected"), "other"},
+	} {
+		before := testutil.ToFloat64(promHandshakeFailures.WithLabelValues(tc.stage, tc.reason))
+		recordHandshakeFailure(tc.stage, tc.err)
+		require.Equal(t, before+1, testutil.ToFloat64(promHandshakeFailures.WithLabelValues(tc.stage, tc.reason)))
+	}
+}
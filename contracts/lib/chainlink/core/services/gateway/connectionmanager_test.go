@@ -3,6 +3,7 @@ package gateway_test
 import (
 	"crypto/ecdsa"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/jonboulle/clockwork"
@@ -242,3 +243,75 @@ func TestConnectionManager_CleanStartClose(t *testing.T) {
 	err = mgr.Close()
 	require.NoError(t, err)
 }
+
+func TestConnectionManager_UpdateDONMembership(t *testing.T) {
+	t.Parallel()
+
+	config, nodes := newTestConfig(t, 4)
+	newNode := gc.NewTestNodes(t, 1)[0]
+	clock := clockwork.NewFakeClock()
+	mgr, err := gateway.NewConnectionManager(config, clock, logger.TestLogger(t))
+	require.NoError(t, err)
+	require.NoError(t, mgr.Start(testutils.Context(t)))
+	t.Cleanup(func() { require.NoError(t, mgr.Close()) })
+
+	authHeaderElems := network.AuthHeaderElems{
+		Timestamp: uint32(clock.Now().Unix()),
+		DonId:     "my_don_1",
+		GatewayId: "my_gateway_no_3",
+	}
+	// handshake of a node removed before it completes
+	attemptId, challenge, err := mgr.StartHandshake(signAndPackAuthHeader(t, &authHeaderElems, nodes[0].PrivateKey))
+	require.NoError(t, err)
+
+	members := []config.NodeConfig{
+		{Name: "node_1", Address: nodes[1].Address},
+		{Name: "node_2", Address: nodes[2].Address},
+		{Name: "node_3", Address: nodes[3].Address},
+		{Name: "new_node", Address: "0x" + strings.ToUpper(newNode.Address[2:])},
+	}
+
+	t.Run("rejects invalid membership", func(t *testing.T) {
+		require.ErrorContains(t, mgr.UpdateDONMembership("my_don_2", members, 1), "not found")
+		require.ErrorContains(t, mgr.UpdateDONMembership("my_don_1", members, 4), "invalid F")
+		require.ErrorContains(t, mgr.UpdateDONMembership("my_don_1", []config.NodeConfig{{Name: "bad", Address: "0x1234"}}, 0), "invalid node address")
+		require.ErrorContains(t, mgr.UpdateDONMembership("my_don_1", append(members, members[0]), 1), "duplicate node address")
+	})
+
+	t.Run("replaces members", func(t *testing.T) {
+		require.NoError(t, mgr.UpdateDONMembership("my_don_1", members, 1))
+
+		current, f := mgr.DONConnectionManager("my_don_1").Membership()
+		require.Len(t, current, 4)
+		require.Equal(t, 1, f)
+		require.Equal(t, newNode.Address, current[3].Address)
+
+		_, _, err := mgr.StartHandshake(signAndPackAuthHeader(t, &authHeaderElems, nodes[0].PrivateKey))
+		require.ErrorIs(t, err, network.ErrAuthInvalidNode)
+		response, err := gc.SignData(nodes[0].PrivateKey, challenge)
+		require.NoError(t, err)
+		require.ErrorIs(t, mgr.FinalizeHandshake(attemptId, response, nil), network.ErrAuthInvalidNode)
+
+		newAttemptId, newChallenge, err := mgr.StartHandshake(signAndPackAuthHeader(t, &authHeaderElems, newNode.PrivateKey))
+		require.NoError(t, err)
+		response, err = gc.SignData(newNode.PrivateKey, newChallenge)
+		require.NoError(t, err)
+		require.NoError(t, mgr.FinalizeHandshake(newAttemptId, response, nil))
+	})
+
+	t.Run("reports health per DON and node", func(t *testing.T) {
+		var donErr error
+		nodeReports := 0
+		for name, err := range mgr.HealthReport() {
+			switch {
+			case strings.HasSuffix(name, "DONConnectionManager.my_don_1"):
+				donErr = err
+			case strings.Contains(name, "DONConnectionManager.my_don_1."):
+				nodeReports++
+				require.ErrorContains(t, err, "not connected")
+			}
+		}
+		require.ErrorContains(t, donErr, "0 of 4 nodes connected, need at least 2")
+		require.Equal(t, 4, nodeReports)
+	})
+}
@@ -3,10 +3,16 @@ package gateway
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
 
 	"github.com/google/uuid"
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
+	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
@@ -15,6 +21,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/registrysyncer"
 )
 
 type Delegate struct {
@@ -22,9 +29,23 @@ type Delegate struct {
 	ks           keystore.Eth
 	ds           sqlutil.DataSource
 	lggr         logger.Logger
+
+	mu sync.Mutex
+	// gateways of the active jobs, by job ID
+	gateways map[int32]*jobGateway
+	// registry is the latest capabilities registry, if any
+	registry *registrysyncer.LocalRegistry
+}
+
+type jobGateway struct {
+	gateway Gateway
+	config  *config.GatewayConfig
 }
 
-var _ job.Delegate = (*Delegate)(nil)
+var (
+	_ job.UpdatableDelegate   = (*Delegate)(nil)
+	_ registrysyncer.Launcher = (*Delegate)(nil)
+)
 
 func NewDelegate(legacyChains legacyevm.LegacyChainContainer, ks keystore.Eth, ds sqlutil.DataSource, lggr logger.Logger) *Delegate {
 	return &Delegate{
@@ -32,6 +53,7 @@ func NewDelegate(legacyChains legacyevm.LegacyChainContainer, ks keystore.Eth, d
 		ks:           ks,
 		ds:           ds,
 		lggr:         lggr,
+		gateways:     make(map[int32]*jobGateway),
 	}
 }
 
@@ -41,33 +63,175 @@ func (d *Delegate) JobType() job.Type {
 
 func (d *Delegate) BeforeJobCreated(spec job.Job)              {}
 func (d *Delegate) AfterJobCreated(spec job.Job)               {}
-func (d *Delegate) BeforeJobDeleted(spec job.Job)              {}
 func (d *Delegate) OnDeleteJob(context.Context, job.Job) error { return nil }
 
+func (d *Delegate) BeforeJobDeleted(spec job.Job) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.gateways, spec.ID)
+}
+
 // ServicesForSpec returns the scheduler to be used for running observer jobs
 func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) (services []job.ServiceCtx, err error) {
-	if spec.GatewaySpec == nil {
-		return nil, errors.Errorf("services.Delegate expects a *jobSpec.GatewaySpec to be present, got %v", spec)
-	}
-
-	var gatewayConfig config.GatewayConfig
-	err2 := json.Unmarshal(spec.GatewaySpec.GatewayConfig.Bytes(), &gatewayConfig)
-	if err2 != nil {
-		return nil, errors.Wrap(err2, "unmarshal gateway config")
+	gatewayConfig, err := parseGatewayConfig(spec)
+	if err != nil {
+		return nil, err
 	}
 	httpClient, err := network.NewHTTPClient(gatewayConfig.HTTPClientConfig, d.lggr)
 	if err != nil {
 		return nil, err
 	}
 	handlerFactory := NewHandlerFactory(d.legacyChains, d.ds, httpClient, d.lggr)
-	gateway, err := NewGatewayFromConfig(&gatewayConfig, handlerFactory, d.lggr)
+	gateway, err := NewGatewayFromConfig(gatewayConfig, handlerFactory, d.lggr)
 	if err != nil {
 		return nil, err
 	}
 
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	jg := &jobGateway{gateway: gateway, config: gatewayConfig}
+	d.gateways[spec.ID] = jg
+	if err = d.syncRegistryMembership(jg); err != nil {
+		d.lggr.Errorw("Failed to apply DON membership from the capabilities registry", "jobID", spec.ID, "err", err)
+	}
+
 	return []job.ServiceCtx{gateway}, nil
 }
 
+// CanUpdateServices returns true when only the members, or F, of DONs changed. They are applied to the running gateway
+// without restarting it.
+func (d *Delegate) CanUpdateServices(old, jb job.Job) bool {
+	if old.Name != jb.Name {
+		return false
+	}
+	oldConfig, err := parseGatewayConfig(old)
+	if err != nil {
+		return false
+	}
+	newConfig, err := parseGatewayConfig(jb)
+	if err != nil {
+		return false
+	}
+	if !reflect.DeepEqual(withoutMembership(*oldConfig), withoutMembership(*newConfig)) {
+		return false
+	}
+	// the new spec is stored before UpdateServices applies it, so every DON must be valid
+	for _, don := range newConfig.Dons {
+		f := don.F
+		if don.CapabilitiesRegistryDonId != 0 {
+			// the members are the nodes the registry may choose from, and F follows the registry
+			f = 0
+		}
+		if _, err = validateMembership(don.DonId, don.Members, f); err != nil {
+			d.lggr.Warnw("Gateway job has to be replaced to apply invalid DON members", "jobID", old.ID, "err", err)
+			return false
+		}
+	}
+	return true
+}
+
+// UpdateServices applies the members, and F, of the DONs of jb to the running gateway. DONs following the capabilities
+// registry are synced with it again, with the members of jb.
+func (d *Delegate) UpdateServices(_ context.Context, old, jb job.Job) (err error) {
+	newConfig, err := parseGatewayConfig(jb)
+	if err != nil {
+		return err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	jg, ok := d.gateways[old.ID]
+	if !ok {
+		return errors.Errorf("gateway of job %d is not running", old.ID)
+	}
+	jg.config = newConfig
+	for _, don := range newConfig.Dons {
+		if don.CapabilitiesRegistryDonId != 0 {
+			continue
+		}
+		if uerr := jg.gateway.UpdateDONMembership(don.DonId, don.Members, don.F); uerr != nil {
+			err = multierr.Append(err, errors.Wrapf(uerr, "failed to update members of DON %s", don.DonId))
+		}
+	}
+	return multierr.Append(err, d.syncRegistryMembership(jg))
+}
+
+// Launch implements registrysyncer.Launcher, updating the members of the DONs following the capabilities registry.
+func (d *Delegate) Launch(_ context.Context, registry *registrysyncer.LocalRegistry) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.registry = registry
+	var err error
+	for jobID, jg := range d.gateways {
+		if serr := d.syncRegistryMembership(jg); serr != nil {
+			err = multierr.Append(err, errors.Wrapf(serr, "job %d", jobID))
+		}
+	}
+	return err
+}
+
+// syncRegistryMembership must be called with mu held.
+func (d *Delegate) syncRegistryMembership(jg *jobGateway) (err error) {
+	if d.registry == nil {
+		return nil
+	}
+	for _, don := range jg.config.Dons {
+		if don.CapabilitiesRegistryDonId == 0 {
+			continue
+		}
+		members, f, merr := registryMembership(d.registry, don)
+		if merr == nil {
+			merr = jg.gateway.UpdateDONMembership(don.DonId, members, f)
+		}
+		if merr != nil {
+			err = multierr.Append(err, errors.Wrapf(merr, "failed to update members of DON %s", don.DonId))
+		}
+	}
+	return err
+}
+
+// registryMembership returns the members, and F, of a DON following the capabilities registry. The registry names the
+// nodes by their peer ID, and the members of don give the addresses they sign gateway messages with.
+func registryMembership(registry *registrysyncer.LocalRegistry, don config.DONConfig) ([]config.NodeConfig, int, error) {
+	registryDON, ok := registry.IDsToDONs[registrysyncer.DonID(don.CapabilitiesRegistryDonId)]
+	if !ok {
+		return nil, 0, fmt.Errorf("DON %d not found in the capabilities registry", don.CapabilitiesRegistryDonId)
+	}
+	members := make([]config.NodeConfig, 0, len(registryDON.Members))
+	for _, peerID := range registryDON.Members {
+		i := slices.IndexFunc(don.Members, func(n config.NodeConfig) bool {
+			return strings.TrimPrefix(n.PeerID, "p2p_") == peerID.String()
+		})
+		if i < 0 {
+			return nil, 0, fmt.Errorf("node %s of DON %d has no gateway address in the members of DON %s", peerID, don.CapabilitiesRegistryDonId, don.DonId)
+		}
+		members = append(members, don.Members[i])
+	}
+	return members, int(registryDON.F), nil
+}
+
+func parseGatewayConfig(spec job.Job) (*config.GatewayConfig, error) {
+	if spec.GatewaySpec == nil {
+		return nil, errors.Errorf("services.Delegate expects a *jobSpec.GatewaySpec to be present, got %v", spec)
+	}
+	var gatewayConfig config.GatewayConfig
+	if err := json.Unmarshal(spec.GatewaySpec.GatewayConfig.Bytes(), &gatewayConfig); err != nil {
+		return nil, errors.Wrap(err, "unmarshal gateway config")
+	}
+	return &gatewayConfig, nil
+}
+
+// withoutMembership returns a copy of cfg without the members and F of its DONs.
+func withoutMembership(cfg config.GatewayConfig) config.GatewayConfig {
+	dons := make([]config.DONConfig, len(cfg.Dons))
+	for i, don := range cfg.Dons {
+		don.Members = nil
+		don.F = 0
+		dons[i] = don
+	}
+	cfg.Dons = dons
+	return cfg
+}
+
 func ValidatedGatewaySpec(tomlString string) (job.Job, error) {
 	var jb = job.Job{ExternalJobID: uuid.New()}
 
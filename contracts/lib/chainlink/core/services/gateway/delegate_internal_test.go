@@ -0,0 +1,40 @@
+package gateway
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	p2ptypes "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types"
+	"github.com/smartcontractkit/chainlink/v2/core/services/registrysyncer"
+)
+
+func TestDelegate_RegistryMembership(t *testing.T) {
+	t.Parallel()
+
+	var peer1, peer2, peer3 p2ptypes.PeerID
+	peer1[0], peer2[0], peer3[0] = 1, 2, 3
+	registry := &registrysyncer.LocalRegistry{
+		IDsToDONs: map[registrysyncer.DonID]registrysyncer.DON{
+			7: {DON: capabilities.DON{ID: 7, Members: []p2ptypes.PeerID{peer1, peer2}, F: 1}},
+		},
+	}
+	node1 := config.NodeConfig{Name: "node1", Address: "0x0000000000000000000000000000000000000001", PeerID: "p2p_" + peer1.String()}
+	node2 := config.NodeConfig{Name: "node2", Address: "0x0000000000000000000000000000000000000002", PeerID: peer2.String()}
+	node3 := config.NodeConfig{Name: "node3", Address: "0x0000000000000000000000000000000000000003", PeerID: peer3.String()}
+
+	members, f, err := registryMembership(registry, config.DONConfig{DonId: "my_don", CapabilitiesRegistryDonId: 7, Members: []config.NodeConfig{node3, node2, node1}})
+	require.NoError(t, err)
+	assert.Equal(t, []config.NodeConfig{node1, node2}, members)
+	assert.Equal(t, 1, f)
+
+	_, _, err = registryMembership(registry, config.DONConfig{DonId: "my_don", CapabilitiesRegistryDonId: 7, Members: []config.NodeConfig{node1}})
+	require.ErrorContains(t, err, "has no gateway address")
+
+	_, _, err = registryMembership(registry, config.DONConfig{DonId: "my_don", CapabilitiesRegistryDonId: 8, Members: []config.NodeConfig{node1}})
+	require.ErrorContains(t, err, "not found in the capabilities registry")
+}
@@ -1,11 +1,15 @@
 package gateway_test
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 )
 
 func TestDelegate_JobSpecValidator(t *testing.T) {
@@ -55,3 +59,40 @@ schemaVersion = 1
 		})
 	}
 }
+
+func TestDelegate_CanUpdateServices(t *testing.T) {
+	t.Parallel()
+
+	const spec = `
+type = "gateway"
+schemaVersion = 1
+name = "%s"
+[gatewayConfig.NodeServerConfig]
+Port = %d
+[[gatewayConfig.Dons]]
+DonId = "my_don"
+HandlerName = "dummy"
+F = %d
+[[gatewayConfig.Dons.Members]]
+Name = "node"
+Address = "%s"
+[[gatewayConfig.Dons.Members]]
+Name = "other"
+Address = "0x0000000000000000000000000000000000000002"
+`
+	newJob := func(name string, port, f int, address string) job.Job {
+		jb, err := gateway.ValidatedGatewaySpec(fmt.Sprintf(spec, name, port, f, address))
+		require.NoError(t, err)
+		return jb
+	}
+
+	delegate := gateway.NewDelegate(nil, nil, nil, logger.TestLogger(t))
+	old := newJob("gateway", 8080, 0, "0x68902d681c28119f9b2531473a417088bf008e59")
+	assert.True(t, delegate.CanUpdateServices(old, newJob("gateway", 8080, 0, "0x0000000000000000000000000000000000000001")))
+	assert.True(t, delegate.CanUpdateServices(old, newJob("gateway", 8080, 1, "0x68902d681c28119f9b2531473a417088bf008e59")))
+	assert.False(t, delegate.CanUpdateServices(old, newJob("gateway", 8081, 0, "0x68902d681c28119f9b2531473a417088bf008e59")))
+	assert.False(t, delegate.CanUpdateServices(old, newJob("renamed", 8080, 0, "0x68902d681c28119f9b2531473a417088bf008e59")))
+	// invalid members are only reported when the job is replaced
+	assert.False(t, delegate.CanUpdateServices(old, newJob("gateway", 8080, 2, "0x68902d681c28119f9b2531473a417088bf008e59")))
+	assert.False(t, delegate.CanUpdateServices(old, newJob("gateway", 8080, 0, "0x0000000000000000000000000000000000000002")))
+}
@@ -4,8 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/multierr"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -28,10 +34,27 @@ var promRequest = promauto.NewCounterVec(prometheus.CounterOpts{
 	Help: "Metric to track received requests and response codes",
 }, []string{"response_code"})
 
+var promUserRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "gateway_user_requests",
+	Help: "Metric to track valid user requests per DON, handler, method and response code",
+}, []string{"don_id", "handler", "method", "response_code"})
+
+var promUserRequestLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "gateway_user_request_latency_seconds",
+	Help:    "Metric to track the time taken to respond to valid user requests per DON, handler and method",
+	Buckets: []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+}, []string{"don_id", "handler", "method"})
+
+// tracer starts the spans of user requests, which SendToNode and HandleNodeMessage continue.
+var tracer = otel.Tracer("gateway")
+
 type Gateway interface {
 	job.ServiceCtx
+	services.HealthReporter
 	gw_net.HTTPRequestHandler
 
+	// UpdateDONMembership replaces the members and F of a DON while the gateway is running.
+	UpdateDONMembership(donId string, members []config.NodeConfig, f int) error
 	GetUserPort() int
 	GetNodePort() int
 }
@@ -48,8 +71,10 @@ type gateway struct {
 	codec      api.Codec
 	httpServer gw_net.HttpServer
 	handlers   map[string]handlers.Handler
-	connMgr    ConnectionManager
-	lggr       logger.Logger
+	// handlerNames labels the metrics of each DON with the name of its handler
+	handlerNames map[string]string
+	connMgr      ConnectionManager
+	lggr         logger.Logger
 }
 
 func NewGatewayFromConfig(config *config.GatewayConfig, handlerFactory HandlerFactory, lggr logger.Logger) (Gateway, error) {
@@ -61,6 +86,7 @@ func NewGatewayFromConfig(config *config.GatewayConfig, handlerFactory HandlerFa
 	}
 
 	handlerMap := make(map[string]handlers.Handler)
+	handlerNames := make(map[string]string)
 	for _, donConfig := range config.Dons {
 		donConfig := donConfig
 		_, ok := handlerMap[donConfig.DonId]
@@ -82,18 +108,26 @@ func NewGatewayFromConfig(config *config.GatewayConfig, handlerFactory HandlerFa
 			return nil, err
 		}
 		handlerMap[donConfig.DonId] = handler
+		handlerNames[donConfig.DonId] = donConfig.HandlerName
 		donConnMgr.SetHandler(handler)
 	}
-	return NewGateway(codec, httpServer, handlerMap, connMgr, lggr), nil
+	gw := newGateway(codec, httpServer, handlerMap, connMgr, lggr)
+	gw.handlerNames = handlerNames
+	return gw, nil
 }
 
 func NewGateway(codec api.Codec, httpServer gw_net.HttpServer, handlers map[string]handlers.Handler, connMgr ConnectionManager, lggr logger.Logger) Gateway {
+	return newGateway(codec, httpServer, handlers, connMgr, lggr)
+}
+
+func newGateway(codec api.Codec, httpServer gw_net.HttpServer, handlers map[string]handlers.Handler, connMgr ConnectionManager, lggr logger.Logger) *gateway {
 	gw := &gateway{
-		codec:      codec,
-		httpServer: httpServer,
-		handlers:   handlers,
-		connMgr:    connMgr,
-		lggr:       lggr.Named("Gateway"),
+		codec:        codec,
+		httpServer:   httpServer,
+		handlers:     handlers,
+		handlerNames: make(map[string]string),
+		connMgr:      connMgr,
+		lggr:         lggr.Named("Gateway"),
 	}
 	httpServer.SetHTTPRequestHandler(gw)
 	return gw
@@ -126,6 +160,18 @@ func (g *gateway) Close() error {
 	})
 }
 
+func (g *gateway) HealthReport() map[string]error {
+	hr := map[string]error{g.Name(): g.Healthy()}
+	services.CopyHealth(hr, g.connMgr.HealthReport())
+	return hr
+}
+
+func (g *gateway) Name() string { return g.lggr.Name() }
+
+func (g *gateway) UpdateDONMembership(donId string, members []config.NodeConfig, f int) error {
+	return g.connMgr.UpdateDONMembership(donId, members, f)
+}
+
 // Called by the server
 func (g *gateway) ProcessRequest(ctx context.Context, rawRequest []byte) (rawResponse []byte, httpStatusCode int) {
 	// decode
@@ -139,32 +185,58 @@ func (g *gateway) ProcessRequest(ctx context.Context, rawRequest []byte) (rawRes
 	if err = msg.Validate(); err != nil {
 		return newError(g.codec, msg.Body.MessageId, api.UserMessageParseError, err.Error())
 	}
+
+	start := time.Now()
+	ctx, span := tracer.Start(ctx, "gateway.ProcessRequest", trace.WithAttributes(
+		attribute.String("message_id", msg.Body.MessageId),
+		attribute.String("don_id", msg.Body.DonId),
+		attribute.String("method", msg.Body.Method),
+	))
+	errCode := api.NoError
+	defer func() {
+		donId, handlerName, method := msg.Body.DonId, g.handlerNames[msg.Body.DonId], msg.Body.Method
+		// don't let users create a metric per made up DON ID or method
+		if _, ok := g.handlers[donId]; !ok {
+			donId, method = "unknown", "unknown"
+		} else if !slices.Contains(handlerMethods[handlerName], method) {
+			method = "unknown"
+		}
+		promUserRequests.WithLabelValues(donId, handlerName, method, errCode.String()).Inc()
+		promUserRequestLatency.WithLabelValues(donId, handlerName, method).Observe(time.Since(start).Seconds())
+		span.End()
+	}()
+	fail := func(code api.ErrorCode, errMsg string) ([]byte, int) {
+		errCode = code
+		span.SetStatus(codes.Error, code.String())
+		return newError(g.codec, msg.Body.MessageId, code, errMsg)
+	}
+
 	// find correct handler
 	handler, ok := g.handlers[msg.Body.DonId]
 	if !ok {
-		return newError(g.codec, msg.Body.MessageId, api.UnsupportedDONIdError, "unsupported DON ID")
+		return fail(api.UnsupportedDONIdError, "unsupported DON ID")
 	}
 	// send to the handler
 	responseCh := make(chan handlers.UserCallbackPayload, 1)
 	err = handler.HandleUserMessage(ctx, msg, responseCh)
 	if err != nil {
-		return newError(g.codec, msg.Body.MessageId, api.HandlerError, err.Error())
+		return fail(api.HandlerError, err.Error())
 	}
 	// await response
 	var response handlers.UserCallbackPayload
 	select {
 	case <-ctx.Done():
-		return newError(g.codec, msg.Body.MessageId, api.RequestTimeoutError, "handler timeout")
+		return fail(api.RequestTimeoutError, "handler timeout")
 	case response = <-responseCh:
 		break
 	}
 	if response.ErrCode != api.NoError {
-		return newError(g.codec, msg.Body.MessageId, response.ErrCode, response.ErrMsg)
+		return fail(response.ErrCode, response.ErrMsg)
 	}
 	// encode
 	rawResponse, err = g.codec.EncodeResponse(response.Msg)
 	if err != nil {
-		return newError(g.codec, msg.Body.MessageId, api.NodeReponseEncodingError, "")
+		return fail(api.NodeReponseEncodingError, "")
 	}
 	promRequest.WithLabelValues(api.NoError.String()).Inc()
 	return rawResponse, api.ToHttpErrorCode(api.NoError)
@@ -9,6 +9,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/capabilities"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/functions"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
@@ -20,6 +21,13 @@ const (
 	WebAPICapabilitiesType HandlerType = "web-api-capabilities"
 )
 
+// handlerMethods are the methods accepted by each handler type. They label the metrics of user requests, and other
+// methods are labelled "unknown".
+var handlerMethods = map[HandlerType][]string{
+	FunctionsHandlerType:   {functions.MethodSecretsSet, functions.MethodSecretsList, functions.MethodHeartbeat},
+	WebAPICapabilitiesType: {capabilities.MethodWebAPITarget, capabilities.MethodWebAPITrigger, capabilities.MethodComputeAction, capabilities.MethodWorkflowSyncer},
+}
+
 type handlerFactory struct {
 	legacyChains legacyevm.LegacyChainContainer
 	ds           sqlutil.DataSource
@@ -39,6 +47,14 @@ func NewHandlerFactory(legacyChains legacyevm.LegacyChainContainer, ds sqlutil.D
 }
 
 func (hf *handlerFactory) NewHandler(handlerType HandlerType, handlerConfig json.RawMessage, donConfig *config.DONConfig, don handlers.DON) (handlers.Handler, error) {
+	handler, err := hf.newHandler(handlerType, handlerConfig, donConfig, don)
+	if err != nil || donConfig.UserAuth == nil {
+		return handler, err
+	}
+	return auth.NewHandler(handler, donConfig.DonId, donConfig.UserAuth, hf.ds, hf.lggr)
+}
+
+func (hf *handlerFactory) newHandler(handlerType HandlerType, handlerConfig json.RawMessage, donConfig *config.DONConfig, don handlers.DON) (handlers.Handler, error) {
 	switch handlerType {
 	case FunctionsHandlerType:
 		return functions.NewFunctionsHandlerFromConfig(handlerConfig, donConfig, don, hf.legacyChains, hf.ds, hf.lggr)
@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+)
+
+const defaultAPIKeyHeader = "X-API-Key"
+
+// APIKeyConfig configures the "apiKey" plugin. Only hashes of the keys are configured.
+type APIKeyConfig struct {
+	// Header carrying the API key, X-API-Key by default.
+	Header string `json:"header"`
+	// Keys maps the hex encoded SHA-256 hash of each API key to its user.
+	Keys map[string]string `json:"keys"`
+}
+
+type apiKeyAuthenticator struct {
+	header string
+	users  map[[sha256.Size]byte]string
+}
+
+func NewAPIKeyAuthenticator(rawConfig json.RawMessage) (Authenticator, error) {
+	var cfg APIKeyConfig
+	if err := json.Unmarshal(rawConfig, &cfg); err != nil {
+		return nil, err
+	}
+	if len(cfg.Keys) == 0 {
+		return nil, errors.New("no API keys configured")
+	}
+	a := &apiKeyAuthenticator{header: cfg.Header, users: make(map[[sha256.Size]byte]string, len(cfg.Keys))}
+	if a.header == "" {
+		a.header = defaultAPIKeyHeader
+	}
+	for hash, user := range cfg.Keys {
+		b, err := hex.DecodeString(strings.TrimPrefix(hash, "0x"))
+		if err != nil || len(b) != sha256.Size {
+			return nil, errors.New("API keys must be configured as hex encoded SHA-256 hashes")
+		}
+		if user == "" {
+			return nil, errors.New("API keys must have a user")
+		}
+		a.users[[sha256.Size]byte(b)] = user
+	}
+	return a, nil
+}
+
+func (a *apiKeyAuthenticator) Authenticate(_ context.Context, header http.Header, _ *api.Message) (string, error) {
+	key := header.Get(a.header)
+	if key == "" {
+		return "", errors.New("missing API key")
+	}
+	user, ok := a.users[sha256.Sum256([]byte(key))]
+	if !ok {
+		return "", errors.New("invalid API key")
+	}
+	return user, nil
+}
@@ -0,0 +1,178 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
+)
+
+const (
+	APIKeyPlugin = "apiKey"
+	JWTPlugin    = "jwt"
+
+	// usageRetention is how long the daily request counts of users are kept.
+	usageRetention     = 7 * 24 * time.Hour
+	usagePruneInterval = time.Hour
+)
+
+var promRejectedRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "gateway_user_auth_rejected_requests",
+	Help: "Metric to track user requests rejected by authentication or quotas per DON",
+}, []string{"don_id", "error_code"})
+
+// Authenticator identifies the users sending requests to a DON.
+type Authenticator interface {
+	// Authenticate returns the user sending msg, in an HTTP request with header.
+	Authenticate(ctx context.Context, header http.Header, msg *api.Message) (user string, err error)
+}
+
+// NewAuthenticator returns the Authenticator of a plugin, configured with pluginConfig.
+func NewAuthenticator(plugin string, pluginConfig json.RawMessage) (Authenticator, error) {
+	switch plugin {
+	case APIKeyPlugin:
+		return NewAPIKeyAuthenticator(pluginConfig)
+	case JWTPlugin:
+		return NewJWTAuthenticator(pluginConfig)
+	default:
+		return nil, fmt.Errorf("unsupported user auth plugin %q", plugin)
+	}
+}
+
+type userKey struct{}
+
+// User returns the authenticated user of a request, passed to Handler.HandleUserMessage with ctx.
+func User(ctx context.Context) (string, bool) {
+	user, ok := ctx.Value(userKey{}).(string)
+	return user, ok
+}
+
+// handler authenticates user messages, and enforces the daily quota of each user, before passing them to the wrapped
+// Handler.
+type handler struct {
+	handlers.Handler
+	donId         string
+	authenticator Authenticator
+	dailyQuota    uint32
+	usage         UsageORM
+	lggr          logger.Logger
+	stopCh        services.StopChan
+	wg            sync.WaitGroup
+}
+
+var _ handlers.Handler = (*handler)(nil)
+
+// NewHandler wraps handler with the authentication and quotas of cfg. Usage counters are persisted to ds, or kept in
+// memory without it.
+func NewHandler(wrapped handlers.Handler, donId string, cfg *config.UserAuthConfig, ds sqlutil.DataSource, lggr logger.Logger) (handlers.Handler, error) {
+	authenticator, err := NewAuthenticator(cfg.Plugin, cfg.PluginConfig)
+	if err != nil {
+		return nil, fmt.Errorf("DON %s: %w", donId, err)
+	}
+	var usage UsageORM
+	if ds != nil {
+		usage = NewUsageORM(ds)
+	} else {
+		usage = newMemoryUsage()
+	}
+	return &handler{
+		Handler:       wrapped,
+		donId:         donId,
+		authenticator: authenticator,
+		dailyQuota:    cfg.DailyQuota,
+		usage:         usage,
+		lggr:          lggr.Named("UserAuth." + donId),
+		stopCh:        make(services.StopChan),
+	}, nil
+}
+
+func (h *handler) Start(ctx context.Context) error {
+	if err := h.Handler.Start(ctx); err != nil {
+		return err
+	}
+	h.wg.Add(1)
+	go h.pruneUsage()
+	return nil
+}
+
+func (h *handler) Close() error {
+	close(h.stopCh)
+	h.wg.Wait()
+	return h.Handler.Close()
+}
+
+// pruneUsage periodically deletes the request counts of the DON older than usageRetention.
+func (h *handler) pruneUsage() {
+	defer h.wg.Done()
+	ctx, cancel := h.stopCh.NewCtx()
+	defer cancel()
+
+	ticker := services.NewTicker(usagePruneInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := h.usage.DeleteUsageBefore(ctx, h.donId, time.Now().Add(-usageRetention))
+			if err != nil {
+				h.lggr.Errorw("failed to delete old request counts", "err", err)
+			} else if n > 0 {
+				h.lggr.Debugw("deleted old request counts", "count", n)
+			}
+		}
+	}
+}
+
+func (h *handler) HandleUserMessage(ctx context.Context, msg *api.Message, callbackCh chan<- handlers.UserCallbackPayload) error {
+	user, err := h.authenticator.Authenticate(ctx, network.RequestHeader(ctx), msg)
+	if err != nil {
+		h.lggr.Debugw("rejected unauthenticated request", "messageId", msg.Body.MessageId, "err", err)
+		h.reject(msg, callbackCh, api.UnauthorizedError, err.Error())
+		return nil
+	}
+
+	now := time.Now()
+	if h.dailyQuota > 0 {
+		allowed, err := h.usage.IncrementUsage(ctx, h.donId, user, now, h.dailyQuota)
+		if err != nil {
+			h.lggr.Errorw("failed to count request towards quota", "user", user, "err", err)
+			return errors.New("failed to count request towards quota")
+		}
+		if !allowed {
+			h.lggr.Debugw("rejected request over quota", "user", user, "messageId", msg.Body.MessageId)
+			h.reject(msg, callbackCh, api.QuotaExceededError, fmt.Sprintf("daily quota of %d requests exceeded", h.dailyQuota))
+			return nil
+		}
+	}
+
+	err = h.Handler.HandleUserMessage(context.WithValue(ctx, userKey{}, user), msg, callbackCh)
+	if err != nil && h.dailyQuota > 0 {
+		// requests the DON rejected do not count towards the quota
+		if derr := h.usage.DecrementUsage(ctx, h.donId, user, now); derr != nil {
+			h.lggr.Errorw("failed to refund rejected request", "user", user, "err", derr)
+		}
+	}
+	return err
+}
+
+func (h *handler) reject(msg *api.Message, callbackCh chan<- handlers.UserCallbackPayload, errCode api.ErrorCode, errMsg string) {
+	promRejectedRequests.WithLabelValues(h.donId, errCode.String()).Inc()
+	callbackCh <- handlers.UserCallbackPayload{Msg: msg, ErrCode: errCode, ErrMsg: errMsg}
+	close(callbackCh)
+}
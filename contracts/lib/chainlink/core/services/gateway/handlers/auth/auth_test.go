@@ -0,0 +1,264 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/auth"
+	handlermocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
+)
+
+func hashKey(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
+
+func handleUserMessage(t *testing.T, handler handlers.Handler, header http.Header) handlers.UserCallbackPayload {
+	callbackCh := make(chan handlers.UserCallbackPayload, 1)
+	msg := &api.Message{Body: api.MessageBody{MessageId: "1", DonId: "my_don"}}
+	require.NoError(t, handler.HandleUserMessage(network.WithRequestHeader(testutils.Context(t), header), msg, callbackCh))
+	select {
+	case payload := <-callbackCh:
+		return payload
+	default:
+		return handlers.UserCallbackPayload{}
+	}
+}
+
+// newWrappedHandler returns the user of each request passed to the wrapped handler on users.
+func newWrappedHandler(t *testing.T) (*handlermocks.Handler, chan string) {
+	wrapped := handlermocks.NewHandler(t)
+	users := make(chan string, 10)
+	wrapped.On("HandleUserMessage", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		user, ok := auth.User(args.Get(0).(context.Context))
+		assert.True(t, ok)
+		users <- user
+	}).Return(nil).Maybe()
+	return wrapped, users
+}
+
+func TestHandler_APIKey(t *testing.T) {
+	t.Parallel()
+
+	pluginConfig, err := json.Marshal(auth.APIKeyConfig{Keys: map[string]string{hashKey("secret"): "alice"}})
+	require.NoError(t, err)
+	wrapped, users := newWrappedHandler(t)
+	handler, err := auth.NewHandler(wrapped, "my_don", &config.UserAuthConfig{Plugin: auth.APIKeyPlugin, PluginConfig: pluginConfig, DailyQuota: 2}, nil, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	payload := handleUserMessage(t, handler, http.Header{})
+	assert.Equal(t, api.UnauthorizedError, payload.ErrCode)
+	assert.Equal(t, "missing API key", payload.ErrMsg)
+
+	payload = handleUserMessage(t, handler, http.Header{"X-Api-Key": []string{"wrong"}})
+	assert.Equal(t, api.UnauthorizedError, payload.ErrCode)
+
+	for i := 0; i < 2; i++ {
+		payload = handleUserMessage(t, handler, http.Header{"X-Api-Key": []string{"secret"}})
+		assert.Equal(t, api.NoError, payload.ErrCode)
+		assert.Equal(t, "alice", <-users)
+	}
+
+	payload = handleUserMessage(t, handler, http.Header{"X-Api-Key": []string{"secret"}})
+	assert.Equal(t, api.QuotaExceededError, payload.ErrCode)
+	assert.Equal(t, "daily quota of 2 requests exceeded", payload.ErrMsg)
+}
+
+func TestHandler_RefundsRejectedRequests(t *testing.T) {
+	t.Parallel()
+
+	pluginConfig, err := json.Marshal(auth.APIKeyConfig{Keys: map[string]string{hashKey("secret"): "alice"}})
+	require.NoError(t, err)
+	wrapped := handlermocks.NewHandler(t)
+	wrapped.On("HandleUserMessage", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("invalid message")).Once()
+	wrapped.On("HandleUserMessage", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	handler, err := auth.NewHandler(wrapped, "my_don", &config.UserAuthConfig{Plugin: auth.APIKeyPlugin, PluginConfig: pluginConfig, DailyQuota: 1}, nil, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	header := http.Header{"X-Api-Key": []string{"secret"}}
+	msg := &api.Message{Body: api.MessageBody{MessageId: "1", DonId: "my_don"}}
+	require.Error(t, handler.HandleUserMessage(network.WithRequestHeader(testutils.Context(t), header), msg, make(chan handlers.UserCallbackPayload, 1)))
+
+	// the rejected request did not count towards the quota
+	payload := handleUserMessage(t, handler, header)
+	assert.Equal(t, api.NoError, payload.ErrCode)
+	payload = handleUserMessage(t, handler, header)
+	assert.Equal(t, api.QuotaExceededError, payload.ErrCode)
+}
+
+func TestHandler_JWT(t *testing.T) {
+	t.Parallel()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	pluginConfig, err := json.Marshal(auth.JWTConfig{
+		PublicKeys: []string{string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))},
+		Issuer:     "https://idp.example.com",
+	})
+	require.NoError(t, err)
+	wrapped, users := newWrappedHandler(t)
+	handler, err := auth.NewHandler(wrapped, "my_don", &config.UserAuthConfig{Plugin: auth.JWTPlugin, PluginConfig: pluginConfig}, nil, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	bearer := func(key *ecdsa.PrivateKey, claims jwt.MapClaims) http.Header {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodES256, claims).SignedString(key)
+		require.NoError(t, err)
+		return http.Header{"Authorization": []string{"Bearer " + token}}
+	}
+	valid := jwt.MapClaims{"sub": "bob", "iss": "https://idp.example.com", "exp": time.Now().Add(time.Hour).Unix()}
+
+	payload := handleUserMessage(t, handler, bearer(key, valid))
+	assert.Equal(t, api.NoError, payload.ErrCode)
+	assert.Equal(t, "bob", <-users)
+
+	for name, header := range map[string]http.Header{
+		"missing token":   {},
+		"other key":       bearer(otherKey, valid),
+		"expired":         bearer(key, jwt.MapClaims{"sub": "bob", "iss": "https://idp.example.com", "exp": time.Now().Add(-time.Hour).Unix()}),
+		"no expiry":       bearer(key, jwt.MapClaims{"sub": "bob", "iss": "https://idp.example.com"}),
+		"wrong issuer":    bearer(key, jwt.MapClaims{"sub": "bob", "iss": "https://evil.example.com", "exp": time.Now().Add(time.Hour).Unix()}),
+		"no user claim":   bearer(key, jwt.MapClaims{"iss": "https://idp.example.com", "exp": time.Now().Add(time.Hour).Unix()}),
+		"not bearer auth": {"Authorization": []string{"Basic Ym9iOnB3"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			payload := handleUserMessage(t, handler, header)
+			assert.Equal(t, api.UnauthorizedError, payload.ErrCode)
+		})
+	}
+}
+
+func TestNewAuthenticator_InvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		plugin string
+		config string
+	}{
+		"unknown plugin":   {"oauth", `{}`},
+		"no API keys":      {auth.APIKeyPlugin, `{}`},
+		"plaintext key":    {auth.APIKeyPlugin, `{"keys": {"secret": "alice"}}`},
+		"no user":          {auth.APIKeyPlugin, fmt.Sprintf(`{"keys": {"%s": ""}}`, hashKey("secret"))},
+		"no JWT keys":      {auth.JWTPlugin, `{}`},
+		"invalid JWT keys": {auth.JWTPlugin, `{"publicKeys": ["not a key"]}`},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := auth.NewAuthenticator(tc.plugin, json.RawMessage(tc.config))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestUsageORM_IncrementUsage(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	orm := auth.NewUsageORM(pgtest.NewSqlxDB(t))
+	now := time.Now()
+
+	for i := 0; i < 3; i++ {
+		allowed, err := orm.IncrementUsage(ctx, "my_don", "alice", now, 3)
+		require.NoError(t, err)
+		require.True(t, allowed)
+	}
+	allowed, err := orm.IncrementUsage(ctx, "my_don", "alice", now, 3)
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	// quotas are per user, DON and day
+	allowed, err = orm.IncrementUsage(ctx, "my_don", "bob", now, 3)
+	require.NoError(t, err)
+	require.True(t, allowed)
+	allowed, err = orm.IncrementUsage(ctx, "other_don", "alice", now, 3)
+	require.NoError(t, err)
+	require.True(t, allowed)
+	allowed, err = orm.IncrementUsage(ctx, "my_don", "alice", now.Add(24*time.Hour), 3)
+	require.NoError(t, err)
+	require.True(t, allowed)
+}
+
+func TestUsageORM_DecrementUsage(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	orm := auth.NewUsageORM(pgtest.NewSqlxDB(t))
+	now := time.Now()
+
+	allowed, err := orm.IncrementUsage(ctx, "my_don", "alice", now, 1)
+	require.NoError(t, err)
+	require.True(t, allowed)
+	require.NoError(t, orm.DecrementUsage(ctx, "my_don", "alice", now))
+	allowed, err = orm.IncrementUsage(ctx, "my_don", "alice", now, 1)
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	// refunds never go below zero
+	require.NoError(t, orm.DecrementUsage(ctx, "my_don", "alice", now))
+	require.NoError(t, orm.DecrementUsage(ctx, "my_don", "alice", now))
+	allowed, err = orm.IncrementUsage(ctx, "my_don", "alice", now, 1)
+	require.NoError(t, err)
+	require.True(t, allowed)
+	allowed, err = orm.IncrementUsage(ctx, "my_don", "alice", now, 1)
+	require.NoError(t, err)
+	require.False(t, allowed)
+}
+
+func TestUsageORM_DeleteUsageBefore(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	orm := auth.NewUsageORM(pgtest.NewSqlxDB(t))
+	now := time.Now()
+
+	for _, tc := range []struct {
+		donId string
+		t     time.Time
+	}{
+		{"my_don", now.Add(-48 * time.Hour)},
+		{"my_don", now},
+		{"other_don", now.Add(-48 * time.Hour)},
+	} {
+		_, err := orm.IncrementUsage(ctx, tc.donId, "alice", tc.t, 1)
+		require.NoError(t, err)
+	}
+
+	n, err := orm.DeleteUsageBefore(ctx, "my_don", now.Add(-24*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+
+	// the counts of the current day, and of other DONs, are kept
+	allowed, err := orm.IncrementUsage(ctx, "my_don", "alice", now, 1)
+	require.NoError(t, err)
+	assert.False(t, allowed)
+	allowed, err = orm.IncrementUsage(ctx, "other_don", "alice", now.Add(-48*time.Hour), 1)
+	require.NoError(t, err)
+	assert.False(t, allowed)
+}
@@ -0,0 +1,108 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+// UsageORM counts the requests users send to DONs per UTC day.
+type UsageORM interface {
+	// IncrementUsage counts a request sent by user to a DON at time t, unless the user already sent limit requests that
+	// day. It returns false when the limit is reached.
+	IncrementUsage(ctx context.Context, donId, user string, t time.Time, limit uint32) (bool, error)
+	// DecrementUsage refunds a request counted by IncrementUsage at time t, which the DON did not accept.
+	DecrementUsage(ctx context.Context, donId, user string, t time.Time) error
+	// DeleteUsageBefore deletes the requests counted for a DON on the days before t.
+	DeleteUsageBefore(ctx context.Context, donId string, t time.Time) (int64, error)
+}
+
+type orm struct {
+	ds sqlutil.DataSource
+}
+
+var _ UsageORM = (*orm)(nil)
+
+func NewUsageORM(ds sqlutil.DataSource) UsageORM {
+	return &orm{ds: ds}
+}
+
+func (o *orm) IncrementUsage(ctx context.Context, donId, user string, t time.Time, limit uint32) (bool, error) {
+	res, err := o.ds.ExecContext(ctx, `INSERT INTO gateway_user_usage (don_id, user_id, day, requests)
+		VALUES ($1, $2, $3, 1)
+		ON CONFLICT (don_id, user_id, day) DO UPDATE SET requests = gateway_user_usage.requests + 1
+		WHERE gateway_user_usage.requests < $4`, donId, user, day(t), limit)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (o *orm) DecrementUsage(ctx context.Context, donId, user string, t time.Time) error {
+	_, err := o.ds.ExecContext(ctx, `UPDATE gateway_user_usage SET requests = requests - 1
+		WHERE don_id = $1 AND user_id = $2 AND day = $3 AND requests > 0`, donId, user, day(t))
+	return err
+}
+
+func (o *orm) DeleteUsageBefore(ctx context.Context, donId string, t time.Time) (int64, error) {
+	res, err := o.ds.ExecContext(ctx, `DELETE FROM gateway_user_usage WHERE don_id = $1 AND day < $2`, donId, day(t))
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+type usageKey struct {
+	donId, user string
+}
+
+// memoryUsage counts requests in memory, for gateways running without a database.
+type memoryUsage struct {
+	mu       sync.Mutex
+	day      time.Time
+	requests map[usageKey]uint32
+}
+
+func newMemoryUsage() *memoryUsage {
+	return &memoryUsage{requests: make(map[usageKey]uint32)}
+}
+
+func (m *memoryUsage) IncrementUsage(_ context.Context, donId, user string, t time.Time, limit uint32) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if d := day(t); !d.Equal(m.day) {
+		m.day = d
+		clear(m.requests)
+	}
+	key := usageKey{donId, user}
+	if m.requests[key] >= limit {
+		return false, nil
+	}
+	m.requests[key]++
+	return true, nil
+}
+
+func (m *memoryUsage) DecrementUsage(_ context.Context, donId, user string, t time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := usageKey{donId, user}
+	if day(t).Equal(m.day) && m.requests[key] > 0 {
+		m.requests[key]--
+	}
+	return nil
+}
+
+// DeleteUsageBefore is a no-op, as only the requests of the current day are kept in memory.
+func (m *memoryUsage) DeleteUsageBefore(context.Context, string, time.Time) (int64, error) {
+	return 0, nil
+}
+
+func day(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
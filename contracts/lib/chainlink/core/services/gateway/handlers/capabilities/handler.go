@@ -247,7 +247,8 @@ func (h *handler) HandleUserMessage(ctx context.Context, msg *api.Message, callb
 	}
 
 	// Send to all nodes.
-	for _, member := range h.donConfig.Members {
+	members, _ := handlers.Membership(don, h.donConfig)
+	for _, member := range members {
 		err = multierr.Combine(err, don.SendToNode(ctx, member.Address, msg))
 	}
 	return err
@@ -1,22 +1,39 @@
 package common
 
 import (
+	"context"
 	"errors"
 	"sync"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
 )
 
+var (
+	promRequestCacheSize = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gateway_request_cache_size",
+		Help: "Metric to track the number of pending requests in the request cache per DON",
+	}, []string{"don_id"})
+	promRequestCacheTimeouts = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gateway_request_cache_timeouts",
+		Help: "Metric to track the number of requests removed from the request cache after timing out per DON",
+	}, []string{"don_id"})
+)
+
 // RequestCache is used to store pending requests and collect incoming responses as they arrive.
 // It is parameterized by responseData, which is a service-specific type storing all data needed to aggregate responses.
 // Client needs to implement a ResponseProcessor, which is called for every response (see below).
 // Additionally, each request has a timeout, after which the netry will be removed from the cache and an error sent to the callback channel.
+// The span of each request is linked from the spans of ctx passed to ProcessResponse, as nodes don't return the trace.
 // All methods are thread-safe.
 type RequestCache[T any] interface {
-	NewRequest(request *api.Message, callbackCh chan<- handlers.UserCallbackPayload, responseData *T) error
-	ProcessResponse(response *api.Message, process ResponseProcessor[T]) error
+	NewRequest(ctx context.Context, request *api.Message, callbackCh chan<- handlers.UserCallbackPayload, responseData *T) error
+	ProcessResponse(ctx context.Context, response *api.Message, process ResponseProcessor[T]) error
 }
 
 // If aggregated != nil then the aggregated response is ready and the entry will be deleted from RequestCache.
@@ -24,6 +41,7 @@ type RequestCache[T any] interface {
 type ResponseProcessor[T any] func(response *api.Message, state *T) (aggregated *handlers.UserCallbackPayload, newState *T, err error)
 
 type requestCache[T any] struct {
+	donId        string
 	cache        map[globalId]*pendingRequest[T]
 	maxCacheSize uint32
 	timeout      time.Duration
@@ -39,14 +57,16 @@ type pendingRequest[T any] struct {
 	callbackCh   chan<- handlers.UserCallbackPayload
 	responseData *T
 	timeoutTimer *time.Timer
+	spanContext  trace.SpanContext
 	mu           sync.Mutex
 }
 
-func NewRequestCache[T any](timeout time.Duration, maxCacheSize uint32) RequestCache[T] {
-	return &requestCache[T]{cache: make(map[globalId]*pendingRequest[T]), timeout: timeout, maxCacheSize: maxCacheSize}
+// NewRequestCache returns a RequestCache for the requests of DON donId, which labels its metrics.
+func NewRequestCache[T any](donId string, timeout time.Duration, maxCacheSize uint32) RequestCache[T] {
+	return &requestCache[T]{donId: donId, cache: make(map[globalId]*pendingRequest[T]), timeout: timeout, maxCacheSize: maxCacheSize}
 }
 
-func (c *requestCache[T]) NewRequest(request *api.Message, callbackCh chan<- handlers.UserCallbackPayload, responseData *T) error {
+func (c *requestCache[T]) NewRequest(ctx context.Context, request *api.Message, callbackCh chan<- handlers.UserCallbackPayload, responseData *T) error {
 	if request == nil {
 		return errors.New("request is nil")
 	}
@@ -64,9 +84,12 @@ func (c *requestCache[T]) NewRequest(request *api.Message, callbackCh chan<- han
 		return errors.New("request cache is full")
 	}
 	timer := time.AfterFunc(c.timeout, func() {
-		c.deleteAndSendOnce(key, handlers.UserCallbackPayload{Msg: request, ErrMsg: "timeout", ErrCode: api.RequestTimeoutError})
+		if c.deleteAndSendOnce(key, handlers.UserCallbackPayload{Msg: request, ErrMsg: "timeout", ErrCode: api.RequestTimeoutError}) {
+			promRequestCacheTimeouts.WithLabelValues(c.donId).Inc()
+		}
 	})
-	c.cache[key] = &pendingRequest[T]{callbackCh: callbackCh, responseData: responseData, timeoutTimer: timer}
+	c.cache[key] = &pendingRequest[T]{callbackCh: callbackCh, responseData: responseData, timeoutTimer: timer, spanContext: trace.SpanContextFromContext(ctx)}
+	promRequestCacheSize.WithLabelValues(c.donId).Set(float64(len(c.cache)))
 	return nil
 }
 
@@ -75,7 +98,7 @@ func (c *requestCache[T]) NewRequest(request *api.Message, callbackCh chan<- han
 //
 //	(a) remove request from cache and send aggregated response to the user
 //	(b) update request's responseData and keep it in cache, awaiting more responses from nodes
-func (c *requestCache[T]) ProcessResponse(response *api.Message, process ResponseProcessor[T]) error {
+func (c *requestCache[T]) ProcessResponse(ctx context.Context, response *api.Message, process ResponseProcessor[T]) error {
 	if response == nil {
 		return errors.New("response is nil")
 	}
@@ -87,6 +110,9 @@ func (c *requestCache[T]) ProcessResponse(response *api.Message, process Respons
 	if !ok {
 		return errors.New("request not found")
 	}
+	if entry.spanContext.IsValid() {
+		trace.SpanFromContext(ctx).AddLink(trace.Link{SpanContext: entry.spanContext})
+	}
 	// process under per-entry lock
 	entry.mu.Lock()
 	aggregated, newResponseData, err := process(response, entry.responseData)
@@ -103,14 +129,17 @@ func (c *requestCache[T]) ProcessResponse(response *api.Message, process Respons
 	return nil
 }
 
-func (c *requestCache[T]) deleteAndSendOnce(key globalId, callbackResponse handlers.UserCallbackPayload) {
+// deleteAndSendOnce returns true if the entry was still in the cache, and so callbackResponse was sent.
+func (c *requestCache[T]) deleteAndSendOnce(key globalId, callbackResponse handlers.UserCallbackPayload) bool {
 	c.mu.Lock()
 	entry, deleted := c.cache[key]
 	delete(c.cache, key)
+	promRequestCacheSize.WithLabelValues(c.donId).Set(float64(len(c.cache)))
 	c.mu.Unlock()
 	if deleted {
 		entry.timeoutTimer.Stop()
 		entry.callbackCh <- callbackResponse
 		close(entry.callbackCh)
 	}
+	return deleted
 }
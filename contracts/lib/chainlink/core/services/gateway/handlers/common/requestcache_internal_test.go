@@ -0,0 +1,59 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+)
+
+func TestRequestCache_Metrics(t *testing.T) {
+	t.Parallel()
+
+	cache := NewRequestCache[struct{}]("metrics_don", time.Millisecond*10, 1000)
+	callbackCh := make(chan handlers.UserCallbackPayload, 1)
+
+	req := &api.Message{Body: api.MessageBody{MessageId: "aa", Sender: "0x1234"}}
+	require.NoError(t, cache.NewRequest(testutils.Context(t), req, callbackCh, &struct{}{}))
+	require.Equal(t, float64(1), testutil.ToFloat64(promRequestCacheSize.WithLabelValues("metrics_don")))
+
+	finalResp := <-callbackCh
+	require.Equal(t, api.RequestTimeoutError, finalResp.ErrCode)
+	require.Equal(t, float64(0), testutil.ToFloat64(promRequestCacheSize.WithLabelValues("metrics_don")))
+	require.Eventually(t, func() bool {
+		return testutil.ToFloat64(promRequestCacheTimeouts.WithLabelValues("metrics_don")) == 1
+	}, time.Second, time.Millisecond)
+}
+
+type linkRecorder struct {
+	noop.Span
+	links []trace.Link
+}
+
+func (s *linkRecorder) AddLink(link trace.Link) { s.links = append(s.links, link) }
+
+func TestRequestCache_LinksResponseSpans(t *testing.T) {
+	t.Parallel()
+
+	cache := NewRequestCache[struct{}]("test_don", time.Hour, 1000)
+	callbackCh := make(chan handlers.UserCallbackPayload, 1)
+
+	requestSpan := trace.NewSpanContext(trace.SpanContextConfig{TraceID: trace.TraceID{1}, SpanID: trace.SpanID{2}})
+	req := &api.Message{Body: api.MessageBody{MessageId: "aa", Sender: "0x1234"}}
+	require.NoError(t, cache.NewRequest(trace.ContextWithSpanContext(testutils.Context(t), requestSpan), req, callbackCh, &struct{}{}))
+
+	nodeSpan := &linkRecorder{}
+	resp := &api.Message{Body: api.MessageBody{MessageId: "aa", Receiver: "0x1234"}}
+	require.NoError(t, cache.ProcessResponse(trace.ContextWithSpan(testutils.Context(t), nodeSpan), resp, func(response *api.Message, _ *struct{}) (*handlers.UserCallbackPayload, *struct{}, error) {
+		return &handlers.UserCallbackPayload{Msg: response}, nil, nil
+	}))
+	<-callbackCh
+	require.Equal(t, []trace.Link{{SpanContext: requestSpan}}, nodeSpan.links)
+}
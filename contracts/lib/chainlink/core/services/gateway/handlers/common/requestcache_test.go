@@ -8,6 +8,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/common"
@@ -20,16 +21,16 @@ type requestState struct {
 func TestRequestCache_Simple(t *testing.T) {
 	t.Parallel()
 
-	cache := common.NewRequestCache[requestState](time.Hour, 1000)
+	cache := common.NewRequestCache[requestState]("test_don", time.Hour, 1000)
 	callbackCh := make(chan handlers.UserCallbackPayload)
 
 	req := &api.Message{Body: api.MessageBody{MessageId: "aa", Sender: "0x1234"}}
 	initialState := &requestState{}
-	require.NoError(t, cache.NewRequest(req, callbackCh, initialState))
+	require.NoError(t, cache.NewRequest(testutils.Context(t), req, callbackCh, initialState))
 
 	nodeResp := &api.Message{Body: api.MessageBody{MessageId: "aa", Receiver: "0x1234"}}
 	go func() {
-		require.NoError(t, cache.ProcessResponse(nodeResp, func(response *api.Message, responseData *requestState) (aggregated *handlers.UserCallbackPayload, newResponseData *requestState, err error) {
+		require.NoError(t, cache.ProcessResponse(testutils.Context(t), nodeResp, func(response *api.Message, responseData *requestState) (aggregated *handlers.UserCallbackPayload, newResponseData *requestState, err error) {
 			// ready after first response
 			return &handlers.UserCallbackPayload{Msg: response}, nil, nil
 		}))
@@ -45,14 +46,14 @@ func TestRequestCache_MultiResponse(t *testing.T) {
 	nResponsesPerRequest := 100
 	maxDelayMillis := 100
 
-	cache := common.NewRequestCache[requestState](time.Hour, 1000)
+	cache := common.NewRequestCache[requestState]("test_don", time.Hour, 1000)
 	chans := make([]chan handlers.UserCallbackPayload, nRequests)
 	reqs := make([]*api.Message, nRequests)
 	for i := 0; i < nRequests; i++ {
 		chans[i] = make(chan handlers.UserCallbackPayload)
 		reqs[i] = &api.Message{Body: api.MessageBody{MessageId: "abcd", Sender: fmt.Sprintf("sender_%d", i)}}
 		initialState := &requestState{counter: 0}
-		require.NoError(t, cache.NewRequest(reqs[i], chans[i], initialState))
+		require.NoError(t, cache.NewRequest(testutils.Context(t), reqs[i], chans[i], initialState))
 	}
 
 	for i := 0; i < nRequests; i++ {
@@ -62,7 +63,7 @@ func TestRequestCache_MultiResponse(t *testing.T) {
 			go func() {
 				n := rand.Intn(maxDelayMillis) + 1
 				time.Sleep(time.Duration(n) * time.Millisecond)
-				require.NoError(t, cache.ProcessResponse(resp, func(response *api.Message, responseData *requestState) (aggregated *handlers.UserCallbackPayload, newResponseData *requestState, err error) {
+				require.NoError(t, cache.ProcessResponse(testutils.Context(t), resp, func(response *api.Message, responseData *requestState) (aggregated *handlers.UserCallbackPayload, newResponseData *requestState, err error) {
 					responseData.counter++
 					if responseData.counter == nResponsesPerRequest {
 						return &handlers.UserCallbackPayload{Msg: response}, nil, nil
@@ -83,12 +84,12 @@ func TestRequestCache_MultiResponse(t *testing.T) {
 func TestRequestCache_Timeout(t *testing.T) {
 	t.Parallel()
 
-	cache := common.NewRequestCache[requestState](time.Millisecond*10, 1000)
+	cache := common.NewRequestCache[requestState]("test_don", time.Millisecond*10, 1000)
 	callbackCh := make(chan handlers.UserCallbackPayload)
 
 	req := &api.Message{Body: api.MessageBody{MessageId: "aa", Sender: "0x1234"}}
 	initialState := &requestState{}
-	require.NoError(t, cache.NewRequest(req, callbackCh, initialState))
+	require.NoError(t, cache.NewRequest(testutils.Context(t), req, callbackCh, initialState))
 
 	finalResp := <-callbackCh
 	require.Equal(t, "aa", finalResp.Msg.Body.MessageId)
@@ -98,16 +99,16 @@ func TestRequestCache_Timeout(t *testing.T) {
 func TestRequestCache_MaxSize(t *testing.T) {
 	t.Parallel()
 
-	cache := common.NewRequestCache[requestState](time.Hour, 2)
+	cache := common.NewRequestCache[requestState]("test_don", time.Hour, 2)
 	callbackCh := make(chan handlers.UserCallbackPayload)
 	initialState := &requestState{}
 
 	req := &api.Message{Body: api.MessageBody{MessageId: "aa", Sender: "0x1234"}}
-	require.NoError(t, cache.NewRequest(req, callbackCh, initialState))
+	require.NoError(t, cache.NewRequest(testutils.Context(t), req, callbackCh, initialState))
 
 	req.Body.MessageId = "bb"
-	require.NoError(t, cache.NewRequest(req, callbackCh, initialState))
+	require.NoError(t, cache.NewRequest(testutils.Context(t), req, callbackCh, initialState))
 
 	req.Body.MessageId = "cc"
-	require.Error(t, cache.NewRequest(req, callbackCh, initialState))
+	require.Error(t, cache.NewRequest(testutils.Context(t), req, callbackCh, initialState))
 }
@@ -156,7 +156,7 @@ func NewFunctionsHandlerFromConfig(handlerConfig json.RawMessage, donConfig *con
 	for _, initiator := range cfg.AllowedHeartbeatInitiators {
 		allowedHeartbeatInitiators[strings.ToLower(initiator)] = struct{}{}
 	}
-	pendingRequestsCache := hc.NewRequestCache[PendingRequest](time.Millisecond*time.Duration(cfg.RequestTimeoutMillis), cfg.MaxPendingRequests)
+	pendingRequestsCache := hc.NewRequestCache[PendingRequest](donConfig.DonId, time.Millisecond*time.Duration(cfg.RequestTimeoutMillis), cfg.MaxPendingRequests)
 	return NewFunctionsHandler(cfg, donConfig, don, pendingRequestsCache, allowlist, subscriptions, cfg.MinimumSubscriptionBalance, userRateLimiter, nodeRateLimiter, allowedHeartbeatInitiators, lggr), nil
 }
 
@@ -232,14 +232,15 @@ func (h *functionsHandler) HandleUserMessage(ctx context.Context, msg *api.Messa
 
 func (h *functionsHandler) handleRequest(ctx context.Context, msg *api.Message, callbackCh chan<- handlers.UserCallbackPayload) error {
 	h.lggr.Debugw("handleRequest: processing message", "sender", msg.Body.Sender, "messageId", msg.Body.MessageId)
-	err := h.pendingRequests.NewRequest(msg, callbackCh, &PendingRequest{request: msg, responses: make(map[string]*api.Message)})
+	err := h.pendingRequests.NewRequest(ctx, msg, callbackCh, &PendingRequest{request: msg, responses: make(map[string]*api.Message)})
 	if err != nil {
 		h.lggr.Warnw("handleRequest: error adding new request", "sender", msg.Body.Sender, "err", err)
 		promHandlerError.WithLabelValues(h.donConfig.DonId, err.Error()).Inc()
 		return err
 	}
 	// Send to all nodes.
-	for _, member := range h.donConfig.Members {
+	members, _ := handlers.Membership(h.don, h.donConfig)
+	for _, member := range members {
 		err := h.don.SendToNode(ctx, member.Address, msg)
 		if err != nil {
 			h.lggr.Debugw("handleRequest: failed to send to a node", "node", member.Address, "err", err)
@@ -256,9 +257,9 @@ func (h *functionsHandler) HandleNodeMessage(ctx context.Context, msg *api.Messa
 	}
 	switch msg.Body.Method {
 	case MethodSecretsSet, MethodSecretsList:
-		return h.pendingRequests.ProcessResponse(msg, h.processSecretsResponse)
+		return h.pendingRequests.ProcessResponse(ctx, msg, h.processSecretsResponse)
 	case MethodHeartbeat:
-		return h.pendingRequests.ProcessResponse(msg, h.processHeartbeatResponse)
+		return h.pendingRequests.ProcessResponse(ctx, msg, h.processHeartbeatResponse)
 	default:
 		h.lggr.Debugw("unsupported method", "method", msg.Body.Method)
 		return ErrUnsupportedMethod
@@ -281,16 +282,17 @@ func (h *functionsHandler) processSecretsResponse(response *api.Message, respons
 		return nil, responseData, err
 	}
 	// user response is ready with either F+1 successes or N-F failures
+	members, f := handlers.Membership(h.don, h.donConfig)
 	if responsePayload.Success {
 		responseData.successful = append(responseData.successful, response)
-		if len(responseData.successful) >= h.donConfig.F+1 {
+		if len(responseData.successful) >= f+1 {
 			// return success to the user
 			callbackPayload, err := newSecretsResponse(responseData.request, true, responseData.successful)
 			return callbackPayload, responseData, err
 		}
 	} else {
 		responseData.errors = append(responseData.errors, response)
-		if len(responseData.errors) >= len(h.donConfig.Members)-h.donConfig.F {
+		if len(responseData.errors) >= len(members)-f {
 			// return error to the user
 			callbackPayload, err := newSecretsResponse(responseData.request, false, responseData.errors)
 			return callbackPayload, responseData, err
@@ -338,7 +340,7 @@ func (h *functionsHandler) processHeartbeatResponse(response *api.Message, respo
 	responseData.responses[response.Body.Sender] = response
 
 	// user response is ready with F+1 node responses
-	if len(responseData.responses) >= h.donConfig.F+1 {
+	if _, f := handlers.Membership(h.don, h.donConfig); len(responseData.responses) >= f+1 {
 		var responseList []*api.Message
 		for _, response := range responseData.responses {
 			responseList = append(responseList, response)
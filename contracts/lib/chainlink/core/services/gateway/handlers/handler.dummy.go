@@ -44,7 +44,8 @@ func (d *dummyHandler) HandleUserMessage(ctx context.Context, msg *api.Message,
 
 	var err error
 	// Send to all nodes.
-	for _, member := range d.donConfig.Members {
+	members, _ := Membership(don, d.donConfig)
+	for _, member := range members {
 		err = multierr.Combine(err, don.SendToNode(ctx, member.Address, msg))
 	}
 	return err
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 )
 
@@ -41,3 +42,18 @@ type DON interface {
 	// Thread-safe
 	SendToNode(ctx context.Context, nodeAddress string, msg *api.Message) error
 }
+
+// MembershipProvider is implemented by DONs whose members can change while the Gateway is running.
+type MembershipProvider interface {
+	// Thread-safe
+	Membership() (members []config.NodeConfig, f int)
+}
+
+// Membership returns the current members and F of don. The static membership from donConfig is returned for DONs
+// not implementing MembershipProvider.
+func Membership(don DON, donConfig *config.DONConfig) ([]config.NodeConfig, int) {
+	if provider, ok := don.(MembershipProvider); ok {
+		return provider.Membership()
+	}
+	return donConfig.Members, donConfig.F
+}
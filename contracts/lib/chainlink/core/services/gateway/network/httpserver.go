@@ -24,9 +24,23 @@ type HttpServer interface {
 }
 
 type HTTPRequestHandler interface {
+	// ProcessRequest is called with a context carrying the headers of the request, see RequestHeader.
 	ProcessRequest(ctx context.Context, rawRequest []byte) (rawResponse []byte, httpStatusCode int)
 }
 
+type requestHeaderKey struct{}
+
+// WithRequestHeader returns a copy of ctx carrying the headers of an HTTP request.
+func WithRequestHeader(ctx context.Context, header http.Header) context.Context {
+	return context.WithValue(ctx, requestHeaderKey{}, header)
+}
+
+// RequestHeader returns the headers of the HTTP request being processed with ctx, or nil.
+func RequestHeader(ctx context.Context) http.Header {
+	header, _ := ctx.Value(requestHeaderKey{}).(http.Header)
+	return header
+}
+
 type HTTPServerConfig struct {
 	Host                 string
 	Port                 uint16
@@ -96,7 +110,7 @@ func (s *httpServer) handleRequest(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	requestCtx := r.Context()
+	requestCtx := WithRequestHeader(r.Context(), r.Header)
 	if s.config.RequestTimeoutMillis > 0 {
 		var cancel context.CancelFunc
 		requestCtx, cancel = context.WithTimeout(requestCtx, time.Duration(s.config.RequestTimeoutMillis)*time.Millisecond)
@@ -2,6 +2,7 @@ package network_test
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -60,7 +61,8 @@ func TestHTTPServer_HandleRequest_Correct(t *testing.T) {
 	server, handler, url := startNewServer(t, 100_000, 100_000)
 	defer server.Close()
 
-	handler.On("ProcessRequest", mock.Anything, mock.Anything).Return([]byte("response"), 200)
+	hasHeader := mock.MatchedBy(func(ctx context.Context) bool { return network.RequestHeader(ctx).Get("User-Agent") != "" })
+	handler.On("ProcessRequest", hasHeader, mock.Anything).Return([]byte("response"), 200)
 
 	resp := sendRequest(t, url, []byte("0123456789"))
 	respBytes, err := io.ReadAll(resp.Body)
@@ -1727,6 +1727,53 @@ func (_c *ORM_TryRecordError_Call) RunAndReturn(run func(context.Context, int32,
 	return _c
 }
 
+// UpdateJobSpec provides a mock function with given fields: ctx, jb
+func (_m *ORM) UpdateJobSpec(ctx context.Context, jb *job.Job) error {
+	ret := _m.Called(ctx, jb)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateJobSpec")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *job.Job) error); ok {
+		r0 = rf(ctx, jb)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_UpdateJobSpec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateJobSpec'
+type ORM_UpdateJobSpec_Call struct {
+	*mock.Call
+}
+
+// UpdateJobSpec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jb *job.Job
+func (_e *ORM_Expecter) UpdateJobSpec(ctx interface{}, jb interface{}) *ORM_UpdateJobSpec_Call {
+	return &ORM_UpdateJobSpec_Call{Call: _e.mock.On("UpdateJobSpec", ctx, jb)}
+}
+
+func (_c *ORM_UpdateJobSpec_Call) Run(run func(ctx context.Context, jb *job.Job)) *ORM_UpdateJobSpec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*job.Job))
+	})
+	return _c
+}
+
+func (_c *ORM_UpdateJobSpec_Call) Return(_a0 error) *ORM_UpdateJobSpec_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_UpdateJobSpec_Call) RunAndReturn(run func(context.Context, *job.Job) error) *ORM_UpdateJobSpec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithDataSource provides a mock function with given fields: source
 func (_m *ORM) WithDataSource(source sqlutil.DataSource) job.ORM {
 	ret := _m.Called(source)
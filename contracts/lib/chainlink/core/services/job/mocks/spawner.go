@@ -442,6 +442,64 @@ func (_c *Spawner_StartService_Call) RunAndReturn(run func(context.Context, job.
 	return _c
 }
 
+// UpdateJob provides a mock function with given fields: ctx, ds, jb
+func (_m *Spawner) UpdateJob(ctx context.Context, ds sqlutil.DataSource, jb *job.Job) (bool, error) {
+	ret := _m.Called(ctx, ds, jb)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateJob")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlutil.DataSource, *job.Job) (bool, error)); ok {
+		return rf(ctx, ds, jb)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlutil.DataSource, *job.Job) bool); ok {
+		r0 = rf(ctx, ds, jb)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlutil.DataSource, *job.Job) error); ok {
+		r1 = rf(ctx, ds, jb)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Spawner_UpdateJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateJob'
+type Spawner_UpdateJob_Call struct {
+	*mock.Call
+}
+
+// UpdateJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ds sqlutil.DataSource
+//   - jb *job.Job
+func (_e *Spawner_Expecter) UpdateJob(ctx interface{}, ds interface{}, jb interface{}) *Spawner_UpdateJob_Call {
+	return &Spawner_UpdateJob_Call{Call: _e.mock.On("UpdateJob", ctx, ds, jb)}
+}
+
+func (_c *Spawner_UpdateJob_Call) Run(run func(ctx context.Context, ds sqlutil.DataSource, jb *job.Job)) *Spawner_UpdateJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlutil.DataSource), args[2].(*job.Job))
+	})
+	return _c
+}
+
+func (_c *Spawner_UpdateJob_Call) Return(_a0 bool, _a1 error) *Spawner_UpdateJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Spawner_UpdateJob_Call) RunAndReturn(run func(context.Context, sqlutil.DataSource, *job.Job) (bool, error)) *Spawner_UpdateJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewSpawner creates a new instance of Spawner. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewSpawner(t interface {
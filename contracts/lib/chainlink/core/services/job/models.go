@@ -193,6 +193,7 @@ type Job struct {
 	MaxTaskDuration               models.Interval
 	Pipeline                      pipeline.Pipeline `toml:"observationSource"`
 	CreatedAt                     time.Time
+	pipeline.RunRetention
 }
 
 func ExternalJobIDEncodeStringToTopic(id uuid.UUID) common.Hash {
@@ -470,8 +471,14 @@ type ExternalInitiatorWebhookSpec struct {
 type WebhookSpec struct {
 	ID                            int32 `toml:"-"`
 	ExternalInitiatorWebhookSpecs []ExternalInitiatorWebhookSpec
-	CreatedAt                     time.Time `json:"createdAt" toml:"-"`
-	UpdatedAt                     time.Time `json:"updatedAt" toml:"-"`
+	// IdempotencyWindow is how long the run started for an Idempotency-Key is returned for repeated requests with
+	// the same key. Zero uses the default window.
+	IdempotencyWindow models.Interval `toml:"idempotencyWindow"`
+	// IdempotencyKeyLease is how long an Idempotency-Key stays reserved for its run between renewals, which happen
+	// while the run is in progress. Zero uses the default lease.
+	IdempotencyKeyLease models.Interval `toml:"idempotencyKeyLease"`
+	CreatedAt           time.Time       `json:"createdAt" toml:"-"`
+	UpdatedAt           time.Time       `json:"updatedAt" toml:"-"`
 }
 
 func (w WebhookSpec) GetID() string {
@@ -498,12 +505,42 @@ type DirectRequestSpec struct {
 	UpdatedAt                time.Time                `toml:"-"`
 }
 
+// MissedRunPolicy decides which of the runs a cron job missed while it was not running are caught up on start.
+type MissedRunPolicy string
+
+const (
+	// MissedRunSkip drops all missed runs. It is the default.
+	MissedRunSkip MissedRunPolicy = "skip"
+	// MissedRunOnce runs once if any run was missed.
+	MissedRunOnce MissedRunPolicy = "runOnce"
+	// MissedRunAll runs every missed run, up to CronSpec.MaxMissedRuns of the most recent ones.
+	MissedRunAll MissedRunPolicy = "runAll"
+)
+
+// ConcurrencyPolicy decides what a cron job does when it is due while a previous run is still in progress.
+type ConcurrencyPolicy string
+
+const (
+	// ConcurrencyAllow starts a new run alongside the previous one. It is the default.
+	ConcurrencyAllow ConcurrencyPolicy = "allow"
+	// ConcurrencyForbid skips the new run.
+	ConcurrencyForbid ConcurrencyPolicy = "forbid"
+	// ConcurrencyReplace cancels the previous run and starts the new one.
+	ConcurrencyReplace ConcurrencyPolicy = "replace"
+)
+
 type CronSpec struct {
-	ID           int32     `toml:"-"`
-	CronSchedule string    `toml:"schedule"`
-	EVMChainID   *big.Big  `toml:"evmChainID"`
-	CreatedAt    time.Time `toml:"-"`
-	UpdatedAt    time.Time `toml:"-"`
+	ID                int32             `toml:"-"`
+	CronSchedule      string            `toml:"schedule"`
+	EVMChainID        *big.Big          `toml:"evmChainID"`
+	TimeZone          string            `toml:"timeZone"`
+	MissedRunPolicy   MissedRunPolicy   `toml:"missedRunPolicy"`
+	MaxMissedRuns     uint32            `toml:"maxMissedRuns"`
+	ConcurrencyPolicy ConcurrencyPolicy `toml:"concurrencyPolicy"`
+	Jitter            models.Interval   `toml:"jitter"`
+	LastFiredAt       *time.Time        `toml:"-"`
+	CreatedAt         time.Time         `toml:"-"`
+	UpdatedAt         time.Time         `toml:"-"`
 }
 
 func (s CronSpec) GetID() string {
@@ -53,6 +53,9 @@ type ORM interface {
 	FindOCR2JobIDByAddress(ctx context.Context, contractID string, feedID *common.Hash) (int32, error)
 	FindJobIDsWithBridge(ctx context.Context, name string) ([]int32, error)
 	DeleteJob(ctx context.Context, id int32, jobType Type) error
+	// UpdateJobSpec replaces the type specific spec of an existing job. Only the job types whose services can apply a
+	// new spec while running, see UpdatableDelegate, are supported.
+	UpdateJobSpec(ctx context.Context, jb *Job) error
 	RecordError(ctx context.Context, jobID int32, description string) error
 	// TryRecordError is a helper which calls RecordError and logs the returned error if present.
 	TryRecordError(ctx context.Context, jobID int32, description string)
@@ -589,8 +592,10 @@ func (o *orm) insertKeeperSpec(ctx context.Context, spec *KeeperSpec) (specID in
 }
 
 func (o *orm) insertCronSpec(ctx context.Context, spec *CronSpec) (specID int32, err error) {
-	return o.prepareQuerySpecID(ctx, `INSERT INTO cron_specs (cron_schedule, evm_chain_id, created_at, updated_at)
-			VALUES (:cron_schedule, :evm_chain_id, NOW(), NOW())
+	return o.prepareQuerySpecID(ctx, `INSERT INTO cron_specs (cron_schedule, evm_chain_id, time_zone, missed_run_policy, max_missed_runs,
+				concurrency_policy, jitter, created_at, updated_at)
+			VALUES (:cron_schedule, :evm_chain_id, :time_zone, :missed_run_policy, :max_missed_runs,
+				:concurrency_policy, :jitter, NOW(), NOW())
 			RETURNING id;`, spec)
 }
 
@@ -721,8 +726,8 @@ func areSendingKeysDefined(ctx context.Context, jb *Job, keystore keystore.Maste
 }
 
 func (o *orm) InsertWebhookSpec(ctx context.Context, webhookSpec *WebhookSpec) error {
-	query, args, err := o.ds.BindNamed(`INSERT INTO webhook_specs (created_at, updated_at)
-			VALUES (NOW(), NOW())
+	query, args, err := o.ds.BindNamed(`INSERT INTO webhook_specs (idempotency_window, idempotency_key_lease, created_at, updated_at)
+			VALUES (:idempotency_window, :idempotency_key_lease, NOW(), NOW())
 			RETURNING *;`, webhookSpec)
 	if err != nil {
 		return fmt.Errorf("error binding arg: %w", err)
@@ -738,18 +743,22 @@ func (o *orm) InsertJob(ctx context.Context, job *Job) error {
 		if job.ID == 0 {
 			query = `INSERT INTO jobs (name, stream_id, schema_version, type, max_task_duration, ocr_oracle_spec_id, ocr2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
 				keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, bootstrap_spec_id, block_header_feeder_spec_id, gateway_spec_id,
-                legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, ccip_spec_id, external_job_id, gas_limit, forwarding_allowed, created_at)
+                legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, ccip_spec_id, external_job_id, gas_limit, forwarding_allowed,
+				retention_max_runs, retention_max_age, retention_keep_errored_only, created_at)
 		VALUES (:name, :stream_id, :schema_version, :type, :max_task_duration, :ocr_oracle_spec_id, :ocr2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
 				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :bootstrap_spec_id, :block_header_feeder_spec_id, :gateway_spec_id,
-				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :ccip_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, NOW())
+				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :ccip_spec_id, :external_job_id, :gas_limit, :forwarding_allowed,
+				:retention_max_runs, :retention_max_age, :retention_keep_errored_only, NOW())
 		RETURNING *;`
 		} else {
 			query = `INSERT INTO jobs (id, name, stream_id, schema_version, type, max_task_duration, ocr_oracle_spec_id, ocr2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
 			keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, bootstrap_spec_id, block_header_feeder_spec_id, gateway_spec_id,
-                  legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, ccip_spec_id, external_job_id, gas_limit, forwarding_allowed, created_at)
+                  legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, ccip_spec_id, external_job_id, gas_limit, forwarding_allowed,
+				retention_max_runs, retention_max_age, retention_keep_errored_only, created_at)
 		VALUES (:id, :name, :stream_id, :schema_version, :type, :max_task_duration, :ocr_oracle_spec_id, :ocr2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
 				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :bootstrap_spec_id, :block_header_feeder_spec_id, :gateway_spec_id,
-				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :ccip_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, NOW())
+				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :ccip_spec_id, :external_job_id, :gas_limit, :forwarding_allowed,
+				:retention_max_runs, :retention_max_age, :retention_keep_errored_only, NOW())
 		RETURNING *;`
 		}
 		query, args, err := tx.ds.BindNamed(query, job)
@@ -844,6 +853,35 @@ func (o *orm) DeleteJob(ctx context.Context, id int32, jobType Type) error {
 	return nil
 }
 
+func (o *orm) UpdateJobSpec(ctx context.Context, jb *Job) error {
+	var (
+		res sql.Result
+		err error
+	)
+	switch jb.Type {
+	case Gateway:
+		if jb.GatewaySpec == nil {
+			return errors.New("gateway job has no GatewaySpec")
+		}
+		res, err = o.ds.ExecContext(ctx, `UPDATE gateway_specs SET gateway_config = $1, updated_at = NOW()
+			WHERE id = (SELECT gateway_spec_id FROM jobs WHERE id = $2)`, jb.GatewaySpec.GatewayConfig, jb.ID)
+	default:
+		return errors.Errorf("updating the spec of %s jobs is not supported", jb.Type)
+	}
+	if err != nil {
+		return errors.Wrap(err, "UpdateJobSpec failed to update spec")
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "UpdateJobSpec failed getting RowsAffected")
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	o.lggr.Debugw("Updated job spec", "jobID", jb.ID)
+	return nil
+}
+
 func (o *orm) RecordError(ctx context.Context, jobID int32, description string) error {
 	sql := `INSERT INTO job_spec_errors (job_id, description, occurrences, created_at, updated_at)
 	VALUES ($1, $2, 1, $3, $3)
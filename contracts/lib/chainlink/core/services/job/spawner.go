@@ -28,6 +28,10 @@ type (
 		CreateJob(ctx context.Context, ds sqlutil.DataSource, jb *Job) (err error)
 		// DeleteJob deletes a job and stops any active services.
 		DeleteJob(ctx context.Context, ds sqlutil.DataSource, jobID int32) error
+		// UpdateJob applies the spec of jb to the active job with its ID, without restarting its services, if its
+		// delegate supports the change. It returns false, leaving the job unchanged, when the job has to be replaced
+		// instead.
+		UpdateJob(ctx context.Context, ds sqlutil.DataSource, jb *Job) (updated bool, err error)
 		// ActiveJobs returns a map of jobs with active services (started without error).
 		ActiveJobs() map[int32]Job
 
@@ -76,6 +80,17 @@ type (
 		OnDeleteJob(ctx context.Context, jb Job) error
 	}
 
+	// UpdatableDelegate is implemented by Delegates whose services can apply some spec changes while running.
+	UpdatableDelegate interface {
+		Delegate
+		// CanUpdateServices returns true if the services of the active job old can apply the spec of jb. The spec of
+		// jb must be validated here, as it is stored before UpdateServices is called.
+		CanUpdateServices(old, jb Job) bool
+		// UpdateServices applies the spec of jb to the services of the active job old. It is called once the spec is
+		// stored.
+		UpdateServices(ctx context.Context, old, jb Job) error
+	}
+
 	activeJob struct {
 		delegate Delegate
 		spec     Job
@@ -286,6 +301,42 @@ func (js *spawner) CreateJob(ctx context.Context, ds sqlutil.DataSource, jb *Job
 	return err
 }
 
+func (js *spawner) UpdateJob(ctx context.Context, ds sqlutil.DataSource, jb *Job) (bool, error) {
+	js.activeJobsMu.Lock()
+	defer js.activeJobsMu.Unlock()
+
+	aj, exists := js.activeJobs[jb.ID]
+	if !exists || aj.spec.Type != jb.Type || len(aj.services) == 0 {
+		return false, nil
+	}
+	delegate, ok := aj.delegate.(UpdatableDelegate)
+	if !ok || !delegate.CanUpdateServices(aj.spec, *jb) {
+		return false, nil
+	}
+	if ds == nil {
+		ds = js.orm.DataSource()
+	}
+
+	if err := js.orm.WithDataSource(ds).UpdateJobSpec(ctx, jb); err != nil {
+		return false, pkgerrors.Wrapf(err, "failed to update job %d", jb.ID)
+	}
+	updated, err := js.orm.WithDataSource(ds).FindJob(ctx, jb.ID)
+	if err != nil {
+		return true, pkgerrors.Wrapf(err, "failed to load updated job %d", jb.ID)
+	}
+	old := aj.spec
+	aj.spec = updated
+	js.activeJobs[jb.ID] = aj
+	*jb = updated
+
+	// the services only apply the spec once it is stored, so they never run a spec which was rolled back
+	if err = delegate.UpdateServices(ctx, old, updated); err != nil {
+		return true, pkgerrors.Wrapf(err, "job %d was updated, but its services failed to apply it", jb.ID)
+	}
+	js.lggr.Infow("Updated job services", "type", jb.Type, "jobID", jb.ID)
+	return true, nil
+}
+
 // Should not get called before Start()
 func (js *spawner) DeleteJob(ctx context.Context, ds sqlutil.DataSource, jobID int32) error {
 	if ds == nil {
@@ -2,6 +2,8 @@ package job_test
 
 import (
 	"context"
+	"errors"
+	"maps"
 	"testing"
 	"time"
 
@@ -29,12 +31,14 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	evmrelayer "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
+	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/v2/plugins"
 )
 
@@ -345,6 +349,94 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 	})
 }
 
+type updatableDelegate struct {
+	delegate
+	canUpdate bool
+	err       error
+	updates   []job.Job
+}
+
+func (d *updatableDelegate) CanUpdateServices(old, jb job.Job) bool {
+	return d.canUpdate
+}
+
+func (d *updatableDelegate) UpdateServices(ctx context.Context, old, jb job.Job) error {
+	d.updates = append(d.updates, jb)
+	return d.err
+}
+
+func TestSpawner_UpdateJob(t *testing.T) {
+	t.Parallel()
+
+	config := configtest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db)
+	lggr := logger.TestLogger(t)
+	orm := NewTestORM(t, db, pipeline.NewORM(db, lggr, config.JobPipeline().MaxSuccessfulRuns()), bridges.NewORM(db), keyStore)
+
+	service := mocks.NewServiceCtx(t)
+	service.On("Start", mock.Anything).Return(nil).Once()
+	service.On("Close").Return(nil).Once()
+	d := &updatableDelegate{delegate: delegate{job.Gateway, []job.ServiceCtx{service}, 0, nil, &job.NullDelegate{Type: job.Gateway}}}
+	spawner := job.NewSpawner(orm, config.Database(), noopChecker{}, map[job.Type]job.Delegate{job.Gateway: d}, lggr, nil)
+	servicetest.Run(t, spawner)
+
+	ctx := testutils.Context(t)
+	jb, err := gateway.ValidatedGatewaySpec(testspecs.GetGatewaySpec())
+	require.NoError(t, err)
+	require.NoError(t, spawner.CreateJob(ctx, nil, &jb))
+
+	newSpec := func(gatewayID string) job.Job {
+		update := jb
+		update.GatewaySpec = &job.GatewaySpec{GatewayConfig: maps.Clone(jb.GatewaySpec.GatewayConfig)}
+		update.GatewaySpec.GatewayConfig["ConnectionManagerConfig"] = map[string]any{"AuthGatewayId": gatewayID}
+		return update
+	}
+
+	gatewayID := func(jb job.Job) any {
+		return jb.GatewaySpec.GatewayConfig["ConnectionManagerConfig"].(map[string]any)["AuthGatewayId"]
+	}
+
+	t.Run("leaves jobs the delegate cannot update", func(t *testing.T) {
+		update := newSpec("not updated")
+		updated, err := spawner.UpdateJob(ctx, nil, &update)
+		require.NoError(t, err)
+		assert.False(t, updated)
+		assert.Empty(t, d.updates)
+
+		stored, err := orm.FindJob(ctx, jb.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "gateway", gatewayID(stored))
+	})
+
+	t.Run("applies the stored spec to the running services", func(t *testing.T) {
+		d.canUpdate = true
+		update := newSpec("updated")
+		updated, err := spawner.UpdateJob(ctx, nil, &update)
+		require.NoError(t, err)
+		assert.True(t, updated)
+
+		stored, err := orm.FindJob(ctx, jb.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "updated", gatewayID(stored))
+		require.Len(t, d.updates, 1)
+		assert.Equal(t, stored.GatewaySpec.GatewayConfig, d.updates[0].GatewaySpec.GatewayConfig)
+		assert.Equal(t, stored.GatewaySpec.GatewayConfig, spawner.ActiveJobs()[jb.ID].GatewaySpec.GatewayConfig)
+	})
+
+	t.Run("keeps the stored spec when the services fail to apply it", func(t *testing.T) {
+		d.err = errors.New("boom")
+		update := newSpec("failed")
+		updated, err := spawner.UpdateJob(ctx, nil, &update)
+		require.ErrorContains(t, err, "boom")
+		assert.True(t, updated)
+
+		stored, err := orm.FindJob(ctx, jb.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "failed", gatewayID(stored))
+	})
+}
+
 type noopChecker struct{}
 
 func (n noopChecker) Register(service services.HealthReporter) error { return nil }
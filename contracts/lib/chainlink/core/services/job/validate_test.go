@@ -90,6 +90,36 @@ ds [type=bridge async=true]
 				require.Error(t, err)
 			},
 		},
+		{
+			name: "contradictory run retention",
+			spec: `
+type="webhook"
+schemaVersion=1
+retentionMaxRuns=10
+retentionKeepErroredOnly=true
+observationSource="""
+ds [type=http]
+"""
+`,
+			assertion: func(t *testing.T, err error) {
+				require.EqualError(t, err, "retentionMaxRuns cannot be combined with retentionKeepErroredOnly")
+			},
+		},
+		{
+			name: "run retention",
+			spec: `
+type="webhook"
+schemaVersion=1
+retentionMaxRuns=10
+retentionMaxAge="24h"
+observationSource="""
+ds [type=http]
+"""
+`,
+			assertion: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
 		{
 			name: "happy path",
 			spec: `
@@ -0,0 +1,262 @@
+package keystore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	gethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+)
+
+// KeyBundleVersion is the version of the key bundle format written by ExportAll.
+const KeyBundleVersion = 1
+
+var (
+	// ErrKeyBundleConflict is returned by ImportAll when keys in the bundle conflict with keys in the keystore.
+	ErrKeyBundleConflict = errors.New("key bundle conflicts with existing keys")
+	// ErrInvalidKeyBundle is returned by ImportAll when the bundle cannot be read, or decrypted with the password.
+	ErrInvalidKeyBundle = errors.New("invalid key bundle")
+)
+
+// singleKeyTypes are the key types a node has a single key of, which is created when the node starts.
+var singleKeyTypes = map[string]bool{"CSA": true, "Workflow": true}
+
+// KeyBundleEntry describes a key in a key bundle.
+type KeyBundleEntry struct {
+	// Type is the key type, e.g. Eth or OCR2.
+	Type string
+	ID   string
+	// Conflict is set if the key already exists in the keystore, or cannot be added next to an existing key.
+	Conflict bool
+	// Exists is set if the key is of a single key type, and is already in the keystore. It is not imported again.
+	Exists bool
+	// Replaces is the ID of the existing key of a single key type, which the key replaces.
+	Replaces string
+}
+
+// encryptedKeyBundle is the exported form of every key in the keystore.
+type encryptedKeyBundle struct {
+	Version   int                     `json:"version"`
+	CreatedAt time.Time               `json:"createdAt"`
+	Crypto    gethkeystore.CryptoJSON `json:"crypto"`
+}
+
+// keyBundle is the encrypted content of an encryptedKeyBundle.
+type keyBundle struct {
+	Keys         rawKeyRing
+	EthKeyStates []keyBundleEthKeyState
+}
+
+type keyBundleEthKeyState struct {
+	Address    common.Address
+	EVMChainID string
+	Disabled   bool
+}
+
+// ExportAll exports every key in the keystore, along with the chains eth keys are enabled for, into a single bundle
+// encrypted with password.
+func (ks *master) ExportAll(ctx context.Context, password string) ([]byte, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	bundle := keyBundle{Keys: ks.keyRing.raw()}
+	for _, state := range ks.keyStates.All {
+		if _, found := ks.keyRing.Eth[state.KeyID()]; !found {
+			continue
+		}
+		bundle.EthKeyStates = append(bundle.EthKeyStates, keyBundleEthKeyState{
+			Address:    state.Address.Address(),
+			EVMChainID: state.EVMChainID.String(),
+			Disabled:   state.Disabled,
+		})
+	}
+	plaintext, err := json.Marshal(bundle)
+	if err != nil {
+		return nil, err
+	}
+	cryptoJSON, err := gethkeystore.EncryptDataV3(plaintext, []byte(adulteratedBundlePassword(password)), ks.scryptParams.N, ks.scryptParams.P)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encrypt key bundle")
+	}
+	return json.Marshal(encryptedKeyBundle{
+		Version:   KeyBundleVersion,
+		CreatedAt: time.Now().UTC(),
+		Crypto:    cryptoJSON,
+	})
+}
+
+// ImportAll decrypts a bundle created by ExportAll with password, and returns its keys. Unless dryRun is set, the keys
+// and eth key states are added to the keystore in a single transaction. A node has a single CSA and Workflow key,
+// which it creates when it starts, so those of the bundle replace the existing ones, unless they are the same. If any
+// key conflicts, nothing is imported and ErrKeyBundleConflict is returned.
+func (ks *master) ImportAll(ctx context.Context, bundleJSON []byte, password string, dryRun bool) ([]KeyBundleEntry, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	bundle, err := decryptKeyBundle(bundleJSON, password)
+	if err != nil {
+		return nil, err
+	}
+	imported, err := bundle.Keys.keys()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidKeyBundle, err)
+	}
+
+	entries := ks.keyRing.bundleEntries(imported)
+	var conflicts int
+	for _, e := range entries {
+		if e.Conflict {
+			conflicts++
+		}
+	}
+	if dryRun {
+		return entries, nil
+	}
+	if conflicts > 0 {
+		return entries, errors.Wrapf(ErrKeyBundleConflict, "%d of %d keys conflict", conflicts, len(entries))
+	}
+
+	replaced := newKeyRing()
+	existing, added, removed := reflect.Indirect(reflect.ValueOf(ks.keyRing)), reflect.Indirect(reflect.ValueOf(imported)), reflect.Indirect(reflect.ValueOf(replaced))
+	for _, e := range entries {
+		if e.Exists {
+			added.FieldByName(e.Type).SetMapIndex(reflect.ValueOf(e.ID), reflect.Value{})
+		}
+		if e.Replaces != "" {
+			id := reflect.ValueOf(e.Replaces)
+			removed.FieldByName(e.Type).SetMapIndex(id, existing.FieldByName(e.Type).MapIndex(id))
+		}
+	}
+
+	var states []*ethkey.State
+	err = ks.replaceKeys(ctx, imported, replaced, func(tx sqlutil.DataSource) error {
+		for _, s := range bundle.EthKeyStates {
+			if _, found := imported.Eth[s.Address.Hex()]; !found {
+				return errors.Errorf("key bundle has a key state for unknown eth key %s", s.Address.Hex())
+			}
+			state := new(ethkey.State)
+			sql := `INSERT INTO evm.key_states (address, disabled, evm_chain_id, created_at, updated_at)
+			VALUES ($1, $2, $3, NOW(), NOW())
+			RETURNING *;`
+			if err := tx.GetContext(ctx, state, sql, s.Address, s.Disabled, s.EVMChainID); err != nil {
+				return errors.Wrap(err, "failed to insert key_state")
+			}
+			states = append(states, state)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to import key bundle")
+	}
+	for _, state := range states {
+		ks.keyStates.add(state)
+	}
+	if len(imported.Eth) > 0 {
+		ks.eth.notify()
+	}
+	for _, e := range entries {
+		if e.Replaces != "" {
+			ks.logger.Warnw("Replaced key with the key of the bundle, restart the node for it to be used", "type", e.Type, "replacedID", e.Replaces, "id", e.ID)
+		}
+	}
+	ks.logger.Infow("Imported key bundle", "keys", len(entries))
+	return entries, nil
+}
+
+func decryptKeyBundle(bundleJSON []byte, password string) (bundle keyBundle, err error) {
+	var export encryptedKeyBundle
+	if err = json.Unmarshal(bundleJSON, &export); err != nil {
+		return bundle, fmt.Errorf("%w: %v", ErrInvalidKeyBundle, err)
+	}
+	if export.Version != KeyBundleVersion {
+		return bundle, fmt.Errorf("%w: unsupported version %d, expected %d", ErrInvalidKeyBundle, export.Version, KeyBundleVersion)
+	}
+	plaintext, err := gethkeystore.DecryptDataV3(export.Crypto, adulteratedBundlePassword(password))
+	if err != nil {
+		return bundle, fmt.Errorf("%w: failed to decrypt it: %v", ErrInvalidKeyBundle, err)
+	}
+	if err = json.Unmarshal(plaintext, &bundle); err != nil {
+		return bundle, fmt.Errorf("%w: %v", ErrInvalidKeyBundle, err)
+	}
+	return bundle, nil
+}
+
+// caller must hold lock!
+// replaceKeys removes every key in old from the keyring, adds every key in kr, and saves it, restoring the keyring if
+// saving fails.
+func (km *keyManager) replaceKeys(ctx context.Context, kr *keyRing, old *keyRing, callbacks ...func(sqlutil.DataSource) error) error {
+	dst := reflect.Indirect(reflect.ValueOf(km.keyRing))
+	set := func(src *keyRing, remove bool) {
+		forEachKeyMap(reflect.Indirect(reflect.ValueOf(src)), func(field string, keyMap reflect.Value) {
+			for _, id := range keyMap.MapKeys() {
+				key := keyMap.MapIndex(id)
+				if remove {
+					key = reflect.Value{}
+				}
+				dst.FieldByName(field).SetMapIndex(id, key)
+			}
+		})
+	}
+	set(old, true)
+	set(kr, false)
+	err := km.save(ctx, callbacks...)
+	if err != nil {
+		set(kr, true)
+		set(old, false)
+	}
+	return err
+}
+
+// bundleEntries lists the keys of imported, sorted by type and ID, and marks those which cannot be added to kr, and
+// those which are already in kr or replace a key of kr, for single key types.
+func (kr *keyRing) bundleEntries(imported *keyRing) (entries []KeyBundleEntry) {
+	existing := reflect.Indirect(reflect.ValueOf(kr))
+	forEachKeyMap(reflect.Indirect(reflect.ValueOf(imported)), func(field string, keyMap reflect.Value) {
+		ids := make([]string, 0, keyMap.Len())
+		for _, id := range keyMap.MapKeys() {
+			ids = append(ids, id.String())
+		}
+		sort.Strings(ids)
+		existingKeys := existing.FieldByName(field)
+		for _, id := range ids {
+			entry := KeyBundleEntry{Type: field, ID: id, Conflict: existingKeys.MapIndex(reflect.ValueOf(id)).IsValid()}
+			// the single key of the node is replaced by the key of the bundle, unless it is the same key
+			if singleKeyTypes[field] {
+				entry.Conflict = len(ids) > 1 || existingKeys.Len() > 1
+				if existingKeys.MapIndex(reflect.ValueOf(id)).IsValid() {
+					entry.Exists = true
+				} else if existingKeys.Len() == 1 {
+					entry.Replaces = existingKeys.MapKeys()[0].String()
+				}
+			}
+			entries = append(entries, entry)
+		}
+	})
+	return entries
+}
+
+// forEachKeyMap calls fn with the name and value of each key map field of the keyRing kr.
+func forEachKeyMap(kr reflect.Value, fn func(field string, keyMap reflect.Value)) {
+	for i := 0; i < kr.NumField(); i++ {
+		if kr.Field(i).Kind() == reflect.Map {
+			fn(kr.Type().Field(i).Name, kr.Field(i))
+		}
+	}
+}
+
+// adulteration prevents the password from getting used in the wrong place
+func adulteratedBundlePassword(password string) string {
+	return "key-bundle-" + password
+}
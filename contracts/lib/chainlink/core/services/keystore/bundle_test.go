@@ -0,0 +1,142 @@
+package keystore_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+)
+
+func TestMasterKeystore_ExportAll_ImportAll(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	keyStore := keystore.ExposedNewMaster(t, db)
+	ctx := testutils.Context(t)
+	require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+	// reset simulates a fresh node sharing the database
+	reset := func() {
+		ctx := context.Background() // Executed on cleanup
+		_, err := db.Exec("DELETE FROM evm.key_states")
+		require.NoError(t, err)
+		_, err = db.Exec("DELETE FROM encrypted_key_rings")
+		require.NoError(t, err)
+		keyStore.ResetXXXTestOnly()
+		require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+	}
+
+	ethKey, err := keyStore.Eth().Create(ctx, testutils.FixtureChainID)
+	require.NoError(t, err)
+	require.NoError(t, keyStore.Eth().Disable(ctx, ethKey.Address, testutils.FixtureChainID))
+	csaKey, err := keyStore.CSA().Create(ctx)
+	require.NoError(t, err)
+	p2pKey, err := keyStore.P2P().Create(ctx)
+	require.NoError(t, err)
+	ocr2Key, err := keyStore.OCR2().Create(ctx, chaintype.EVM)
+	require.NoError(t, err)
+	vrfKey, err := keyStore.VRF().Create(ctx)
+	require.NoError(t, err)
+	workflowKey, err := keyStore.Workflow().Create(ctx)
+	require.NoError(t, err)
+
+	bundle, err := keyStore.ExportAll(ctx, "bundlepassword")
+	require.NoError(t, err)
+
+	t.Run("lists conflicts on a dry run", func(t *testing.T) {
+		entries, err := keyStore.ImportAll(ctx, bundle, "bundlepassword", true)
+		require.NoError(t, err)
+		require.Len(t, entries, 6)
+		for _, e := range entries {
+			// the single CSA and Workflow keys of the node are the same keys
+			single := e.Type == "CSA" || e.Type == "Workflow"
+			assert.Equal(t, !single, e.Conflict, e.Type)
+			assert.Equal(t, single, e.Exists, e.Type)
+		}
+	})
+
+	t.Run("refuses to import conflicting keys", func(t *testing.T) {
+		_, err := keyStore.ImportAll(ctx, bundle, "bundlepassword", false)
+		require.True(t, errors.Is(err, keystore.ErrKeyBundleConflict))
+	})
+
+	reset()
+	// a fresh node creates its own CSA and Workflow keys when it starts
+	require.NoError(t, keyStore.CSA().EnsureKey(ctx))
+	require.NoError(t, keyStore.Workflow().EnsureKey(ctx))
+	freshCSAKeys, err := keyStore.CSA().GetAll()
+	require.NoError(t, err)
+	require.Len(t, freshCSAKeys, 1)
+	freshWorkflowKeys, err := keyStore.Workflow().GetAll()
+	require.NoError(t, err)
+	require.Len(t, freshWorkflowKeys, 1)
+
+	t.Run("errors with the wrong password", func(t *testing.T) {
+		_, err := keyStore.ImportAll(ctx, bundle, "wrongpassword", true)
+		require.ErrorIs(t, err, keystore.ErrInvalidKeyBundle)
+		_, err = keyStore.ImportAll(ctx, []byte(`{"version":2}`), "bundlepassword", true)
+		require.ErrorIs(t, err, keystore.ErrInvalidKeyBundle)
+	})
+
+	t.Run("does not import on a dry run", func(t *testing.T) {
+		entries, err := keyStore.ImportAll(ctx, bundle, "bundlepassword", true)
+		require.NoError(t, err)
+		require.Len(t, entries, 6)
+		for _, e := range entries {
+			assert.False(t, e.Conflict, e.Type)
+		}
+		keys, err := keyStore.Eth().GetAll(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+
+	t.Run("imports every key into an empty keystore", func(t *testing.T) {
+		entries, err := keyStore.ImportAll(ctx, bundle, "bundlepassword", false)
+		require.NoError(t, err)
+		assert.Equal(t, []keystore.KeyBundleEntry{
+			{Type: "CSA", ID: csaKey.ID(), Replaces: freshCSAKeys[0].ID()},
+			{Type: "Eth", ID: ethKey.ID()},
+			{Type: "OCR2", ID: ocr2Key.ID()},
+			{Type: "P2P", ID: p2pKey.ID()},
+			{Type: "VRF", ID: vrfKey.ID()},
+			{Type: "Workflow", ID: workflowKey.ID(), Replaces: freshWorkflowKeys[0].ID()},
+		}, entries)
+
+		// the keys of the fresh node are replaced
+		csaKeys, err := keyStore.CSA().GetAll()
+		require.NoError(t, err)
+		require.Len(t, csaKeys, 1)
+		assert.Equal(t, csaKey.ID(), csaKeys[0].ID())
+		workflowKeys, err := keyStore.Workflow().GetAll()
+		require.NoError(t, err)
+		require.Len(t, workflowKeys, 1)
+		assert.Equal(t, workflowKey.ID(), workflowKeys[0].ID())
+
+		gotEth, err := keyStore.Eth().Get(ctx, ethKey.ID())
+		require.NoError(t, err)
+		assert.Equal(t, ethKey, gotEth)
+		state, err := keyStore.Eth().GetState(ctx, ethKey.ID(), testutils.FixtureChainID)
+		require.NoError(t, err)
+		assert.True(t, state.Disabled)
+		_, err = keyStore.CSA().Get(csaKey.ID())
+		require.NoError(t, err)
+		_, err = keyStore.P2P().Get(p2pKey.PeerID())
+		require.NoError(t, err)
+		_, err = keyStore.OCR2().Get(ocr2Key.ID())
+		require.NoError(t, err)
+		_, err = keyStore.VRF().Get(vrfKey.ID())
+		require.NoError(t, err)
+
+		// the keys are saved
+		keyStore.ResetXXXTestOnly()
+		require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+		_, err = keyStore.OCR2().Get(ocr2Key.ID())
+		require.NoError(t, err)
+		cltest.AssertCount(t, db, "evm.key_states", 1)
+	})
+}
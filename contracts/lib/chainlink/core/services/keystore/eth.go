@@ -33,6 +33,8 @@ type Eth interface {
 	Disable(ctx context.Context, address common.Address, chainID *big.Int) error
 	Add(ctx context.Context, address common.Address, chainID *big.Int) error
 
+	AddRemoteSigner(ctx context.Context, address common.Address, signer Signer) error
+
 	EnsureKeys(ctx context.Context, chainIDs ...*big.Int) error
 	SubscribeToKeyChanges(ctx context.Context) (ch chan struct{}, unsub func())
 
@@ -60,6 +62,7 @@ type eth struct {
 	ds            sqlutil.DataSource
 	subscribers   [](chan struct{})
 	subscribersMu *sync.RWMutex
+	remoteSigners map[string]Signer                 // remoteSigners holds the signers of keys held outside the keystore, by key ID
 	resourceMutex map[common.Address]*ResourceMutex // ResourceMutex is an internal field and ought not be persisted to the database. Its main usage is to verify that the same key is not used for both TXMv1 and TXMv2 (usage in both TXMs will cause nonce drift and will lead to missing transactions). This functionality should be removed after we completely switch to TXMv2
 }
 
@@ -91,6 +94,7 @@ func newEthKeyStore(km *keyManager, orm keystateORM, ds sqlutil.DataSource) *eth
 		ds:            ds,
 		subscribers:   make([](chan struct{}), 0),
 		subscribersMu: new(sync.RWMutex),
+		remoteSigners: make(map[string]Signer),
 	}
 }
 
@@ -117,10 +121,34 @@ func (ks *eth) getAll(ctx context.Context) (keys []ethkey.KeyV2) {
 	for _, key := range ks.keyRing.Eth {
 		keys = append(keys, key)
 	}
+	for id := range ks.remoteSigners {
+		if _, found := ks.keyRing.Eth[id]; !found {
+			keys = append(keys, ethkey.FromAddress(common.HexToAddress(id)))
+		}
+	}
 	sort.Slice(keys, func(i, j int) bool { return keys[i].Cmp(keys[j]) < 0 })
 	return
 }
 
+// AddRemoteSigner adds the key with address, held by signer instead of the keystore. Like other keys, it must be
+// enabled for chains before it is used to send transactions.
+func (ks *eth) AddRemoteSigner(ctx context.Context, address common.Address, signer Signer) error {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ErrLocked
+	}
+	if _, found := ks.keyRing.Eth[address.Hex()]; found {
+		return errors.Wrapf(ErrKeyExists, "eth key %s is held by the keystore", address.Hex())
+	}
+	if _, found := ks.remoteSigners[address.Hex()]; found {
+		return errors.Wrapf(ErrKeyExists, "eth key %s already has a remote signer", address.Hex())
+	}
+	ks.remoteSigners[address.Hex()] = signer
+	ks.notify()
+	return nil
+}
+
 // Create generates a fresh new key and enables it for the given chain IDs
 func (ks *eth) Create(ctx context.Context, chainIDs ...*big.Int) (ethkey.KeyV2, error) {
 	ks.lock.Lock()
@@ -181,7 +209,7 @@ func (ks *eth) Import(ctx context.Context, keyJSON []byte, password string, chai
 		return ethkey.KeyV2{}, errors.Wrap(err, "EthKeyStore#ImportKey failed to decrypt key")
 	}
 	key := ethkey.FromPrivateKey(dKey.PrivateKey)
-	if _, found := ks.keyRing.Eth[key.ID()]; found {
+	if _, err = ks.getByID(key.ID()); err == nil {
 		return ethkey.KeyV2{}, ErrKeyExists
 	}
 	err = ks.add(ctx, key, chainIDs...)
@@ -198,6 +226,9 @@ func (ks *eth) Export(ctx context.Context, id string, password string) ([]byte,
 	if ks.isLocked() {
 		return nil, ErrLocked
 	}
+	if _, found := ks.remoteSigners[id]; found {
+		return nil, errors.Errorf("eth key %s is held by a remote signer and cannot be exported", id)
+	}
 	key, err := ks.getByID(id)
 	if err != nil {
 		return nil, err
@@ -208,9 +239,8 @@ func (ks *eth) Export(ctx context.Context, id string, password string) ([]byte,
 func (ks *eth) Add(ctx context.Context, address common.Address, chainID *big.Int) error {
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
-	_, found := ks.keyRing.Eth[address.Hex()]
-	if !found {
-		return ErrKeyNotFound
+	if _, err := ks.getByID(address.Hex()); err != nil {
+		return err
 	}
 	return ks.addKey(ctx, nil, address, chainID)
 }
@@ -238,9 +268,8 @@ func (ks *eth) addKey(ctx context.Context, ds sqlutil.DataSource, address common
 func (ks *eth) Enable(ctx context.Context, address common.Address, chainID *big.Int) error {
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
-	_, found := ks.keyRing.Eth[address.Hex()]
-	if !found {
-		return ErrKeyNotFound
+	if _, err := ks.getByID(address.Hex()); err != nil {
+		return err
 	}
 	return ks.enable(ctx, address, chainID)
 }
@@ -267,8 +296,7 @@ func (ks *eth) enable(ctx context.Context, address common.Address, chainID *big.
 func (ks *eth) Disable(ctx context.Context, address common.Address, chainID *big.Int) error {
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
-	_, found := ks.keyRing.Eth[address.Hex()]
-	if !found {
+	if _, err := ks.getByID(address.Hex()); err != nil {
 		return errors.Errorf("no key exists with ID %s", address.Hex())
 	}
 	return ks.disable(ctx, address, chainID)
@@ -298,6 +326,9 @@ func (ks *eth) Delete(ctx context.Context, id string) (ethkey.KeyV2, error) {
 	if ks.isLocked() {
 		return ethkey.KeyV2{}, ErrLocked
 	}
+	if _, found := ks.remoteSigners[id]; found {
+		return ethkey.KeyV2{}, errors.Errorf("eth key %s is held by a remote signer and must be removed from the RemoteSigner config", id)
+	}
 	key, err := ks.getByID(id)
 	if err != nil {
 		return ethkey.KeyV2{}, err
@@ -332,15 +363,13 @@ func (ks *eth) SubscribeToKeyChanges(ctx context.Context) (ch chan struct{}, uns
 }
 
 func (ks *eth) SignTx(ctx context.Context, address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
-	ks.lock.RLock()
-	defer ks.lock.RUnlock()
-	if ks.isLocked() {
-		return nil, ErrLocked
-	}
-	key, err := ks.getByID(address.String())
+	key, remote, err := ks.getSigningKey(address)
 	if err != nil {
 		return nil, err
 	}
+	if remote != nil {
+		return remote.SignTx(ctx, address, tx, chainID)
+	}
 	signer := types.LatestSignerForChainID(chainID)
 	return types.SignTx(tx, signer, key.ToEcdsaPrivKey())
 }
@@ -416,14 +445,7 @@ func (ks *eth) CheckEnabled(ctx context.Context, address common.Address, chainID
 	if ks.isLocked() {
 		return ErrLocked
 	}
-	var found bool
-	for _, k := range ks.keyRing.Eth {
-		if k.Address == address {
-			found = true
-			break
-		}
-	}
-	if !found {
+	if _, err := ks.getByID(address.Hex()); err != nil {
 		return errors.Errorf("no eth key exists with address %s", address.String())
 	}
 	states := ks.keyStates.KeyIDChainID[address.String()]
@@ -559,15 +581,13 @@ func (ks *eth) XXXTestingOnlyAdd(ctx context.Context, key ethkey.KeyV2) {
 // SignMessage signs the provided message using the private key associated with the given address,
 // following the EIP-191 specific identifier (e.g., keccak256("\x19Ethereum Signed Message:\n"${message length}${message}))
 func (ks *eth) SignMessage(ctx context.Context, address common.Address, data []byte) ([]byte, error) {
-	ks.lock.RLock()
-	defer ks.lock.RUnlock()
-	if ks.isLocked() {
-		return nil, ErrLocked
-	}
-	key, err := ks.getByID(address.Hex())
+	key, remote, err := ks.getSigningKey(address)
 	if err != nil {
 		return nil, err
 	}
+	if remote != nil {
+		return remote.SignMessage(ctx, address, data)
+	}
 	signature, err := crypto.Sign(accounts.TextHash(data), key.ToEcdsaPrivKey())
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to sign data")
@@ -579,11 +599,31 @@ func (ks *eth) SignMessage(ctx context.Context, address common.Address, data []b
 func (ks *eth) getByID(id string) (ethkey.KeyV2, error) {
 	key, found := ks.keyRing.Eth[id]
 	if !found {
+		if _, remote := ks.remoteSigners[id]; remote {
+			return ethkey.FromAddress(common.HexToAddress(id)), nil
+		}
 		return ethkey.KeyV2{}, ErrKeyNotFound
 	}
 	return key, nil
 }
 
+// getSigningKey returns the key of address, or its remote signer if the key is held by one. Remote signers are
+// called without holding the lock, since they may be slow.
+func (ks *eth) getSigningKey(address common.Address) (ethkey.KeyV2, Signer, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return ethkey.KeyV2{}, nil, ErrLocked
+	}
+	if key, found := ks.keyRing.Eth[address.Hex()]; found {
+		return key, nil, nil
+	}
+	if remote, found := ks.remoteSigners[address.Hex()]; found {
+		return ethkey.KeyV2{}, remote, nil
+	}
+	return ethkey.KeyV2{}, nil, ErrKeyNotFound
+}
+
 // caller must hold lock!
 func (ks *eth) enabledKeysForChain(chainID *big.Int) (keys []ethkey.KeyV2) {
 	return ks.keysForChain(chainID, false)
@@ -597,7 +637,7 @@ func (ks *eth) keysForChain(chainID *big.Int, includeDisabled bool) (keys []ethk
 	}
 	for keyID, state := range states {
 		if includeDisabled || !state.Disabled {
-			k := ks.keyRing.Eth[keyID]
+			k, _ := ks.getByID(keyID)
 			keys = append(keys, k)
 		}
 	}
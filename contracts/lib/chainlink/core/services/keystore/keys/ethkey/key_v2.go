@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto/ecdsa"
 	"crypto/rand"
+	"errors"
 	"fmt"
 	"math/big"
 
@@ -15,6 +16,9 @@ import (
 
 var curve = crypto.S256()
 
+// ErrRemoteKey is returned by users of private keys for keys held by a remote signer, which have none.
+var ErrRemoteKey = errors.New("key is held by a remote signer and has no private key")
+
 type Raw []byte
 
 func (raw Raw) Key() KeyV2 {
@@ -66,14 +70,32 @@ func FromPrivateKey(privKey *ecdsa.PrivateKey) (key KeyV2) {
 	}
 }
 
+// FromAddress returns a key without a private key, for an address held outside the keystore, e.g. by a remote signer.
+func FromAddress(address common.Address) KeyV2 {
+	return KeyV2{
+		Address:      address,
+		EIP55Address: types.EIP55AddressFromAddress(address),
+	}
+}
+
 func (key KeyV2) ID() string {
 	return key.Address.Hex()
 }
 
+// IsRemote returns true if the key is held by a remote signer, and so has no private key.
+func (key KeyV2) IsRemote() bool {
+	return key.privateKey == nil
+}
+
+// Raw returns the private key, or nil if the key is held by a remote signer.
 func (key KeyV2) Raw() Raw {
+	if key.IsRemote() {
+		return nil
+	}
 	return key.privateKey.D.Bytes()
 }
 
+// ToEcdsaPrivKey returns the private key, or nil if the key is held by a remote signer.
 func (key KeyV2) ToEcdsaPrivKey() *ecdsa.PrivateKey {
 	return key.privateKey
 }
@@ -43,3 +43,15 @@ func TestEthKeyV2_NewV2(t *testing.T) {
 	assert.NotNil(t, keyV2.privateKey)
 	assert.Equal(t, keyV2.Address.Hex(), keyV2.ID())
 }
+
+func TestEthKeyV2_FromAddress(t *testing.T) {
+	keyV2, err := NewV2()
+	require.NoError(t, err)
+	assert.False(t, keyV2.IsRemote())
+
+	remote := FromAddress(keyV2.Address)
+	assert.True(t, remote.IsRemote())
+	assert.Equal(t, keyV2.ID(), remote.ID())
+	assert.Nil(t, remote.Raw())
+	assert.Nil(t, remote.ToEcdsaPrivKey())
+}
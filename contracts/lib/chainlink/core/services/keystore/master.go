@@ -51,6 +51,9 @@ type Master interface {
 	Workflow() Workflow
 	Unlock(ctx context.Context, password string) error
 	IsEmpty(ctx context.Context) (bool, error)
+	ExportAll(ctx context.Context, password string) ([]byte, error)
+	ImportAll(ctx context.Context, bundleJSON []byte, password string, dryRun bool) ([]KeyBundleEntry, error)
+	RotatePassword(ctx context.Context, newPassword string, scryptParams utils.ScryptParams, hooks ...RotationHook) error
 }
 
 type master struct {
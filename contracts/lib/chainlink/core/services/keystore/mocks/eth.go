@@ -78,6 +78,54 @@ func (_c *Eth_Add_Call) RunAndReturn(run func(context.Context, common.Address, *
 	return _c
 }
 
+// AddRemoteSigner provides a mock function with given fields: ctx, address, signer
+func (_m *Eth) AddRemoteSigner(ctx context.Context, address common.Address, signer keystore.Signer) error {
+	ret := _m.Called(ctx, address, signer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRemoteSigner")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, keystore.Signer) error); ok {
+		r0 = rf(ctx, address, signer)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Eth_AddRemoteSigner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRemoteSigner'
+type Eth_AddRemoteSigner_Call struct {
+	*mock.Call
+}
+
+// AddRemoteSigner is a helper method to define mock.On call
+//   - ctx context.Context
+//   - address common.Address
+//   - signer keystore.Signer
+func (_e *Eth_Expecter) AddRemoteSigner(ctx interface{}, address interface{}, signer interface{}) *Eth_AddRemoteSigner_Call {
+	return &Eth_AddRemoteSigner_Call{Call: _e.mock.On("AddRemoteSigner", ctx, address, signer)}
+}
+
+func (_c *Eth_AddRemoteSigner_Call) Run(run func(ctx context.Context, address common.Address, signer keystore.Signer)) *Eth_AddRemoteSigner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address), args[2].(keystore.Signer))
+	})
+	return _c
+}
+
+func (_c *Eth_AddRemoteSigner_Call) Return(_a0 error) *Eth_AddRemoteSigner_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Eth_AddRemoteSigner_Call) RunAndReturn(run func(context.Context, common.Address, keystore.Signer) error) *Eth_AddRemoteSigner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckEnabled provides a mock function with given fields: ctx, address, chainID
 func (_m *Eth) CheckEnabled(ctx context.Context, address common.Address, chainID *big.Int) error {
 	ret := _m.Called(ctx, address, chainID)
@@ -7,6 +7,8 @@ import (
 
 	keystore "github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	mock "github.com/stretchr/testify/mock"
+
+	utils "github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 // Master is an autogenerated mock type for the Master type
@@ -210,6 +212,126 @@ func (_c *Master_Eth_Call) RunAndReturn(run func() keystore.Eth) *Master_Eth_Cal
 	return _c
 }
 
+// ExportAll provides a mock function with given fields: ctx, password
+func (_m *Master) ExportAll(ctx context.Context, password string) ([]byte, error) {
+	ret := _m.Called(ctx, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportAll")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]byte, error)); ok {
+		return rf(ctx, password)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []byte); ok {
+		r0 = rf(ctx, password)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, password)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Master_ExportAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportAll'
+type Master_ExportAll_Call struct {
+	*mock.Call
+}
+
+// ExportAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - password string
+func (_e *Master_Expecter) ExportAll(ctx interface{}, password interface{}) *Master_ExportAll_Call {
+	return &Master_ExportAll_Call{Call: _e.mock.On("ExportAll", ctx, password)}
+}
+
+func (_c *Master_ExportAll_Call) Run(run func(ctx context.Context, password string)) *Master_ExportAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Master_ExportAll_Call) Return(_a0 []byte, _a1 error) *Master_ExportAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Master_ExportAll_Call) RunAndReturn(run func(context.Context, string) ([]byte, error)) *Master_ExportAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportAll provides a mock function with given fields: ctx, bundleJSON, password, dryRun
+func (_m *Master) ImportAll(ctx context.Context, bundleJSON []byte, password string, dryRun bool) ([]keystore.KeyBundleEntry, error) {
+	ret := _m.Called(ctx, bundleJSON, password, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportAll")
+	}
+
+	var r0 []keystore.KeyBundleEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, string, bool) ([]keystore.KeyBundleEntry, error)); ok {
+		return rf(ctx, bundleJSON, password, dryRun)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, string, bool) []keystore.KeyBundleEntry); ok {
+		r0 = rf(ctx, bundleJSON, password, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]keystore.KeyBundleEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, string, bool) error); ok {
+		r1 = rf(ctx, bundleJSON, password, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Master_ImportAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportAll'
+type Master_ImportAll_Call struct {
+	*mock.Call
+}
+
+// ImportAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bundleJSON []byte
+//   - password string
+//   - dryRun bool
+func (_e *Master_Expecter) ImportAll(ctx interface{}, bundleJSON interface{}, password interface{}, dryRun interface{}) *Master_ImportAll_Call {
+	return &Master_ImportAll_Call{Call: _e.mock.On("ImportAll", ctx, bundleJSON, password, dryRun)}
+}
+
+func (_c *Master_ImportAll_Call) Run(run func(ctx context.Context, bundleJSON []byte, password string, dryRun bool)) *Master_ImportAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]byte), args[2].(string), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *Master_ImportAll_Call) Return(_a0 []keystore.KeyBundleEntry, _a1 error) *Master_ImportAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Master_ImportAll_Call) RunAndReturn(run func(context.Context, []byte, string, bool) ([]keystore.KeyBundleEntry, error)) *Master_ImportAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsEmpty provides a mock function with given fields: ctx
 func (_m *Master) IsEmpty(ctx context.Context) (bool, error) {
 	ret := _m.Called(ctx)
@@ -407,6 +529,69 @@ func (_c *Master_P2P_Call) RunAndReturn(run func() keystore.P2P) *Master_P2P_Cal
 	return _c
 }
 
+// RotatePassword provides a mock function with given fields: ctx, newPassword, scryptParams, hooks
+func (_m *Master) RotatePassword(ctx context.Context, newPassword string, scryptParams utils.ScryptParams, hooks ...keystore.RotationHook) error {
+	_va := make([]interface{}, len(hooks))
+	for _i := range hooks {
+		_va[_i] = hooks[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, newPassword, scryptParams)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotatePassword")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, utils.ScryptParams, ...keystore.RotationHook) error); ok {
+		r0 = rf(ctx, newPassword, scryptParams, hooks...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Master_RotatePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotatePassword'
+type Master_RotatePassword_Call struct {
+	*mock.Call
+}
+
+// RotatePassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - newPassword string
+//   - scryptParams utils.ScryptParams
+//   - hooks ...keystore.RotationHook
+func (_e *Master_Expecter) RotatePassword(ctx interface{}, newPassword interface{}, scryptParams interface{}, hooks ...interface{}) *Master_RotatePassword_Call {
+	return &Master_RotatePassword_Call{Call: _e.mock.On("RotatePassword",
+		append([]interface{}{ctx, newPassword, scryptParams}, hooks...)...)}
+}
+
+func (_c *Master_RotatePassword_Call) Run(run func(ctx context.Context, newPassword string, scryptParams utils.ScryptParams, hooks ...keystore.RotationHook)) *Master_RotatePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]keystore.RotationHook, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(keystore.RotationHook)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), args[2].(utils.ScryptParams), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Master_RotatePassword_Call) Return(_a0 error) *Master_RotatePassword_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Master_RotatePassword_Call) RunAndReturn(run func(context.Context, string, utils.ScryptParams, ...keystore.RotationHook) error) *Master_RotatePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Solana provides a mock function with no fields
 func (_m *Master) Solana() keystore.Solana {
 	ret := _m.Called()
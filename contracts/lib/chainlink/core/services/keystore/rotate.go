@@ -0,0 +1,144 @@
+package keystore
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// RotationHook re-encrypts data kept outside of the keystore with a key derived from the keystore password, from
+// oldPassword to newPassword, in the transaction tx which rotates the password.
+type RotationHook func(ctx context.Context, tx sqlutil.DataSource, oldPassword, newPassword string) error
+
+// RotatePassword re-encrypts the keyring, and any legacy VRF keys encrypted with the current password, with
+// newPassword and scryptParams in a single transaction, in which hooks are run too. The transaction is only committed
+// once the stored keyring can be decrypted with newPassword and holds every key. The keystore must be unlocked, and
+// no other node may use the database, since it would keep saving the keyring with the old password.
+func (ks *master) RotatePassword(ctx context.Context, newPassword string, scryptParams utils.ScryptParams, hooks ...RotationHook) error {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ErrLocked
+	}
+	if newPassword == "" {
+		return errors.New("new password must not be empty")
+	}
+	ekr, err := ks.keyRing.Encrypt(newPassword, scryptParams)
+	if err != nil {
+		return errors.Wrap(err, "unable to encrypt keyRing")
+	}
+	want := ks.keyRing.keyIDs()
+	err = ks.orm.saveEncryptedKeyRing(ctx, &ekr, func(tx sqlutil.DataSource) error {
+		n, skipped, err := reencryptLegacyVRFKeys(ctx, tx, ks.password, newPassword, scryptParams)
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			ks.logger.Infow("Re-encrypted legacy VRF keys", "count", n)
+		}
+		if len(skipped) > 0 {
+			ks.logger.Warnw("Legacy VRF keys which do not decrypt with the keystore password were left as they are, and still need the password they were encrypted with", "publicKeys", skipped)
+		}
+		for _, hook := range hooks {
+			if err = hook(ctx, tx, ks.password, newPassword); err != nil {
+				return err
+			}
+		}
+		return verifyKeyRing(ctx, tx, newPassword, want)
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to rotate keystore password")
+	}
+	ks.password = newPassword
+	ks.scryptParams = scryptParams
+	ks.logger.Info("Rotated keystore password")
+	return nil
+}
+
+// verifyKeyRing checks that the keyring stored in tx decrypts with password, and holds the keys with IDs want.
+func verifyKeyRing(ctx context.Context, tx sqlutil.DataSource, password string, want map[string][]string) error {
+	var ekr encryptedKeyRing
+	if err := tx.GetContext(ctx, &ekr, `SELECT * FROM encrypted_key_rings LIMIT 1`); err != nil {
+		return errors.Wrap(err, "unable to get encrypted key ring")
+	}
+	kr, err := ekr.Decrypt(password)
+	if err != nil {
+		return errors.Wrap(err, "unable to decrypt the re-encrypted key ring with the new password")
+	}
+	if got := kr.keyIDs(); !reflect.DeepEqual(got, want) {
+		return errors.New("the re-encrypted key ring does not hold the same keys")
+	}
+	return nil
+}
+
+// keyIDs returns the sorted IDs of the keys in kr by type.
+func (kr *keyRing) keyIDs() map[string][]string {
+	ids := make(map[string][]string)
+	forEachKeyMap(reflect.Indirect(reflect.ValueOf(kr)), func(field string, keyMap reflect.Value) {
+		for _, id := range keyMap.MapKeys() {
+			ids[field] = append(ids[field], id.String())
+		}
+		sort.Strings(ids[field])
+	})
+	return ids
+}
+
+// reencryptLegacyVRFKeys re-encrypts the keys left in the legacy encrypted_vrf_keys table with newPassword, if they
+// were encrypted with oldPassword. Keys encrypted with another password, such as a separate VRF password, are left
+// as they are, and their public keys are returned as skipped.
+func reencryptLegacyVRFKeys(ctx context.Context, tx sqlutil.DataSource, oldPassword, newPassword string, scryptParams utils.ScryptParams) (n int, skipped []string, err error) {
+	var exists bool
+	if err = tx.GetContext(ctx, &exists, `SELECT to_regclass('encrypted_vrf_keys') IS NOT NULL`); err != nil {
+		return 0, nil, errors.Wrap(err, "failed to check for legacy VRF keys")
+	}
+	if !exists {
+		return 0, nil, nil
+	}
+	var rows []struct {
+		PublicKey string `db:"public_key"`
+		VRFKey    string `db:"vrf_key"`
+	}
+	err = tx.SelectContext(ctx, &rows, `SELECT public_key, vrf_key FROM encrypted_vrf_keys WHERE deleted_at IS NULL`)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "failed to load legacy VRF keys")
+	}
+	for _, row := range rows {
+		export, err := json.Marshal(map[string]interface{}{
+			"PublicKey": row.PublicKey,
+			"vrf_key":   json.RawMessage(row.VRFKey),
+		})
+		if err != nil {
+			return n, skipped, err
+		}
+		key, err := vrfkey.FromEncryptedJSON(export, oldPassword)
+		if err != nil {
+			skipped = append(skipped, row.PublicKey)
+			continue
+		}
+		export, err = key.ToEncryptedJSON(newPassword, scryptParams)
+		if err != nil {
+			return n, skipped, err
+		}
+		var encrypted vrfkey.EncryptedVRFKeyExport
+		if err = json.Unmarshal(export, &encrypted); err != nil {
+			return n, skipped, err
+		}
+		vrfKey, err := json.Marshal(encrypted.VRFKey)
+		if err != nil {
+			return n, skipped, err
+		}
+		_, err = tx.ExecContext(ctx, `UPDATE encrypted_vrf_keys SET vrf_key = $1, updated_at = NOW() WHERE public_key = $2`, string(vrfKey), row.PublicKey)
+		if err != nil {
+			return n, skipped, errors.Wrapf(err, "failed to save legacy VRF key %s", row.PublicKey)
+		}
+		n++
+	}
+	return n, skipped, nil
+}
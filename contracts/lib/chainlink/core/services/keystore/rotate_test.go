@@ -0,0 +1,111 @@
+package keystore_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func TestMasterKeystore_RotatePassword(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	keyStore := keystore.ExposedNewMaster(t, db)
+	ctx := testutils.Context(t)
+	const newPassword = "n3wP4ssword-for-the-keystore"
+
+	require.ErrorIs(t, keyStore.RotatePassword(ctx, newPassword, utils.FastScryptParams), keystore.ErrLocked)
+
+	require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+	ethKey, err := keyStore.Eth().Create(ctx, testutils.FixtureChainID)
+	require.NoError(t, err)
+	vrfKey, err := keyStore.VRF().Create(ctx)
+	require.NoError(t, err)
+
+	var legacyVRFKeys bool
+	require.NoError(t, db.Get(&legacyVRFKeys, `SELECT to_regclass('encrypted_vrf_keys') IS NOT NULL`))
+	legacyKey := vrfkey.MustNewV2XXXTestingOnly(big.NewInt(testutils.NewRandomPositiveInt64()))
+	// a legacy key encrypted with a separate VRF password, which is left as it is
+	const vrfPassword = "s3parate-VRF-password"
+	otherLegacyKey := vrfkey.MustNewV2XXXTestingOnly(big.NewInt(testutils.NewRandomPositiveInt64()))
+	insertLegacyKey := func(key vrfkey.KeyV2, password string) {
+		export, err2 := key.ToEncryptedJSON(password, utils.FastScryptParams)
+		require.NoError(t, err2)
+		var encrypted vrfkey.EncryptedVRFKeyExport
+		require.NoError(t, json.Unmarshal(export, &encrypted))
+		vrfKeyJSON, err2 := json.Marshal(encrypted.VRFKey)
+		require.NoError(t, err2)
+		_, err2 = db.Exec(`INSERT INTO encrypted_vrf_keys (public_key, vrf_key, created_at, updated_at) VALUES ($1, $2, NOW(), NOW())`,
+			key.PublicKey.String(), string(vrfKeyJSON))
+		require.NoError(t, err2)
+	}
+	if legacyVRFKeys {
+		insertLegacyKey(legacyKey, cltest.Password)
+		insertLegacyKey(otherLegacyKey, vrfPassword)
+	}
+
+	require.Error(t, keyStore.RotatePassword(ctx, "", utils.FastScryptParams))
+	failingHook := func(ctx context.Context, tx sqlutil.DataSource, oldPassword, newPassword string) error {
+		return errors.New("hook failed")
+	}
+	require.ErrorContains(t, keyStore.RotatePassword(ctx, newPassword, utils.FastScryptParams, failingHook), "hook failed")
+	var hookPasswords []string
+	hook := func(ctx context.Context, tx sqlutil.DataSource, oldPassword, newPassword string) error {
+		hookPasswords = append(hookPasswords, oldPassword, newPassword)
+		return nil
+	}
+	require.NoError(t, keyStore.RotatePassword(ctx, newPassword, utils.FastScryptParams, hook))
+	assert.Equal(t, []string{cltest.Password, newPassword}, hookPasswords)
+
+	// keys can still be added, and are saved with the new password
+	p2pKey, err := keyStore.P2P().Create(ctx)
+	require.NoError(t, err)
+
+	keyStore.ResetXXXTestOnly()
+	require.Error(t, keyStore.Unlock(ctx, cltest.Password))
+	require.NoError(t, keyStore.Unlock(ctx, newPassword))
+
+	gotEth, err := keyStore.Eth().Get(ctx, ethKey.ID())
+	require.NoError(t, err)
+	assert.Equal(t, ethKey.ID(), gotEth.ID())
+	_, err = keyStore.VRF().Get(vrfKey.ID())
+	require.NoError(t, err)
+	_, err = keyStore.P2P().Get(p2pKey.PeerID())
+	require.NoError(t, err)
+
+	if legacyVRFKeys {
+		var vrfKeyJSON string
+		require.NoError(t, db.Get(&vrfKeyJSON, `SELECT vrf_key FROM encrypted_vrf_keys WHERE public_key = $1`, legacyKey.PublicKey.String()))
+		export, err := json.Marshal(map[string]interface{}{
+			"PublicKey": legacyKey.PublicKey.String(),
+			"vrf_key":   json.RawMessage(vrfKeyJSON),
+		})
+		require.NoError(t, err)
+		_, err = vrfkey.FromEncryptedJSON(export, cltest.Password)
+		require.Error(t, err)
+		got, err := vrfkey.FromEncryptedJSON(export, newPassword)
+		require.NoError(t, err)
+		assert.Equal(t, legacyKey.ID(), got.ID())
+
+		require.NoError(t, db.Get(&vrfKeyJSON, `SELECT vrf_key FROM encrypted_vrf_keys WHERE public_key = $1`, otherLegacyKey.PublicKey.String()))
+		export, err = json.Marshal(map[string]interface{}{
+			"PublicKey": otherLegacyKey.PublicKey.String(),
+			"vrf_key":   json.RawMessage(vrfKeyJSON),
+		})
+		require.NoError(t, err)
+		got, err = vrfkey.FromEncryptedJSON(export, vrfPassword)
+		require.NoError(t, err)
+		assert.Equal(t, otherLegacyKey.ID(), got.ID())
+	}
+}
@@ -0,0 +1,157 @@
+package keystore
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+)
+
+// Signer signs EVM transactions and messages for keys held outside the keystore.
+type Signer interface {
+	// SignTx returns tx signed by address for chainID.
+	SignTx(ctx context.Context, address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+	// SignMessage returns the EIP-191 signature of data by address, in the [R || S || V] format with V 0 or 1.
+	SignMessage(ctx context.Context, address common.Address, data []byte) ([]byte, error)
+}
+
+type remoteSigner struct {
+	client  *rpc.Client
+	timeout time.Duration
+}
+
+// NewRemoteSigner returns a Signer for the JSON-RPC endpoint at url, which must implement eth_signTransaction and
+// eth_sign, like Web3Signer and Clef. Every signature is checked against the requested transaction or message and
+// the signing address, so a misbehaving signer cannot have the node send anything else.
+func NewRemoteSigner(url string, timeout time.Duration) (Signer, error) {
+	client, err := rpc.DialOptions(context.Background(), url)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to dial remote signer %s", url)
+	}
+	return &remoteSigner{client: client, timeout: timeout}, nil
+}
+
+// signTxArgs are the eth_signTransaction parameters.
+type signTxArgs struct {
+	From                 common.Address    `json:"from"`
+	To                   *common.Address   `json:"to,omitempty"`
+	Gas                  hexutil.Uint64    `json:"gas"`
+	GasPrice             *hexutil.Big      `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big      `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big      `json:"maxPriorityFeePerGas,omitempty"`
+	Value                *hexutil.Big      `json:"value"`
+	Nonce                hexutil.Uint64    `json:"nonce"`
+	Data                 hexutil.Bytes     `json:"data"`
+	ChainID              *hexutil.Big      `json:"chainId"`
+	AccessList           *types.AccessList `json:"accessList,omitempty"`
+}
+
+func newSignTxArgs(address common.Address, tx *types.Transaction, chainID *big.Int) (*signTxArgs, error) {
+	args := &signTxArgs{
+		From:    address,
+		To:      tx.To(),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   (*hexutil.Big)(tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    tx.Data(),
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	switch tx.Type() {
+	case types.LegacyTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	case types.AccessListTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+		accessList := tx.AccessList()
+		args.AccessList = &accessList
+	case types.DynamicFeeTxType:
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+		accessList := tx.AccessList()
+		args.AccessList = &accessList
+	default:
+		return nil, errors.Errorf("remote signers do not support transaction type %d", tx.Type())
+	}
+	return args, nil
+}
+
+func (s *remoteSigner) SignTx(ctx context.Context, address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	args, err := newSignTxArgs(address, tx, chainID)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	var result json.RawMessage
+	if err = s.client.CallContext(ctx, &result, "eth_signTransaction", args); err != nil {
+		return nil, errors.Wrap(err, "remote signer failed to sign transaction")
+	}
+	raw, err := parseSignTxResult(result)
+	if err != nil {
+		return nil, err
+	}
+	signed := new(types.Transaction)
+	if err = signed.UnmarshalBinary(raw); err != nil {
+		return nil, errors.Wrap(err, "remote signer returned an invalid transaction")
+	}
+
+	signer := types.LatestSignerForChainID(chainID)
+	if signer.Hash(signed) != signer.Hash(tx) {
+		return nil, errors.New("remote signer signed a different transaction")
+	}
+	sender, err := types.Sender(signer, signed)
+	if err != nil {
+		return nil, errors.Wrap(err, "remote signer returned an invalid signature")
+	}
+	if sender != address {
+		return nil, errors.Errorf("remote signer signed with %s instead of %s", sender, address)
+	}
+	return signed, nil
+}
+
+// parseSignTxResult returns the raw signed transaction from an eth_signTransaction result, which is either the raw
+// transaction, as returned by Web3Signer, or an object holding it, as returned by Clef and geth.
+func parseSignTxResult(result json.RawMessage) ([]byte, error) {
+	var raw hexutil.Bytes
+	if err := json.Unmarshal(result, &raw); err == nil {
+		return raw, nil
+	}
+	var obj struct {
+		Raw hexutil.Bytes `json:"raw"`
+	}
+	if err := json.Unmarshal(result, &obj); err != nil || len(obj.Raw) == 0 {
+		return nil, errors.Errorf("remote signer returned an invalid eth_signTransaction result: %s", result)
+	}
+	return obj.Raw, nil
+}
+
+func (s *remoteSigner) SignMessage(ctx context.Context, address common.Address, data []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	var signature hexutil.Bytes
+	if err := s.client.CallContext(ctx, &signature, "eth_sign", address, hexutil.Bytes(data)); err != nil {
+		return nil, errors.Wrap(err, "remote signer failed to sign message")
+	}
+	if len(signature) != crypto.SignatureLength {
+		return nil, errors.Errorf("remote signer returned a signature of %d bytes, expected %d", len(signature), crypto.SignatureLength)
+	}
+	// signers commonly return V as 27 or 28
+	if signature[crypto.RecoveryIDOffset] >= 27 {
+		signature[crypto.RecoveryIDOffset] -= 27
+	}
+	pubKey, err := crypto.SigToPub(accounts.TextHash(data), signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "remote signer returned an invalid signature")
+	}
+	if signer := crypto.PubkeyToAddress(*pubKey); signer != address {
+		return nil, errors.Errorf("remote signer signed with %s instead of %s", signer, address)
+	}
+	return signature, nil
+}
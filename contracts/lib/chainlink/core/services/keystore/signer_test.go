@@ -0,0 +1,196 @@
+package keystore_test
+
+import (
+	"math/big"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+)
+
+// mockSigner implements the eth_signTransaction and eth_sign methods of a remote signer, signing everything with key.
+type mockSigner struct {
+	key ethkey.KeyV2
+	// clef returns signed transactions in an object, like Clef, instead of as raw transactions, like Web3Signer.
+	clef bool
+	// bumpNonce signs a different transaction from the one requested.
+	bumpNonce bool
+}
+
+type mockSignTxArgs struct {
+	From                 common.Address  `json:"from"`
+	To                   *common.Address `json:"to"`
+	Gas                  hexutil.Uint64  `json:"gas"`
+	GasPrice             *hexutil.Big    `json:"gasPrice"`
+	MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas"`
+	Value                *hexutil.Big    `json:"value"`
+	Nonce                hexutil.Uint64  `json:"nonce"`
+	Data                 hexutil.Bytes   `json:"data"`
+	ChainID              *hexutil.Big    `json:"chainId"`
+}
+
+func (s *mockSigner) SignTransaction(args mockSignTxArgs) (interface{}, error) {
+	nonce := uint64(args.Nonce)
+	if s.bumpNonce {
+		nonce++
+	}
+	var txData types.TxData
+	if args.MaxFeePerGas != nil {
+		txData = &types.DynamicFeeTx{ChainID: args.ChainID.ToInt(), Nonce: nonce, GasTipCap: args.MaxPriorityFeePerGas.ToInt(), GasFeeCap: args.MaxFeePerGas.ToInt(),
+			Gas: uint64(args.Gas), To: args.To, Value: args.Value.ToInt(), Data: args.Data}
+	} else {
+		txData = &types.LegacyTx{Nonce: nonce, GasPrice: args.GasPrice.ToInt(), Gas: uint64(args.Gas), To: args.To, Value: args.Value.ToInt(), Data: args.Data}
+	}
+	signed, err := types.SignNewTx(s.key.ToEcdsaPrivKey(), types.LatestSignerForChainID(args.ChainID.ToInt()), txData)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := signed.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	if s.clef {
+		return map[string]interface{}{"raw": hexutil.Bytes(raw), "tx": signed}, nil
+	}
+	return hexutil.Bytes(raw), nil
+}
+
+func (s *mockSigner) Sign(_ common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
+	signature, err := crypto.Sign(accounts.TextHash(data), s.key.ToEcdsaPrivKey())
+	if err != nil {
+		return nil, err
+	}
+	signature[crypto.RecoveryIDOffset] += 27
+	return signature, nil
+}
+
+func newMockSignerServer(t *testing.T, signer *mockSigner) keystore.Signer {
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", signer))
+	t.Cleanup(server.Stop)
+	srv := httptest.NewServer(server)
+	t.Cleanup(srv.Close)
+
+	remote, err := keystore.NewRemoteSigner(srv.URL, time.Second)
+	require.NoError(t, err)
+	return remote
+}
+
+func Test_EthKeyStore_RemoteSigner(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db)
+	ethKeyStore := keyStore.Eth()
+	chainID := testutils.FixtureChainID
+
+	remoteKey, err := ethkey.NewV2()
+	require.NoError(t, err)
+	require.NoError(t, ethKeyStore.AddRemoteSigner(ctx, remoteKey.Address, newMockSignerServer(t, &mockSigner{key: remoteKey})))
+	require.ErrorIs(t, ethKeyStore.AddRemoteSigner(ctx, remoteKey.Address, newMockSignerServer(t, &mockSigner{key: remoteKey})), keystore.ErrKeyExists)
+	localKey, _ := cltest.MustInsertRandomKey(t, ethKeyStore)
+	require.ErrorIs(t, ethKeyStore.AddRemoteSigner(ctx, localKey.Address, newMockSignerServer(t, &mockSigner{key: localKey})), keystore.ErrKeyExists)
+
+	t.Run("lists remote keys", func(t *testing.T) {
+		key, err := ethKeyStore.Get(ctx, remoteKey.ID())
+		require.NoError(t, err)
+		assert.Equal(t, remoteKey.Address, key.Address)
+		assert.Equal(t, remoteKey.EIP55Address, key.EIP55Address)
+		keys, err := ethKeyStore.GetAll(ctx)
+		require.NoError(t, err)
+		require.Len(t, keys, 2)
+	})
+
+	t.Run("enables remote keys for chains", func(t *testing.T) {
+		require.Error(t, ethKeyStore.CheckEnabled(ctx, remoteKey.Address, chainID))
+		require.NoError(t, ethKeyStore.Enable(ctx, remoteKey.Address, chainID))
+		require.NoError(t, ethKeyStore.CheckEnabled(ctx, remoteKey.Address, chainID))
+		addresses, err := ethKeyStore.EnabledAddressesForChain(ctx, chainID)
+		require.NoError(t, err)
+		assert.Contains(t, addresses, remoteKey.Address)
+		keys, err := ethKeyStore.EnabledKeysForChain(ctx, chainID)
+		require.NoError(t, err)
+		require.Len(t, keys, 2)
+	})
+
+	t.Run("signs transactions", func(t *testing.T) {
+		signer := types.LatestSignerForChainID(chainID)
+		txs := []*types.Transaction{
+			cltest.NewLegacyTransaction(1, testutils.NewAddress(), big.NewInt(53), 21000, big.NewInt(1000000000), []byte{1, 2, 3, 4}),
+			types.NewTx(&types.DynamicFeeTx{ChainID: chainID, Nonce: 2, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(100), Gas: 21000, Value: big.NewInt(1)}),
+		}
+		for _, tx := range txs {
+			signed, err := ethKeyStore.SignTx(ctx, remoteKey.Address, tx, chainID)
+			require.NoError(t, err)
+			assert.Equal(t, signer.Hash(tx), signer.Hash(signed))
+			sender, err := types.Sender(signer, signed)
+			require.NoError(t, err)
+			assert.Equal(t, remoteKey.Address, sender)
+		}
+	})
+
+	t.Run("signs messages", func(t *testing.T) {
+		message := []byte("this is a message")
+		signature, err := ethKeyStore.SignMessage(ctx, remoteKey.Address, message)
+		require.NoError(t, err)
+		pubKey, err := crypto.SigToPub(accounts.TextHash(message), signature)
+		require.NoError(t, err)
+		assert.Equal(t, remoteKey.Address, crypto.PubkeyToAddress(*pubKey))
+	})
+
+	t.Run("cannot export or delete remote keys", func(t *testing.T) {
+		_, err := ethKeyStore.Export(ctx, remoteKey.ID(), "password")
+		require.ErrorContains(t, err, "remote signer")
+		_, err = ethKeyStore.Delete(ctx, remoteKey.ID())
+		require.ErrorContains(t, err, "remote signer")
+	})
+}
+
+func TestRemoteSigner(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	chainID := testutils.FixtureChainID
+	key, err := ethkey.NewV2()
+	require.NoError(t, err)
+	tx := cltest.NewLegacyTransaction(0, testutils.NewAddress(), big.NewInt(53), 21000, big.NewInt(1000000000), nil)
+
+	t.Run("accepts Clef results", func(t *testing.T) {
+		signer := newMockSignerServer(t, &mockSigner{key: key, clef: true})
+		signed, err := signer.SignTx(ctx, key.Address, tx, chainID)
+		require.NoError(t, err)
+		assert.Equal(t, tx.Nonce(), signed.Nonce())
+	})
+
+	t.Run("rejects a different transaction", func(t *testing.T) {
+		signer := newMockSignerServer(t, &mockSigner{key: key, bumpNonce: true})
+		_, err := signer.SignTx(ctx, key.Address, tx, chainID)
+		require.ErrorContains(t, err, "signed a different transaction")
+	})
+
+	t.Run("rejects signatures by another key", func(t *testing.T) {
+		other, err := ethkey.NewV2()
+		require.NoError(t, err)
+		signer := newMockSignerServer(t, &mockSigner{key: other})
+		_, err = signer.SignTx(ctx, key.Address, tx, chainID)
+		require.ErrorContains(t, err, "instead of")
+		_, err = signer.SignMessage(ctx, key.Address, []byte("message"))
+		require.ErrorContains(t, err, "instead of")
+	})
+}
@@ -12,7 +12,6 @@ import (
 	"runtime/pprof"
 	"runtime/trace"
 	"sort"
-	"strings"
 	"sync"
 	"time"
 
@@ -21,6 +20,7 @@ import (
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/timeutil"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
@@ -29,7 +29,8 @@ type Nurse struct {
 	services.Service
 	eng *services.Engine
 
-	cfg Config
+	cfg    Config
+	vitals Vitals
 
 	checks   map[string]CheckFunc
 	checksMu sync.RWMutex
@@ -44,11 +45,13 @@ type Config interface {
 	GatherTraceDuration() commonconfig.Duration
 	GoroutineThreshold() int
 	MaxProfileSize() utils.FileSize
+	MaxProfileSizePerType() utils.FileSize
 	MemProfileRate() int
 	MemThreshold() utils.FileSize
 	MutexProfileFraction() int
 	PollInterval() commonconfig.Duration
 	ProfileRoot() string
+	Triggers() config.AutoPprofTriggers
 }
 
 type CheckFunc func() (unwell bool, meta Meta)
@@ -63,11 +66,13 @@ type Meta map[string]interface{}
 const (
 	cpuProfName   = "cpu"
 	traceProfName = "trace"
+	nurseLogName  = "nurse.log"
 )
 
-func NewNurse(cfg Config, log logger.Logger) *Nurse {
+func NewNurse(cfg Config, vitals Vitals, log logger.Logger) *Nurse {
 	n := &Nurse{
 		cfg:      cfg,
+		vitals:   vitals,
 		checks:   make(map[string]CheckFunc),
 		chGather: make(chan gatherRequest, 1),
 	}
@@ -97,6 +102,7 @@ func (n *Nurse) start(_ context.Context) error {
 
 	n.AddCheck("mem", n.checkMem)
 	n.AddCheck("goroutines", n.checkGoroutines)
+	n.addTriggers()
 
 	// Checker
 	n.eng.GoTick(timeutil.NewTicker(n.cfg.PollInterval().Duration), func(ctx context.Context) {
@@ -222,11 +228,12 @@ func (n *Nurse) gatherVitals(reason string, meta Meta) {
 	select {
 	case <-n.eng.StopChan:
 	case <-ch:
+		n.enforceRetention()
 	}
 }
 
 func (n *Nurse) appendLog(now time.Time, reason string, meta Meta) error {
-	filename := filepath.Join(n.cfg.ProfileRoot(), "nurse.log")
+	filename := filepath.Join(n.cfg.ProfileRoot(), nurseLogName)
 
 	n.eng.Debugf("creating nurse log %s", filename)
 	file, err := os.Create(filename)
@@ -447,10 +454,7 @@ func (n *Nurse) listProfiles() ([]fs.FileInfo, error) {
 		return nil, err
 	}
 	for _, entry := range entries {
-		if entry.IsDir() ||
-			(filepath.Ext(entry.Name()) != ".pprof" &&
-				entry.Name() != "nurse.log" &&
-				!strings.HasSuffix(entry.Name(), ".pprof.gz")) {
+		if entry.IsDir() || !isProfileFile(entry.Name()) {
 			continue
 		}
 		info, err := entry.Info()
@@ -0,0 +1,193 @@
+package services
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/pprof/profile"
+)
+
+var (
+	ErrProfileNotFound       = errors.New("profile not found")
+	ErrNotEnoughHeapProfiles = errors.New("at least two heap profiles are required")
+)
+
+// Profile is a file gathered by the nurse.
+type Profile struct {
+	Name      string
+	Type      string
+	Size      int64
+	CreatedAt time.Time
+}
+
+// ListProfiles returns the files gathered by the nurse in root, newest first.
+func ListProfiles(root string) ([]Profile, error) {
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var profiles []Profile
+	for _, entry := range entries {
+		if entry.IsDir() || !isProfileFile(entry.Name()) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		p := Profile{Name: entry.Name(), Type: "log", Size: info.Size(), CreatedAt: info.ModTime()}
+		if createdAt, typ, ok := parseProfileName(entry.Name()); ok {
+			p.Type, p.CreatedAt = typ, createdAt
+		}
+		profiles = append(profiles, p)
+	}
+	sort.SliceStable(profiles, func(i, j int) bool {
+		return profiles[i].CreatedAt.After(profiles[j].CreatedAt)
+	})
+	return profiles, nil
+}
+
+// OpenProfile opens the file gathered by the nurse in root with name.
+func OpenProfile(root, name string) (*os.File, error) {
+	path, err := profilePath(root, name)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrProfileNotFound
+	}
+	return f, err
+}
+
+// DeleteProfile deletes the file gathered by the nurse in root with name.
+func DeleteProfile(root, name string) error {
+	path, err := profilePath(root, name)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return ErrProfileNotFound
+	}
+	return err
+}
+
+// HeapProfileDiff returns the difference between the last two heap profiles gathered by the nurse in root, which
+// can be inspected like any other profile, for example with `go tool pprof`.
+func HeapProfileDiff(root string) (*profile.Profile, error) {
+	profiles, err := ListProfiles(root)
+	if err != nil {
+		return nil, err
+	}
+	var heaps []Profile
+	for _, p := range profiles {
+		if p.Type == "heap" {
+			heaps = append(heaps, p)
+		}
+		if len(heaps) == 2 {
+			break
+		}
+	}
+	if len(heaps) < 2 {
+		return nil, ErrNotEnoughHeapProfiles
+	}
+	last, err := readProfile(root, heaps[0].Name)
+	if err != nil {
+		return nil, err
+	}
+	prev, err := readProfile(root, heaps[1].Name)
+	if err != nil {
+		return nil, err
+	}
+	prev.Scale(-1)
+	diff, err := profile.Merge([]*profile.Profile{prev, last})
+	if err != nil {
+		return nil, fmt.Errorf("could not compute difference of %s and %s: %w", heaps[1].Name, heaps[0].Name, err)
+	}
+	diff.TimeNanos = last.TimeNanos
+	return diff, nil
+}
+
+func readProfile(root, name string) (*profile.Profile, error) {
+	f, err := OpenProfile(root, name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var r io.Reader = f
+	if strings.HasSuffix(name, ".gz") {
+		gr, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, err
+		}
+		defer gr.Close()
+		r = gr
+	}
+	return profile.Parse(r)
+}
+
+// enforceRetention deletes the oldest profiles of each type which exceed MaxProfileSizePerType. The newest profile
+// of each type is always kept, even if it exceeds the limit on its own.
+func (n *Nurse) enforceRetention() {
+	maxSize := int64(n.cfg.MaxProfileSizePerType())
+	if maxSize <= 0 {
+		return
+	}
+	profiles, err := ListProfiles(n.cfg.ProfileRoot())
+	if err != nil {
+		n.eng.Errorw("could not list profiles", "err", err)
+		return
+	}
+	sizes := map[string]int64{}
+	for _, p := range profiles {
+		if p.Type == "log" {
+			continue
+		}
+		size, seen := sizes[p.Type]
+		sizes[p.Type] = size + p.Size
+		if !seen || sizes[p.Type] <= maxSize {
+			continue
+		}
+		n.eng.Debugw("deleting profile which exceeds MaxProfileSizePerType", "profile", p.Name, "max", n.cfg.MaxProfileSizePerType())
+		if err := DeleteProfile(n.cfg.ProfileRoot(), p.Name); err != nil {
+			n.eng.Errorw("could not delete profile", "profile", p.Name, "err", err)
+		}
+	}
+}
+
+func isProfileFile(name string) bool {
+	return filepath.Ext(name) == ".pprof" || name == nurseLogName || strings.HasSuffix(name, ".pprof.gz")
+}
+
+func profilePath(root, name string) (string, error) {
+	if name != filepath.Base(name) || !isProfileFile(name) {
+		return "", ErrProfileNotFound
+	}
+	return filepath.Join(root, name), nil
+}
+
+// parseProfileName parses the names of profiles written by createFile.
+func parseProfileName(name string) (createdAt time.Time, typ string, ok bool) {
+	name = strings.TrimSuffix(strings.TrimSuffix(name, ".gz"), ".pprof")
+	ts, typ, ok := strings.Cut(name, ".")
+	if !ok {
+		return
+	}
+	micros, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return time.Time{}, "", false
+	}
+	return time.UnixMicro(micros), typ, true
+}
@@ -1,16 +1,21 @@
 package services
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
 	"runtime"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/google/pprof/profile"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
@@ -29,6 +34,8 @@ type mockConfig struct {
 	mutexProfileFraction int
 	memThreshold         utils.FileSize
 	goroutineThreshold   int
+	profileSizePerType   utils.FileSize
+	triggers             mockTriggers
 }
 
 var (
@@ -98,9 +105,65 @@ func (c mockConfig) GoroutineThreshold() int {
 	return c.goroutineThreshold
 }
 
+func (c mockConfig) MaxProfileSizePerType() utils.FileSize {
+	return c.profileSizePerType
+}
+
+func (c mockConfig) Triggers() config.AutoPprofTriggers {
+	return c.triggers
+}
+
+type mockTriggers struct {
+	dbPoolSaturation      mockCountTrigger
+	pipelineRunQueueDepth mockCountTrigger
+	headTrackerLag        mockDurationTrigger
+	gcPauseP99            mockDurationTrigger
+	openFileDescriptors   mockCountTrigger
+}
+
+func (t mockTriggers) DBPoolSaturation() config.AutoPprofCountTrigger {
+	return t.dbPoolSaturation
+}
+
+func (t mockTriggers) PipelineRunQueueDepth() config.AutoPprofCountTrigger {
+	return t.pipelineRunQueueDepth
+}
+
+func (t mockTriggers) HeadTrackerLag() config.AutoPprofDurationTrigger {
+	return t.headTrackerLag
+}
+
+func (t mockTriggers) GCPauseP99() config.AutoPprofDurationTrigger {
+	return t.gcPauseP99
+}
+
+func (t mockTriggers) OpenFileDescriptors() config.AutoPprofCountTrigger {
+	return t.openFileDescriptors
+}
+
+type mockCountTrigger struct {
+	enabled   bool
+	threshold uint32
+	cooldown  time.Duration
+}
+
+func (t mockCountTrigger) Enabled() bool           { return t.enabled }
+func (t mockCountTrigger) Threshold() uint32       { return t.threshold }
+func (t mockCountTrigger) Cooldown() time.Duration { return t.cooldown }
+
+type mockDurationTrigger struct {
+	enabled   bool
+	threshold time.Duration
+	cooldown  time.Duration
+}
+
+func (t mockDurationTrigger) Enabled() bool            { return t.enabled }
+func (t mockDurationTrigger) Threshold() time.Duration { return t.threshold }
+func (t mockDurationTrigger) Cooldown() time.Duration  { return t.cooldown }
+
 func TestNurse(t *testing.T) {
 	l := logger.TestLogger(t)
-	nrse := NewNurse(newMockConfig(t), l)
+	nrse := NewNurse(newMockConfig(t), Vitals{}, l)
 	nrse.AddCheck("test", func() (bool, Meta) { return true, Meta{} })
 
 	require.NoError(t, nrse.Start(tests.Context(t)))
@@ -140,3 +203,129 @@ func profileExists(t *testing.T, nrse *Nurse, typ string) bool {
 	}
 	return false
 }
+
+func TestNurse_Triggers(t *testing.T) {
+	cfg := newMockConfig(t)
+	// only the trigger under test reports the node unwell
+	cfg.memThreshold = utils.FileSize(1 << 62)
+	cfg.goroutineThreshold = 1 << 30
+	cfg.triggers.pipelineRunQueueDepth = mockCountTrigger{enabled: true, threshold: 10, cooldown: time.Hour}
+
+	runs := 10
+	nrse := NewNurse(cfg, Vitals{PipelineRunsInProgress: func() int { return runs }}, logger.TestLogger(t))
+
+	require.NoError(t, nrse.Start(tests.Context(t)))
+	defer func() { require.NoError(t, nrse.Close()) }()
+
+	testutils.AssertEventually(t, func() bool { return profileExists(t, nrse, cpuProfName) })
+	b, err := os.ReadFile(filepath.Join(cfg.root, nurseLogName))
+	require.NoError(t, err)
+	assert.Contains(t, string(b), "reason: pipeline_run_queue_depth")
+	assert.Contains(t, string(b), "- runs_in_progress: 10")
+}
+
+func TestNurse_addTrigger(t *testing.T) {
+	nrse := NewNurse(newMockConfig(t), Vitals{}, logger.TestLogger(t))
+	nrse.addTrigger("test", testInterval, func() (bool, Meta) { return true, Meta{} })
+	check := nrse.checks["test"]
+
+	unwell, _ := check()
+	assert.True(t, unwell)
+	unwell, _ = check()
+	assert.False(t, unwell)
+	time.Sleep(testInterval)
+	unwell, _ = check()
+	assert.True(t, unwell)
+}
+
+func TestProfiles(t *testing.T) {
+	root := t.TempDir()
+	now := time.Now()
+	writeHeap := func(ts time.Time, bytes int64) string {
+		p := &profile.Profile{
+			SampleType: []*profile.ValueType{{Type: "alloc_space", Unit: "bytes"}},
+			PeriodType: &profile.ValueType{Type: "space", Unit: "bytes"},
+			Period:     1,
+			Sample:     []*profile.Sample{{Value: []int64{bytes}}},
+			TimeNanos:  ts.UnixNano(),
+		}
+		name := fmt.Sprintf("%d.heap.pprof", ts.UnixMicro())
+		f, err := os.Create(filepath.Join(root, name))
+		require.NoError(t, err)
+		require.NoError(t, p.Write(f))
+		require.NoError(t, f.Close())
+		return name
+	}
+
+	_, err := HeapProfileDiff(root)
+	require.ErrorIs(t, err, ErrNotEnoughHeapProfiles)
+
+	older := writeHeap(now.Add(-time.Minute), 100)
+	newer := writeHeap(now, 250)
+	require.NoError(t, os.WriteFile(filepath.Join(root, nurseLogName), []byte("==== test\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "other.txt"), nil, 0600))
+
+	profiles, err := ListProfiles(root)
+	require.NoError(t, err)
+	require.Len(t, profiles, 3)
+	assert.Equal(t, newer, profiles[1].Name)
+	assert.Equal(t, "heap", profiles[1].Type)
+	assert.Equal(t, now.UnixMicro(), profiles[1].CreatedAt.UnixMicro())
+	assert.Equal(t, older, profiles[2].Name)
+
+	diff, err := HeapProfileDiff(root)
+	require.NoError(t, err)
+	var total int64
+	for _, s := range diff.Sample {
+		total += s.Value[0]
+	}
+	assert.Equal(t, int64(150), total)
+
+	_, err = OpenProfile(root, "../"+newer)
+	require.ErrorIs(t, err, ErrProfileNotFound)
+	_, err = OpenProfile(root, "other.txt")
+	require.ErrorIs(t, err, ErrProfileNotFound)
+
+	require.NoError(t, DeleteProfile(root, older))
+	require.ErrorIs(t, DeleteProfile(root, older), ErrProfileNotFound)
+	_, err = HeapProfileDiff(root)
+	require.ErrorIs(t, err, ErrNotEnoughHeapProfiles)
+}
+
+func TestNurse_enforceRetention(t *testing.T) {
+	cfg := newMockConfig(t)
+	cfg.profileSizePerType = 10
+	nrse := NewNurse(cfg, Vitals{}, logger.TestLogger(t))
+
+	now := time.Now()
+	for i, typ := range []string{"heap", "heap", "heap", "cpu"} {
+		name := fmt.Sprintf("%d.%s.pprof", now.Add(time.Duration(i)*time.Second).UnixMicro(), typ)
+		require.NoError(t, os.WriteFile(filepath.Join(cfg.root, name), []byte("12345"), 0600))
+	}
+
+	nrse.enforceRetention()
+
+	profiles, err := ListProfiles(cfg.root)
+	require.NoError(t, err)
+	var types []string
+	for _, p := range profiles {
+		types = append(types, p.Type)
+	}
+	// the oldest heap profile exceeds the limit
+	assert.Equal(t, []string{"cpu", "heap", "heap"}, types)
+
+	// the newest profile is kept even if it exceeds the limit on its own
+	name := fmt.Sprintf("%d.heap.pprof", now.Add(time.Minute).UnixMicro())
+	require.NoError(t, os.WriteFile(filepath.Join(cfg.root, name), []byte("12345678901"), 0600))
+
+	nrse.enforceRetention()
+
+	profiles, err = ListProfiles(cfg.root)
+	require.NoError(t, err)
+	types = nil
+	for _, p := range profiles {
+		types = append(types, p.Type)
+	}
+	assert.Equal(t, []string{"heap", "cpu"}, types)
+	assert.Equal(t, name, profiles[0].Name)
+}
@@ -0,0 +1,142 @@
+package services
+
+import (
+	"database/sql"
+	"os"
+	"runtime/debug"
+	"time"
+)
+
+// Vitals reads the state of other parts of the node, for the triggers configured in [AutoPprof.Triggers]. A
+// trigger is skipped when the vital it needs is nil.
+type Vitals struct {
+	// DBStats returns the stats of the database connection pool.
+	DBStats func() sql.DBStats
+	// PipelineRunsInProgress returns the number of pipeline runs which are executing.
+	PipelineRunsInProgress func() int
+	// HeadTrackerLag returns the age of the latest head of the chain which is furthest behind.
+	HeadTrackerLag func() time.Duration
+}
+
+func (n *Nurse) addTriggers() {
+	triggers := n.cfg.Triggers()
+
+	if t := triggers.DBPoolSaturation(); t.Enabled() {
+		if n.vitals.DBStats == nil {
+			n.eng.Warn("DBPoolSaturation trigger is enabled, but database stats are not available")
+		} else {
+			n.addTrigger("db_pool_saturation", t.Cooldown(), func() (bool, Meta) {
+				stats := n.vitals.DBStats()
+				if stats.MaxOpenConnections <= 0 {
+					return false, nil
+				}
+				percent := stats.InUse * 100 / stats.MaxOpenConnections
+				if percent < int(t.Threshold()) {
+					return false, nil
+				}
+				return true, Meta{
+					"in_use":    stats.InUse,
+					"max_open":  stats.MaxOpenConnections,
+					"wait":      stats.WaitCount,
+					"threshold": t.Threshold(),
+				}
+			})
+		}
+	}
+
+	if t := triggers.PipelineRunQueueDepth(); t.Enabled() {
+		if n.vitals.PipelineRunsInProgress == nil {
+			n.eng.Warn("PipelineRunQueueDepth trigger is enabled, but the pipeline runner is not available")
+		} else {
+			n.addTrigger("pipeline_run_queue_depth", t.Cooldown(), func() (bool, Meta) {
+				runs := n.vitals.PipelineRunsInProgress()
+				if runs < int(t.Threshold()) {
+					return false, nil
+				}
+				return true, Meta{
+					"runs_in_progress": runs,
+					"threshold":        t.Threshold(),
+				}
+			})
+		}
+	}
+
+	if t := triggers.HeadTrackerLag(); t.Enabled() {
+		if n.vitals.HeadTrackerLag == nil {
+			n.eng.Warn("HeadTrackerLag trigger is enabled, but no head trackers are available")
+		} else {
+			n.addTrigger("head_tracker_lag", t.Cooldown(), func() (bool, Meta) {
+				lag := n.vitals.HeadTrackerLag()
+				if lag < t.Threshold() {
+					return false, nil
+				}
+				return true, Meta{
+					"lag":       lag,
+					"threshold": t.Threshold(),
+				}
+			})
+		}
+	}
+
+	if t := triggers.GCPauseP99(); t.Enabled() {
+		n.addTrigger("gc_pause_p99", t.Cooldown(), func() (bool, Meta) {
+			p99 := gcPauseP99()
+			if p99 < t.Threshold() {
+				return false, nil
+			}
+			return true, Meta{
+				"gc_pause_p99": p99,
+				"threshold":    t.Threshold(),
+			}
+		})
+	}
+
+	if t := triggers.OpenFileDescriptors(); t.Enabled() {
+		if _, err := openFileDescriptors(); err != nil {
+			n.eng.Warnw("OpenFileDescriptors trigger is enabled, but open file descriptors cannot be counted", "err", err)
+		} else {
+			n.addTrigger("open_file_descriptors", t.Cooldown(), func() (bool, Meta) {
+				fds, err := openFileDescriptors()
+				if err != nil || fds < int(t.Threshold()) {
+					return false, nil
+				}
+				return true, Meta{
+					"open_fds":  fds,
+					"threshold": t.Threshold(),
+				}
+			})
+		}
+	}
+}
+
+// addTrigger adds a check which is skipped for cooldown after it reported the node unwell.
+func (n *Nurse) addTrigger(reason string, cooldown time.Duration, checkFunc CheckFunc) {
+	// checks are only called by the checker, one at a time
+	var last time.Time
+	n.AddCheck(reason, func() (bool, Meta) {
+		if !last.IsZero() && time.Since(last) < cooldown {
+			return false, nil
+		}
+		unwell, meta := checkFunc()
+		if unwell {
+			last = time.Now()
+		}
+		return unwell, meta
+	})
+}
+
+// gcPauseP99 returns the 99th percentile of the most recent garbage collection pauses.
+func gcPauseP99() time.Duration {
+	stats := debug.GCStats{PauseQuantiles: make([]time.Duration, 101)}
+	debug.ReadGCStats(&stats)
+	return stats.PauseQuantiles[99]
+}
+
+// openFileDescriptors returns the number of file descriptors held by the process. It is only supported on Linux.
+func openFileDescriptors() (int, error) {
+	entries, err := os.ReadDir("/proc/self/fd")
+	if err != nil {
+		return 0, err
+	}
+	return len(entries), nil
+}
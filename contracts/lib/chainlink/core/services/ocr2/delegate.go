@@ -72,6 +72,7 @@ import (
 	evmmercury "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury"
 	mercuryutils "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/utils"
 	evmrelaytypes "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/services/s4"
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization"
 	"github.com/smartcontractkit/chainlink/v2/core/services/telemetry"
@@ -125,6 +126,7 @@ type Delegate struct {
 
 	legacyChains         legacyevm.LegacyChainContainer // legacy: use relayers instead
 	capabilitiesRegistry core.CapabilitiesRegistry
+	s4Sweepers           *s4.SweeperPool
 }
 
 type DelegateConfig interface {
@@ -257,6 +259,7 @@ func NewDelegate(
 		mailMon:               opts.MailMon,
 		capabilitiesRegistry:  opts.CapabilitiesRegistry,
 		retirementReportCache: opts.RetirementReportCache,
+		s4Sweepers:            s4.NewSweeperPool(),
 	}
 }
 
@@ -1590,6 +1593,7 @@ func (d *Delegate) newServicesOCR2Functions(
 		EthKeystore:       d.ethKs,
 		ThresholdKeyShare: thresholdKeyShare,
 		LogPollerWrapper:  functionsProvider.LogPollerWrapper(),
+		S4Sweepers:        d.s4Sweepers,
 	}
 
 	functionsServices, err := functions.NewFunctionsServices(ctx, &functionsOracleArgs, &thresholdOracleArgs, &s4OracleArgs, &functionsServicesConfig)
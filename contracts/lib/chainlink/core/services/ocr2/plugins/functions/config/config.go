@@ -48,6 +48,7 @@ type PluginConfig struct {
 	OnchainSubscriptions                     *subscriptions.OnchainSubscriptionsConfig `json:"onchainSubscriptions"`
 	RateLimiter                              *common.RateLimiterConfig                 `json:"rateLimiter"`
 	S4Constraints                            *s4.Constraints                           `json:"s4Constraints"`
+	S4SweeperIntervalSec                     *uint32                                   `json:"s4SweeperIntervalSec"` // Defaults to a minute, 0 disables the sweeper. The sweeper is shared by all jobs, and the first one started sets the interval
 	DecryptionQueueConfig                    *DecryptionQueueConfig                    `json:"decryptionQueueConfig"`
 	ExternalAdapterMaxRetries                *uint32                                   `json:"externalAdapterMaxRetries"`
 	ExternalAdapterExponentialBackoffBaseSec *uint32                                   `json:"externalAdapterExponentialBackoffBaseSec"`
@@ -15,6 +15,7 @@ import (
 	libocr2 "github.com/smartcontractkit/libocr/offchainreporting2plus"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/mailbox"
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
@@ -49,6 +50,7 @@ type FunctionsServicesConfig struct {
 	EthKeystore       keystore.Eth
 	ThresholdKeyShare []byte
 	LogPollerWrapper  evmrelayTypes.LogPollerWrapper
+	S4Sweepers        *s4.SweeperPool
 }
 
 const (
@@ -58,8 +60,31 @@ const (
 	DefaultOffchainTransmitterChannelSize uint32 = 1000
 	DefaultMaxAdapterRetry                int    = 3
 	DefaultExponentialBackoffBase                = 5 * time.Second
+	DefaultS4SweeperInterval                     = time.Minute
+	DefaultS4SweeperBatchSize             uint   = 1000
 )
 
+// ErrNoS4Storage is returned by NewJobS4Storage for jobs which don't store data in S4.
+var ErrNoS4Storage = errors.New("job has no S4 storage")
+
+// NewJobS4Storage returns the S4 storage of a Functions job, for operators to inspect the usage of addresses against
+// its S4Constraints. The storage is FunctionsS4Namespace, shared by all Functions jobs, so listing it is not scoped to
+// the job. ErrNoS4Storage is returned for other jobs, and Functions jobs without S4Constraints.
+func NewJobS4Storage(ds sqlutil.DataSource, jb job.Job, lggr logger.Logger) (s4.Storage, error) {
+	if jb.Type != job.OffchainReporting2 || jb.OCR2OracleSpec == nil || jb.OCR2OracleSpec.PluginType != types.Functions {
+		return nil, ErrNoS4Storage
+	}
+	var pluginConfig config.PluginConfig
+	if err := json.Unmarshal(jb.OCR2OracleSpec.PluginConfig.Bytes(), &pluginConfig); err != nil {
+		return nil, err
+	}
+	if pluginConfig.S4Constraints == nil {
+		return nil, ErrNoS4Storage
+	}
+	orm := s4.NewPostgresORM(ds, s4.SharedTableName, FunctionsS4Namespace)
+	return s4.NewStorage(lggr, *pluginConfig.S4Constraints, orm, clockwork.NewRealClock()), nil
+}
+
 // Create all OCR2 plugin Oracles and all extra services needed to run a Functions job.
 func NewFunctionsServices(ctx context.Context, functionsOracleArgs, thresholdOracleArgs, s4OracleArgs *libocr2.OCR2OracleArgs, conf *FunctionsServicesConfig) ([]job.ServiceCtx, error) {
 	pluginORM := functions.NewORM(conf.DS, common.HexToAddress(conf.ContractID))
@@ -103,6 +128,20 @@ func NewFunctionsServices(ctx context.Context, functionsOracleArgs, thresholdOra
 	var s4Storage s4.Storage
 	if pluginConfig.S4Constraints != nil {
 		s4Storage = s4.NewStorage(conf.Logger, *pluginConfig.S4Constraints, s4ORM, clockwork.NewRealClock())
+
+		sweeperInterval := DefaultS4SweeperInterval
+		if pluginConfig.S4SweeperIntervalSec != nil {
+			sweeperInterval = time.Duration(*pluginConfig.S4SweeperIntervalSec) * time.Second
+		}
+		if sweeperInterval > 0 {
+			sweeper, err := conf.S4Sweepers.Sweeper(conf.Logger, s4ORM, FunctionsS4Namespace, sweeperInterval, DefaultS4SweeperBatchSize, clockwork.NewRealClock())
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to create S4 sweeper")
+			}
+			allServices = append(allServices, sweeper)
+		} else {
+			conf.Logger.Info("S4 sweeper is disabled, expired S4 rows are only deleted by the S4 plugin")
+		}
 	}
 
 	offchainTransmitter := functions.NewOffchainTransmitter(DefaultOffchainTransmitterChannelSize)
@@ -144,6 +183,7 @@ func NewFunctionsServices(ctx context.Context, functionsOracleArgs, thresholdOra
 		Logger:              functionsOracleArgs.Logger,
 		PluginORM:           pluginORM,
 		JobID:               conf.Job.ExternalJobID,
+		DONID:               pluginConfig.DONID,
 		ContractVersion:     pluginConfig.ContractVersion,
 		OffchainTransmitter: offchainTransmitter,
 	}
@@ -213,6 +253,9 @@ func NewConnector(ctx context.Context, pluginConfig *config.PluginConfig, ethKey
 	if idx == -1 {
 		return nil, nil, errors.New("key for configured node address not found")
 	}
+	if enabledKeys[idx].IsRemote() {
+		return nil, nil, errors.Wrapf(ethkey.ErrRemoteKey, "the gateway connector signs with the key of node address %s itself", enabledKeys[idx].ID())
+	}
 	signerKey := enabledKeys[idx].ToEcdsaPrivKey()
 	if enabledKeys[idx].ID() != pluginConfig.GatewayConnectorConfig.NodeAddress {
 		return nil, nil, errors.New("node address mismatch")
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/google/uuid"
@@ -24,6 +25,7 @@ type FunctionsReportingPluginFactory struct {
 	Logger              commontypes.Logger
 	PluginORM           functions.ORM
 	JobID               uuid.UUID
+	DONID               string
 	ContractVersion     uint32
 	OffchainTransmitter functions.OffchainTransmitter
 }
@@ -34,6 +36,7 @@ type functionsReporting struct {
 	logger              commontypes.Logger
 	pluginORM           functions.ORM
 	jobID               uuid.UUID
+	donID               string
 	reportCodec         encoding.ReportCodec
 	genericConfig       *types.ReportingPluginConfig
 	specificConfig      *config.ReportingPluginConfigWrapper
@@ -84,6 +87,12 @@ var (
 		Help:    "Metric to track batch size of transmitting reports",
 		Buckets: []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 100, 1000},
 	}, []string{"jobID"})
+
+	promReportingTimeToFinalize = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "functions_reporting_plugin_time_to_finalize_seconds",
+		Help:    "Metric to track the time from receiving a request to finalizing its report per DON",
+		Buckets: []float64{1, 2, 5, 10, 15, 20, 30, 45, 60, 90, 120, 180, 300, 600},
+	}, []string{"donID"})
 )
 
 func formatRequestId(requestId []byte) string {
@@ -117,6 +126,7 @@ func (f FunctionsReportingPluginFactory) NewReportingPlugin(ctx context.Context,
 		logger:              f.Logger,
 		pluginORM:           f.PluginORM,
 		jobID:               f.JobID,
+		donID:               f.DONID,
 		reportCodec:         codec,
 		genericConfig:       &rpConfig,
 		specificConfig:      pluginConfig,
@@ -428,7 +438,7 @@ func (r *functionsReporting) ShouldAcceptFinalizedReport(ctx context.Context, ts
 			r.logger.Error("FunctionsReporting ShouldAcceptFinalizedReport: invalid ID", commontypes.LogFields{"requestID": reqIdStr, "err": err})
 			continue
 		}
-		_, err = r.pluginORM.FindById(ctx, id)
+		request, err := r.pluginORM.FindById(ctx, id)
 		if err != nil {
 			// TODO: Differentiate between ID not found and other ORM errors (https://smartcontract-it.atlassian.net/browse/DRO-215)
 			r.logger.Warn("FunctionsReporting ShouldAcceptFinalizedReport: request doesn't exist locally! Accepting anyway.", commontypes.LogFields{"requestID": reqIdStr})
@@ -440,6 +450,7 @@ func (r *functionsReporting) ShouldAcceptFinalizedReport(ctx context.Context, ts
 			r.logger.Debug("FunctionsReporting ShouldAcceptFinalizedReport: state couldn't be changed to FINALIZED. Not transmitting.", commontypes.LogFields{"requestID": reqIdStr, "err": err})
 			continue
 		}
+		promReportingTimeToFinalize.WithLabelValues(r.donID).Observe(time.Since(request.ReceivedAt).Seconds())
 		if bytes.Equal(item.OnchainMetadata, []byte(functions.OffchainRequestMarker)) {
 			r.logger.Debug("FunctionsReporting ShouldAcceptFinalizedReport: transmitting offchain", commontypes.LogFields{"requestID": reqIdStr})
 			result := functions.OffchainResponse{RequestId: item.RequestID, Result: item.Result, Error: item.Error}
@@ -1,14 +1,17 @@
 package periodicbackup
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	"net/url"
 	"os"
-	"os/exec"
-	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/jackc/pgx/v4"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
@@ -19,7 +22,8 @@ import (
 )
 
 var (
-	filePattern        = "cl_backup_%s.dump"
+	filePattern        = "cl_backup_%s_%s.dump"
+	timestampFormat    = "20060102T150405Z"
 	minBackupFrequency = time.Minute
 
 	excludedDataFromTables = []string{
@@ -29,10 +33,10 @@ var (
 )
 
 type backupResult struct {
-	size            int64
-	path            string
-	maskedArguments []string
-	pgDumpArguments []string
+	size     int64
+	name     string
+	location string
+	header   *Header
 }
 
 type (
@@ -48,7 +52,11 @@ type (
 		mode            config.DatabaseBackupMode
 		frequency       time.Duration
 		outputParentDir string
-		done            chan bool
+		destination     Destination
+		generations     int
+		password        string
+		stopCh          services.StopChan
+		wg              sync.WaitGroup
 	}
 
 	BackupConfig interface {
@@ -56,34 +64,49 @@ type (
 		Dir() string
 		Mode() config.DatabaseBackupMode
 		Frequency() time.Duration
+		Destination() config.DatabaseBackupDestination
+		Encrypt() bool
+		Generations() uint32
+		S3() config.BackupS3
 	}
 )
 
-// NewDatabaseBackup instantiates a *databaseBackup
-func NewDatabaseBackup(dbUrl url.URL, rootDir string, backupConfig BackupConfig, lggr logger.Logger) (DatabaseBackup, error) {
+// NewDatabaseBackup instantiates a *databaseBackup. Backups are encrypted with keystorePassword if
+// Database.Backup.Encrypt is set.
+func NewDatabaseBackup(dbUrl url.URL, rootDir string, backupConfig BackupConfig, keystorePassword string, lggr logger.Logger) (DatabaseBackup, error) {
 	lggr = lggr.Named("DatabaseBackup")
 	dbBackupUrl := backupConfig.URL()
 	if dbBackupUrl != nil {
 		dbUrl = *dbBackupUrl
 	}
 
-	outputParentDir := filepath.Join(rootDir, "backup")
-	if backupConfig.Dir() != "" {
-		dir, err := filepath.Abs(backupConfig.Dir())
-		if err != nil {
-			return nil, errors.Errorf("failed to get path for Database.Backup.Dir (%s) - please set it to a valid directory path", backupConfig.Dir())
+	outputParentDir, err := OutputDir(rootDir, backupConfig)
+	if err != nil {
+		return nil, err
+	}
+	destination, err := NewDestination(backupConfig, outputParentDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var password string
+	if backupConfig.Encrypt() {
+		if keystorePassword == "" {
+			return nil, errors.New("Database.Backup.Encrypt requires the keystore password to be set with Password.Keystore")
 		}
-		outputParentDir = dir
+		password = keystorePassword
 	}
 
 	return &databaseBackup{
-		services.StateMachine{},
-		lggr,
-		dbUrl,
-		backupConfig.Mode(),
-		backupConfig.Frequency(),
-		outputParentDir,
-		make(chan bool),
+		logger:          lggr,
+		databaseURL:     dbUrl,
+		mode:            backupConfig.Mode(),
+		frequency:       backupConfig.Frequency(),
+		outputParentDir: outputParentDir,
+		destination:     destination,
+		generations:     int(backupConfig.Generations()),
+		password:        password,
+		stopCh:          make(services.StopChan),
 	}, nil
 }
 
@@ -99,10 +122,12 @@ func (backup *databaseBackup) Start(context.Context) error {
 			return errors.Errorf("Database backup frequency (%s=%v) is too small. Please set it to at least %s (or set to 0 to disable periodic backups)", "Database.Backup.Frequency", backup.frequency, minBackupFrequency)
 		}
 
+		backup.wg.Add(1)
 		go func() {
+			defer backup.wg.Done()
 			for {
 				select {
-				case <-backup.done:
+				case <-backup.stopCh:
 					ticker.Stop()
 					return
 				case <-ticker.C:
@@ -119,7 +144,8 @@ func (backup *databaseBackup) Start(context.Context) error {
 
 func (backup *databaseBackup) Close() error {
 	return backup.StopOnce("DatabaseBackup", func() (err error) {
-		backup.done <- true
+		close(backup.stopCh)
+		backup.wg.Wait()
 		return nil
 	})
 }
@@ -138,19 +164,25 @@ func (backup *databaseBackup) frequencyIsTooSmall() bool {
 
 func (backup *databaseBackup) RunBackup(version string) error {
 	backup.logger.Debugw("Starting backup", "mode", backup.mode, "directory", backup.outputParentDir)
+	ctx, cancel := backup.stopCh.NewCtx()
+	defer cancel()
 	startAt := time.Now()
-	result, err := backup.runBackup(version)
+	result, err := backup.runBackup(ctx, version)
 	duration := time.Since(startAt)
 	if err != nil {
 		backup.logger.Criticalw("Backup failed", "duration", duration, "err", err)
 		backup.SvcErrBuffer.Append(err)
 		return err
 	}
-	backup.logger.Infow("Backup completed successfully.", "duration", duration, "fileSize", result.size, "filePath", result.path)
+	backup.logger.Infow("Backup completed successfully.", "duration", duration, "fileSize", result.size, "location", result.location,
+		"migration", result.header.Migration, "encrypted", result.header.Encrypted())
+	if err = backup.prune(ctx, version); err != nil {
+		backup.logger.Errorw("Failed to delete old backups", "err", err)
+	}
 	return nil
 }
 
-func (backup *databaseBackup) runBackup(version string) (*backupResult, error) {
+func (backup *databaseBackup) runBackup(ctx context.Context, version string) (*backupResult, error) {
 	err := os.MkdirAll(backup.outputParentDir, os.ModePerm)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Failed to create directories on the path: %s", backup.outputParentDir)
@@ -159,73 +191,74 @@ func (backup *databaseBackup) runBackup(version string) (*backupResult, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to create a tmp file")
 	}
-	err = os.Remove(tmpFile.Name())
-	if err != nil {
-		return nil, errors.Wrap(err, "Failed to remove the tmp file before running backup")
-	}
+	defer os.Remove(tmpFile.Name())
 
-	args := []string{
-		backup.databaseURL.String(),
-		"-f", tmpFile.Name(),
-		"-F", "c", // format: custom (zipped)
+	if version == "" {
+		version = "unknown"
 	}
-
-	if backup.mode == config.DatabaseBackupModeLite {
-		for _, table := range excludedDataFromTables {
-			args = append(args, fmt.Sprintf("--exclude-table-data=%s", table))
-		}
+	header, err := backup.dumpTo(ctx, tmpFile, version)
+	if cerr := tmpFile.Close(); err == nil {
+		err = cerr
 	}
-
-	maskArgs := func(args []string) []string {
-		masked := make([]string, len(args))
-		copy(masked, args)
-		masked[0] = backup.databaseURL.Redacted()
-		return masked
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Stat(tmpFile.Name())
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to access the backup file")
 	}
 
-	maskedArgs := maskArgs(args)
-	backup.logger.Debugf("Running pg_dump with: %v", maskedArgs)
-
-	cmd := exec.Command(
-		"pg_dump", args...,
-	)
+	name := fmt.Sprintf(filePattern, version, header.CreatedAt.Format(timestampFormat))
+	if err = backup.destination.Put(ctx, name, tmpFile.Name()); err != nil {
+		return nil, errors.Wrapf(err, "Failed to store the backup at %s", backup.destination.Location(name))
+	}
 
-	_, err = cmd.Output()
+	return &backupResult{
+		size:     file.Size(),
+		name:     name,
+		location: backup.destination.Location(name),
+		header:   header,
+	}, nil
+}
 
+func (backup *databaseBackup) dumpTo(ctx context.Context, f *os.File, version string) (*Header, error) {
+	conn, err := pgx.Connect(ctx, backup.databaseURL.String())
 	if err != nil {
-		partialResult := &backupResult{
-			size:            0,
-			path:            "",
-			maskedArguments: maskedArgs,
-			pgDumpArguments: args,
-		}
-		var ee *exec.ExitError
-		if errors.As(err, &ee) {
-			return partialResult, errors.Wrapf(err, "pg_dump failed with output: %s", string(ee.Stderr))
-		}
-		return partialResult, errors.Wrap(err, "pg_dump failed")
+		return nil, errors.Wrapf(err, "failed to connect to %s", backup.databaseURL.Redacted())
 	}
+	defer conn.Close(context.Background()) //nolint:errcheck
 
-	if version == "" {
-		version = "unknown"
-	}
-	finalFilePath := filepath.Join(backup.outputParentDir, fmt.Sprintf(filePattern, version))
-	_ = os.Remove(finalFilePath)
-	err = os.Rename(tmpFile.Name(), finalFilePath)
+	w := bufio.NewWriter(f)
+	header, err := dump(ctx, conn, w, dumpOptions{nodeVersion: version, mode: backup.mode, password: backup.password})
 	if err != nil {
-		_ = os.Remove(tmpFile.Name())
-		return nil, errors.Wrap(err, "Failed to rename the temp file to the final backup file")
+		return nil, errors.Wrap(err, "database dump failed")
 	}
+	return header, w.Flush()
+}
 
-	file, err := os.Stat(finalFilePath)
+// prune deletes all but the newest generations of backups of version.
+func (backup *databaseBackup) prune(ctx context.Context, version string) error {
+	names, err := backup.destination.List(ctx)
 	if err != nil {
-		return nil, errors.Wrap(err, "Failed to access the final backup file")
+		return err
 	}
-
-	return &backupResult{
-		size:            file.Size(),
-		path:            finalFilePath,
-		maskedArguments: maskedArgs,
-		pgDumpArguments: args,
-	}, nil
+	prefix := strings.TrimSuffix(fmt.Sprintf(filePattern, version, ""), ".dump")
+	var backups []string
+	for _, name := range names {
+		if strings.HasPrefix(name, prefix) && strings.HasSuffix(name, ".dump") {
+			backups = append(backups, name)
+		}
+	}
+	if len(backups) <= backup.generations {
+		return nil
+	}
+	// timestamps sort chronologically
+	sort.Strings(backups)
+	for _, name := range backups[:len(backups)-backup.generations] {
+		backup.logger.Infow("Deleting old backup", "location", backup.destination.Location(name))
+		if err = backup.destination.Delete(ctx, name); err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -0,0 +1,211 @@
+package periodicbackup
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// chunkSize is the size of the plaintext chunks which are sealed separately, so that backups can be
+	// encrypted and decrypted as a stream.
+	chunkSize = 64 * 1024
+	// finalChunk is set on the length prefix of the last chunk, so that a truncated backup can be detected.
+	finalChunk = 1 << 31
+
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+	// maxScryptN bounds the cost of deriving the key of a backup, since the parameters are read from its
+	// header before it can be authenticated.
+	maxScryptN = 1 << 20
+)
+
+// ErrDecrypt is returned when a backup cannot be decrypted with the keystore password.
+var ErrDecrypt = errors.New("failed to decrypt backup: wrong keystore password, or the backup is corrupted")
+
+// encryption describes how the body of a backup is encrypted: with AES-256-GCM, in chunks, using a key derived
+// from the keystore password with scrypt. Every chunk is authenticated together with the header of the backup, so
+// that the header, which is not encrypted, cannot be modified either.
+type encryption struct {
+	Cipher string `json:"cipher"`
+	Salt   []byte `json:"salt"`
+	Nonce  []byte `json:"nonce"`
+	N      int    `json:"n"`
+	R      int    `json:"r"`
+	P      int    `json:"p"`
+}
+
+func newEncryption() (*encryption, error) {
+	e := &encryption{Cipher: "aes-256-gcm", Salt: make([]byte, 32), Nonce: make([]byte, 12), N: scryptN, R: scryptR, P: scryptP}
+	if _, err := rand.Read(e.Salt); err != nil {
+		return nil, err
+	}
+	if _, err := rand.Read(e.Nonce); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (e *encryption) aead(password string) (cipher.AEAD, error) {
+	if e.Cipher != "aes-256-gcm" {
+		return nil, errors.Errorf("unsupported cipher %q", e.Cipher)
+	}
+	if len(e.Nonce) != 12 {
+		return nil, errors.Errorf("invalid nonce length %d", len(e.Nonce))
+	}
+	if e.N > maxScryptN || e.R > scryptR || e.P > scryptP {
+		return nil, errors.Errorf("scrypt parameters N=%d, r=%d, p=%d exceed the supported cost", e.N, e.R, e.P)
+	}
+	key, err := scrypt.Key([]byte(password), e.Salt, e.N, e.R, e.P, 32)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to derive backup key")
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func (e *encryption) nonce(counter uint64) []byte {
+	nonce := make([]byte, len(e.Nonce))
+	copy(nonce, e.Nonce)
+	var c [8]byte
+	binary.BigEndian.PutUint64(c[:], counter)
+	for i := range c {
+		nonce[4+i] ^= c[i]
+	}
+	return nonce
+}
+
+// chunkAD returns the associated data of a chunk: the header of the backup, and whether the chunk is the last one.
+func chunkAD(header []byte, final bool) []byte {
+	ad := make([]byte, len(header)+1)
+	copy(ad, header)
+	if final {
+		ad[len(header)] = 1
+	}
+	return ad
+}
+
+type encryptWriter struct {
+	e       *encryption
+	aead    cipher.AEAD
+	header  []byte
+	w       io.Writer
+	buf     []byte
+	counter uint64
+}
+
+// newEncryptWriter returns a writer which encrypts to w, authenticating header with every chunk. Close must be
+// called to write the final chunk.
+func newEncryptWriter(w io.Writer, e *encryption, password string, header []byte) (io.WriteCloser, error) {
+	aead, err := e.aead(password)
+	if err != nil {
+		return nil, err
+	}
+	return &encryptWriter{e: e, aead: aead, header: header, w: w, buf: make([]byte, 0, 2*chunkSize)}, nil
+}
+
+func (w *encryptWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	// the last chunk is held back until Close, since it must be sealed as final
+	for len(w.buf) > chunkSize {
+		if err := w.seal(w.buf[:chunkSize], false); err != nil {
+			return 0, err
+		}
+		w.buf = append(w.buf[:0], w.buf[chunkSize:]...)
+	}
+	return len(p), nil
+}
+
+func (w *encryptWriter) Close() error {
+	return w.seal(w.buf, true)
+}
+
+func (w *encryptWriter) seal(chunk []byte, final bool) error {
+	sealed := w.aead.Seal(nil, w.e.nonce(w.counter), chunk, chunkAD(w.header, final))
+	w.counter++
+	prefix := uint32(len(sealed))
+	if final {
+		prefix |= finalChunk
+	}
+	var p [4]byte
+	binary.BigEndian.PutUint32(p[:], prefix)
+	if _, err := w.w.Write(p[:]); err != nil {
+		return err
+	}
+	_, err := w.w.Write(sealed)
+	return err
+}
+
+type decryptReader struct {
+	e       *encryption
+	aead    cipher.AEAD
+	header  []byte
+	r       io.Reader
+	buf     []byte
+	counter uint64
+	final   bool
+}
+
+// newDecryptReader returns a reader which decrypts r. It returns ErrDecrypt if any chunk fails to
+// authenticate, with header, and io.ErrUnexpectedEOF if r ends before the final chunk.
+func newDecryptReader(r io.Reader, e *encryption, password string, header []byte) (io.Reader, error) {
+	aead, err := e.aead(password)
+	if err != nil {
+		return nil, err
+	}
+	return &decryptReader{e: e, aead: aead, header: header, r: r}, nil
+}
+
+func (r *decryptReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.final {
+			return 0, io.EOF
+		}
+		if err := r.open(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func (r *decryptReader) open() error {
+	var p [4]byte
+	if _, err := io.ReadFull(r.r, p[:]); err != nil {
+		if errors.Is(err, io.EOF) {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	prefix := binary.BigEndian.Uint32(p[:])
+	final := prefix&finalChunk != 0
+	size := int(prefix &^ finalChunk)
+	if size > chunkSize+r.aead.Overhead() {
+		return ErrDecrypt
+	}
+	sealed := make([]byte, size)
+	if _, err := io.ReadFull(r.r, sealed); err != nil {
+		if errors.Is(err, io.EOF) {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	chunk, err := r.aead.Open(sealed[:0], r.e.nonce(r.counter), sealed, chunkAD(r.header, final))
+	if err != nil {
+		return ErrDecrypt
+	}
+	r.counter++
+	r.buf = chunk
+	r.final = final
+	return nil
+}
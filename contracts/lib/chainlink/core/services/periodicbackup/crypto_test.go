@@ -0,0 +1,80 @@
+package periodicbackup
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryption(t *testing.T) {
+	t.Parallel()
+
+	e, err := newEncryption()
+	require.NoError(t, err)
+	header := []byte(`{"tables":[["public","users"]]}`)
+	encrypt := func(t *testing.T, plaintext []byte) []byte {
+		var buf bytes.Buffer
+		w, err := newEncryptWriter(&buf, e, "password", header)
+		require.NoError(t, err)
+		_, err = w.Write(plaintext)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		return buf.Bytes()
+	}
+
+	for _, size := range []int{0, 1, chunkSize, chunkSize + 1, 3*chunkSize + 17} {
+		plaintext := make([]byte, size)
+		_, err = rand.Read(plaintext)
+		require.NoError(t, err)
+
+		r, err := newDecryptReader(bytes.NewReader(encrypt(t, plaintext)), e, "password", header)
+		require.NoError(t, err)
+		decrypted, err := io.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, plaintext, decrypted, "size %d", size)
+	}
+
+	ciphertext := encrypt(t, bytes.Repeat([]byte("backup"), chunkSize))
+
+	t.Run("wrong password", func(t *testing.T) {
+		r, err := newDecryptReader(bytes.NewReader(ciphertext), e, "wrong", header)
+		require.NoError(t, err)
+		_, err = io.ReadAll(r)
+		require.ErrorIs(t, err, ErrDecrypt)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		// drop the final chunk
+		r, err := newDecryptReader(bytes.NewReader(ciphertext[:4+chunkSize+16]), e, "password", header)
+		require.NoError(t, err)
+		_, err = io.ReadAll(r)
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	})
+
+	t.Run("modified header", func(t *testing.T) {
+		r, err := newDecryptReader(bytes.NewReader(ciphertext), e, "password", []byte(`{"tables":[]}`))
+		require.NoError(t, err)
+		_, err = io.ReadAll(r)
+		require.ErrorIs(t, err, ErrDecrypt)
+	})
+
+	t.Run("costly scrypt parameters", func(t *testing.T) {
+		costly := *e
+		costly.N = 1 << 30
+		_, err := newDecryptReader(bytes.NewReader(ciphertext), &costly, "password", header)
+		require.ErrorContains(t, err, "exceed the supported cost")
+	})
+
+	t.Run("modified", func(t *testing.T) {
+		modified := bytes.Clone(ciphertext)
+		modified[10] ^= 1
+		r, err := newDecryptReader(bytes.NewReader(modified), e, "password", header)
+		require.NoError(t, err)
+		_, err = io.ReadAll(r)
+		require.ErrorIs(t, err, ErrDecrypt)
+	})
+}
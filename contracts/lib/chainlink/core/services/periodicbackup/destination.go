@@ -0,0 +1,103 @@
+package periodicbackup
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+)
+
+// Destination stores backups by name.
+type Destination interface {
+	// Put stores the backup file at path as name. The file at path may be moved.
+	Put(ctx context.Context, name, path string) error
+	// Get copies the backup stored as name to a new file at path.
+	Get(ctx context.Context, name, path string) error
+	// List returns the names of the stored backups.
+	List(ctx context.Context) ([]string, error)
+	// Delete removes the backup stored as name.
+	Delete(ctx context.Context, name string) error
+	// Location describes where the backup stored as name is.
+	Location(name string) string
+}
+
+// NewDestination returns the destination configured by backupConfig. Local backups are stored in dir.
+func NewDestination(backupConfig BackupConfig, dir string) (Destination, error) {
+	switch d := backupConfig.Destination(); d {
+	case config.DatabaseBackupDestinationLocal:
+		return &localDestination{dir: dir}, nil
+	case config.DatabaseBackupDestinationS3:
+		return newS3Destination(backupConfig.S3(), newS3Client())
+	default:
+		return nil, errors.Errorf("unknown Database.Backup.Destination %q", d)
+	}
+}
+
+// OutputDir returns the directory backups are written to: Database.Backup.Dir, or the backup directory in rootDir.
+func OutputDir(rootDir string, backupConfig BackupConfig) (string, error) {
+	if backupConfig.Dir() == "" {
+		return filepath.Join(rootDir, "backup"), nil
+	}
+	dir, err := filepath.Abs(backupConfig.Dir())
+	if err != nil {
+		return "", errors.Errorf("failed to get path for Database.Backup.Dir (%s) - please set it to a valid directory path", backupConfig.Dir())
+	}
+	return dir, nil
+}
+
+type localDestination struct {
+	dir string
+}
+
+func (l *localDestination) Put(_ context.Context, name, path string) error {
+	return errors.Wrap(os.Rename(path, l.Location(name)), "failed to move the backup file to its final path")
+}
+
+func (l *localDestination) Get(_ context.Context, name, path string) error {
+	src, err := os.Open(l.Location(name))
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	return writeFile(path, src)
+}
+
+func (l *localDestination) List(context.Context) ([]string, error) {
+	entries, err := os.ReadDir(l.dir)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, e := range entries {
+		if e.Type().IsRegular() {
+			names = append(names, e.Name())
+		}
+	}
+	return names, nil
+}
+
+func (l *localDestination) Delete(_ context.Context, name string) error {
+	return os.Remove(l.Location(name))
+}
+
+func (l *localDestination) Location(name string) string {
+	return filepath.Join(l.dir, name)
+}
+
+func writeFile(path string, r io.Reader) (err error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+	}()
+	_, err = io.Copy(f, r)
+	return err
+}
@@ -0,0 +1,521 @@
+package periodicbackup
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+)
+
+// backupMagic starts every backup, followed by a line of JSON with its Header. The compressed, and optionally
+// encrypted, body follows, and the backup ends with the SHA-256 checksum of everything before it.
+const backupMagic = "CLBACKUP1\n"
+
+var (
+	// ErrChecksumMismatch is returned when a backup does not match its checksum.
+	ErrChecksumMismatch = errors.New("backup checksum mismatch: the backup is truncated or corrupted")
+	// ErrNotABackup is returned when a file is not a backup taken by the node.
+	ErrNotABackup = errors.New("not a Chainlink database backup")
+)
+
+// Header describes a backup. It is not encrypted, so that a backup can be inspected without the keystore password,
+// but it is authenticated with the body of encrypted backups.
+type Header struct {
+	NodeVersion string                    `json:"nodeVersion"`
+	Migration   int64                     `json:"migration"`
+	Mode        config.DatabaseBackupMode `json:"mode"`
+	CreatedAt   time.Time                 `json:"createdAt"`
+	// Tables are in the order they are restored in, with tables before the tables which reference them.
+	Tables     []pgx.Identifier `json:"tables"`
+	Encryption *encryption      `json:"encryption,omitempty"`
+}
+
+// Encrypted returns true if the body of the backup is encrypted with the keystore password.
+func (h *Header) Encrypted() bool {
+	return h.Encryption != nil
+}
+
+// entry starts a section of the body: either the rows of a table in COPY text format, terminated by a line
+// with `\.`, or the value of a sequence.
+type entry struct {
+	Table    pgx.Identifier `json:"table,omitempty"`
+	Columns  []string       `json:"columns,omitempty"`
+	Sequence pgx.Identifier `json:"sequence,omitempty"`
+	Value    int64          `json:"value,omitempty"`
+}
+
+const copyTerminator = "\\.\n"
+
+type table struct {
+	oid         uint32
+	id          pgx.Identifier
+	partitioned bool
+	columns     []string
+}
+
+type dumpOptions struct {
+	nodeVersion string
+	mode        config.DatabaseBackupMode
+	// password encrypts the backup if set.
+	password string
+}
+
+// dump writes a backup of the database to w, from a consistent snapshot.
+func dump(ctx context.Context, conn *pgx.Conn, w io.Writer, opts dumpOptions) (*Header, error) {
+	tx, err := conn.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to begin snapshot")
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	h := &Header{NodeVersion: opts.nodeVersion, Mode: opts.mode, CreatedAt: time.Now().UTC()}
+	if err = tx.QueryRow(ctx, `SELECT COALESCE(MAX(version_id), 0) FROM goose_migrations`).Scan(&h.Migration); err != nil {
+		return nil, errors.Wrap(err, "failed to get migration version")
+	}
+	tables, err := listTables(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tables {
+		h.Tables = append(h.Tables, t.id)
+	}
+	if opts.password != "" {
+		if h.Encryption, err = newEncryption(); err != nil {
+			return nil, err
+		}
+	}
+
+	hash := sha256.New()
+	out := io.MultiWriter(w, hash)
+	b, err := json.Marshal(h)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = fmt.Fprintf(out, "%s%s\n", backupMagic, b); err != nil {
+		return nil, err
+	}
+
+	body := io.WriteCloser(nopWriteCloser{out})
+	if h.Encrypted() {
+		if body, err = newEncryptWriter(out, h.Encryption, opts.password, b); err != nil {
+			return nil, err
+		}
+	}
+	gz := gzip.NewWriter(body)
+	for _, t := range tables {
+		if err = writeEntry(gz, entry{Table: t.id, Columns: t.columns}); err != nil {
+			return nil, err
+		}
+		if !(opts.mode == config.DatabaseBackupModeLite && excludeData(t.id)) {
+			if _, err = conn.PgConn().CopyTo(ctx, gz, t.copyTo()); err != nil {
+				return nil, errors.Wrapf(err, "failed to dump %s", t.id.Sanitize())
+			}
+		}
+		if _, err = io.WriteString(gz, copyTerminator); err != nil {
+			return nil, err
+		}
+	}
+	if err = dumpSequences(ctx, tx, gz); err != nil {
+		return nil, err
+	}
+	if err = gz.Close(); err != nil {
+		return nil, err
+	}
+	if err = body.Close(); err != nil {
+		return nil, err
+	}
+	if _, err = w.Write(hash.Sum(nil)); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+type nopWriteCloser struct{ io.Writer }
+
+func (nopWriteCloser) Close() error { return nil }
+
+func writeEntry(w io.Writer, e entry) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", b)
+	return err
+}
+
+func excludeData(id pgx.Identifier) bool {
+	if len(id) != 2 || id[0] != "public" {
+		return false
+	}
+	for _, name := range excludedDataFromTables {
+		if id[1] == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *table) columnList() string {
+	cols := make([]string, len(t.columns))
+	for i, c := range t.columns {
+		cols[i] = pgx.Identifier{c}.Sanitize()
+	}
+	return strings.Join(cols, ", ")
+}
+
+func (t *table) copyTo() string {
+	from := t.id.Sanitize()
+	if !t.partitioned {
+		// rows of inheriting tables are dumped with those tables
+		from = "ONLY " + from
+	}
+	return fmt.Sprintf(`COPY (SELECT %s FROM %s) TO STDOUT`, t.columnList(), from)
+}
+
+// listTables returns the tables of the database, excluding partitions, which are dumped through their
+// partitioned table, and the migrations table, which restores recreate by migrating. Tables are ordered so that
+// every table comes after the tables it references.
+func listTables(ctx context.Context, tx pgx.Tx) ([]*table, error) {
+	rows, err := tx.Query(ctx, `SELECT c.oid, n.nspname, c.relname, c.relkind = 'p'
+FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE c.relkind IN ('r', 'p') AND NOT c.relispartition
+AND n.nspname <> 'information_schema' AND n.nspname NOT LIKE 'pg\_%'
+AND NOT (n.nspname = 'public' AND c.relname = 'goose_migrations')
+ORDER BY n.nspname, c.relname`)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list tables")
+	}
+	var tables []*table
+	for rows.Next() {
+		t := &table{id: make(pgx.Identifier, 2)}
+		if err = rows.Scan(&t.oid, &t.id[0], &t.id[1], &t.partitioned); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		tables = append(tables, t)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to list tables")
+	}
+
+	for _, t := range tables {
+		// generated columns are computed again by the restore
+		rows, err = tx.Query(ctx, `SELECT column_name FROM information_schema.columns
+WHERE table_schema = $1 AND table_name = $2 AND is_generated = 'NEVER'
+ORDER BY ordinal_position`, t.id[0], t.id[1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list columns of %s", t.id.Sanitize())
+		}
+		for rows.Next() {
+			var column string
+			if err = rows.Scan(&column); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			t.columns = append(t.columns, column)
+		}
+		if err = rows.Err(); err != nil {
+			return nil, errors.Wrapf(err, "failed to list columns of %s", t.id.Sanitize())
+		}
+	}
+
+	references := map[uint32][]uint32{}
+	rows, err = tx.Query(ctx, `SELECT conrelid, confrelid FROM pg_constraint WHERE contype = 'f' AND conrelid <> confrelid`)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list foreign keys")
+	}
+	for rows.Next() {
+		var from, to uint32
+		if err = rows.Scan(&from, &to); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		references[from] = append(references[from], to)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to list foreign keys")
+	}
+	return sortTables(tables, references), nil
+}
+
+// sortTables orders tables so that every table comes after the tables it references, and otherwise keeps their
+// order. Tables which reference each other are ordered as they are found.
+func sortTables(tables []*table, references map[uint32][]uint32) []*table {
+	pending := map[uint32]bool{}
+	for _, t := range tables {
+		pending[t.oid] = true
+	}
+	sorted := make([]*table, 0, len(tables))
+	for len(sorted) < len(tables) {
+		progress := false
+		for _, t := range tables {
+			if !pending[t.oid] {
+				continue
+			}
+			ready := true
+			for _, ref := range references[t.oid] {
+				if pending[ref] {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				sorted = append(sorted, t)
+				delete(pending, t.oid)
+				progress = true
+			}
+		}
+		if !progress {
+			// a cycle: break it at the first table left
+			for _, t := range tables {
+				if pending[t.oid] {
+					sorted = append(sorted, t)
+					delete(pending, t.oid)
+					break
+				}
+			}
+		}
+	}
+	return sorted
+}
+
+func dumpSequences(ctx context.Context, tx pgx.Tx, w io.Writer) error {
+	// sequences which were never used are reset by the restore
+	rows, err := tx.Query(ctx, `SELECT schemaname, sequencename, last_value FROM pg_sequences
+WHERE last_value IS NOT NULL AND schemaname <> 'information_schema' AND schemaname NOT LIKE 'pg\_%'
+ORDER BY schemaname, sequencename`)
+	if err != nil {
+		return errors.Wrap(err, "failed to list sequences")
+	}
+	var entries []entry
+	for rows.Next() {
+		e := entry{Sequence: make(pgx.Identifier, 2)}
+		if err = rows.Scan(&e.Sequence[0], &e.Sequence[1], &e.Value); err != nil {
+			rows.Close()
+			return err
+		}
+		entries = append(entries, e)
+	}
+	if err = rows.Err(); err != nil {
+		return errors.Wrap(err, "failed to list sequences")
+	}
+	for _, e := range entries {
+		if err = writeEntry(w, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadHeader returns the header of the backup at path, without verifying its checksum.
+func ReadHeader(path string) (*Header, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	h, _, err := readHeader(bufio.NewReader(f))
+	return h, err
+}
+
+// readHeader returns the header read from r, and its JSON encoding as it was written.
+func readHeader(r *bufio.Reader) (*Header, []byte, error) {
+	magic := make([]byte, len(backupMagic))
+	if _, err := io.ReadFull(r, magic); err != nil || string(magic) != backupMagic {
+		return nil, nil, ErrNotABackup
+	}
+	line, err := r.ReadBytes('\n')
+	if err != nil {
+		return nil, nil, ErrNotABackup
+	}
+	line = bytes.TrimSuffix(line, []byte("\n"))
+	var h Header
+	if err = json.Unmarshal(line, &h); err != nil {
+		return nil, nil, errors.Wrap(err, "invalid backup header")
+	}
+	return &h, line, nil
+}
+
+// Verify checks the backup at path against its checksum, and returns its header.
+func Verify(path string) (*Header, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	size := info.Size() - sha256.Size
+	if size < int64(len(backupMagic)) {
+		return nil, ErrNotABackup
+	}
+	hash := sha256.New()
+	if _, err = io.Copy(hash, io.LimitReader(f, size)); err != nil {
+		return nil, err
+	}
+	sum := make([]byte, sha256.Size)
+	if _, err = io.ReadFull(f, sum); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(sum, hash.Sum(nil)) {
+		if _, err = ReadHeader(path); err != nil {
+			return nil, err
+		}
+		return nil, ErrChecksumMismatch
+	}
+	return ReadHeader(path)
+}
+
+// RestoreOptions are the checks a backup must pass before it is restored.
+type RestoreOptions struct {
+	// NodeVersion is the version of the node, which must have taken the backup.
+	NodeVersion string
+	// Migration is the migration version of the database, which the backup must have been taken at.
+	Migration int64
+	// Password is the keystore password, which decrypts encrypted backups.
+	Password string
+}
+
+// beginner is a *pgx.Conn, or a pgx.Tx, in which case the restore is applied in a savepoint.
+type beginner interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// Restore replaces the data of the database with the backup at path, in a single transaction. The backup is
+// verified against its checksum, and must have been taken by the same node version, at the same migration.
+func Restore(ctx context.Context, db beginner, path string, opts RestoreOptions) (*Header, error) {
+	h, err := Verify(path)
+	if err != nil {
+		return nil, err
+	}
+	if h.NodeVersion != opts.NodeVersion {
+		return nil, errors.Errorf("backup was taken by node version %s, but this node is version %s: restore it with the node version which took it", h.NodeVersion, opts.NodeVersion)
+	}
+	if h.Migration != opts.Migration {
+		return nil, errors.Errorf("backup was taken at migration %d, but the database is at migration %d", h.Migration, opts.Migration)
+	}
+	if h.Encrypted() && opts.Password == "" {
+		return nil, errors.New("backup is encrypted: the keystore password is required to restore it")
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	r := bufio.NewReader(io.LimitReader(f, info.Size()-sha256.Size))
+	_, header, err := readHeader(r)
+	if err != nil {
+		return nil, err
+	}
+	var body io.Reader = r
+	if h.Encrypted() {
+		if body, err = newDecryptReader(r, h.Encryption, opts.Password, header); err != nil {
+			return nil, err
+		}
+	}
+	gz, err := gzip.NewReader(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read backup")
+	}
+	defer gz.Close()
+
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+	if err = restore(ctx, tx, h, bufio.NewReader(gz)); err != nil {
+		return nil, err
+	}
+	return h, tx.Commit(ctx)
+}
+
+func restore(ctx context.Context, tx pgx.Tx, h *Header, r *bufio.Reader) error {
+	if _, err := tx.Exec(ctx, `SET CONSTRAINTS ALL DEFERRED`); err != nil {
+		return err
+	}
+	if len(h.Tables) > 0 {
+		tables := make([]string, len(h.Tables))
+		for i, id := range h.Tables {
+			tables[i] = id.Sanitize()
+		}
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`TRUNCATE TABLE %s RESTART IDENTITY CASCADE`, strings.Join(tables, ", "))); err != nil {
+			return errors.Wrap(err, "failed to truncate tables")
+		}
+	}
+	for {
+		line, err := r.ReadBytes('\n')
+		if errors.Is(err, io.EOF) && len(line) == 0 {
+			return nil
+		} else if err != nil {
+			return errors.Wrap(err, "failed to read backup")
+		}
+		var e entry
+		if err = json.Unmarshal(line, &e); err != nil {
+			return errors.Wrap(err, "invalid backup entry")
+		}
+		switch {
+		case len(e.Table) > 0:
+			t := table{id: e.Table, columns: e.Columns}
+			sql := fmt.Sprintf(`COPY %s (%s) FROM STDIN`, t.id.Sanitize(), t.columnList())
+			if _, err = tx.Conn().PgConn().CopyFrom(ctx, &copyReader{r: r}, sql); err != nil {
+				return errors.Wrapf(err, "failed to restore %s", t.id.Sanitize())
+			}
+		case len(e.Sequence) > 0:
+			if _, err = tx.Exec(ctx, `SELECT setval($1::regclass, $2, true)`, e.Sequence.Sanitize(), e.Value); err != nil {
+				return errors.Wrapf(err, "failed to restore sequence %s", e.Sequence.Sanitize())
+			}
+		default:
+			return errors.Errorf("invalid backup entry: %s", line)
+		}
+	}
+}
+
+// copyReader reads the rows of one table, up to the line which terminates them.
+type copyReader struct {
+	r    *bufio.Reader
+	line []byte
+	done bool
+}
+
+func (c *copyReader) Read(p []byte) (int, error) {
+	for len(c.line) == 0 {
+		if c.done {
+			return 0, io.EOF
+		}
+		line, err := c.r.ReadBytes('\n')
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return 0, io.ErrUnexpectedEOF
+			}
+			return 0, err
+		}
+		if string(line) == copyTerminator {
+			c.done = true
+			continue
+		}
+		c.line = line
+	}
+	n := copy(p, c.line)
+	c.line = c.line[n:]
+	return n, nil
+}
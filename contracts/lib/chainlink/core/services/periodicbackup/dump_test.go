@@ -0,0 +1,40 @@
+package periodicbackup
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_sortTables(t *testing.T) {
+	t.Parallel()
+
+	jobs := &table{oid: 1, id: pgx.Identifier{"public", "jobs"}}
+	keys := &table{oid: 2, id: pgx.Identifier{"evm", "key_states"}}
+	runs := &table{oid: 3, id: pgx.Identifier{"public", "pipeline_runs"}}
+	a := &table{oid: 4, id: pgx.Identifier{"public", "a"}}
+	b := &table{oid: 5, id: pgx.Identifier{"public", "b"}}
+	// runs references jobs, and a and b reference each other
+	sorted := sortTables([]*table{runs, a, b, jobs, keys}, map[uint32][]uint32{3: {1}, 4: {5}, 5: {4}})
+	assert.Equal(t, []*table{jobs, keys, runs, a, b}, sorted)
+}
+
+func Test_copyReader(t *testing.T) {
+	t.Parallel()
+
+	r := bufio.NewReader(strings.NewReader("1\tfoo\n2\t\\\\.\n\\.\n{\"sequence\":[\"public\",\"jobs_id_seq\"],\"value\":2}\n"))
+	rows, err := io.ReadAll(&copyReader{r: r})
+	require.NoError(t, err)
+	assert.Equal(t, "1\tfoo\n2\t\\\\.\n", string(rows))
+	rest, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "{\"sequence\":[\"public\",\"jobs_id_seq\"],\"value\":2}\n", string(rest))
+
+	_, err = io.ReadAll(&copyReader{r: bufio.NewReader(strings.NewReader("1\tfoo\n"))})
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
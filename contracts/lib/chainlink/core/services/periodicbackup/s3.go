@@ -0,0 +1,265 @@
+package periodicbackup
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+)
+
+const (
+	// unsignedPayload skips hashing uploads to sign them, since backups are checksummed themselves.
+	unsignedPayload  = "UNSIGNED-PAYLOAD"
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	amzDateFormat    = "20060102T150405Z"
+
+	// s3RequestTimeout bounds a whole request, including the transfer of the backup.
+	s3RequestTimeout = time.Hour
+	// s3ResponseTimeout bounds the wait for the response to a request, once it has been sent.
+	s3ResponseTimeout = time.Minute
+)
+
+// s3Destination stores backups in a bucket of an S3-compatible object store. Requests are signed with AWS
+// Signature Version 4, and objects are addressed path-style, which is supported by S3 and compatible stores.
+type s3Destination struct {
+	endpoint        url.URL
+	bucket          string
+	region          string
+	prefix          string
+	accessKeyID     string
+	secretAccessKey string
+	client          *http.Client
+	now             func() time.Time
+}
+
+// newS3Client returns the client for requests to S3, which does not wait forever on a stalled store.
+func newS3Client() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.ResponseHeaderTimeout = s3ResponseTimeout
+	return &http.Client{Transport: transport, Timeout: s3RequestTimeout}
+}
+
+func newS3Destination(cfg config.BackupS3, client *http.Client) (*s3Destination, error) {
+	if cfg.Endpoint() == nil || cfg.Bucket() == "" {
+		return nil, errors.New("Database.Backup.S3.Endpoint and Database.Backup.S3.Bucket must be set to upload backups to S3")
+	}
+	if cfg.AccessKeyID() == "" || cfg.SecretAccessKey() == "" {
+		return nil, errors.New("Database.BackupS3AccessKeyID and Database.BackupS3SecretAccessKey secrets must be set to upload backups to S3")
+	}
+	return &s3Destination{
+		endpoint:        *cfg.Endpoint(),
+		bucket:          cfg.Bucket(),
+		region:          cfg.Region(),
+		prefix:          cfg.Prefix(),
+		accessKeyID:     cfg.AccessKeyID(),
+		secretAccessKey: cfg.SecretAccessKey(),
+		client:          client,
+		now:             time.Now,
+	}, nil
+}
+
+func (d *s3Destination) Put(ctx context.Context, name, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, d.url(d.prefix+name, nil).String(), f)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = info.Size()
+	resp, err := d.do(req, unsignedPayload)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if err = os.Remove(path); err != nil {
+		return errors.Wrap(err, "failed to remove the uploaded backup file")
+	}
+	return nil
+}
+
+func (d *s3Destination) Get(ctx context.Context, name, path string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, d.url(d.prefix+name, nil).String(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := d.do(req, emptyPayloadHash)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return writeFile(path, resp.Body)
+}
+
+type listBucketResult struct {
+	Contents []struct {
+		Key string `xml:"Key"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+func (d *s3Destination) List(ctx context.Context) ([]string, error) {
+	var names []string
+	var token string
+	for {
+		query := url.Values{"list-type": {"2"}, "prefix": {d.prefix}}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, d.url("", query).String(), nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := d.do(req, emptyPayloadHash)
+		if err != nil {
+			return nil, err
+		}
+		var result listBucketResult
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decode S3 object list")
+		}
+		for _, c := range result.Contents {
+			names = append(names, strings.TrimPrefix(c.Key, d.prefix))
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return names, nil
+		}
+		token = result.NextContinuationToken
+	}
+}
+
+func (d *s3Destination) Delete(ctx context.Context, name string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, d.url(d.prefix+name, nil).String(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := d.do(req, emptyPayloadHash)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func (d *s3Destination) Location(name string) string {
+	return fmt.Sprintf("s3://%s/%s%s", d.bucket, d.prefix, name)
+}
+
+// url returns the path-style URL of key in the bucket, or of the bucket itself if key is empty. The path and
+// query are encoded as they are signed.
+func (d *s3Destination) url(key string, query url.Values) *url.URL {
+	u := d.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + d.bucket
+	if key != "" {
+		u.Path += "/" + key
+	}
+	u.RawPath = uriEncode(u.Path, false)
+	u.RawQuery = canonicalQuery(query)
+	return &u
+}
+
+func (d *s3Destination) do(req *http.Request, payloadHash string) (*http.Response, error) {
+	d.sign(req, payloadHash)
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "S3 %s request failed", req.Method)
+	}
+	if resp.StatusCode/100 != 2 {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, errors.Errorf("S3 %s %s failed with status %s: %s", req.Method, req.URL.Path, resp.Status, body)
+	}
+	return resp, nil
+}
+
+// sign adds an AWS Signature Version 4 Authorization header to req.
+func (d *s3Destination) sign(req *http.Request, payloadHash string) {
+	now := d.now().UTC()
+	amzDate := now.Format(amzDateFormat)
+	date := now.Format("20060102")
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	const signedHeaders = "host;x-amz-content-sha256;x-amz-date"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		"host:" + req.URL.Host,
+		"x-amz-content-sha256:" + payloadHash,
+		"x-amz-date:" + amzDate,
+		"",
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	scope := strings.Join([]string{date, d.region, "s3", "aws4_request"}, "/")
+	hash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, hex.EncodeToString(hash[:])}, "\n")
+
+	key := []byte("AWS4" + d.secretAccessKey)
+	for _, s := range []string{date, d.region, "s3", "aws4_request"} {
+		key = hmacSHA256(key, s)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s", d.accessKeyID, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// uriEncode encodes s as AWS Signature Version 4 requires: every byte except the unreserved characters is
+// percent-encoded, and slashes are kept unless encodeSlash is set.
+func uriEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9', c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var params []string
+	for _, k := range keys {
+		for _, v := range query[k] {
+			params = append(params, uriEncode(k, true)+"="+uriEncode(v, true))
+		}
+	}
+	return strings.Join(params, "&")
+}
@@ -45,6 +45,14 @@ func (t *BridgeTask) HelperSetDependencies(
 	t.specId = specId
 }
 
+func (t *BridgeTask) HelperSetResponseCache(responses *bridges.ResponseCache) {
+	t.responses = responses
+}
+
+func (t *BridgeTask) HelperSetHealthTracker(health *bridges.HealthTracker) {
+	t.health = health
+}
+
 func (t *HTTPTask) HelperSetDependencies(config Config, restrictedHTTPClient, unrestrictedHTTPClient *http.Client) {
 	t.config = config
 	t.httpClient = restrictedHTTPClient
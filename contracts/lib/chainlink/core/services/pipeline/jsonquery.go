@@ -0,0 +1,2032 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// JSONQuery is a compiled jq-style expression evaluated by the jsonquery task.
+//
+// The supported language is a deterministic subset of jq:
+//
+//	.  .foo  ."foo"  .[0]  .[-1]  .[1:3]  .[]  .foo?   paths, indexing, slicing and iteration
+//	a | b  a, b  a // b                                   pipes, multiple outputs and alternatives
+//	+ - * / %  == != < <= > >=  and or                    arithmetic, comparisons and logic
+//	[ ... ]  { key: value, "k": v, (expr): v, key }       array and object construction
+//	if c then a elif d then b else e end                  conditionals
+//	map(f) select(f) sort sort_by(f) length keys ...      builtin functions, see jqBuiltins
+//
+// Numbers are decimals, so arithmetic is exact and equal on all nodes. Objects are iterated in key order.
+type JSONQuery struct {
+	source string
+	root   jqNode
+}
+
+var (
+	ErrJSONQueryParse = errors.New("invalid jsonquery expression")
+	ErrJSONQueryEval  = errors.New("jsonquery evaluation failed")
+)
+
+const (
+	// jqMaxDepth bounds the nesting of expressions
+	jqMaxDepth = 64
+	// jqMaxOutputs bounds the number of values produced while evaluating an expression
+	jqMaxOutputs = 100_000
+	// jqMaxSteps bounds the number of expressions evaluated, the context is checked every jqCheckInterval steps
+	jqMaxSteps      = 10_000_000
+	jqCheckInterval = 1024
+	// jqMaxSize bounds the total size of the arrays, objects and strings built while evaluating an expression, counted
+	// in elements and bytes
+	jqMaxSize = 64 << 20
+	// jqMaxExponent bounds the exponent of numbers, rounding decimals with huge exponents is slow
+	jqMaxExponent = 1000
+)
+
+// ParseJSONQuery compiles a jsonquery expression.
+func ParseJSONQuery(source string) (*JSONQuery, error) {
+	tokens, err := jqLex(source)
+	if err != nil {
+		return nil, errors.Wrap(ErrJSONQueryParse, err.Error())
+	}
+	p := &jqParser{tokens: tokens}
+	root, err := p.parsePipe()
+	if err != nil {
+		return nil, errors.Wrap(ErrJSONQueryParse, err.Error())
+	}
+	if tok := p.peek(); tok.kind != jqEOF {
+		return nil, errors.Wrapf(ErrJSONQueryParse, "unexpected %q at offset %d", tok.text, tok.pos)
+	}
+	return &JSONQuery{source: source, root: root}, nil
+}
+
+func (q *JSONQuery) String() string {
+	return q.source
+}
+
+// Eval runs the query against input and returns every value it produces. Numbers in the input may be of any Go numeric
+// type or json.Number, numbers in the output are decimal.Decimal. Evaluation stops when ctx is done, or when the query
+// exceeds its budget of steps or of built values.
+func (q *JSONQuery) Eval(ctx context.Context, input interface{}) ([]interface{}, error) {
+	in, err := jqNormalize(input)
+	if err != nil {
+		return nil, errors.Wrap(ErrJSONQueryEval, err.Error())
+	}
+	env := &jqEnv{ctx: ctx}
+	outs, err := env.eval(q.root, in)
+	if err != nil {
+		return nil, errors.Wrap(ErrJSONQueryEval, err.Error())
+	}
+	return outs, nil
+}
+
+// jqNormalize converts input into the value types used by the evaluator: nil, bool, decimal.Decimal, string,
+// []interface{} and map[string]interface{}.
+func jqNormalize(v interface{}) (interface{}, error) {
+	switch t := v.(type) {
+	case nil, bool, string, decimal.Decimal:
+		return t, nil
+	case json.Number:
+		return jqParseNumber(t.String())
+	case float64:
+		return decimal.NewFromFloat(t), nil
+	case float32:
+		return decimal.NewFromFloat32(t), nil
+	case int:
+		return decimal.NewFromInt(int64(t)), nil
+	case int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		i, err := strconv.ParseInt(fmt.Sprint(t), 10, 64)
+		if err != nil {
+			d, err2 := decimal.NewFromString(fmt.Sprint(t))
+			return d, err2
+		}
+		return decimal.NewFromInt(i), nil
+	case *decimal.Decimal:
+		if t == nil {
+			return nil, nil
+		}
+		return *t, nil
+	case *big.Int:
+		if t == nil {
+			return nil, nil
+		}
+		return decimal.NewFromBigInt(t, 0), nil
+	case []byte:
+		return string(t), nil
+	case []interface{}:
+		out := make([]interface{}, len(t))
+		for i, e := range t {
+			n, err := jqNormalize(e)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = n
+		}
+		return out, nil
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(t))
+		for k, e := range t {
+			n, err := jqNormalize(e)
+			if err != nil {
+				return nil, err
+			}
+			out[k] = n
+		}
+		return out, nil
+	default:
+		return nil, errors.Errorf("unsupported input type %T", v)
+	}
+}
+
+func jqParseNumber(s string) (decimal.Decimal, error) {
+	d, err := decimal.NewFromString(s)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	if exp := d.Exponent(); exp > jqMaxExponent || exp < -jqMaxExponent {
+		return decimal.Decimal{}, errors.Errorf("number exponent %d is out of range", exp)
+	}
+	return d, nil
+}
+
+// jqDecodeJSON decodes a JSON document with exact numbers.
+func jqDecodeJSON(data []byte) (interface{}, error) {
+	var decoded interface{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	if err := d.Decode(&decoded); err != nil {
+		return nil, err
+	}
+	return jqNormalize(decoded)
+}
+
+// -- lexer --
+
+type jqTokenKind int
+
+const (
+	jqEOF jqTokenKind = iota
+	jqPunct
+	jqIdent
+	jqField
+	jqNumber
+	jqString
+)
+
+type jqToken struct {
+	kind jqTokenKind
+	text string
+	pos  int
+}
+
+var jqPuncts = []string{"//", "==", "!=", "<=", ">=", "|", ",", ".", "[", "]", "(", ")", "{", "}", ":", ";", "+", "-", "*", "/", "%", "<", ">", "?"}
+
+func jqLex(src string) ([]jqToken, error) {
+	var tokens []jqToken
+	i := 0
+	for i < len(src) {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '#':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case c == '"':
+			s, n, err := jqLexString(src[i:])
+			if err != nil {
+				return nil, errors.Wrapf(err, "at offset %d", i)
+			}
+			tokens = append(tokens, jqToken{kind: jqString, text: s, pos: i})
+			i += n
+		case c >= '0' && c <= '9':
+			start := i
+			for i < len(src) && (src[i] >= '0' && src[i] <= '9' || src[i] == '.') {
+				i++
+			}
+			if i < len(src) && (src[i] == 'e' || src[i] == 'E') {
+				i++
+				if i < len(src) && (src[i] == '+' || src[i] == '-') {
+					i++
+				}
+				for i < len(src) && src[i] >= '0' && src[i] <= '9' {
+					i++
+				}
+			}
+			tokens = append(tokens, jqToken{kind: jqNumber, text: src[start:i], pos: start})
+		case c == '.' && i+1 < len(src) && jqIsIdentStart(src[i+1]):
+			start := i
+			i++
+			for i < len(src) && jqIsIdentPart(src[i]) {
+				i++
+			}
+			tokens = append(tokens, jqToken{kind: jqField, text: src[start+1 : i], pos: start})
+		case jqIsIdentStart(c):
+			start := i
+			for i < len(src) && jqIsIdentPart(src[i]) {
+				i++
+			}
+			tokens = append(tokens, jqToken{kind: jqIdent, text: src[start:i], pos: start})
+		default:
+			matched := false
+			for _, p := range jqPuncts {
+				if strings.HasPrefix(src[i:], p) {
+					tokens = append(tokens, jqToken{kind: jqPunct, text: p, pos: i})
+					i += len(p)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, errors.Errorf("unexpected character %q at offset %d", c, i)
+			}
+		}
+	}
+	return append(tokens, jqToken{kind: jqEOF, pos: len(src)}), nil
+}
+
+func jqLexString(src string) (string, int, error) {
+	escaped := false
+	for i := 1; i < len(src); i++ {
+		switch {
+		case escaped:
+			escaped = false
+		case src[i] == '\\':
+			escaped = true
+		case src[i] == '"':
+			var s string
+			if err := json.Unmarshal([]byte(src[:i+1]), &s); err != nil {
+				return "", 0, errors.Wrap(err, "invalid string literal")
+			}
+			return s, i + 1, nil
+		}
+	}
+	return "", 0, errors.New("unterminated string literal")
+}
+
+func jqIsIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func jqIsIdentPart(c byte) bool {
+	return jqIsIdentStart(c) || (c >= '0' && c <= '9')
+}
+
+// -- parser --
+
+type jqParser struct {
+	tokens []jqToken
+	pos    int
+	depth  int
+}
+
+func (p *jqParser) peek() jqToken {
+	return p.tokens[p.pos]
+}
+
+func (p *jqParser) next() jqToken {
+	tok := p.tokens[p.pos]
+	if tok.kind != jqEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *jqParser) isPunct(text string) bool {
+	tok := p.peek()
+	return tok.kind == jqPunct && tok.text == text
+}
+
+func (p *jqParser) isKeyword(text string) bool {
+	tok := p.peek()
+	return tok.kind == jqIdent && tok.text == text
+}
+
+func (p *jqParser) expectPunct(text string) error {
+	if tok := p.next(); tok.kind != jqPunct || tok.text != text {
+		return errors.Errorf("expected %q at offset %d", text, tok.pos)
+	}
+	return nil
+}
+
+func (p *jqParser) expectKeyword(text string) error {
+	if tok := p.next(); tok.kind != jqIdent || tok.text != text {
+		return errors.Errorf("expected %q at offset %d", text, tok.pos)
+	}
+	return nil
+}
+
+func (p *jqParser) enter() error {
+	p.depth++
+	if p.depth > jqMaxDepth {
+		return errors.Errorf("expression nested deeper than %d", jqMaxDepth)
+	}
+	return nil
+}
+
+func (p *jqParser) leave() {
+	p.depth--
+}
+
+func (p *jqParser) parsePipe() (jqNode, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	defer p.leave()
+
+	left, err := p.parseComma()
+	if err != nil {
+		return nil, err
+	}
+	for p.isPunct("|") {
+		p.next()
+		right, err := p.parseComma()
+		if err != nil {
+			return nil, err
+		}
+		left = &jqPipe{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *jqParser) parseComma() (jqNode, error) {
+	left, err := p.parseAlt()
+	if err != nil {
+		return nil, err
+	}
+	for p.isPunct(",") {
+		p.next()
+		right, err := p.parseAlt()
+		if err != nil {
+			return nil, err
+		}
+		left = &jqComma{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *jqParser) parseAlt() (jqNode, error) {
+	left, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.isPunct("//") {
+		p.next()
+		// right associative
+		right, err := p.parseAlt()
+		if err != nil {
+			return nil, err
+		}
+		left = &jqAlt{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *jqParser) parseOr() (jqNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.isKeyword("or") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &jqLogic{and: false, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *jqParser) parseAnd() (jqNode, error) {
+	left, err := p.parseCompare()
+	if err != nil {
+		return nil, err
+	}
+	for p.isKeyword("and") {
+		p.next()
+		right, err := p.parseCompare()
+		if err != nil {
+			return nil, err
+		}
+		left = &jqLogic{and: true, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *jqParser) parseCompare() (jqNode, error) {
+	left, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	for _, op := range []string{"==", "!=", "<=", ">=", "<", ">"} {
+		if p.isPunct(op) {
+			p.next()
+			right, err := p.parseAdditive()
+			if err != nil {
+				return nil, err
+			}
+			return &jqBinary{op: op, left: left, right: right}, nil
+		}
+	}
+	return left, nil
+}
+
+func (p *jqParser) parseAdditive() (jqNode, error) {
+	left, err := p.parseMultiplicative()
+	if err != nil {
+		return nil, err
+	}
+	for p.isPunct("+") || p.isPunct("-") {
+		op := p.next().text
+		right, err := p.parseMultiplicative()
+		if err != nil {
+			return nil, err
+		}
+		left = &jqBinary{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *jqParser) parseMultiplicative() (jqNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.isPunct("*") || p.isPunct("/") || p.isPunct("%") {
+		op := p.next().text
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &jqBinary{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *jqParser) parseUnary() (jqNode, error) {
+	if p.isPunct("-") {
+		p.next()
+		if err := p.enter(); err != nil {
+			return nil, err
+		}
+		defer p.leave()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &jqNegate{operand: operand}, nil
+	}
+	return p.parsePostfix()
+}
+
+func (p *jqParser) parsePostfix() (jqNode, error) {
+	node, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch {
+		case p.peek().kind == jqField:
+			node = &jqIndex{target: node, key: &jqLiteral{value: p.next().text}}
+		case p.isPunct(".") && p.tokens[p.pos+1].kind == jqString:
+			p.next()
+			node = &jqIndex{target: node, key: &jqLiteral{value: p.next().text}}
+		case p.isPunct(".") && p.tokens[p.pos+1].kind == jqPunct && p.tokens[p.pos+1].text == "[":
+			p.next()
+		case p.isPunct("["):
+			node, err = p.parseBracketSuffix(node)
+			if err != nil {
+				return nil, err
+			}
+		case p.isPunct("?"):
+			p.next()
+			node = &jqTry{body: node}
+		default:
+			return node, nil
+		}
+	}
+}
+
+func (p *jqParser) parseBracketSuffix(target jqNode) (jqNode, error) {
+	if err := p.expectPunct("["); err != nil {
+		return nil, err
+	}
+	if p.isPunct("]") {
+		p.next()
+		return &jqIterate{target: target}, nil
+	}
+	var from, to jqNode
+	var err error
+	if !p.isPunct(":") {
+		if from, err = p.parsePipe(); err != nil {
+			return nil, err
+		}
+	}
+	if p.isPunct(":") {
+		p.next()
+		if !p.isPunct("]") {
+			if to, err = p.parsePipe(); err != nil {
+				return nil, err
+			}
+		}
+		if err = p.expectPunct("]"); err != nil {
+			return nil, err
+		}
+		return &jqSlice{target: target, from: from, to: to}, nil
+	}
+	if err = p.expectPunct("]"); err != nil {
+		return nil, err
+	}
+	return &jqIndex{target: target, key: from}, nil
+}
+
+func (p *jqParser) parsePrimary() (jqNode, error) {
+	tok := p.peek()
+	switch tok.kind {
+	case jqField:
+		p.next()
+		return &jqIndex{target: jqIdentity{}, key: &jqLiteral{value: tok.text}}, nil
+	case jqNumber:
+		p.next()
+		d, err := jqParseNumber(tok.text)
+		if err != nil {
+			return nil, errors.Errorf("invalid number %q at offset %d", tok.text, tok.pos)
+		}
+		return &jqLiteral{value: d}, nil
+	case jqString:
+		p.next()
+		return &jqLiteral{value: tok.text}, nil
+	case jqIdent:
+		return p.parseIdent()
+	case jqPunct:
+		switch tok.text {
+		case ".":
+			p.next()
+			if p.peek().kind == jqString {
+				return &jqIndex{target: jqIdentity{}, key: &jqLiteral{value: p.next().text}}, nil
+			}
+			return jqIdentity{}, nil
+		case "(":
+			p.next()
+			body, err := p.parsePipe()
+			if err != nil {
+				return nil, err
+			}
+			return body, p.expectPunct(")")
+		case "[":
+			p.next()
+			if p.isPunct("]") {
+				p.next()
+				return &jqArray{}, nil
+			}
+			body, err := p.parsePipe()
+			if err != nil {
+				return nil, err
+			}
+			return &jqArray{body: body}, p.expectPunct("]")
+		case "{":
+			return p.parseObject()
+		}
+	case jqEOF:
+		return nil, errors.New("unexpected end of expression")
+	}
+	return nil, errors.Errorf("unexpected %q at offset %d", tok.text, tok.pos)
+}
+
+func (p *jqParser) parseIdent() (jqNode, error) {
+	tok := p.next()
+	switch tok.text {
+	case "true":
+		return &jqLiteral{value: true}, nil
+	case "false":
+		return &jqLiteral{value: false}, nil
+	case "null":
+		return &jqLiteral{value: nil}, nil
+	case "if":
+		return p.parseIf()
+	case "and", "or", "then", "elif", "else", "end":
+		return nil, errors.Errorf("unexpected keyword %q at offset %d", tok.text, tok.pos)
+	}
+
+	var args []jqNode
+	if p.isPunct("(") {
+		p.next()
+		for {
+			arg, err := p.parsePipe()
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+			if !p.isPunct(";") {
+				break
+			}
+			p.next()
+		}
+		if err := p.expectPunct(")"); err != nil {
+			return nil, err
+		}
+	}
+	builtin, ok := jqBuiltins[jqFuncKey(tok.text, len(args))]
+	if !ok {
+		return nil, errors.Errorf("unknown function %s/%d at offset %d", tok.text, len(args), tok.pos)
+	}
+	return &jqCall{name: tok.text, fn: builtin, args: args}, nil
+}
+
+func (p *jqParser) parseIf() (jqNode, error) {
+	cond, err := p.parsePipe()
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expectKeyword("then"); err != nil {
+		return nil, err
+	}
+	then, err := p.parsePipe()
+	if err != nil {
+		return nil, err
+	}
+	node := &jqIf{cond: cond, then: then}
+	switch {
+	case p.isKeyword("elif"):
+		p.next()
+		if node.els, err = p.parseIf(); err != nil {
+			return nil, err
+		}
+		// the nested if consumed the shared "end"
+		return node, nil
+	case p.isKeyword("else"):
+		p.next()
+		if node.els, err = p.parsePipe(); err != nil {
+			return nil, err
+		}
+	}
+	return node, p.expectKeyword("end")
+}
+
+func (p *jqParser) parseObject() (jqNode, error) {
+	if err := p.expectPunct("{"); err != nil {
+		return nil, err
+	}
+	obj := &jqObject{}
+	for !p.isPunct("}") {
+		var entry jqObjectEntry
+		tok := p.next()
+		switch {
+		case tok.kind == jqIdent || tok.kind == jqString:
+			entry.key = &jqLiteral{value: tok.text}
+			if !p.isPunct(":") {
+				// {foo} is short for {foo: .foo}
+				entry.value = &jqIndex{target: jqIdentity{}, key: entry.key}
+			}
+		case tok.kind == jqPunct && tok.text == "(":
+			key, err := p.parsePipe()
+			if err != nil {
+				return nil, err
+			}
+			if err = p.expectPunct(")"); err != nil {
+				return nil, err
+			}
+			entry.key = key
+		default:
+			return nil, errors.Errorf("unexpected %q in object at offset %d", tok.text, tok.pos)
+		}
+		if entry.value == nil {
+			if err := p.expectPunct(":"); err != nil {
+				return nil, err
+			}
+			// values bind tighter than the separating comma
+			value, err := p.parseAlt()
+			if err != nil {
+				return nil, err
+			}
+			entry.value = value
+		}
+		obj.entries = append(obj.entries, entry)
+		if !p.isPunct(",") {
+			break
+		}
+		p.next()
+	}
+	return obj, p.expectPunct("}")
+}
+
+// -- evaluator --
+
+type jqEnv struct {
+	ctx     context.Context
+	outputs int
+	steps   int
+	size    int
+	// err is set once the budget of the query is exhausted, it cannot be caught by try or //
+	err error
+}
+
+// eval evaluates node, charging a step to the budget of the query.
+func (env *jqEnv) eval(node jqNode, in interface{}) ([]interface{}, error) {
+	if env.err != nil {
+		return nil, env.err
+	}
+	env.steps++
+	if env.steps > jqMaxSteps {
+		return nil, env.abort(errors.Errorf("expression took more than %d steps", jqMaxSteps))
+	}
+	if env.steps%jqCheckInterval == 0 {
+		if err := env.ctx.Err(); err != nil {
+			return nil, env.abort(err)
+		}
+	}
+	return node.eval(env, in)
+}
+
+func (env *jqEnv) abort(err error) error {
+	env.err = err
+	return err
+}
+
+func (env *jqEnv) emit(outs []interface{}) ([]interface{}, error) {
+	env.outputs += len(outs)
+	if env.outputs > jqMaxOutputs {
+		return nil, env.abort(errors.Errorf("expression produced more than %d values", jqMaxOutputs))
+	}
+	return outs, nil
+}
+
+// built charges the size of v, a value built by the query, to the budget of the query. Values are charged in full,
+// so nesting the same value repeatedly cannot build a value larger than the budget.
+func (env *jqEnv) built(v interface{}) (interface{}, error) {
+	env.size += jqSize(v, jqMaxSize-env.size)
+	if env.size > jqMaxSize {
+		return nil, env.abort(errors.Errorf("expression built values larger than %d", jqMaxSize))
+	}
+	return v, nil
+}
+
+// jqSize returns the number of elements and bytes of v, or a number above limit once it is exceeded.
+func jqSize(v interface{}, limit int) int {
+	switch t := v.(type) {
+	case string:
+		return len(t)
+	case []interface{}:
+		n := len(t)
+		for _, e := range t {
+			if n > limit {
+				break
+			}
+			n += jqSize(e, limit-n)
+		}
+		return n
+	case map[string]interface{}:
+		n := len(t)
+		for k, e := range t {
+			if n > limit {
+				break
+			}
+			n += len(k) + jqSize(e, limit-n)
+		}
+		return n
+	}
+	return 1
+}
+
+type jqNode interface {
+	eval(env *jqEnv, in interface{}) ([]interface{}, error)
+}
+
+type jqIdentity struct{}
+
+func (jqIdentity) eval(_ *jqEnv, in interface{}) ([]interface{}, error) {
+	return []interface{}{in}, nil
+}
+
+type jqLiteral struct {
+	value interface{}
+}
+
+func (n *jqLiteral) eval(_ *jqEnv, _ interface{}) ([]interface{}, error) {
+	return []interface{}{n.value}, nil
+}
+
+type jqPipe struct {
+	left, right jqNode
+}
+
+func (n *jqPipe) eval(env *jqEnv, in interface{}) ([]interface{}, error) {
+	lefts, err := env.eval(n.left, in)
+	if err != nil {
+		return nil, err
+	}
+	var outs []interface{}
+	for _, l := range lefts {
+		rights, err := env.eval(n.right, l)
+		if err != nil {
+			return nil, err
+		}
+		outs = append(outs, rights...)
+	}
+	return env.emit(outs)
+}
+
+type jqComma struct {
+	left, right jqNode
+}
+
+func (n *jqComma) eval(env *jqEnv, in interface{}) ([]interface{}, error) {
+	lefts, err := env.eval(n.left, in)
+	if err != nil {
+		return nil, err
+	}
+	rights, err := env.eval(n.right, in)
+	if err != nil {
+		return nil, err
+	}
+	return env.emit(append(lefts, rights...))
+}
+
+type jqAlt struct {
+	left, right jqNode
+}
+
+func (n *jqAlt) eval(env *jqEnv, in interface{}) ([]interface{}, error) {
+	// errors on the left are treated like false or null
+	lefts, _ := env.eval(n.left, in)
+	if env.err != nil {
+		return nil, env.err
+	}
+	var outs []interface{}
+	for _, l := range lefts {
+		if jqTruthy(l) {
+			outs = append(outs, l)
+		}
+	}
+	if len(outs) > 0 {
+		return outs, nil
+	}
+	return env.eval(n.right, in)
+}
+
+type jqTry struct {
+	body jqNode
+}
+
+func (n *jqTry) eval(env *jqEnv, in interface{}) ([]interface{}, error) {
+	outs, err := env.eval(n.body, in)
+	if err != nil {
+		return nil, env.err
+	}
+	return outs, nil
+}
+
+type jqLogic struct {
+	and         bool
+	left, right jqNode
+}
+
+func (n *jqLogic) eval(env *jqEnv, in interface{}) ([]interface{}, error) {
+	lefts, err := env.eval(n.left, in)
+	if err != nil {
+		return nil, err
+	}
+	var outs []interface{}
+	for _, l := range lefts {
+		// short circuit
+		if n.and && !jqTruthy(l) {
+			outs = append(outs, false)
+			continue
+		} else if !n.and && jqTruthy(l) {
+			outs = append(outs, true)
+			continue
+		}
+		rights, err := env.eval(n.right, in)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range rights {
+			outs = append(outs, jqTruthy(r))
+		}
+	}
+	return env.emit(outs)
+}
+
+type jqBinary struct {
+	op          string
+	left, right jqNode
+}
+
+func (n *jqBinary) eval(env *jqEnv, in interface{}) ([]interface{}, error) {
+	rights, err := env.eval(n.right, in)
+	if err != nil {
+		return nil, err
+	}
+	lefts, err := env.eval(n.left, in)
+	if err != nil {
+		return nil, err
+	}
+	var outs []interface{}
+	for _, r := range rights {
+		for _, l := range lefts {
+			v, err := jqApplyBinary(n.op, l, r)
+			if err != nil {
+				return nil, err
+			}
+			if v, err = env.built(v); err != nil {
+				return nil, err
+			}
+			outs = append(outs, v)
+		}
+	}
+	return env.emit(outs)
+}
+
+func jqApplyBinary(op string, l, r interface{}) (interface{}, error) {
+	switch op {
+	case "==":
+		return jqCompare(l, r) == 0, nil
+	case "!=":
+		return jqCompare(l, r) != 0, nil
+	case "<":
+		return jqCompare(l, r) < 0, nil
+	case "<=":
+		return jqCompare(l, r) <= 0, nil
+	case ">":
+		return jqCompare(l, r) > 0, nil
+	case ">=":
+		return jqCompare(l, r) >= 0, nil
+	case "+":
+		return jqAdd(l, r)
+	}
+
+	ld, lok := l.(decimal.Decimal)
+	rd, rok := r.(decimal.Decimal)
+	switch op {
+	case "-":
+		if lok && rok {
+			return ld.Sub(rd), nil
+		}
+		if la, ok := l.([]interface{}); ok {
+			if ra, ok := r.([]interface{}); ok {
+				out := []interface{}{}
+				for _, e := range la {
+					if !jqContainsValue(ra, e) {
+						out = append(out, e)
+					}
+				}
+				return out, nil
+			}
+		}
+	case "*":
+		if lok && rok {
+			return ld.Mul(rd), nil
+		}
+	case "/":
+		if lok && rok {
+			if rd.IsZero() {
+				return nil, errors.New("division by zero")
+			}
+			return ld.Div(rd), nil
+		}
+		if ls, ok := l.(string); ok {
+			if rs, ok := r.(string); ok {
+				return jqSplit(ls, rs), nil
+			}
+		}
+	case "%":
+		if lok && rok {
+			// like jq, the operands are truncated to integers
+			li, ri := ld.Truncate(0), rd.Truncate(0)
+			if ri.IsZero() {
+				return nil, errors.New("modulo by zero")
+			}
+			return li.Mod(ri), nil
+		}
+	}
+	return nil, errors.Errorf("%s (%s) and %s (%s) cannot be combined with %s", jqTypeOf(l), jqShort(l), jqTypeOf(r), jqShort(r), op)
+}
+
+func jqAdd(l, r interface{}) (interface{}, error) {
+	if l == nil {
+		return r, nil
+	}
+	if r == nil {
+		return l, nil
+	}
+	switch lv := l.(type) {
+	case decimal.Decimal:
+		if rv, ok := r.(decimal.Decimal); ok {
+			return lv.Add(rv), nil
+		}
+	case string:
+		if rv, ok := r.(string); ok {
+			return lv + rv, nil
+		}
+	case []interface{}:
+		if rv, ok := r.([]interface{}); ok {
+			out := make([]interface{}, 0, len(lv)+len(rv))
+			return append(append(out, lv...), rv...), nil
+		}
+	case map[string]interface{}:
+		if rv, ok := r.(map[string]interface{}); ok {
+			out := make(map[string]interface{}, len(lv)+len(rv))
+			for k, v := range lv {
+				out[k] = v
+			}
+			for k, v := range rv {
+				out[k] = v
+			}
+			return out, nil
+		}
+	}
+	return nil, errors.Errorf("%s (%s) and %s (%s) cannot be added", jqTypeOf(l), jqShort(l), jqTypeOf(r), jqShort(r))
+}
+
+type jqNegate struct {
+	operand jqNode
+}
+
+func (n *jqNegate) eval(env *jqEnv, in interface{}) ([]interface{}, error) {
+	vals, err := env.eval(n.operand, in)
+	if err != nil {
+		return nil, err
+	}
+	outs := make([]interface{}, len(vals))
+	for i, v := range vals {
+		d, ok := v.(decimal.Decimal)
+		if !ok {
+			return nil, errors.Errorf("%s (%s) cannot be negated", jqTypeOf(v), jqShort(v))
+		}
+		outs[i] = d.Neg()
+	}
+	return outs, nil
+}
+
+type jqIndex struct {
+	target jqNode
+	key    jqNode
+}
+
+func (n *jqIndex) eval(env *jqEnv, in interface{}) ([]interface{}, error) {
+	targets, err := env.eval(n.target, in)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := env.eval(n.key, in)
+	if err != nil {
+		return nil, err
+	}
+	var outs []interface{}
+	for _, t := range targets {
+		for _, k := range keys {
+			v, err := jqIndexValue(t, k)
+			if err != nil {
+				return nil, err
+			}
+			outs = append(outs, v)
+		}
+	}
+	return env.emit(outs)
+}
+
+func jqIndexValue(t, k interface{}) (interface{}, error) {
+	switch tv := t.(type) {
+	case nil:
+		switch k.(type) {
+		case string, decimal.Decimal, nil:
+			return nil, nil
+		}
+	case map[string]interface{}:
+		if ks, ok := k.(string); ok {
+			return tv[ks], nil
+		}
+	case []interface{}:
+		if kd, ok := k.(decimal.Decimal); ok {
+			i := kd.Floor()
+			if i.IsNegative() {
+				i = i.Add(decimal.NewFromInt(int64(len(tv))))
+			}
+			if i.IsNegative() || i.GreaterThanOrEqual(decimal.NewFromInt(int64(len(tv)))) {
+				return nil, nil
+			}
+			return tv[i.IntPart()], nil
+		}
+	}
+	return nil, errors.Errorf("cannot index %s with %s", jqTypeOf(t), jqShort(k))
+}
+
+type jqSlice struct {
+	target   jqNode
+	from, to jqNode
+}
+
+func (n *jqSlice) eval(env *jqEnv, in interface{}) ([]interface{}, error) {
+	targets, err := env.eval(n.target, in)
+	if err != nil {
+		return nil, err
+	}
+	froms, tos := []interface{}{nil}, []interface{}{nil}
+	if n.from != nil {
+		if froms, err = env.eval(n.from, in); err != nil {
+			return nil, err
+		}
+	}
+	if n.to != nil {
+		if tos, err = env.eval(n.to, in); err != nil {
+			return nil, err
+		}
+	}
+	var outs []interface{}
+	for _, t := range targets {
+		for _, to := range tos {
+			for _, from := range froms {
+				v, err := jqSliceValue(t, from, to)
+				if err != nil {
+					return nil, err
+				}
+				outs = append(outs, v)
+			}
+		}
+	}
+	return env.emit(outs)
+}
+
+func jqSliceValue(t, from, to interface{}) (interface{}, error) {
+	var length int
+	switch tv := t.(type) {
+	case nil:
+		return nil, nil
+	case []interface{}:
+		length = len(tv)
+	case string:
+		length = utf8.RuneCountInString(tv)
+	default:
+		return nil, errors.Errorf("cannot slice %s", jqTypeOf(t))
+	}
+	bound := func(v interface{}, def int) (int, error) {
+		if v == nil {
+			return def, nil
+		}
+		d, ok := v.(decimal.Decimal)
+		if !ok {
+			return 0, errors.Errorf("slice indices must be numbers, got %s", jqTypeOf(v))
+		}
+		d = d.Floor()
+		if d.IsNegative() {
+			d = d.Add(decimal.NewFromInt(int64(length)))
+		}
+		switch {
+		case d.IsNegative():
+			return 0, nil
+		case d.GreaterThan(decimal.NewFromInt(int64(length))):
+			return length, nil
+		}
+		return int(d.IntPart()), nil
+	}
+	start, err := bound(from, 0)
+	if err != nil {
+		return nil, err
+	}
+	end, err := bound(to, length)
+	if err != nil {
+		return nil, err
+	}
+	if end < start {
+		end = start
+	}
+	if s, ok := t.(string); ok {
+		return string([]rune(s)[start:end]), nil
+	}
+	return append([]interface{}{}, t.([]interface{})[start:end]...), nil
+}
+
+type jqIterate struct {
+	target jqNode
+}
+
+func (n *jqIterate) eval(env *jqEnv, in interface{}) ([]interface{}, error) {
+	targets, err := env.eval(n.target, in)
+	if err != nil {
+		return nil, err
+	}
+	var outs []interface{}
+	for _, t := range targets {
+		vals, err := jqValues(t)
+		if err != nil {
+			return nil, err
+		}
+		outs = append(outs, vals...)
+	}
+	return env.emit(outs)
+}
+
+// jqValues returns the elements of an array, or the values of an object ordered by key
+func jqValues(v interface{}) ([]interface{}, error) {
+	switch t := v.(type) {
+	case []interface{}:
+		return t, nil
+	case map[string]interface{}:
+		keys := jqSortedKeys(t)
+		vals := make([]interface{}, len(keys))
+		for i, k := range keys {
+			vals[i] = t[k]
+		}
+		return vals, nil
+	}
+	return nil, errors.Errorf("cannot iterate over %s (%s)", jqTypeOf(v), jqShort(v))
+}
+
+type jqArray struct {
+	body jqNode
+}
+
+func (n *jqArray) eval(env *jqEnv, in interface{}) ([]interface{}, error) {
+	if n.body == nil {
+		return []interface{}{[]interface{}{}}, nil
+	}
+	vals, err := env.eval(n.body, in)
+	if err != nil {
+		return nil, err
+	}
+	arr, err := env.built(append([]interface{}{}, vals...))
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{arr}, nil
+}
+
+type jqObjectEntry struct {
+	key, value jqNode
+}
+
+type jqObject struct {
+	entries []jqObjectEntry
+}
+
+func (n *jqObject) eval(env *jqEnv, in interface{}) ([]interface{}, error) {
+	// each entry producing several values multiplies the objects produced
+	objs := []map[string]interface{}{{}}
+	for _, entry := range n.entries {
+		keys, err := env.eval(entry.key, in)
+		if err != nil {
+			return nil, err
+		}
+		vals, err := env.eval(entry.value, in)
+		if err != nil {
+			return nil, err
+		}
+		var next []map[string]interface{}
+		for _, obj := range objs {
+			for _, k := range keys {
+				ks, ok := k.(string)
+				if !ok {
+					return nil, errors.Errorf("object keys must be strings, got %s", jqTypeOf(k))
+				}
+				for _, v := range vals {
+					cp := make(map[string]interface{}, len(obj)+1)
+					for ok, ov := range obj {
+						cp[ok] = ov
+					}
+					cp[ks] = v
+					next = append(next, cp)
+				}
+			}
+		}
+		if len(next) > jqMaxOutputs {
+			return nil, env.abort(errors.Errorf("expression produced more than %d values", jqMaxOutputs))
+		}
+		objs = next
+	}
+	outs := make([]interface{}, len(objs))
+	for i, obj := range objs {
+		var err error
+		if outs[i], err = env.built(obj); err != nil {
+			return nil, err
+		}
+	}
+	return env.emit(outs)
+}
+
+type jqIf struct {
+	cond, then, els jqNode
+}
+
+func (n *jqIf) eval(env *jqEnv, in interface{}) ([]interface{}, error) {
+	conds, err := env.eval(n.cond, in)
+	if err != nil {
+		return nil, err
+	}
+	var outs []interface{}
+	for _, c := range conds {
+		branch := n.then
+		if !jqTruthy(c) {
+			branch = n.els
+		}
+		if branch == nil {
+			outs = append(outs, in)
+			continue
+		}
+		vals, err := env.eval(branch, in)
+		if err != nil {
+			return nil, err
+		}
+		outs = append(outs, vals...)
+	}
+	return env.emit(outs)
+}
+
+type jqCall struct {
+	name string
+	fn   jqBuiltin
+	args []jqNode
+}
+
+func (n *jqCall) eval(env *jqEnv, in interface{}) ([]interface{}, error) {
+	outs, err := n.fn(env, in, n.args)
+	if err != nil {
+		return nil, errors.Wrap(err, n.name)
+	}
+	return env.emit(outs)
+}
+
+// -- values --
+
+func jqTruthy(v interface{}) bool {
+	return v != nil && v != false
+}
+
+func jqTypeOf(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case decimal.Decimal:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+// jqShort returns a truncated JSON representation of v for error messages
+func jqShort(v interface{}) string {
+	s, err := jqToJSON(v)
+	if err != nil {
+		return jqTypeOf(v)
+	}
+	if len(s) > 32 {
+		return s[:29] + "..."
+	}
+	return s
+}
+
+func jqTypeRank(v interface{}) int {
+	switch t := v.(type) {
+	case nil:
+		return 0
+	case bool:
+		if t {
+			return 2
+		}
+		return 1
+	case decimal.Decimal:
+		return 3
+	case string:
+		return 4
+	case []interface{}:
+		return 5
+	case map[string]interface{}:
+		return 6
+	}
+	return 7
+}
+
+// jqCompare orders values like jq: null < false < true < numbers < strings < arrays < objects
+func jqCompare(l, r interface{}) int {
+	lr, rr := jqTypeRank(l), jqTypeRank(r)
+	if lr != rr {
+		if lr < rr {
+			return -1
+		}
+		return 1
+	}
+	switch lv := l.(type) {
+	case decimal.Decimal:
+		return lv.Cmp(r.(decimal.Decimal))
+	case string:
+		return strings.Compare(lv, r.(string))
+	case []interface{}:
+		rv := r.([]interface{})
+		for i := 0; i < len(lv) && i < len(rv); i++ {
+			if c := jqCompare(lv[i], rv[i]); c != 0 {
+				return c
+			}
+		}
+		return jqCompareInts(len(lv), len(rv))
+	case map[string]interface{}:
+		rv := r.(map[string]interface{})
+		lk, rk := jqSortedKeys(lv), jqSortedKeys(rv)
+		for i := 0; i < len(lk) && i < len(rk); i++ {
+			if c := strings.Compare(lk[i], rk[i]); c != 0 {
+				return c
+			}
+		}
+		if c := jqCompareInts(len(lk), len(rk)); c != 0 {
+			return c
+		}
+		for _, k := range lk {
+			if c := jqCompare(lv[k], rv[k]); c != 0 {
+				return c
+			}
+		}
+	}
+	return 0
+}
+
+func jqCompareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func jqSortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func jqContainsValue(arr []interface{}, v interface{}) bool {
+	for _, e := range arr {
+		if jqCompare(e, v) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func jqSplit(s, sep string) []interface{} {
+	if s == "" {
+		return []interface{}{}
+	}
+	var parts []string
+	if sep == "" {
+		parts = strings.Split(s, "")
+	} else {
+		parts = strings.Split(s, sep)
+	}
+	out := make([]interface{}, len(parts))
+	for i, p := range parts {
+		out[i] = p
+	}
+	return out
+}
+
+// jqToJSON encodes v with sorted object keys and numbers in their exact decimal form
+func jqToJSON(v interface{}) (string, error) {
+	b, err := json.Marshal(jqDenormalize(v))
+	return string(b), err
+}
+
+// jqDenormalize converts evaluator values into plain Go values, with numbers as json.Number
+func jqDenormalize(v interface{}) interface{} {
+	switch t := v.(type) {
+	case decimal.Decimal:
+		return json.Number(t.String())
+	case []interface{}:
+		out := make([]interface{}, len(t))
+		for i, e := range t {
+			out[i] = jqDenormalize(e)
+		}
+		return out
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(t))
+		for k, e := range t {
+			out[k] = jqDenormalize(e)
+		}
+		return out
+	}
+	return v
+}
+
+// -- builtins --
+
+type jqBuiltin func(env *jqEnv, in interface{}, args []jqNode) ([]interface{}, error)
+
+func jqFuncKey(name string, arity int) string {
+	return name + "/" + strconv.Itoa(arity)
+}
+
+// jqBuiltins are the functions available to jsonquery expressions, keyed by name/arity
+var jqBuiltins map[string]jqBuiltin
+
+func init() {
+	one := func(f func(in interface{}) (interface{}, error)) jqBuiltin {
+		return func(_ *jqEnv, in interface{}, _ []jqNode) ([]interface{}, error) {
+			v, err := f(in)
+			if err != nil {
+				return nil, err
+			}
+			return []interface{}{v}, nil
+		}
+	}
+	number := func(f func(d decimal.Decimal) decimal.Decimal) jqBuiltin {
+		return one(func(in interface{}) (interface{}, error) {
+			d, ok := in.(decimal.Decimal)
+			if !ok {
+				return nil, errors.Errorf("%s (%s) is not a number", jqTypeOf(in), jqShort(in))
+			}
+			return f(d), nil
+		})
+	}
+	array := func(f func(arr []interface{}) (interface{}, error)) jqBuiltin {
+		return one(func(in interface{}) (interface{}, error) {
+			arr, ok := in.([]interface{})
+			if !ok {
+				return nil, errors.Errorf("%s (%s) is not an array", jqTypeOf(in), jqShort(in))
+			}
+			return f(arr)
+		})
+	}
+	str := func(f func(s string) interface{}) jqBuiltin {
+		return one(func(in interface{}) (interface{}, error) {
+			s, ok := in.(string)
+			if !ok {
+				return nil, errors.Errorf("%s (%s) is not a string", jqTypeOf(in), jqShort(in))
+			}
+			return f(s), nil
+		})
+	}
+	// strArg evaluates a string argument once per output and applies f
+	strArg := func(f func(in interface{}, arg string) (interface{}, error)) jqBuiltin {
+		return func(env *jqEnv, in interface{}, args []jqNode) ([]interface{}, error) {
+			argVals, err := env.eval(args[0], in)
+			if err != nil {
+				return nil, err
+			}
+			outs := make([]interface{}, 0, len(argVals))
+			for _, a := range argVals {
+				s, ok := a.(string)
+				if !ok {
+					return nil, errors.Errorf("argument %s (%s) is not a string", jqTypeOf(a), jqShort(a))
+				}
+				v, err := f(in, s)
+				if err != nil {
+					return nil, err
+				}
+				if v, err = env.built(v); err != nil {
+					return nil, err
+				}
+				outs = append(outs, v)
+			}
+			return outs, nil
+		}
+	}
+	sortBy := func(env *jqEnv, arr []interface{}, f jqNode) ([]interface{}, [][]interface{}, error) {
+		keys := make([][]interface{}, len(arr))
+		for i, e := range arr {
+			k, err := env.eval(f, e)
+			if err != nil {
+				return nil, nil, err
+			}
+			keys[i] = k
+		}
+		idx := make([]int, len(arr))
+		for i := range idx {
+			idx[i] = i
+		}
+		sort.SliceStable(idx, func(i, j int) bool {
+			return jqCompare(keys[idx[i]], keys[idx[j]]) < 0
+		})
+		sorted := make([]interface{}, len(arr))
+		sortedKeys := make([][]interface{}, len(arr))
+		for i, j := range idx {
+			sorted[i], sortedKeys[i] = arr[j], keys[j]
+		}
+		return sorted, sortedKeys, nil
+	}
+	extreme := func(max bool) func(arr []interface{}) (interface{}, error) {
+		return func(arr []interface{}) (interface{}, error) {
+			var best interface{}
+			for i, e := range arr {
+				if c := jqCompare(e, best); i == 0 || (max && c >= 0) || (!max && c < 0) {
+					best = e
+				}
+			}
+			return best, nil
+		}
+	}
+
+	jqBuiltins = map[string]jqBuiltin{
+		"empty/0": func(_ *jqEnv, _ interface{}, _ []jqNode) ([]interface{}, error) {
+			return nil, nil
+		},
+		"not/0": one(func(in interface{}) (interface{}, error) {
+			return !jqTruthy(in), nil
+		}),
+		"type/0": one(func(in interface{}) (interface{}, error) {
+			return jqTypeOf(in), nil
+		}),
+		"length/0": one(func(in interface{}) (interface{}, error) {
+			switch t := in.(type) {
+			case nil:
+				return decimal.Zero, nil
+			case decimal.Decimal:
+				return t.Abs(), nil
+			case string:
+				return decimal.NewFromInt(int64(utf8.RuneCountInString(t))), nil
+			case []interface{}:
+				return decimal.NewFromInt(int64(len(t))), nil
+			case map[string]interface{}:
+				return decimal.NewFromInt(int64(len(t))), nil
+			}
+			return nil, errors.Errorf("%s (%s) has no length", jqTypeOf(in), jqShort(in))
+		}),
+		"keys/0": one(func(in interface{}) (interface{}, error) {
+			switch t := in.(type) {
+			case map[string]interface{}:
+				keys := jqSortedKeys(t)
+				out := make([]interface{}, len(keys))
+				for i, k := range keys {
+					out[i] = k
+				}
+				return out, nil
+			case []interface{}:
+				out := make([]interface{}, len(t))
+				for i := range t {
+					out[i] = decimal.NewFromInt(int64(i))
+				}
+				return out, nil
+			}
+			return nil, errors.Errorf("%s (%s) has no keys", jqTypeOf(in), jqShort(in))
+		}),
+		"has/1": func(env *jqEnv, in interface{}, args []jqNode) ([]interface{}, error) {
+			keys, err := env.eval(args[0], in)
+			if err != nil {
+				return nil, err
+			}
+			outs := make([]interface{}, len(keys))
+			for i, k := range keys {
+				switch t := in.(type) {
+				case map[string]interface{}:
+					ks, ok := k.(string)
+					if !ok {
+						return nil, errors.Errorf("cannot check whether object has a key of type %s", jqTypeOf(k))
+					}
+					_, outs[i] = t[ks]
+				case []interface{}:
+					kd, ok := k.(decimal.Decimal)
+					if !ok {
+						return nil, errors.Errorf("cannot check whether array has a key of type %s", jqTypeOf(k))
+					}
+					outs[i] = !kd.IsNegative() && kd.LessThan(decimal.NewFromInt(int64(len(t))))
+				default:
+					return nil, errors.Errorf("cannot check whether %s has a key", jqTypeOf(in))
+				}
+			}
+			return outs, nil
+		},
+		"map/1": func(env *jqEnv, in interface{}, args []jqNode) ([]interface{}, error) {
+			vals, err := jqValues(in)
+			if err != nil {
+				return nil, err
+			}
+			out := []interface{}{}
+			for _, v := range vals {
+				mapped, err := env.eval(args[0], v)
+				if err != nil {
+					return nil, err
+				}
+				out = append(out, mapped...)
+			}
+			built, err := env.built(out)
+			if err != nil {
+				return nil, err
+			}
+			return []interface{}{built}, nil
+		},
+		"select/1": func(env *jqEnv, in interface{}, args []jqNode) ([]interface{}, error) {
+			conds, err := env.eval(args[0], in)
+			if err != nil {
+				return nil, err
+			}
+			var outs []interface{}
+			for _, c := range conds {
+				if jqTruthy(c) {
+					outs = append(outs, in)
+				}
+			}
+			return outs, nil
+		},
+		"sort/0": array(func(arr []interface{}) (interface{}, error) {
+			out := append([]interface{}{}, arr...)
+			sort.SliceStable(out, func(i, j int) bool { return jqCompare(out[i], out[j]) < 0 })
+			return out, nil
+		}),
+		"sort_by/1": func(env *jqEnv, in interface{}, args []jqNode) ([]interface{}, error) {
+			arr, ok := in.([]interface{})
+			if !ok {
+				return nil, errors.Errorf("%s (%s) cannot be sorted", jqTypeOf(in), jqShort(in))
+			}
+			sorted, _, err := sortBy(env, arr, args[0])
+			if err != nil {
+				return nil, err
+			}
+			return []interface{}{sorted}, nil
+		},
+		"group_by/1": func(env *jqEnv, in interface{}, args []jqNode) ([]interface{}, error) {
+			arr, ok := in.([]interface{})
+			if !ok {
+				return nil, errors.Errorf("%s (%s) cannot be grouped", jqTypeOf(in), jqShort(in))
+			}
+			sorted, keys, err := sortBy(env, arr, args[0])
+			if err != nil {
+				return nil, err
+			}
+			groups := []interface{}{}
+			for i := range sorted {
+				if i == 0 || jqCompare(keys[i], keys[i-1]) != 0 {
+					groups = append(groups, []interface{}{})
+				}
+				last := len(groups) - 1
+				groups[last] = append(groups[last].([]interface{}), sorted[i])
+			}
+			return []interface{}{groups}, nil
+		},
+		"unique/0": array(func(arr []interface{}) (interface{}, error) {
+			sorted := append([]interface{}{}, arr...)
+			sort.SliceStable(sorted, func(i, j int) bool { return jqCompare(sorted[i], sorted[j]) < 0 })
+			out := []interface{}{}
+			for i, e := range sorted {
+				if i == 0 || jqCompare(e, sorted[i-1]) != 0 {
+					out = append(out, e)
+				}
+			}
+			return out, nil
+		}),
+		"min/0": array(extreme(false)),
+		"max/0": array(extreme(true)),
+		"add/0": func(env *jqEnv, in interface{}, _ []jqNode) ([]interface{}, error) {
+			vals, err := jqValues(in)
+			if err != nil {
+				return nil, err
+			}
+			var sum interface{}
+			for _, v := range vals {
+				if sum, err = jqAdd(sum, v); err != nil {
+					return nil, err
+				}
+				// every partial sum is a copy
+				if sum, err = env.built(sum); err != nil {
+					return nil, err
+				}
+			}
+			return []interface{}{sum}, nil
+		},
+		"any/0": array(func(arr []interface{}) (interface{}, error) {
+			for _, e := range arr {
+				if jqTruthy(e) {
+					return true, nil
+				}
+			}
+			return false, nil
+		}),
+		"all/0": array(func(arr []interface{}) (interface{}, error) {
+			for _, e := range arr {
+				if !jqTruthy(e) {
+					return false, nil
+				}
+			}
+			return true, nil
+		}),
+		"first/0": one(func(in interface{}) (interface{}, error) {
+			return jqIndexValue(in, decimal.Zero)
+		}),
+		"last/0": one(func(in interface{}) (interface{}, error) {
+			return jqIndexValue(in, decimal.NewFromInt(-1))
+		}),
+		"reverse/0": one(func(in interface{}) (interface{}, error) {
+			switch t := in.(type) {
+			case nil:
+				return []interface{}{}, nil
+			case string:
+				r := []rune(t)
+				for i, j := 0, len(r)-1; i < j; i, j = i+1, j-1 {
+					r[i], r[j] = r[j], r[i]
+				}
+				return string(r), nil
+			case []interface{}:
+				out := make([]interface{}, len(t))
+				for i, e := range t {
+					out[len(t)-1-i] = e
+				}
+				return out, nil
+			}
+			return nil, errors.Errorf("%s (%s) cannot be reversed", jqTypeOf(in), jqShort(in))
+		}),
+		"flatten/0": array(func(arr []interface{}) (interface{}, error) {
+			var flatten func(arr []interface{}, depth int) ([]interface{}, error)
+			flatten = func(arr []interface{}, depth int) ([]interface{}, error) {
+				if depth > jqMaxDepth {
+					return nil, errors.New("array nested too deeply")
+				}
+				out := []interface{}{}
+				for _, e := range arr {
+					if inner, ok := e.([]interface{}); ok {
+						flat, err := flatten(inner, depth+1)
+						if err != nil {
+							return nil, err
+						}
+						out = append(out, flat...)
+						continue
+					}
+					out = append(out, e)
+				}
+				return out, nil
+			}
+			return flatten(arr, 0)
+		}),
+		"to_entries/0": one(func(in interface{}) (interface{}, error) {
+			m, ok := in.(map[string]interface{})
+			if !ok {
+				return nil, errors.Errorf("%s (%s) is not an object", jqTypeOf(in), jqShort(in))
+			}
+			return jqToEntries(m), nil
+		}),
+		"from_entries/0": array(jqFromEntries),
+		"with_entries/1": func(env *jqEnv, in interface{}, args []jqNode) ([]interface{}, error) {
+			m, ok := in.(map[string]interface{})
+			if !ok {
+				return nil, errors.Errorf("%s (%s) is not an object", jqTypeOf(in), jqShort(in))
+			}
+			var mapped []interface{}
+			for _, entry := range jqToEntries(m) {
+				vals, err := env.eval(args[0], entry)
+				if err != nil {
+					return nil, err
+				}
+				mapped = append(mapped, vals...)
+			}
+			out, err := jqFromEntries(mapped)
+			if err != nil {
+				return nil, err
+			}
+			if out, err = env.built(out); err != nil {
+				return nil, err
+			}
+			return []interface{}{out}, nil
+		},
+		"floor/0": number(func(d decimal.Decimal) decimal.Decimal { return d.Floor() }),
+		"ceil/0":  number(func(d decimal.Decimal) decimal.Decimal { return d.Ceil() }),
+		"round/0": number(func(d decimal.Decimal) decimal.Decimal { return d.Round(0) }),
+		"abs/0":   number(func(d decimal.Decimal) decimal.Decimal { return d.Abs() }),
+		"tostring/0": one(func(in interface{}) (interface{}, error) {
+			if s, ok := in.(string); ok {
+				return s, nil
+			}
+			return jqToJSON(in)
+		}),
+		"tojson/0": one(func(in interface{}) (interface{}, error) {
+			return jqToJSON(in)
+		}),
+		"fromjson/0": one(func(in interface{}) (interface{}, error) {
+			s, ok := in.(string)
+			if !ok {
+				return nil, errors.Errorf("%s (%s) is not a string", jqTypeOf(in), jqShort(in))
+			}
+			return jqDecodeJSON([]byte(s))
+		}),
+		"tonumber/0": one(func(in interface{}) (interface{}, error) {
+			switch t := in.(type) {
+			case decimal.Decimal:
+				return t, nil
+			case string:
+				d, err := jqParseNumber(strings.TrimSpace(t))
+				if err != nil {
+					return nil, errors.Errorf("cannot parse %q as a number", t)
+				}
+				return d, nil
+			}
+			return nil, errors.Errorf("%s (%s) cannot be parsed as a number", jqTypeOf(in), jqShort(in))
+		}),
+		"ascii_downcase/0": str(func(s string) interface{} {
+			return strings.Map(func(r rune) rune {
+				if r < utf8.RuneSelf {
+					return unicode.ToLower(r)
+				}
+				return r
+			}, s)
+		}),
+		"ascii_upcase/0": str(func(s string) interface{} {
+			return strings.Map(func(r rune) rune {
+				if r < utf8.RuneSelf {
+					return unicode.ToUpper(r)
+				}
+				return r
+			}, s)
+		}),
+		"startswith/1": strArg(func(in interface{}, arg string) (interface{}, error) {
+			s, ok := in.(string)
+			if !ok {
+				return nil, errors.Errorf("%s (%s) is not a string", jqTypeOf(in), jqShort(in))
+			}
+			return strings.HasPrefix(s, arg), nil
+		}),
+		"endswith/1": strArg(func(in interface{}, arg string) (interface{}, error) {
+			s, ok := in.(string)
+			if !ok {
+				return nil, errors.Errorf("%s (%s) is not a string", jqTypeOf(in), jqShort(in))
+			}
+			return strings.HasSuffix(s, arg), nil
+		}),
+		"ltrimstr/1": strArg(func(in interface{}, arg string) (interface{}, error) {
+			if s, ok := in.(string); ok {
+				return strings.TrimPrefix(s, arg), nil
+			}
+			return in, nil
+		}),
+		"rtrimstr/1": strArg(func(in interface{}, arg string) (interface{}, error) {
+			if s, ok := in.(string); ok {
+				return strings.TrimSuffix(s, arg), nil
+			}
+			return in, nil
+		}),
+		"split/1": strArg(func(in interface{}, arg string) (interface{}, error) {
+			s, ok := in.(string)
+			if !ok {
+				return nil, errors.Errorf("%s (%s) is not a string", jqTypeOf(in), jqShort(in))
+			}
+			return jqSplit(s, arg), nil
+		}),
+		"join/1": strArg(func(in interface{}, arg string) (interface{}, error) {
+			arr, ok := in.([]interface{})
+			if !ok {
+				return nil, errors.Errorf("%s (%s) is not an array", jqTypeOf(in), jqShort(in))
+			}
+			parts := make([]string, len(arr))
+			for i, e := range arr {
+				switch t := e.(type) {
+				case nil:
+				case string:
+					parts[i] = t
+				case bool, decimal.Decimal:
+					parts[i] = fmt.Sprint(t)
+				default:
+					return nil, errors.Errorf("cannot join %s (%s)", jqTypeOf(e), jqShort(e))
+				}
+			}
+			return strings.Join(parts, arg), nil
+		}),
+	}
+}
+
+func jqToEntries(m map[string]interface{}) []interface{} {
+	keys := jqSortedKeys(m)
+	out := make([]interface{}, len(keys))
+	for i, k := range keys {
+		out[i] = map[string]interface{}{"key": k, "value": m[k]}
+	}
+	return out
+}
+
+func jqFromEntries(arr []interface{}) (interface{}, error) {
+	out := make(map[string]interface{}, len(arr))
+	for _, e := range arr {
+		entry, ok := e.(map[string]interface{})
+		if !ok {
+			return nil, errors.Errorf("entry %s is not an object", jqShort(e))
+		}
+		var key string
+		switch k := entry["key"].(type) {
+		case string:
+			key = k
+		case decimal.Decimal:
+			key = k.String()
+		case bool:
+			key = strconv.FormatBool(k)
+		default:
+			return nil, errors.Errorf("entry key %s is not a string", jqShort(entry["key"]))
+		}
+		out[key] = entry["value"]
+	}
+	return out, nil
+}
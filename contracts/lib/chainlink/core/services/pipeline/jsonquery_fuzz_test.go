@@ -0,0 +1,83 @@
+//go:build go1.18
+
+package pipeline
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func FuzzJSONQuery(f *testing.F) {
+	const input = `{"data":[{"price":1.5,"ok":true},{"price":"2","ok":false}],"meta":{"b":1,"a":null},"name":"ETH/USD"}`
+	f.Add(`.`, input)
+	f.Add(`.data[0].price`, input)
+	f.Add(`.data[1:], .data[-1]`, input)
+	f.Add(`[.data[] | select(.ok) | .price * 2]`, input)
+	f.Add(`.data | map(.price | tonumber) | add / length`, input)
+	f.Add(`.data | sort_by(.price) | first`, input)
+	f.Add(`{name, (.name): .meta | keys}`, input)
+	f.Add(`.meta | to_entries | map(select(.value != null)) | from_entries`, input)
+	f.Add(`if .meta.a // false then 1 elif .meta.b then 2 else 3 end`, input)
+	f.Add(`.name | split("/") | map(ascii_downcase) | join("-")`, input)
+	f.Add(`.missing?.x // "default"`, `[]`)
+	f.Add(`[.[] | . % 3] | unique`, `[1,2,3,4,5]`)
+	f.Fuzz(func(t *testing.T, source string, data string) {
+		query, err := ParseJSONQuery(source)
+		if err != nil {
+			t.Skip()
+		}
+		value, err := jqDecodeJSON([]byte(data))
+		if err != nil {
+			t.Skip()
+		}
+		out, err := query.Eval(testutils.Context(t), value)
+		if err != nil {
+			t.Skip()
+		}
+		// evaluation must be deterministic
+		again, err := query.Eval(testutils.Context(t), value)
+		require.NoError(t, err)
+		require.Equal(t, out, again)
+	})
+}
+
+func FuzzParseJSONQuery(f *testing.F) {
+	f.Add(`.`)
+	f.Add(`.a.b[0]["c"][1:-1][]?`)
+	f.Add(`.a // .b | .c, .d`)
+	f.Add(`1 + 2 * 3 - 4 / 5 % 6 == 7 and true or not`)
+	f.Add(`-1.5e3 <= .x`)
+	f.Add(`[.[] | {a, "b": 1, (.c): [2]}]`)
+	f.Add(`if .a then 1 elif .b then 2 else 3 end`)
+	f.Add(`map(select(.ok)) | sort_by(.price) | .[0]`)
+	f.Add(`"escaped \" \u00e9" # comment`)
+	f.Add(`try error("x")`)
+	f.Add(strings.Repeat("(", 100) + "." + strings.Repeat(")", 100))
+	f.Add(strings.Repeat("[", 100) + strings.Repeat("]", 100))
+	f.Add(strings.Repeat("-", 100) + "1")
+	f.Add(strings.Repeat(".a", 10_000))
+	f.Fuzz(func(t *testing.T, source string) {
+		query, err := ParseJSONQuery(source)
+		if err != nil {
+			require.ErrorIs(t, err, ErrJSONQueryParse)
+		}
+
+		// whitespace and comments around the expression do not change it
+		padded, paddedErr := ParseJSONQuery(" \t" + source + "\n# trailing comment")
+		require.Equal(t, err == nil, paddedErr == nil, "source %q: %v, padded: %v", source, err, paddedErr)
+		if err != nil {
+			return
+		}
+		require.Equal(t, source, query.String())
+
+		input := map[string]interface{}{"a": []interface{}{1, "b", nil, true}}
+		out, err := query.Eval(testutils.Context(t), input)
+		paddedOut, paddedErr := padded.Eval(testutils.Context(t), input)
+		require.Equal(t, err == nil, paddedErr == nil, "source %q: %v, padded: %v", source, err, paddedErr)
+		require.Equal(t, out, paddedOut)
+	})
+}
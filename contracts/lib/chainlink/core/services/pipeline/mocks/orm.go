@@ -767,6 +767,63 @@ func (_c *ORM_Name_Call) RunAndReturn(run func() string) *ORM_Name_Call {
 	return _c
 }
 
+// PruneRuns provides a mock function with given fields: ctx, jobID
+func (_m *ORM) PruneRuns(ctx context.Context, jobID int32) (int64, error) {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PruneRuns")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (int64, error)); ok {
+		return rf(ctx, jobID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) int64); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_PruneRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PruneRuns'
+type ORM_PruneRuns_Call struct {
+	*mock.Call
+}
+
+// PruneRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+func (_e *ORM_Expecter) PruneRuns(ctx interface{}, jobID interface{}) *ORM_PruneRuns_Call {
+	return &ORM_PruneRuns_Call{Call: _e.mock.On("PruneRuns", ctx, jobID)}
+}
+
+func (_c *ORM_PruneRuns_Call) Run(run func(ctx context.Context, jobID int32)) *ORM_PruneRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *ORM_PruneRuns_Call) Return(_a0 int64, _a1 error) *ORM_PruneRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_PruneRuns_Call) RunAndReturn(run func(context.Context, int32) (int64, error)) *ORM_PruneRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Ready provides a mock function with no fields
 func (_m *ORM) Ready() error {
 	ret := _m.Called()
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	bridges "github.com/smartcontractkit/chainlink/v2/core/bridges"
+
 	context "context"
 
 	pipeline "github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
@@ -26,6 +28,54 @@ func (_m *Runner) EXPECT() *Runner_Expecter {
 	return &Runner_Expecter{mock: &_m.Mock}
 }
 
+// BridgeHealth provides a mock function with given fields: bt
+func (_m *Runner) BridgeHealth(bt bridges.BridgeType) []bridges.URLHealth {
+	ret := _m.Called(bt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BridgeHealth")
+	}
+
+	var r0 []bridges.URLHealth
+	if rf, ok := ret.Get(0).(func(bridges.BridgeType) []bridges.URLHealth); ok {
+		r0 = rf(bt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]bridges.URLHealth)
+		}
+	}
+
+	return r0
+}
+
+// Runner_BridgeHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BridgeHealth'
+type Runner_BridgeHealth_Call struct {
+	*mock.Call
+}
+
+// BridgeHealth is a helper method to define mock.On call
+//   - bt bridges.BridgeType
+func (_e *Runner_Expecter) BridgeHealth(bt interface{}) *Runner_BridgeHealth_Call {
+	return &Runner_BridgeHealth_Call{Call: _e.mock.On("BridgeHealth", bt)}
+}
+
+func (_c *Runner_BridgeHealth_Call) Run(run func(bt bridges.BridgeType)) *Runner_BridgeHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bridges.BridgeType))
+	})
+	return _c
+}
+
+func (_c *Runner_BridgeHealth_Call) Return(_a0 []bridges.URLHealth) *Runner_BridgeHealth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Runner_BridgeHealth_Call) RunAndReturn(run func(bridges.BridgeType) []bridges.URLHealth) *Runner_BridgeHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with no fields
 func (_m *Runner) Close() error {
 	ret := _m.Called()
@@ -642,6 +692,112 @@ func (_c *Runner_Run_Call) RunAndReturn(run func(context.Context, *pipeline.Run,
 	return _c
 }
 
+// RunsInProgress provides a mock function with no fields
+func (_m *Runner) RunsInProgress() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunsInProgress")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// Runner_RunsInProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunsInProgress'
+type Runner_RunsInProgress_Call struct {
+	*mock.Call
+}
+
+// RunsInProgress is a helper method to define mock.On call
+func (_e *Runner_Expecter) RunsInProgress() *Runner_RunsInProgress_Call {
+	return &Runner_RunsInProgress_Call{Call: _e.mock.On("RunsInProgress")}
+}
+
+func (_c *Runner_RunsInProgress_Call) Run(run func()) *Runner_RunsInProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Runner_RunsInProgress_Call) Return(_a0 int) *Runner_RunsInProgress_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Runner_RunsInProgress_Call) RunAndReturn(run func() int) *Runner_RunsInProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SimulateRun provides a mock function with given fields: ctx, spec, vars, stubs
+func (_m *Runner) SimulateRun(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars, stubs map[string]pipeline.TaskStub) (*pipeline.Simulation, error) {
+	ret := _m.Called(ctx, spec, vars, stubs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateRun")
+	}
+
+	var r0 *pipeline.Simulation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, pipeline.Vars, map[string]pipeline.TaskStub) (*pipeline.Simulation, error)); ok {
+		return rf(ctx, spec, vars, stubs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, pipeline.Vars, map[string]pipeline.TaskStub) *pipeline.Simulation); ok {
+		r0 = rf(ctx, spec, vars, stubs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Simulation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.Spec, pipeline.Vars, map[string]pipeline.TaskStub) error); ok {
+		r1 = rf(ctx, spec, vars, stubs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Runner_SimulateRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimulateRun'
+type Runner_SimulateRun_Call struct {
+	*mock.Call
+}
+
+// SimulateRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec pipeline.Spec
+//   - vars pipeline.Vars
+//   - stubs map[string]pipeline.TaskStub
+func (_e *Runner_Expecter) SimulateRun(ctx interface{}, spec interface{}, vars interface{}, stubs interface{}) *Runner_SimulateRun_Call {
+	return &Runner_SimulateRun_Call{Call: _e.mock.On("SimulateRun", ctx, spec, vars, stubs)}
+}
+
+func (_c *Runner_SimulateRun_Call) Run(run func(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars, stubs map[string]pipeline.TaskStub)) *Runner_SimulateRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pipeline.Spec), args[2].(pipeline.Vars), args[3].(map[string]pipeline.TaskStub))
+	})
+	return _c
+}
+
+func (_c *Runner_SimulateRun_Call) Return(_a0 *pipeline.Simulation, _a1 error) *Runner_SimulateRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Runner_SimulateRun_Call) RunAndReturn(run func(context.Context, pipeline.Spec, pipeline.Vars, map[string]pipeline.TaskStub) (*pipeline.Simulation, error)) *Runner_SimulateRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *Runner) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -16,6 +16,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
 
+	clnull "github.com/smartcontractkit/chainlink/v2/core/null"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
@@ -34,6 +35,37 @@ type Spec struct {
 	Pipeline *Pipeline `json:"-" db:"-"` // This may be nil, or may be populated manually as a cache. There is no locking on this, so be careful
 }
 
+// RunRetention is the retention policy for the runs of a job, declared in its job spec. It is enforced whenever the
+// runs of the job are pruned, and overrides the node wide JobPipeline.MaxSuccessfulRuns.
+type RunRetention struct {
+	// RetentionMaxRuns is the number of successful runs to keep. The node wide limit applies if it is not set.
+	RetentionMaxRuns clnull.Uint32 `toml:"retentionMaxRuns"`
+	// RetentionMaxAge is the age after which finished runs are deleted by the run reaper, whether they succeeded or not.
+	// Zero keeps them.
+	RetentionMaxAge models.Interval `toml:"retentionMaxAge"`
+	// RetentionKeepErroredOnly deletes all successful runs, keeping errored runs only.
+	RetentionKeepErroredOnly bool `toml:"retentionKeepErroredOnly"`
+}
+
+// Validate returns an error if the policy is contradictory.
+func (r RunRetention) Validate() error {
+	if r.RetentionKeepErroredOnly && r.RetentionMaxRuns.Valid {
+		return errors.New("retentionMaxRuns cannot be combined with retentionKeepErroredOnly")
+	}
+	return nil
+}
+
+// maxRuns returns the number of successful runs to keep, given the node wide limit.
+func (r RunRetention) maxRuns(nodeMaxRuns uint64) uint64 {
+	if r.RetentionKeepErroredOnly {
+		return 0
+	}
+	if r.RetentionMaxRuns.Valid {
+		return uint64(r.RetentionMaxRuns.Uint32)
+	}
+	return nodeMaxRuns
+}
+
 func (s *Spec) GetOrParsePipeline() (*Pipeline, error) {
 	if s.Pipeline != nil {
 		return s.Pipeline, nil
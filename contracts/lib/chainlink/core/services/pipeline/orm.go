@@ -91,6 +91,9 @@ type ORM interface {
 	InsertFinishedRuns(ctx context.Context, run []*Run, saveSuccessfulTaskRuns bool) (err error)
 
 	DeleteRunsOlderThan(context.Context, time.Duration) error
+	// PruneRuns deletes the runs of a job which are outside of its retention policy, and returns the number of
+	// deleted runs.
+	PruneRuns(ctx context.Context, jobID int32) (int64, error)
 	FindRun(ctx context.Context, id int64) (Run, error)
 	GetAllRuns(ctx context.Context) ([]Run, error)
 	GetUnfinishedRuns(context.Context, time.Time, func(run Run) error) error
@@ -109,6 +112,9 @@ type orm struct {
 	pm     sync.Map
 	wg     sync.WaitGroup
 	stopCh services.StopChan
+
+	// jobID => cachedRetention
+	retentions sync.Map
 }
 
 var _ ORM = (*orm)(nil)
@@ -441,24 +447,40 @@ func (o *orm) InsertFinishedRun(ctx context.Context, run *Run, saveSuccessfulTas
 		return err
 	}
 
-	if o.maxSuccessfulRuns == 0 {
+	if keep, err := o.keepsRun(ctx, run); err != nil || !keep {
 		// optimisation: avoid persisting if we oughtn't to save any
-		return nil
+		return err
 	}
 
 	err = o.insertFinishedRun(ctx, run, saveSuccessfulTaskRuns)
 	return errors.Wrap(err, "InsertFinishedRun failed")
 }
 
+// keepsRun returns false if the run would be pruned right away: the node keeps no successful runs, and the job has no
+// retention policy keeping the run.
+func (o *orm) keepsRun(ctx context.Context, run *Run) (bool, error) {
+	if o.maxSuccessfulRuns != 0 {
+		return true, nil
+	}
+	if run.PruningKey == 0 {
+		return false, nil
+	}
+	retention, err := o.retention(ctx, o.ds, run.PruningKey, false)
+	if err != nil {
+		return false, errors.Wrap(err, "InsertFinishedRun failed")
+	}
+	return retention.maxRuns(0) > 0 || (retention.RetentionKeepErroredOnly && run.HasErrors()), nil
+}
+
 // InsertFinishedRunWithSpec works like InsertFinishedRun but also inserts the pipeline spec.
 func (o *orm) InsertFinishedRunWithSpec(ctx context.Context, run *Run, saveSuccessfulTaskRuns bool) (err error) {
 	if err = o.checkFinishedRun(run, saveSuccessfulTaskRuns); err != nil {
 		return err
 	}
 
-	if o.maxSuccessfulRuns == 0 {
+	if keep, err := o.keepsRun(ctx, run); err != nil || !keep {
 		// optimisation: avoid persisting if we oughtn't to save any
-		return nil
+		return err
 	}
 
 	err = o.transact(ctx, func(tx *orm) error {
@@ -550,6 +572,41 @@ WHERE pipeline_runs.id = batched_pipeline_runs.id`,
 		return errors.Wrap(err, "DeleteRunsOlderThan failed")
 	}
 
+	// runs of jobs with a retention max age are deleted once they are older than it
+	err = pg.Batch(func(_, limit uint) (count uint, err error) {
+		result, err := o.ds.ExecContext(ctx, `
+WITH batched_pipeline_runs AS (
+	SELECT pipeline_runs.id FROM pipeline_runs
+	JOIN jobs ON jobs.id = pipeline_runs.pruning_key
+	WHERE jobs.retention_max_age > 0 AND pipeline_runs.state IN ($1, $2)
+	AND pipeline_runs.finished_at < $3::timestamptz - jobs.retention_max_age / 1000 * interval '1 microsecond'
+	ORDER BY pipeline_runs.finished_at ASC
+	LIMIT $4
+)
+DELETE FROM pipeline_runs
+USING batched_pipeline_runs
+WHERE pipeline_runs.id = batched_pipeline_runs.id`,
+			RunStatusCompleted,
+			RunStatusErrored,
+			start,
+			limit,
+		)
+		if err != nil {
+			return count, errors.Wrap(err, "DeleteRunsOlderThan failed to delete pipeline_runs older than the retention max age of their job")
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return count, errors.Wrap(err, "DeleteRunsOlderThan failed to get rows affected")
+		}
+		rowsDeleted += rowsAffected
+
+		return uint(rowsAffected), err
+	})
+	if err != nil {
+		return errors.Wrap(err, "DeleteRunsOlderThan failed")
+	}
+
 	deleteTS := time.Now()
 
 	o.lggr.Debugw("pipeline_runs reaper DELETE query completed", "rowsDeleted", rowsDeleted, "duration", deleteTS.Sub(start))
@@ -690,12 +747,83 @@ func (o *orm) loadCount(jobID int32) *atomic.Uint64 {
 	return actual.(*atomic.Uint64)
 }
 
+// retentionCacheTTL is how long the run retention of a job is cached for before it is loaded again.
+const retentionCacheTTL = time.Minute
+
+type cachedRetention struct {
+	RunRetention
+	loadedAt time.Time
+}
+
+// retention returns the run retention of the job, which is cached so that pruning does not load it on every run.
+// Jobs which do not exist have no retention policy.
+func (o *orm) retention(ctx context.Context, ds sqlutil.DataSource, jobID int32, fresh bool) (RunRetention, error) {
+	if cached, ok := o.retentions.Load(jobID); ok && !fresh && time.Since(cached.(cachedRetention).loadedAt) < retentionCacheTTL {
+		return cached.(cachedRetention).RunRetention, nil
+	}
+	var retention RunRetention
+	err := ds.GetContext(ctx, &retention, `SELECT retention_max_runs, retention_max_age, retention_keep_errored_only FROM jobs WHERE id = $1`, jobID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return retention, errors.Wrap(err, "failed to load run retention")
+	}
+	o.retentions.Store(jobID, cachedRetention{RunRetention: retention, loadedAt: time.Now()})
+	return retention, nil
+}
+
+// PruneRuns deletes the successful runs of the job above its retention limit, and the finished runs which are older
+// than its retention max age. Jobs without a retention policy keep the node wide maxSuccessfulRuns.
+func (o *orm) PruneRuns(ctx context.Context, jobID int32) (int64, error) {
+	retention, err := o.retention(ctx, o.ds, jobID, true)
+	if err != nil {
+		return 0, err
+	}
+
+	rowsAffected, err := o.pruneSuccessful(ctx, jobID, retention.maxRuns(o.maxSuccessfulRuns))
+	if err != nil {
+		return 0, err
+	}
+
+	if maxAge := retention.RetentionMaxAge.Duration(); maxAge > 0 {
+		res, err := o.ds.ExecContext(ctx, `DELETE FROM pipeline_runs WHERE pruning_key = $1 AND state IN ($2, $3) AND finished_at < $4`,
+			jobID, RunStatusCompleted, RunStatusErrored, time.Now().Add(-maxAge))
+		if err != nil {
+			return rowsAffected, err
+		}
+		var expired int64
+		if expired, err = res.RowsAffected(); err != nil {
+			return rowsAffected, errors.Wrap(err, "failed to get RowsAffected")
+		}
+		rowsAffected += expired
+	}
+	return rowsAffected, nil
+}
+
+// pruneSuccessful deletes the successful runs of the job except for the latest maxRuns.
+func (o *orm) pruneSuccessful(ctx context.Context, jobID int32, maxRuns uint64) (int64, error) {
+	res, err := o.ds.ExecContext(ctx, `DELETE FROM pipeline_runs WHERE pruning_key = $1 AND state = $2 AND id NOT IN (
+SELECT id FROM pipeline_runs
+WHERE pruning_key = $1 AND state = $2
+ORDER BY id DESC
+LIMIT $3
+)`, jobID, RunStatusCompleted, maxRuns)
+	if err != nil {
+		return 0, err
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get RowsAffected")
+	}
+	return rowsAffected, nil
+}
+
 // Runs will be pruned async on a sampled basis if maxSuccessfulRuns is set to
 // this value or higher
 const syncLimit = 1000
 
 // prune attempts to keep the pipeline_runs table capped close to the
-// maxSuccessfulRuns length for each job_id.
+// maxSuccessfulRuns length for each job_id, or to the retentionMaxRuns of the
+// job if it has one. Runs older than the retentionMaxAge of the job are
+// deleted by the run reaper, see DeleteRunsOlderThan.
 //
 // It does this synchronously for small values and async/sampled for large
 // values.
@@ -706,26 +834,32 @@ func (o *orm) prune(ctx context.Context, tx sqlutil.DataSource, jobID int32) {
 	if jobID == 0 {
 		o.lggr.Panic("expected a non-zero job ID")
 	}
-	// For small maxSuccessfulRuns its fast enough to prune every time
-	if o.maxSuccessfulRuns < syncLimit {
-		o.withDataSource(tx).execPrune(ctx, jobID)
+	retention, err := o.retention(ctx, tx, jobID, false)
+	if err != nil {
+		o.lggr.Errorw("Failed to prune runs", "err", err, "jobID", jobID)
+		return
+	}
+	maxRuns := retention.maxRuns(o.maxSuccessfulRuns)
+	// For small maxRuns its fast enough to prune every time
+	if maxRuns < syncLimit {
+		o.withDataSource(tx).execPrune(ctx, jobID, maxRuns)
 		return
 	}
-	// for large maxSuccessfulRuns we do it async on a sampled basis
-	every := o.maxSuccessfulRuns / 20 // it can get up to 5% larger than maxSuccessfulRuns before a prune
+	// for large maxRuns we do it async on a sampled basis
+	every := maxRuns / 20 // it can get up to 5% larger than maxRuns before a prune
 	cnt := o.loadCount(jobID)
 	val := cnt.Add(1)
 	if val%every == 0 {
 		ok := o.IfStarted(func() {
 			o.wg.Add(1)
 			go func(ctx context.Context) {
-				o.lggr.Debugw("Pruning runs", "jobID", jobID, "count", val, "every", every, "maxSuccessfulRuns", o.maxSuccessfulRuns)
+				o.lggr.Debugw("Pruning runs", "jobID", jobID, "count", val, "every", every, "maxRuns", maxRuns)
 				defer o.wg.Done()
 				ctx, cancel := o.stopCh.CtxCancel(context.WithTimeout(sqlutil.WithoutDefaultTimeout(ctx), time.Minute))
 				defer cancel()
 
 				// Must not use tx here since it could be stale by the time we execute async.
-				o.execPrune(ctx, jobID)
+				o.execPrune(ctx, jobID, maxRuns)
 			}(context.WithoutCancel(ctx)) // don't propagate cancellation
 		})
 		if !ok {
@@ -735,22 +869,12 @@ func (o *orm) prune(ctx context.Context, tx sqlutil.DataSource, jobID int32) {
 	}
 }
 
-func (o *orm) execPrune(ctx context.Context, jobID int32) {
-	res, err := o.ds.ExecContext(ctx, `DELETE FROM pipeline_runs WHERE pruning_key = $1 AND state = $2 AND id NOT IN (
-SELECT id FROM pipeline_runs
-WHERE pruning_key = $1 AND state = $2
-ORDER BY id DESC
-LIMIT $3
-)`, jobID, RunStatusCompleted, o.maxSuccessfulRuns)
+func (o *orm) execPrune(ctx context.Context, jobID int32, maxRuns uint64) {
+	rowsAffected, err := o.pruneSuccessful(ctx, jobID, maxRuns)
 	if err != nil {
 		o.lggr.Errorw("Failed to prune runs", "err", err, "jobID", jobID)
 		return
 	}
-	rowsAffected, err := res.RowsAffected()
-	if err != nil {
-		o.lggr.Errorw("Failed to get RowsAffected while pruning runs", "err", err, "jobID", jobID)
-		return
-	}
 	if rowsAffected == 0 {
 		// check the spec still exists and garbage collect if necessary
 		var exists bool
@@ -761,8 +885,9 @@ LIMIT $3
 		if !exists {
 			o.lggr.Debugw("Pipeline spec no longer exists, removing prune count", "jobID", jobID)
 			o.pm.Delete(jobID)
+			o.retentions.Delete(jobID)
 		}
-	} else if o.maxSuccessfulRuns < syncLimit {
+	} else if maxRuns < syncLimit {
 		o.lggr.Tracew("Pruned runs", "rowsAffected", rowsAffected, "jobID", jobID)
 	} else {
 		o.lggr.Debugw("Pruned runs", "rowsAffected", rowsAffected, "jobID", jobID)
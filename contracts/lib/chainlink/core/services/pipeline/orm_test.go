@@ -25,6 +25,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	clnull "github.com/smartcontractkit/chainlink/v2/core/null"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
@@ -914,3 +915,136 @@ func Test_Prune(t *testing.T) {
 	cnt = pgtest.MustCount(t, db, "SELECT count(*) FROM pipeline_runs WHERE pipeline_spec_id = $1 AND state = $2", ps2.ID, pipeline.RunStatusSuspended)
 	assert.Equal(t, 3, cnt)
 }
+
+func Test_PruneRuns_RunRetention(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db, orm, jorm := setupLiteORM(t)
+
+	createJob := func(retention pipeline.RunRetention) job.Job {
+		jb := job.Job{
+			Type:          job.Webhook,
+			SchemaVersion: 1,
+			ExternalJobID: uuid.New(),
+			WebhookSpec:   &job.WebhookSpec{},
+			PipelineSpec:  &pipeline.Spec{DotDagSource: `ds [type=memo value="foo"];`},
+			RunRetention:  retention,
+		}
+		require.NoError(t, jorm.CreateJob(ctx, &jb))
+		return jb
+	}
+	insertRuns := func(jb job.Job) {
+		for i := 0; i < 10; i++ {
+			cltest.MustInsertPipelineRunWithStatus(t, db, jb.PipelineSpecID, pipeline.RunStatusCompleted, jb.ID)
+		}
+		for i := 0; i < 4; i++ {
+			cltest.MustInsertPipelineRunWithStatus(t, db, jb.PipelineSpecID, pipeline.RunStatusErrored, jb.ID)
+		}
+		cltest.MustInsertPipelineRunWithStatus(t, db, jb.PipelineSpecID, pipeline.RunStatusRunning, jb.ID)
+		// two of the errored runs are a day old
+		_, err := db.Exec(`UPDATE pipeline_runs SET finished_at = NOW() - interval '1 day' WHERE id IN (
+SELECT id FROM pipeline_runs WHERE pruning_key = $1 AND state = $2 ORDER BY id LIMIT 2)`, jb.ID, pipeline.RunStatusErrored)
+		require.NoError(t, err)
+	}
+	count := func(jb job.Job, status pipeline.RunStatus) int {
+		return pgtest.MustCount(t, db, "SELECT count(*) FROM pipeline_runs WHERE pruning_key = $1 AND state = $2", jb.ID, status)
+	}
+
+	t.Run("max runs and max age", func(t *testing.T) {
+		jb := createJob(pipeline.RunRetention{
+			RetentionMaxRuns: clnull.Uint32From(3),
+			RetentionMaxAge:  models.Interval(time.Hour),
+		})
+		insertRuns(jb)
+
+		deleted, err := orm.PruneRuns(ctx, jb.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(9), deleted)
+		assert.Equal(t, 3, count(jb, pipeline.RunStatusCompleted))
+		assert.Equal(t, 2, count(jb, pipeline.RunStatusErrored))
+		assert.Equal(t, 1, count(jb, pipeline.RunStatusRunning))
+	})
+
+	t.Run("keep errored only", func(t *testing.T) {
+		jb := createJob(pipeline.RunRetention{RetentionKeepErroredOnly: true})
+		insertRuns(jb)
+
+		deleted, err := orm.PruneRuns(ctx, jb.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), deleted)
+		assert.Equal(t, 0, count(jb, pipeline.RunStatusCompleted))
+		assert.Equal(t, 4, count(jb, pipeline.RunStatusErrored))
+	})
+
+	t.Run("without a policy the node wide limit applies", func(t *testing.T) {
+		jb := createJob(pipeline.RunRetention{})
+		insertRuns(jb)
+
+		deleted, err := orm.PruneRuns(ctx, jb.ID)
+		require.NoError(t, err)
+		assert.Zero(t, deleted)
+		assert.Equal(t, 10, count(jb, pipeline.RunStatusCompleted))
+	})
+
+	t.Run("runs are pruned to the max runs of the job, and reaped after its max age", func(t *testing.T) {
+		jb := createJob(pipeline.RunRetention{
+			RetentionMaxRuns: clnull.Uint32From(3),
+			RetentionMaxAge:  models.Interval(time.Hour),
+		})
+		insertRuns(jb)
+
+		orm.(interface{ Prune(context.Context, int32) }).Prune(ctx, jb.ID)
+		assert.Equal(t, 3, count(jb, pipeline.RunStatusCompleted))
+		assert.Equal(t, 4, count(jb, pipeline.RunStatusErrored))
+
+		// the node wide reaper threshold is far longer than the max age of the job
+		require.NoError(t, orm.DeleteRunsOlderThan(ctx, 30*24*time.Hour))
+		assert.Equal(t, 3, count(jb, pipeline.RunStatusCompleted))
+		assert.Equal(t, 2, count(jb, pipeline.RunStatusErrored))
+		assert.Equal(t, 1, count(jb, pipeline.RunStatusRunning))
+	})
+}
+
+func Test_InsertFinishedRun_RunRetention(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	lggr := logger.TestLogger(t)
+	// the node keeps no runs
+	orm := pipeline.NewORM(db, lggr, 0)
+	jorm := job.NewORM(db, orm, bridges.NewORM(db), cltest.NewKeyStore(t, db), lggr)
+
+	insertRun := func(retention pipeline.RunRetention) job.Job {
+		jb := job.Job{
+			Type:          job.Webhook,
+			SchemaVersion: 1,
+			ExternalJobID: uuid.New(),
+			WebhookSpec:   &job.WebhookSpec{},
+			PipelineSpec:  &pipeline.Spec{DotDagSource: `ds [type=memo value="foo"];`},
+			RunRetention:  retention,
+		}
+		require.NoError(t, jorm.CreateJob(ctx, &jb))
+
+		now := time.Now()
+		require.NoError(t, orm.InsertFinishedRun(ctx, &pipeline.Run{
+			PipelineSpecID: jb.PipelineSpecID,
+			PruningKey:     jb.ID,
+			State:          pipeline.RunStatusCompleted,
+			AllErrors:      pipeline.RunErrors{null.NewString("", false)},
+			FatalErrors:    pipeline.RunErrors{null.NewString("", false)},
+			Outputs:        jsonserializable.JSONSerializable{Val: "foo", Valid: true},
+			CreatedAt:      now,
+			FinishedAt:     null.TimeFrom(now),
+		}, false))
+		return jb
+	}
+	count := func(jb job.Job) int {
+		return pgtest.MustCount(t, db, "SELECT count(*) FROM pipeline_runs WHERE pruning_key = $1", jb.ID)
+	}
+
+	assert.Equal(t, 1, count(insertRun(pipeline.RunRetention{RetentionMaxRuns: clnull.Uint32From(3)})))
+	assert.Equal(t, 0, count(insertRun(pipeline.RunRetention{})))
+	assert.Equal(t, 0, count(insertRun(pipeline.RunRetention{RetentionKeepErroredOnly: true})))
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
@@ -51,6 +52,16 @@ type Runner interface {
 	// This will persist the Spec in the DB if it doesn't have an ID.
 	ExecuteAndInsertFinishedRun(ctx context.Context, spec Spec, vars Vars, saveSuccessfulTaskRuns bool) (runID int64, results TaskRunResults, err error)
 
+	// SimulateRun executes a dry-run of a spec in-memory and returns a trace of every task.
+	// Tasks keyed by dot ID in stubs return the stubbed response instead of running.
+	SimulateRun(ctx context.Context, spec Spec, vars Vars, stubs map[string]TaskStub) (*Simulation, error)
+
+	// BridgeHealth returns the circuit breaker state of every URL of the bridge, as seen by bridge tasks.
+	BridgeHealth(bt bridges.BridgeType) []bridges.URLHealth
+
+	// RunsInProgress returns the number of pipeline runs which are executing.
+	RunsInProgress() int
+
 	OnRunFinished(func(*Run))
 	InitializePipeline(spec Spec) (*Pipeline, error)
 }
@@ -59,6 +70,8 @@ type runner struct {
 	services.StateMachine
 	orm                    ORM
 	btORM                  bridges.ORM
+	bridgeResponses        *bridges.ResponseCache
+	bridgeHealth           *bridges.HealthTracker
 	config                 Config
 	bridgeConfig           BridgeConfig
 	legacyEVMChains        legacyevm.LegacyChainContainer
@@ -69,6 +82,8 @@ type runner struct {
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
 
+	runsInProgress atomic.Int64
+
 	// test helper
 	runFinished func(*Run)
 
@@ -118,10 +133,17 @@ func NewRunner(
 	httpClient, unrestrictedHTTPClient *http.Client,
 ) *runner {
 	lggr = lggr.Named("PipelineRunner")
+	// a cache given by the caller is shared with it, e.g. so that bridges updated through the API are purged
+	btCache, ok := btORM.(*bridges.Cache)
+	if !ok {
+		btCache = bridges.NewCache(btORM, lggr, bridges.DefaultUpsertInterval)
+	}
 
 	r := &runner{
 		orm:                    orm,
-		btORM:                  bridges.NewCache(btORM, lggr, bridges.DefaultUpsertInterval),
+		btORM:                  btCache,
+		bridgeResponses:        btCache.Responses(),
+		bridgeHealth:           btCache.Health(),
 		config:                 cfg,
 		bridgeConfig:           bridgeCfg,
 		legacyEVMChains:        legacyChains,
@@ -253,6 +275,10 @@ func (r *runner) OnRunFinished(fn func(*Run)) {
 	r.runFinished = fn
 }
 
+func (r *runner) BridgeHealth(bt bridges.BridgeType) []bridges.URLHealth {
+	return r.bridgeHealth.Health(bt)
+}
+
 var (
 	// github.com/smartcontractkit/libocr/offchainreporting2plus/internal/protocol.ReportingPluginTimeoutWarningGracePeriod
 	overtime           = 100 * time.Millisecond
@@ -342,6 +368,8 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*BridgeTask).bridgeConfig = r.bridgeConfig
 			// orm added to BridgeTask
 			task.(*BridgeTask).orm = r.btORM
+			task.(*BridgeTask).responses = r.bridgeResponses
+			task.(*BridgeTask).health = r.bridgeHealth
 			task.(*BridgeTask).specId = spec.ID
 			// URL is "safe" because it comes from the node's own database. We
 			// must use the unrestrictedHTTPClient because some node operators
@@ -362,6 +390,9 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*EstimateGasLimitTask).legacyChains = r.legacyEVMChains
 			task.(*EstimateGasLimitTask).specGasLimit = spec.GasLimit
 			task.(*EstimateGasLimitTask).jobType = spec.JobType
+		case TaskTypeForeach:
+			task.(*ForeachTask).runner = r
+			task.(*ForeachTask).spec = spec
 		case TaskTypeETHTx:
 			task.(*ETHTxTask).keyStore = r.ethKeyStore
 			task.(*ETHTxTask).legacyChains = r.legacyEVMChains
@@ -375,45 +406,26 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 	return pipeline, nil
 }
 
+func (r *runner) RunsInProgress() int {
+	return int(r.runsInProgress.Load())
+}
+
 func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Vars) TaskRunResults {
+	r.runsInProgress.Add(1)
+	defer r.runsInProgress.Add(-1)
+
 	l := r.lggr.With("run.ID", run.ID, "executionID", uuid.New(), "specID", run.PipelineSpecID, "jobID", run.PipelineSpec.JobID, "jobName", run.PipelineSpec.JobName)
 	if r.config.VerboseLogging() {
 		l.Debug("Initiating tasks for pipeline run of spec")
 	}
 
-	scheduler := newScheduler(pipeline, run, vars, l)
-	go scheduler.Run()
-
-	// This is "just in case" for cleaning up any stray reports.
-	// Normally the scheduler loop doesn't stop until all in progress runs report back
-	reportCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
-	defer cancel()
-
 	if pipelineTimeout := r.config.MaxRunDuration(); pipelineTimeout != 0 {
+		var cancel context.CancelFunc
 		ctx, cancel = context.WithTimeout(ctx, pipelineTimeout)
 		defer cancel()
 	}
 
-	for taskRun := range scheduler.taskCh {
-		taskRun := taskRun
-		// execute
-		go recovery.WrapRecoverHandle(l, func() {
-			result := r.executeTaskRun(ctx, run.PipelineSpec, taskRun, l)
-
-			logTaskRunToPrometheus(result, run.PipelineSpec)
-
-			scheduler.report(reportCtx, result)
-		}, func(err interface{}) {
-			t := time.Now()
-			scheduler.report(reportCtx, TaskRunResult{
-				ID:         uuid.New(),
-				Task:       taskRun.task,
-				Result:     Result{Error: ErrRunPanicked{err}},
-				FinishedAt: null.TimeFrom(t),
-				CreatedAt:  t, // TODO: more accurate start time
-			})
-		})
-	}
+	scheduler := r.schedule(ctx, pipeline, run, vars, l)
 
 	// if the run is suspended, awaiting resumption
 	run.Pending = scheduler.pending
@@ -431,29 +443,7 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 	}
 
 	// Update run results
-	run.PipelineTaskRuns = nil
-	for _, result := range scheduler.results {
-		output := result.Result.OutputDB()
-		run.PipelineTaskRuns = append(run.PipelineTaskRuns, TaskRun{
-			ID:            result.ID,
-			PipelineRunID: run.ID,
-			Type:          result.Task.Type(),
-			Index:         result.Task.OutputIndex(),
-			Output:        output,
-			Error:         result.Result.ErrorDB(),
-			DotID:         result.Task.DotID(),
-			CreatedAt:     result.CreatedAt,
-			FinishedAt:    result.FinishedAt,
-			task:          result.Task,
-		})
-
-		sort.Slice(run.PipelineTaskRuns, func(i, j int) bool {
-			if run.PipelineTaskRuns[i].task.OutputIndex() == run.PipelineTaskRuns[j].task.OutputIndex() {
-				return run.PipelineTaskRuns[i].FinishedAt.ValueOrZero().Before(run.PipelineTaskRuns[j].FinishedAt.ValueOrZero())
-			}
-			return run.PipelineTaskRuns[i].task.OutputIndex() < run.PipelineTaskRuns[j].task.OutputIndex()
-		})
-	}
+	run.PipelineTaskRuns = scheduler.taskRuns(run.ID)
 
 	// Update run errors/outputs
 	if run.FinishedAt.Valid {
@@ -483,6 +473,12 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 		}
 	}
 
+	// Task runs of foreach iterations are stored with the run, but never count as its outputs
+	for _, taskRun := range scheduler.iterationTaskRuns() {
+		taskRun.PipelineRunID = run.ID
+		run.PipelineTaskRuns = append(run.PipelineTaskRuns, taskRun)
+	}
+
 	// TODO: drop this once we stop using TaskRunResults
 	var taskRunResults TaskRunResults
 	for _, result := range scheduler.results {
@@ -535,6 +531,87 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 	return taskRunResults
 }
 
+// schedule executes the tasks of pipeline until no further progress can be made and returns the scheduler holding
+// their results.
+func (r *runner) schedule(ctx context.Context, pipeline *Pipeline, run *Run, vars Vars, l logger.Logger) *scheduler {
+	scheduler := newScheduler(pipeline, run, vars, l)
+	go scheduler.Run()
+
+	// This is "just in case" for cleaning up any stray reports.
+	// Normally the scheduler loop doesn't stop until all in progress runs report back
+	reportCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancel()
+
+	for taskRun := range scheduler.taskCh {
+		taskRun := taskRun
+		// execute
+		go recovery.WrapRecoverHandle(l, func() {
+			result := r.executeTaskRun(ctx, run.PipelineSpec, taskRun, l)
+
+			logTaskRunToPrometheus(result, run.PipelineSpec)
+
+			scheduler.report(reportCtx, result)
+		}, func(err interface{}) {
+			t := time.Now()
+			scheduler.report(reportCtx, TaskRunResult{
+				ID:         uuid.New(),
+				Task:       taskRun.task,
+				Result:     Result{Error: ErrRunPanicked{err}},
+				FinishedAt: null.TimeFrom(t),
+				CreatedAt:  t, // TODO: more accurate start time
+			})
+		})
+	}
+	return scheduler
+}
+
+// runIteration executes one iteration of a foreach task and returns its result along with the task runs of the
+// iteration. The sub-pipeline is parsed again for every iteration, so every task run gets its own ID. dotIDPrefix is
+// the prefix the task runs of the iteration are stored under, e.g. loop[2].
+func (r *runner) runIteration(ctx context.Context, spec Spec, source string, vars Vars, dotIDPrefix string) (interface{}, []TaskRun, error) {
+	spec.DotDagSource = source
+	spec.Pipeline = nil
+	pipeline, err := r.InitializePipeline(spec)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, task := range pipeline.Tasks {
+		switch task := task.(type) {
+		case *BridgeTask:
+			// the bridge last value table is keyed by dot ID, iterations must not share the same key
+			task.lastValueDotID = dotIDPrefix + task.DotID()
+		case *ForeachTask:
+			task.dotIDPrefix = dotIDPrefix
+		default:
+		}
+	}
+	if isSimulation(ctx) {
+		sandbox(pipeline, nil)
+	}
+
+	l := r.lggr.With("specID", spec.ID, "jobID", spec.JobID, "jobName", spec.JobName, "executionID", uuid.New())
+	scheduler := r.schedule(ctx, pipeline, &Run{PipelineSpec: spec}, vars, l)
+	if scheduler.pending {
+		return nil, nil, pkgerrors.New("async tasks are not supported in foreach")
+	}
+
+	taskRuns := append(scheduler.taskRuns(0), scheduler.iterationTaskRuns()...)
+	var trrs TaskRunResults
+	for _, result := range scheduler.results {
+		trrs = append(trrs, result)
+	}
+	fr := trrs.FinalResult()
+	for _, err = range fr.FatalErrors {
+		if err != nil {
+			return nil, taskRuns, err
+		}
+	}
+	if len(fr.Values) == 1 {
+		return fr.Values[0], taskRuns, nil
+	}
+	return fr.Values, taskRuns, nil
+}
+
 func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryTaskRun, l logger.Logger) TaskRunResult {
 	start := time.Now()
 	l = l.With("taskName", taskRun.task.DotID(),
@@ -557,7 +634,8 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 		ctx, cancel = context.WithTimeout(ctx, taskTimeout)
 		defer cancel()
 	}
-	if spec.MaxTaskDuration != models.Interval(time.Duration(0)) {
+	// MaxTaskDuration applies to every task of every iteration of a foreach task rather than to the whole loop
+	if spec.MaxTaskDuration != models.Interval(time.Duration(0)) && taskRun.task.Type() != TaskTypeForeach {
 		ctx, cancel = context.WithTimeout(ctx, time.Duration(spec.MaxTaskDuration))
 		defer cancel()
 	}
@@ -643,6 +721,9 @@ func (r *runner) Run(ctx context.Context, run *Run, saveSuccessfulTaskRuns bool,
 
 	// retain old UUID values
 	for _, taskRun := range run.PipelineTaskRuns {
+		if pipeline.iterationParent(taskRun.DotID) != nil {
+			continue
+		}
 		task := pipeline.ByDotID(taskRun.DotID)
 		if task == nil || task.Base() == nil {
 			return false, pkgerrors.Errorf("failed to match a pipeline task for dot ID: %v", taskRun.DotID)
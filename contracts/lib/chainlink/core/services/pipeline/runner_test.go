@@ -1077,3 +1077,21 @@ succeed;
 		assert.Equal(t, "1", trrs[0].Result.Value.(pipeline.ObjectParam).DecimalValue.Decimal().String())
 	})
 }
+
+func Test_PipelineRunner_SharesBridgeCache(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	btORM := bridgesMocks.NewORM(t)
+	cache := bridges.NewCache(btORM, logger.TestLogger(t), bridges.DefaultUpsertInterval)
+	r, _ := newRunner(t, db, cache, cfg)
+
+	bt := bridges.BridgeType{Name: "shared", URL: cltest.WebURL(t, "https://bridge.example.com")}
+	cache.Health().Record(bt, bt.URL.String(), errors.New("boom"))
+	require.Equal(t, uint32(1), r.BridgeHealth(bt)[0].ConsecutiveFailures)
+
+	// updating the bridge through the cache purges the state the runner sees
+	btORM.On("UpdateBridgeType", mock.Anything, &bt, mock.Anything).Return(nil).Once()
+	require.NoError(t, cache.UpdateBridgeType(testutils.Context(t), &bt, &bridges.BridgeTypeRequest{}))
+	require.Zero(t, r.BridgeHealth(bt)[0].ConsecutiveFailures)
+}
@@ -55,7 +55,9 @@ type scheduler struct {
 	waiting      uint
 	results      map[int]TaskRunResult
 	vars         Vars
-	logger       logger.Logger
+	// iterationRuns holds the task runs of the iterations of foreach tasks, by task ID
+	iterationRuns map[int][]TaskRun
+	logger        logger.Logger
 
 	pending bool
 	exiting bool
@@ -73,12 +75,13 @@ func newScheduler(p *Pipeline, run *Run, vars Vars, lggr logger.Logger) *schedul
 	}
 
 	s := &scheduler{
-		pipeline:     p,
-		run:          run,
-		dependencies: dependencies,
-		results:      make(map[int]TaskRunResult, len(p.Tasks)),
-		vars:         vars,
-		logger:       lggr,
+		pipeline:      p,
+		run:           run,
+		dependencies:  dependencies,
+		results:       make(map[int]TaskRunResult, len(p.Tasks)),
+		vars:          vars,
+		iterationRuns: make(map[int][]TaskRun),
+		logger:        lggr,
 
 		// taskCh should never block
 		taskCh:   make(chan *memoryTaskRun, len(dependencies)),
@@ -114,6 +117,11 @@ func newScheduler(p *Pipeline, run *Run, vars Vars, lggr logger.Logger) *schedul
 func (s *scheduler) reconstructResults() {
 	// if there's results already present on Run, then this is a resumption. Loop over them and fill results table
 	for _, r := range s.run.PipelineTaskRuns {
+		if parent := s.pipeline.iterationParent(r.DotID); parent != nil {
+			s.iterationRuns[parent.ID()] = append(s.iterationRuns[parent.ID()], r)
+			continue
+		}
+
 		task := s.pipeline.ByDotID(r.DotID)
 
 		if task == nil {
@@ -185,6 +193,11 @@ func (s *scheduler) Run() {
 		// store task run
 		s.results[result.Task.ID()] = result
 
+		if result.Task.Type() == TaskTypeForeach {
+			// only the iterations of the latest attempt are kept
+			s.iterationRuns[result.Task.ID()] = result.runInfo.iterationRuns
+		}
+
 		// catch the pending state, we will keep the pipeline running until no more progress is made
 		if result.runInfo.IsPending {
 			s.pending = true
@@ -274,6 +287,46 @@ func (s *scheduler) Run() {
 	close(s.taskCh)
 }
 
+// taskRuns returns the results of the run as task runs, ordered by output index and finish time.
+func (s *scheduler) taskRuns(runID int64) []TaskRun {
+	taskRuns := make([]TaskRun, 0, len(s.results))
+	for _, result := range s.results {
+		taskRuns = append(taskRuns, TaskRun{
+			ID:            result.ID,
+			PipelineRunID: runID,
+			Type:          result.Task.Type(),
+			Index:         result.Task.OutputIndex(),
+			Output:        result.Result.OutputDB(),
+			Error:         result.Result.ErrorDB(),
+			DotID:         result.Task.DotID(),
+			CreatedAt:     result.CreatedAt,
+			FinishedAt:    result.FinishedAt,
+			task:          result.Task,
+		})
+	}
+	sort.Slice(taskRuns, func(i, j int) bool {
+		if taskRuns[i].task.OutputIndex() == taskRuns[j].task.OutputIndex() {
+			return taskRuns[i].FinishedAt.ValueOrZero().Before(taskRuns[j].FinishedAt.ValueOrZero())
+		}
+		return taskRuns[i].task.OutputIndex() < taskRuns[j].task.OutputIndex()
+	})
+	return taskRuns
+}
+
+// iterationTaskRuns returns the task runs of the iterations of all foreach tasks, ordered by task ID.
+func (s *scheduler) iterationTaskRuns() []TaskRun {
+	ids := make([]int, 0, len(s.iterationRuns))
+	for id := range s.iterationRuns {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	var taskRuns []TaskRun
+	for _, id := range ids {
+		taskRuns = append(taskRuns, s.iterationRuns[id]...)
+	}
+	return taskRuns
+}
+
 func (s *scheduler) markRemaining(err error) {
 	now := time.Now()
 	for _, task := range s.pipeline.Tasks {
@@ -0,0 +1,249 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+)
+
+// ErrSimulationSideEffect is returned by tasks which would change external state when run in a simulation without a stub
+var ErrSimulationSideEffect = errors.New("task is not executed in simulations, stub it to continue")
+
+// SimulateRequest is the body of a job simulation request.
+type SimulateRequest struct {
+	Vars  map[string]interface{} `json:"vars"`
+	Stubs map[string]TaskStub    `json:"stubs"`
+}
+
+// TaskStub is a canned response returned instead of running a task during a simulation.
+type TaskStub struct {
+	Value interface{} `json:"value"`
+	Error string      `json:"error"`
+}
+
+func (s TaskStub) result() Result {
+	if s.Error != "" {
+		return Result{Error: errors.New(s.Error)}
+	}
+	return Result{Value: s.Value}
+}
+
+// Stubbable task types may be replaced with canned responses in simulations.
+var stubbableTaskTypes = map[TaskType]bool{
+	TaskTypeHTTP:    true,
+	TaskTypeBridge:  true,
+	TaskTypeETHCall: true,
+	TaskTypeETHTx:   true,
+}
+
+// TaskTrace records the last execution of a task during a simulation.
+type TaskTrace struct {
+	DotID string
+	Type  TaskType
+	// Params holds the task attributes after variable interpolation
+	Params   map[string]interface{}
+	Inputs   []Result
+	Output   interface{}
+	Error    error
+	Duration time.Duration
+	Retries  uint
+	Stubbed  bool
+}
+
+// Simulation is the result of a dry-run of a pipeline spec.
+type Simulation struct {
+	Run    *Run
+	Result FinalResult
+	// Trace is ordered by the time each task started
+	Trace []TaskTrace
+}
+
+// tracedTask records what a task was given and resolved to, and optionally returns a stubbed result instead of running it.
+type tracedTask struct {
+	Task
+	stub *TaskStub
+
+	mu     sync.Mutex
+	params map[string]interface{}
+	inputs []Result
+}
+
+func (t *tracedTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (Result, RunInfo) {
+	t.mu.Lock()
+	t.params = resolveTaskParams(t.Task, vars)
+	t.inputs = inputs
+	t.mu.Unlock()
+
+	switch {
+	case t.stub != nil:
+		return t.stub.result(), RunInfo{}
+	case t.Type() == TaskTypeETHTx:
+		return Result{Error: ErrSimulationSideEffect}, RunInfo{}
+	}
+	return t.Task.Run(ctx, lggr, vars, inputs)
+}
+
+// simulationBridgeORM keeps bridge tasks of simulations from overwriting the last value of the bridge.
+type simulationBridgeORM struct {
+	bridges.ORM
+}
+
+func (simulationBridgeORM) UpsertBridgeResponse(context.Context, string, int32, []byte) error {
+	return nil
+}
+
+func (simulationBridgeORM) BulkUpsertBridgeResponse(context.Context, []bridges.BridgeResponse) error {
+	return nil
+}
+
+type simulationCtxKey struct{}
+
+func isSimulation(ctx context.Context) bool {
+	return ctx.Value(simulationCtxKey{}) != nil
+}
+
+// sandbox wraps the tasks of pipeline in tracedTasks, and detaches bridge tasks from the bridge last value table, the
+// shared response cache and the bridge health tracker, so that simulations leave no trace on the node.
+func sandbox(pipeline *Pipeline, stubs map[string]TaskStub) map[int]*tracedTask {
+	traced := make(map[int]*tracedTask, len(pipeline.Tasks))
+	for i, task := range pipeline.Tasks {
+		if bt, ok := task.(*BridgeTask); ok {
+			bt.orm = simulationBridgeORM{bt.orm}
+			bt.responses = nil
+			bt.health = nil
+		}
+		tt := &tracedTask{Task: task}
+		if stub, ok := stubs[task.DotID()]; ok {
+			tt.stub = &stub
+		}
+		traced[task.ID()] = tt
+		pipeline.Tasks[i] = tt
+	}
+	return traced
+}
+
+// SimulateRun executes spec in-memory against vars without persisting anything and returns a trace of every task.
+// Tasks whose dot ID is a key of stubs return the stubbed response instead of running. ETH transactions are never sent,
+// but unstubbed http and bridge tasks do send their requests, so stub them when the endpoints must not be called.
+func (r *runner) SimulateRun(ctx context.Context, spec Spec, vars Vars, stubs map[string]TaskStub) (*Simulation, error) {
+	// Always parse a fresh pipeline, so the wrapped tasks do not leak into the pipeline of the job
+	spec.Pipeline = nil
+	pipeline, err := r.InitializePipeline(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	for dotID := range stubs {
+		task := pipeline.ByDotID(dotID)
+		if task == nil {
+			return nil, errors.Errorf("cannot stub task %s: no such task", dotID)
+		}
+		if !stubbableTaskTypes[task.Type()] {
+			return nil, errors.Errorf("cannot stub task %s: tasks of type %s cannot be stubbed", dotID, task.Type())
+		}
+	}
+
+	traced := sandbox(pipeline, stubs)
+	spec.Pipeline = pipeline
+
+	// foreach iterations parse their own pipelines, which are sandboxed as well
+	ctx = context.WithValue(ctx, simulationCtxKey{}, struct{}{})
+	run, trrs, err := r.ExecuteRun(ctx, spec, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	sim := &Simulation{Run: run, Result: trrs.FinalResult()}
+	started := append(TaskRunResults(nil), trrs...)
+	sort.SliceStable(started, func(i, j int) bool {
+		return started[i].CreatedAt.Before(started[j].CreatedAt)
+	})
+	for _, trr := range started {
+		tt := traced[trr.Task.ID()]
+		trace := TaskTrace{
+			DotID:    trr.Task.DotID(),
+			Type:     trr.Task.Type(),
+			Output:   trr.Result.Value,
+			Error:    trr.Result.Error,
+			Duration: trr.FinishedAt.Time.Sub(trr.CreatedAt),
+		}
+		if trr.Attempts > 0 {
+			trace.Retries = trr.Attempts - 1
+		}
+		if tt != nil {
+			tt.mu.Lock()
+			trace.Params, trace.Inputs, trace.Stubbed = tt.params, tt.inputs, tt.stub != nil
+			tt.mu.Unlock()
+		}
+		sim.Trace = append(sim.Trace, trace)
+	}
+	return sim, nil
+}
+
+// resolveTaskParams interpolates the variable expressions of the string attributes of task. Attributes which cannot be
+// resolved are returned as written in the spec.
+func resolveTaskParams(task Task, vars Vars) map[string]interface{} {
+	v := reflect.Indirect(reflect.ValueOf(task))
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	params := make(map[string]interface{})
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if field.Anonymous || !field.IsExported() || field.Type.Kind() != reflect.String {
+			continue
+		}
+		raw := v.Field(i).String()
+		if raw == "" {
+			continue
+		}
+		params[paramName(field)] = interpolateParam(raw, vars)
+	}
+	return params
+}
+
+func paramName(field reflect.StructField) string {
+	if name, _, _ := strings.Cut(field.Tag.Get("json"), ","); name != "" && name != "-" {
+		return name
+	}
+	// Lowercase the leading acronym, e.g. URL -> url and EVMChainID -> evmChainID
+	name := field.Name
+	n := 0
+	for n < len(name) && unicode.IsUpper(rune(name[n])) {
+		n++
+	}
+	if n > 1 && n < len(name) {
+		n--
+	}
+	return strings.ToLower(name[:n]) + name[n:]
+}
+
+func interpolateParam(raw string, vars Vars) interface{} {
+	if !variableRegexp.MatchString(raw) {
+		return raw
+	}
+	if val, err := VarExpr(raw, vars)(); err == nil {
+		return val
+	}
+	if val, err := JSONWithVarExprs(raw, vars, true)(); err == nil {
+		return val
+	}
+	return variableRegexp.ReplaceAllStringFunc(raw, func(expr string) string {
+		val, err := vars.Get(expr[2 : len(expr)-1])
+		if err != nil {
+			return expr
+		}
+		return fmt.Sprintf("%v", val)
+	})
+}
@@ -0,0 +1,112 @@
+package pipeline_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	bridgesMocks "github.com/smartcontractkit/chainlink/v2/core/bridges/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func Test_PipelineRunner_SimulateRun(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	r, _ := newRunner(t, db, bridgesMocks.NewORM(t), cfg)
+
+	t.Run("traces stubbed and interpolated tasks", func(t *testing.T) {
+		spec := pipeline.Spec{DotDagSource: `
+fetch    [type=http method=GET url="$(url)"]
+parse    [type=jsonparse path="data,price"]
+multiply [type=multiply times="$(times)"]
+fetch -> parse -> multiply
+`}
+		vars := pipeline.NewVarsFrom(map[string]interface{}{"url": "https://example.com/price", "times": 2})
+		stubs := map[string]pipeline.TaskStub{"fetch": {Value: `{"data":{"price":3}}`}}
+
+		sim, err := r.SimulateRun(testutils.Context(t), spec, vars, stubs)
+		require.NoError(t, err)
+		require.False(t, sim.Result.HasFatalErrors())
+		result, err := sim.Result.SingularResult()
+		require.NoError(t, err)
+		assert.Equal(t, "6", result.Value.(decimal.Decimal).String())
+
+		require.Len(t, sim.Trace, 3)
+		fetch, parse, multiply := sim.Trace[0], sim.Trace[1], sim.Trace[2]
+		assert.Equal(t, "fetch", fetch.DotID)
+		assert.True(t, fetch.Stubbed)
+		assert.Equal(t, "https://example.com/price", fetch.Params["url"])
+		assert.Equal(t, "GET", fetch.Params["method"])
+
+		assert.Equal(t, "parse", parse.DotID)
+		assert.False(t, parse.Stubbed)
+		require.Len(t, parse.Inputs, 1)
+		assert.Equal(t, `{"data":{"price":3}}`, parse.Inputs[0].Value)
+
+		assert.Equal(t, "multiply", multiply.DotID)
+		assert.Equal(t, 2, multiply.Params["times"])
+		assert.Zero(t, multiply.Retries)
+
+		// the spec itself is never modified
+		assert.Nil(t, spec.Pipeline)
+	})
+
+	t.Run("does not send transactions", func(t *testing.T) {
+		spec := pipeline.Spec{DotDagSource: `
+tx [type=ethtx to="0x2a3e23c6f242F5345320814aC8a1b4E58707D292" data="0x"]
+`}
+		sim, err := r.SimulateRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, err)
+		require.Len(t, sim.Trace, 1)
+		require.ErrorIs(t, sim.Trace[0].Error, pipeline.ErrSimulationSideEffect)
+		assert.True(t, sim.Result.HasFatalErrors())
+
+		sim, err = r.SimulateRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), map[string]pipeline.TaskStub{"tx": {Error: "reverted"}})
+		require.NoError(t, err)
+		require.EqualError(t, sim.Trace[0].Error, "reverted")
+	})
+
+	t.Run("does not store bridge responses", func(t *testing.T) {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"data":{"result":7}}`))
+		}))
+		t.Cleanup(s.Close)
+		_, bt := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: s.URL})
+
+		// the mock fails the test on any call to UpsertBridgeResponse
+		btORM := bridgesMocks.NewORM(t)
+		btORM.On("FindBridge", mock.Anything, bt.Name).Return(*bt, nil)
+		r, _ := newRunner(t, db, btORM, cfg)
+
+		spec := pipeline.Spec{DotDagSource: fmt.Sprintf(`
+ds   [type=bridge name="%[1]s" cacheTTL=30]
+loop [type=foreach input="[1]" subpipeline=<
+	ds [type=bridge name="%[1]s" cacheTTL=30]
+>]
+`, bt.Name)}
+		sim, err := r.SimulateRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, err)
+		require.False(t, sim.Result.HasFatalErrors())
+	})
+
+	t.Run("rejects invalid stubs", func(t *testing.T) {
+		spec := pipeline.Spec{DotDagSource: `
+a [type=memo value=1]
+`}
+		_, err := r.SimulateRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), map[string]pipeline.TaskStub{"b": {}})
+		require.ErrorContains(t, err, "no such task")
+
+		_, err = r.SimulateRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), map[string]pipeline.TaskStub{"a": {}})
+		require.ErrorContains(t, err, "cannot be stubbed")
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
@@ -50,8 +51,30 @@ var (
 	},
 		[]string{"name"},
 	)
+	promBridgeCacheMisses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bridge_cache_misses_total",
+		Help: "Bridge cache misses count scoped by name, for bridges with a cache policy",
+	},
+		[]string{"name"},
+	)
+	promBridgeCacheStale = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bridge_cache_stale_total",
+		Help: "Bridge stale responses served while revalidating count scoped by name",
+	},
+		[]string{"name"},
+	)
+	promBridgeCacheCoalesced = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bridge_cache_coalesced_total",
+		Help: "Bridge requests coalesced with an identical in-flight request count scoped by name",
+	},
+		[]string{"name"},
+	)
 )
 
+// If the bridge has a cache policy, synchronous requests go through the node-wide response cache, which may serve
+// a cached response or the response of an identical in-flight request of another job. Requests fail over to the
+// backup URLs of the bridge, and fail fast with bridges.ErrCircuitOpen while the circuit breakers of all URLs are open.
+//
 // Return types:
 //
 //	string
@@ -70,6 +93,11 @@ type BridgeTask struct {
 	config       Config
 	bridgeConfig BridgeConfig
 	httpClient   *http.Client
+	responses    *bridges.ResponseCache
+	health       *bridges.HealthTracker
+	// lastValueDotID is the dot ID the responses of a task of a foreach iteration are stored under, so that iterations
+	// never fall back to the responses of each other
+	lastValueDotID string
 }
 
 type BridgeTelemetry struct {
@@ -96,6 +124,13 @@ func (t *BridgeTask) Type() TaskType {
 	return TaskTypeBridge
 }
 
+func (t *BridgeTask) lastValueKey() string {
+	if t.lastValueDotID != "" {
+		return t.lastValueDotID
+	}
+	return t.dotID
+}
+
 func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
 	inputValues, err := CheckInputs(inputs, -1, -1, 0)
 	if err != nil {
@@ -127,10 +162,11 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	overtimeCtx, cancel := overtimeContext(ctx)
 	defer cancel()
 
-	url, err := t.getBridgeURLFromName(overtimeCtx, name)
+	bt, err := t.findBridge(overtimeCtx, name)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+	url := URLParam(bt.URL)
 
 	var metaMap MapParam
 
@@ -184,10 +220,23 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		cacheDuration = stalenessCap
 	}
 
-	var cachedResponse bool
-	responseBytes, statusCode, headers, start, finish, err := makeHTTPRequest(requestCtx, lggr, "POST", url, reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
+	var (
+		cachedResponse bool
+		responseBytes  []byte
+		statusCode     int
+		headers        http.Header
+		start, finish  time.Time
+	)
+	if t.responses != nil && bt.CachePolicy.Enabled() && t.Async != "true" {
+		var cacheStatus bridges.CacheStatus
+		start = time.Now()
+		responseBytes, statusCode, cacheStatus, err = t.fetchWithCachePolicy(requestCtx, lggr, bt, reqHeaders, requestData)
+		finish = time.Now()
+		cachedResponse = cacheStatus == bridges.CacheHit || cacheStatus == bridges.CacheStale
+	} else {
+		responseBytes, statusCode, headers, start, finish, err = t.sendRequest(requestCtx, lggr, bt, reqHeaders, requestData)
+	}
 	elapsed := finish.Sub(start)
-	promBridgeLatency.WithLabelValues(t.Name, statusCodeGroup(statusCode)).Set(elapsed.Seconds())
 
 	defer func() {
 		telemetryCh := GetTelemetryCh(ctx)
@@ -230,11 +279,11 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 
 		promBridgeErrors.WithLabelValues(t.Name).Inc()
 		if cacheTTL == 0 {
-			return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
+			return Result{Error: err}, RunInfo{IsRetryable: isRetryableBridgeError(statusCode, err)}
 		}
 
 		var cacheErr error
-		responseBytes, cacheErr = t.orm.GetCachedResponse(overtimeCtx, t.dotID, t.specId, cacheDuration)
+		responseBytes, cacheErr = t.orm.GetCachedResponse(overtimeCtx, t.lastValueKey(), t.specId, cacheDuration)
 		if cacheErr != nil {
 			promBridgeCacheErrors.WithLabelValues(t.Name).Inc()
 			if !errors.Is(cacheErr, sql.ErrNoRows) {
@@ -243,7 +292,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 					"url", url.String(),
 				)
 			}
-			return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
+			return Result{Error: err}, RunInfo{IsRetryable: isRetryableBridgeError(statusCode, err)}
 		}
 		promBridgeCacheHits.WithLabelValues(t.Name).Inc()
 		lggr.Debugw("Bridge task: request failed, falling back to cache",
@@ -268,7 +317,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	}
 
 	if !cachedResponse && cacheTTL > 0 {
-		err := t.orm.UpsertBridgeResponse(overtimeCtx, t.dotID, t.specId, responseBytes)
+		err := t.orm.UpsertBridgeResponse(overtimeCtx, t.lastValueKey(), t.specId, responseBytes)
 		if err != nil {
 			lggr.Errorw("Bridge task: failed to upsert response in bridge cache", "err", err)
 		}
@@ -292,12 +341,105 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	return result, runInfo
 }
 
-func (t *BridgeTask) getBridgeURLFromName(ctx context.Context, name StringParam) (URLParam, error) {
+func (t *BridgeTask) findBridge(ctx context.Context, name StringParam) (bridges.BridgeType, error) {
 	bt, err := t.orm.FindBridge(ctx, bridges.BridgeName(name))
 	if err != nil {
-		return URLParam{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
+		return bridges.BridgeType{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
+	}
+	return bt, nil
+}
+
+// sendRequest posts requestData to the URLs of the bridge in order, skipping the URLs whose circuit breaker is open and
+// moving on to the next URL while the external adapter is unavailable. It returns bridges.ErrCircuitOpen if every
+// circuit breaker is open.
+func (t *BridgeTask) sendRequest(ctx context.Context, lggr logger.Logger, bt bridges.BridgeType, reqHeaders []string, requestData MapParam) (responseBytes []byte, statusCode int, headers http.Header, start, finish time.Time, err error) {
+	urls, err := bt.URLs()
+	if err != nil {
+		return
+	}
+
+	err = errors.Wrapf(bridges.ErrCircuitOpen, "bridge %s", bt.Name)
+	for _, u := range urls {
+		if t.health != nil && !t.health.Allow(bt, u.String()) {
+			continue
+		}
+		responseBytes, statusCode, headers, start, finish, err = makeHTTPRequest(ctx, lggr, "POST", URLParam(*u), reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
+		promBridgeLatency.WithLabelValues(t.Name, statusCodeGroup(statusCode)).Set(finish.Sub(start).Seconds())
+
+		// client errors mean the external adapter is up, so only transport and server errors count as failures
+		unavailable := err != nil && (statusCode == 0 || statusCode >= http.StatusInternalServerError)
+		if t.health != nil {
+			var healthErr error
+			if unavailable {
+				healthErr = err
+			}
+			t.health.Record(bt, u.String(), healthErr)
+		}
+		if !unavailable || ctx.Err() != nil {
+			return
+		}
+		lggr.Warnw("Bridge task: external adapter unavailable, trying the next URL", "url", u.String(), "err", err)
+	}
+	return
+}
+
+// isRetryableBridgeError is isRetryableHTTPError, except that open circuit breakers are not retried within a run.
+func isRetryableBridgeError(statusCode int, err error) bool {
+	return isRetryableHTTPError(statusCode, err) && !errors.Is(err, bridges.ErrCircuitOpen)
+}
+
+// bridgeResponseError carries a failed response through the response cache, so that coalesced requests see the same
+// status code and body as the request which was sent.
+type bridgeResponseError struct {
+	statusCode    int
+	responseBytes []byte
+	err           error
+}
+
+func (e *bridgeResponseError) Error() string {
+	if e.err == nil {
+		return "bridge returned status code " + strconv.Itoa(e.statusCode)
+	}
+	return e.err.Error()
+}
+
+// fetchWithCachePolicy requests the bridge through the response cache, which applies the cache policy of bt. Only
+// successful responses are cached.
+func (t *BridgeTask) fetchWithCachePolicy(ctx context.Context, lggr logger.Logger, bt bridges.BridgeType, reqHeaders []string, requestData MapParam) ([]byte, int, bridges.CacheStatus, error) {
+	key, err := bridges.RequestKey(bt.Name, reqHeaders, requestData)
+	if err != nil {
+		return nil, 0, bridges.CacheMiss, errors.Wrap(err, "failed to compute bridge cache key")
+	}
+
+	responseBytes, cacheStatus, err := t.responses.Do(ctx, bt, key, func(ctx context.Context) ([]byte, error) {
+		responseBytes, statusCode, _, _, _, err := t.sendRequest(ctx, lggr, bt, reqHeaders, requestData)
+		if code, ok := eautils.BestEffortExtractEAStatus(responseBytes); ok {
+			statusCode = code
+		}
+		if err != nil || statusCode != http.StatusOK {
+			return nil, &bridgeResponseError{statusCode: statusCode, responseBytes: responseBytes, err: err}
+		}
+		return responseBytes, nil
+	})
+
+	switch cacheStatus {
+	case bridges.CacheHit:
+		promBridgeCacheHits.WithLabelValues(t.Name).Inc()
+	case bridges.CacheStale:
+		promBridgeCacheStale.WithLabelValues(t.Name).Inc()
+	case bridges.CacheCoalesced:
+		promBridgeCacheCoalesced.WithLabelValues(t.Name).Inc()
+	case bridges.CacheMiss:
+		promBridgeCacheMisses.WithLabelValues(t.Name).Inc()
+	}
+
+	var responseErr *bridgeResponseError
+	if errors.As(err, &responseErr) {
+		return responseErr.responseBytes, responseErr.statusCode, cacheStatus, responseErr.err
+	} else if err != nil {
+		return nil, 0, cacheStatus, err
 	}
-	return URLParam(bt.URL), nil
+	return responseBytes, http.StatusOK, cacheStatus, nil
 }
 
 func withRunInfo(request MapParam, meta MapParam) MapParam {
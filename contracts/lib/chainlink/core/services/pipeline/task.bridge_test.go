@@ -329,6 +329,135 @@ func TestBridgeTask_HandlesIntermittentFailure(t *testing.T) {
 	require.Equal(t, runInfo.IsRetryable, runInfo2.IsRetryable)
 }
 
+func TestBridgeTask_CachePolicy(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	ctx := testutils.Context(t)
+
+	var requests atomic.Int32
+	s1 := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		_, _ = w.Write([]byte(`{"data":{"result":"9700"}}`))
+	}))
+	defer s1.Close()
+
+	orm := bridges.NewORM(db)
+	_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: s1.URL})
+	require.NoError(t, orm.UpdateBridgeType(ctx, bridge, &bridges.BridgeTypeRequest{
+		URL:         bridge.URL,
+		CachePolicy: bridges.CachePolicy{CacheFreshTTL: models.Interval(time.Hour)},
+	}))
+
+	responses := bridges.NewResponseCache()
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	run := func(dotID string, requestData string, latestAnswer int) (pipeline.Result, *pipeline.BridgeTelemetry) {
+		telemCh := make(chan interface{}, 1)
+		task := pipeline.BridgeTask{
+			BaseTask:    pipeline.NewBaseTask(0, dotID, nil, nil, 0),
+			Name:        bridge.Name.String(),
+			RequestData: requestData,
+		}
+		task.HelperSetDependencies(cfg.JobPipeline(), cfg.WebServer(), orm, 0, uuid.UUID{}, c)
+		task.HelperSetResponseCache(responses)
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"jobRun": map[string]interface{}{"meta": map[string]interface{}{"latestAnswer": latestAnswer}},
+		})
+		result, _ := task.Run(pipeline.WithTelemetryCh(ctx, telemCh), logger.TestLogger(t), vars, nil)
+		return result, (<-telemCh).(*pipeline.BridgeTelemetry)
+	}
+
+	result, telem := run("a", `{"data":{"coin":"BTC","market":"USD"}}`, 1)
+	require.NoError(t, result.Error)
+	assert.False(t, telem.LocalCacheHit)
+	assert.Equal(t, int32(1), requests.Load())
+
+	// identical requests of other tasks and jobs are served from the cache, regardless of field order and meta
+	result2, telem := run("b", `{"data":{"market":"USD","coin":"BTC"}}`, 2)
+	require.NoError(t, result2.Error)
+	assert.Equal(t, result.Value, result2.Value)
+	assert.True(t, telem.LocalCacheHit)
+	assert.Equal(t, http.StatusOK, telem.ResponseStatusCode)
+	assert.Equal(t, int32(1), requests.Load())
+
+	result, telem = run("a", ethUSDPairing, 1)
+	require.NoError(t, result.Error)
+	assert.False(t, telem.LocalCacheHit)
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+func TestBridgeTask_Failover(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	ctx := testutils.Context(t)
+
+	var primaryRequests, backupRequests atomic.Int32
+	var backupDown atomic.Bool
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		primaryRequests.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer primary.Close()
+	backup := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		backupRequests.Add(1)
+		if backupDown.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"result":"9700"}}`))
+	}))
+	defer backup.Close()
+
+	orm := bridges.NewORM(db)
+	_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: primary.URL})
+	require.NoError(t, orm.UpdateBridgeType(ctx, bridge, &bridges.BridgeTypeRequest{
+		URL: bridge.URL,
+		FailoverPolicy: bridges.FailoverPolicy{
+			BackupURLs:              []string{backup.URL},
+			CircuitBreakerThreshold: 1,
+			CircuitBreakerCooldown:  models.Interval(time.Hour),
+		},
+	}))
+
+	health := bridges.NewHealthTracker()
+	task := pipeline.BridgeTask{
+		BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+		Name:        bridge.Name.String(),
+		RequestData: btcUSDPairing,
+	}
+	task.HelperSetDependencies(cfg.JobPipeline(), cfg.WebServer(), orm, 0, uuid.UUID{}, clhttptest.NewTestLocalOnlyHTTPClient())
+	task.HelperSetHealthTracker(health)
+
+	// the primary URL fails and trips its circuit breaker, the backup URL answers
+	result, _ := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.NoError(t, result.Error)
+	assert.Equal(t, `{"data":{"result":"9700"}}`, result.Value)
+	assert.Equal(t, int32(1), primaryRequests.Load())
+
+	// the open circuit of the primary URL is skipped
+	result, _ = task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.NoError(t, result.Error)
+	assert.Equal(t, int32(1), primaryRequests.Load())
+	assert.Equal(t, int32(2), backupRequests.Load())
+
+	// once every circuit is open the task fails fast without calling the external adapters
+	backupDown.Store(true)
+	result, _ = task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.Error(t, result.Error)
+	result, runInfo := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.ErrorIs(t, result.Error, bridges.ErrCircuitOpen)
+	assert.False(t, runInfo.IsRetryable)
+	assert.Equal(t, int32(3), backupRequests.Load())
+
+	states := health.Health(*bridge)
+	require.Len(t, states, 2)
+	assert.Equal(t, bridges.CircuitOpen, states[0].State)
+	assert.Equal(t, bridges.CircuitOpen, states[1].State)
+}
+
 func TestBridgeTask_DoesNotReturnStaleResults(t *testing.T) {
 	t.Parallel()
 
@@ -164,7 +164,10 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 	}
 
 	_, err = txManager.CreateTransaction(ctx, txRequest)
-	if err != nil {
+	if errors.Is(err, txmgr.ErrBudgetExceeded) {
+		// Retrying will not help until the budget window rolls over
+		return Result{Error: errors.Wrap(err, "while creating transaction")}, RunInfo{}
+	} else if err != nil {
 		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while creating transaction: %v", err)}, retryableRunInfo()
 	}
 
@@ -432,6 +432,26 @@ func TestETHTxTask(t *testing.T) {
 			},
 			nil, pipeline.ErrTaskRunFailed, "while creating transaction", pipeline.RunInfo{IsRetryable: true},
 		},
+		{
+			"spend budget exceeded",
+			`[ "0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c" ]`,
+			"0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
+			"foobar",
+			"12345",
+			`{ "jobID": 321, "requestID": "0x5198616554d738d9485d1a7cf53b2f33e09c3bbc8fe9ac0020bd672cd2bc15d2", "requestTxHash": "0xc524fafafcaec40652b1f84fca09c231185437d008d195fccf2f51e64b7062f8" }`,
+			`0`,
+			"0",
+			"",
+			nil,
+			false,
+			pipeline.NewVarsFrom(nil),
+			nil,
+			func(keyStore *keystoremocks.Eth, txManager *txmmocks.MockEvmTxManager) {
+				keyStore.On("GetRoundRobinAddress", mock.Anything, testutils.FixtureChainID, from).Return(from, nil)
+				txManager.On("CreateTransaction", mock.Anything, mock.Anything).Return(txmgr.Tx{}, txmgr.ErrBudgetExceeded)
+			},
+			nil, txmgr.ErrBudgetExceeded, "while creating transaction", pipeline.RunInfo{},
+		},
 		{
 			"extra keys in txMeta",
 			`[ "0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c" ]`,
@@ -0,0 +1,160 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+const (
+	// ForeachItemVar and ForeachIndexVar hold the element and its index within the sub-pipeline of a foreach task
+	ForeachItemVar  = "item"
+	ForeachIndexVar = "index"
+
+	defaultForeachMaxConcurrency = 10
+)
+
+// ForeachTask runs a sub-pipeline once for every element of its input array, with the element available as $(item)
+// and its index as $(index). Iterations run on their own scheduler, so retries and timeouts apply to every task of
+// every iteration, and their task runs are stored as <dotID>[<index>].<sub-task dotID>.
+//
+// The sub-pipeline is a DOT fragment. Edges can be implicit, e.g.
+//
+//	loop [type=foreach input="$(assets)" maxConcurrency=5 subpipeline=<
+//	    fetch [type=http method=GET url="$(item.url)"]
+//	    parse [type=jsonparse data="$(fetch)" path="price"]
+//	>]
+//
+// Return types:
+//
+//	[]interface{} with the result of every iteration, in input order
+type ForeachTask struct {
+	BaseTask       `mapstructure:",squash"`
+	Input          string `json:"input"`
+	Subpipeline    string `json:"subpipeline"`
+	MaxConcurrency string `json:"maxConcurrency"`
+
+	runner *runner
+	spec   Spec
+	// dotIDPrefix is the prefix of the dot IDs of the iteration running the task, when foreach tasks are nested
+	dotIDPrefix string
+}
+
+var _ Task = (*ForeachTask)(nil)
+
+func (t *ForeachTask) Type() TaskType {
+	return TaskTypeForeach
+}
+
+// validate checks that the sub-pipeline parses and only contains tasks which complete synchronously and have no side
+// effects, since an iteration cannot be suspended or resumed.
+func (t *ForeachTask) validate() error {
+	sub, err := Parse(t.Subpipeline)
+	if err != nil {
+		return errors.Wrap(err, "subpipeline")
+	}
+	for _, task := range sub.Tasks {
+		switch task.Type() {
+		case TaskTypeETHTx:
+			return errors.Errorf("subpipeline: task %s: %s tasks are not supported in foreach", task.DotID(), task.Type())
+		case TaskTypeBridge:
+			if task.(*BridgeTask).Async == "true" {
+				return errors.Errorf("subpipeline: task %s: async bridge tasks are not supported in foreach", task.DotID())
+			}
+		default:
+		}
+	}
+	return nil
+}
+
+func (t *ForeachTask) Run(ctx context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		items          SliceParam
+		maxConcurrency Uint64Param
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&items, From(VarExpr(t.Input, vars), JSONWithVarExprs(t.Input, vars, false), Input(inputs, 0))), "input"),
+		errors.Wrap(ResolveParam(&maxConcurrency, From(NonemptyString(t.MaxConcurrency), defaultForeachMaxConcurrency)), "maxConcurrency"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	} else if maxConcurrency == 0 {
+		return Result{Error: errors.Wrap(ErrBadInput, "maxConcurrency must be greater than 0")}, runInfo
+	}
+	if t.runner == nil {
+		return Result{Error: errors.New("foreach task was not initialized by the pipeline runner")}, runInfo
+	}
+
+	var (
+		wg       sync.WaitGroup
+		sem      = make(chan struct{}, maxConcurrency)
+		values   = make([]interface{}, len(items))
+		errs     = make([]error, len(items))
+		taskRuns = make([][]TaskRun, len(items))
+	)
+	for i, item := range items {
+		iterationVars := vars.Copy()
+		if err = multierr.Combine(iterationVars.Set(ForeachItemVar, item), iterationVars.Set(ForeachIndexVar, i)); err != nil {
+			return Result{Error: err}, runInfo
+		}
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return Result{Error: ctx.Err()}, runInfo
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			values[i], taskRuns[i], errs[i] = t.runner.runIteration(ctx, t.spec, t.Subpipeline, iterationVars, t.dotIDPrefix+iterationDotID(t.DotID(), i, ""))
+		}(i)
+	}
+	wg.Wait()
+
+	for i := range items {
+		for _, taskRun := range taskRuns[i] {
+			taskRun.DotID = iterationDotID(t.DotID(), i, taskRun.DotID)
+			runInfo.iterationRuns = append(runInfo.iterationRuns, taskRun)
+		}
+	}
+	for i, err := range errs {
+		if err != nil {
+			return Result{Error: errors.Wrapf(err, "iteration %d", i)}, runInfo
+		}
+	}
+	return Result{Value: values}, runInfo
+}
+
+// iterationDotID returns the dot ID a task run of an iteration of a foreach task is stored under, e.g. loop[2].fetch
+func iterationDotID(foreachDotID string, index int, dotID string) string {
+	return fmt.Sprintf("%s[%d].%s", foreachDotID, index, dotID)
+}
+
+// iterationParent returns the foreach task which ran the iteration stored under dotID, or nil if dotID does not belong
+// to an iteration.
+func (p *Pipeline) iterationParent(dotID string) Task {
+	if p.ByDotID(dotID) != nil {
+		return nil
+	}
+	parent, _, found := strings.Cut(dotID, "[")
+	if !found {
+		return nil
+	}
+	if task := p.ByDotID(parent); task != nil && task.Type() == TaskTypeForeach {
+		return task
+	}
+	return nil
+}
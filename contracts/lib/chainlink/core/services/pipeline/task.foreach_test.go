@@ -0,0 +1,134 @@
+package pipeline_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	bridgesMocks "github.com/smartcontractkit/chainlink/v2/core/bridges/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+func TestForeachTask(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	r, _ := newRunner(t, db, bridgesMocks.NewORM(t), cfg)
+
+	t.Run("runs the subpipeline for every element", func(t *testing.T) {
+		spec := pipeline.Spec{DotDagSource: `
+loop [type=foreach input="$(items)" maxConcurrency=2 subpipeline=<
+	double [type=multiply input="$(item)" times=2]
+	offset [type=sum values="[ $(double), $(index) ]"]
+>]
+`}
+		vars := pipeline.NewVarsFrom(map[string]interface{}{"items": []interface{}{1, 2, 3}})
+
+		run, trrs, err := r.ExecuteRun(testutils.Context(t), spec, vars)
+		require.NoError(t, err)
+		require.False(t, trrs.FinalResult().HasFatalErrors())
+		result, err := trrs.FinalResult().SingularResult()
+		require.NoError(t, err)
+		values := result.Value.([]interface{})
+		require.Len(t, values, 3)
+		for i, want := range []int64{2, 5, 8} {
+			assert.Equal(t, want, values[i].(decimal.Decimal).IntPart())
+		}
+
+		// every task of every iteration is stored with the run, but only the foreach task is an output
+		require.Len(t, run.PipelineTaskRuns, 7)
+		taskRun := run.ByDotID("loop[1].offset")
+		require.NotNil(t, taskRun)
+		assert.Equal(t, pipeline.TaskTypeSum, taskRun.Type)
+		assert.Equal(t, int64(5), taskRun.Output.Val.(decimal.Decimal).IntPart())
+		require.Len(t, run.Outputs.Val, 1)
+	})
+
+	t.Run("fails if an iteration fails", func(t *testing.T) {
+		spec := pipeline.Spec{DotDagSource: `
+loop [type=foreach input="[1, 2]" subpipeline=<
+	fail [type=fail msg="boom"]
+>]
+`}
+		run, trrs, err := r.ExecuteRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil))
+		require.NoError(t, err)
+		fr := trrs.FinalResult()
+		require.True(t, fr.HasFatalErrors())
+		require.ErrorContains(t, fr.FatalErrors[0], "iteration 0: boom")
+		require.NotNil(t, run.ByDotID("loop[1].fail"))
+	})
+
+	t.Run("applies MaxTaskDuration to every iteration", func(t *testing.T) {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			time.Sleep(100 * time.Millisecond)
+			_, _ = w.Write([]byte(`{"price":1}`))
+		}))
+		t.Cleanup(s.Close)
+
+		spec := pipeline.Spec{
+			DotDagSource: `
+loop [type=foreach input="[1, 2, 3, 4]" maxConcurrency=1 subpipeline=<
+	fetch [type=http method=GET url="` + s.URL + `"]
+	parse [type=jsonparse data="$(fetch)" path="price"]
+>]
+`,
+			MaxTaskDuration: models.Interval(250 * time.Millisecond),
+		}
+		_, trrs, err := r.ExecuteRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil))
+		require.NoError(t, err)
+		// the loop takes longer than MaxTaskDuration, but none of its tasks do
+		require.False(t, trrs.FinalResult().HasFatalErrors())
+
+		spec.MaxTaskDuration = models.Interval(10 * time.Millisecond)
+		_, trrs, err = r.ExecuteRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil))
+		require.NoError(t, err)
+		require.True(t, trrs.FinalResult().HasFatalErrors())
+	})
+
+	t.Run("stores the bridge responses of every iteration separately", func(t *testing.T) {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"data":{"result":1}}`))
+		}))
+		t.Cleanup(s.Close)
+		_, bt := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: s.URL})
+
+		btORM := bridgesMocks.NewORM(t)
+		btORM.On("FindBridge", mock.Anything, bt.Name).Return(*bt, nil)
+		for _, dotID := range []string{"loop[0].ds", "loop[1].ds"} {
+			btORM.On("UpsertBridgeResponse", mock.Anything, dotID, mock.Anything, mock.Anything).Return(nil).Once()
+		}
+		r, _ := newRunner(t, db, btORM, cfg)
+
+		spec := pipeline.Spec{DotDagSource: fmt.Sprintf(`
+loop [type=foreach input="[1, 2]" subpipeline=<
+	ds [type=bridge name="%s" cacheTTL=30]
+>]
+`, bt.Name)}
+		_, trrs, err := r.ExecuteRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil))
+		require.NoError(t, err)
+		require.False(t, trrs.FinalResult().HasFatalErrors())
+	})
+
+	t.Run("rejects tasks with side effects", func(t *testing.T) {
+		_, err := pipeline.Parse(`
+loop [type=foreach input="[1]" subpipeline=<
+	tx [type=ethtx to="0x2a3e23c6f242F5345320814aC8a1b4E58707D292" data="0x"]
+>]
+`)
+		require.ErrorContains(t, err, "ethtx tasks are not supported in foreach")
+
+		_, err = pipeline.Parse(`loop [type=foreach input="[1]" subpipeline="a -> "]`)
+		require.ErrorContains(t, err, "subpipeline")
+	})
+}
@@ -0,0 +1,78 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+)
+
+// JSONQueryTask evaluates a jq-style expression over its input, see JSONQuery for the supported language.
+// Object keys are always visited in sorted order, so every node produces the same output for the same input.
+//
+// Return types:
+//
+//	int64, float64, string, bool, map[string]interface{}, []interface{} or nil when the query produces one value
+//	[]interface{} when the query produces several values
+type JSONQueryTask struct {
+	BaseTask `mapstructure:",squash"`
+	Query    string `json:"query"`
+	Data     string `json:"data"`
+	// Lax when disabled will return an error if the query produces no value
+	// Lax when enabled will return nil with no error if the query produces no value
+	Lax string
+}
+
+var _ Task = (*JSONQueryTask)(nil)
+
+func (t *JSONQueryTask) Type() TaskType {
+	return TaskTypeJSONQuery
+}
+
+func (t *JSONQueryTask) Run(ctx context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		query JSONQueryParam
+		data  JSONValueParam
+		lax   BoolParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&query, From(VarExpr(t.Query, vars), NonemptyString(t.Query))), "query"),
+		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), Input(inputs, 0))), "data"),
+		errors.Wrap(ResolveParam(&lax, From(NonemptyString(t.Lax), false)), "lax"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	outputs, err := query.Eval(ctx, data.Value)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	var value interface{}
+	switch len(outputs) {
+	case 0:
+		if !bool(lax) {
+			return Result{Error: errors.Wrapf(ErrKeypathNotFound, "query %q produced no value", query.String())}, runInfo
+		}
+		return Result{Value: nil}, runInfo
+	case 1:
+		value = jqDenormalize(outputs[0])
+	default:
+		value = jqDenormalize(outputs)
+	}
+
+	value, err = jsonserializable.ReinterpretJSONNumbers(value)
+	if err != nil {
+		return Result{Error: multierr.Combine(ErrBadInput, err)}, runInfo
+	}
+	return Result{Value: value}, runInfo
+}
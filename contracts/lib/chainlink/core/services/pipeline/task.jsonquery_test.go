@@ -0,0 +1,260 @@
+package pipeline_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestJSONQueryTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		data           string
+		query          string
+		lax            string
+		vars           pipeline.Vars
+		inputs         []pipeline.Result
+		wantData       interface{}
+		wantErrorCause error
+	}{
+		{
+			"path",
+			"",
+			`.data[0].availability`,
+			"false",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"data":[{"availability":"0.99991"}]}`}},
+			"0.99991",
+			nil,
+		},
+		{
+			"large int result",
+			"",
+			`.some_id`,
+			"false",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"some_id":1564679049192120321}`}},
+			int64(1564679049192120321),
+			nil,
+		},
+		{
+			"exact arithmetic",
+			"",
+			`.a * .b + 0.1 + 0.2`,
+			"false",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"a":2,"b":3.35}`}},
+			int64(7),
+			nil,
+		},
+		{
+			"filter and map",
+			"",
+			`[.prices[] | select(.ok and .value > 1) | .value * 10]`,
+			"false",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"prices":[{"ok":true,"value":1},{"ok":false,"value":2},{"ok":true,"value":3}]}`}},
+			[]interface{}{int64(30)},
+			nil,
+		},
+		{
+			"slice",
+			"",
+			`.data[1:3], .data[-1:]`,
+			"false",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"data":[0,1,2,3]}`}},
+			[]interface{}{[]interface{}{int64(1), int64(2)}, []interface{}{int64(3)}},
+			nil,
+		},
+		{
+			"object construction",
+			"",
+			`{price: (.bid + .ask) / 2, "source": (.name | ascii_upcase)}`,
+			"false",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"bid":1.5,"ask":2.5,"name":"cex"}`}},
+			map[string]interface{}{"price": int64(2), "source": "CEX"},
+			nil,
+		},
+		{
+			"object values are iterated in key order",
+			"",
+			`[.[]]`,
+			"false",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"c":3,"a":1,"b":2}`}},
+			[]interface{}{int64(1), int64(2), int64(3)},
+			nil,
+		},
+		{
+			"sort_by and conditionals",
+			"",
+			`.quotes | sort_by(.price) | map(if .price > 2 then .venue else empty end)`,
+			"false",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"quotes":[{"venue":"b","price":4},{"venue":"a","price":3},{"venue":"c","price":1}]}`}},
+			[]interface{}{"a", "b"},
+			nil,
+		},
+		{
+			"data and query from vars",
+			"$(foo)",
+			"$(query)",
+			"false",
+			pipeline.NewVarsFrom(map[string]interface{}{
+				"foo":   map[string]interface{}{"values": []interface{}{1.5, 2, "3"}},
+				"query": `.values | map(tonumber) | add`,
+			}),
+			nil,
+			6.5,
+			nil,
+		},
+		{
+			"no value without lax returns error",
+			"",
+			`.data[] | select(. > 10)`,
+			"false",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"data":[1,2]}`}},
+			nil,
+			pipeline.ErrKeypathNotFound,
+		},
+		{
+			"no value with lax returns nil",
+			"",
+			`.data[] | select(. > 10)`,
+			"true",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"data":[1,2]}`}},
+			nil,
+			nil,
+		},
+		{
+			"invalid query",
+			"",
+			`.data[`,
+			"false",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"data":[1,2]}`}},
+			nil,
+			pipeline.ErrJSONQueryParse,
+		},
+		{
+			"evaluation error",
+			"",
+			`.data + 1`,
+			"false",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"data":"a"}`}},
+			nil,
+			pipeline.ErrJSONQueryEval,
+		},
+		{
+			"invalid json",
+			"",
+			`.`,
+			"false",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"data":`}},
+			nil,
+			pipeline.ErrBadInput,
+		},
+		{
+			"input error",
+			"",
+			`.`,
+			"false",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Error: errors.New("foo")}},
+			nil,
+			pipeline.ErrTooManyErrors,
+		},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.JSONQueryTask{
+				BaseTask: pipeline.NewBaseTask(0, "json", nil, nil, 0),
+				Query:    test.query,
+				Data:     test.data,
+				Lax:      test.lax,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), test.vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.wantErrorCause != nil {
+				require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+				require.Nil(t, result.Value)
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.wantData, result.Value)
+			}
+		})
+	}
+}
+
+func TestParseJSONQuery_DeterministicOutput(t *testing.T) {
+	t.Parallel()
+
+	query, err := pipeline.ParseJSONQuery(`to_entries | map({(.key): (.value / 3)}) | add | tojson`)
+	require.NoError(t, err)
+
+	// maps are iterated in random order, the encoded output must not depend on it
+	input := map[string]interface{}{"z": 1, "y": 2, "x": 3, "w": 4, "v": 5}
+	first, err := query.Eval(testutils.Context(t), input)
+	require.NoError(t, err)
+	for i := 0; i < 20; i++ {
+		out, err := query.Eval(testutils.Context(t), input)
+		require.NoError(t, err)
+		require.Equal(t, first, out)
+	}
+}
+
+func TestParseJSONQuery_Budget(t *testing.T) {
+	t.Parallel()
+
+	t.Run("bounds the size of built values", func(t *testing.T) {
+		// every stage doubles the size of the value, even though both halves are shared
+		query, err := pipeline.ParseJSONQuery(strings.Repeat("[., .] | ", 40) + "length")
+		require.NoError(t, err)
+		_, err = query.Eval(testutils.Context(t), "x")
+		require.ErrorContains(t, err, "expression built values larger than")
+	})
+
+	t.Run("cannot be caught", func(t *testing.T) {
+		query, err := pipeline.ParseJSONQuery(`(` + strings.Repeat("[., .] | ", 40) + `length)? // 1`)
+		require.NoError(t, err)
+		_, err = query.Eval(testutils.Context(t), "x")
+		require.ErrorContains(t, err, "expression built values larger than")
+	})
+
+	t.Run("stops when the context is done", func(t *testing.T) {
+		query, err := pipeline.ParseJSONQuery(`[.[] | [.[] | select(. > 0)] | length] | add`)
+		require.NoError(t, err)
+		row := make([]interface{}, 1000)
+		for i := range row {
+			row[i] = i
+		}
+		input := make([]interface{}, 1000)
+		for i := range input {
+			input[i] = row
+		}
+		ctx, cancel := context.WithCancel(testutils.Context(t))
+		cancel()
+		_, err = query.Eval(ctx, input)
+		require.ErrorContains(t, err, context.Canceled.Error())
+	})
+}
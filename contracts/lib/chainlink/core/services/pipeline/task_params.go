@@ -728,6 +728,50 @@ func (p *JSONPathParam) UnmarshalPipelineParam(val interface{}) error {
 	return nil
 }
 
+// JSONQueryParam is a compiled jsonquery expression.
+type JSONQueryParam struct {
+	*JSONQuery
+}
+
+func (p *JSONQueryParam) UnmarshalPipelineParam(val interface{}) error {
+	var source string
+	switch v := val.(type) {
+	case string:
+		source = v
+	case []byte:
+		source = string(v)
+	default:
+		return ErrBadInput
+	}
+	query, err := ParseJSONQuery(source)
+	if err != nil {
+		return err
+	}
+	p.JSONQuery = query
+	return nil
+}
+
+// JSONValueParam is a JSON document. Strings and bytes are decoded, any other value is used as already decoded.
+type JSONValueParam struct {
+	Value interface{}
+}
+
+func (p *JSONValueParam) UnmarshalPipelineParam(val interface{}) error {
+	var err error
+	switch v := val.(type) {
+	case string:
+		p.Value, err = jqDecodeJSON([]byte(v))
+	case []byte:
+		p.Value, err = jqDecodeJSON(v)
+	default:
+		p.Value, err = jqNormalize(v)
+	}
+	if err != nil {
+		return errors.Wrap(ErrBadInput, err.Error())
+	}
+	return nil
+}
+
 type MaybeBigIntParam struct {
 	n *big.Int
 }
@@ -85,6 +85,18 @@ func (c CachedORM) GetUnconfirmedRows(ctx context.Context, limit uint) ([]*Row,
 	return c.underlayingORM.GetUnconfirmedRows(ctx, limit)
 }
 
+func (c CachedORM) ListAll(ctx context.Context, offset, limit int) ([]*SnapshotRow, int, error) {
+	return c.underlayingORM.ListAll(ctx, offset, limit)
+}
+
+func (c CachedORM) ListByExpirationRange(ctx context.Context, fromExpiration, toExpiration int64, offset, limit int) ([]*SnapshotRow, int, error) {
+	return c.underlayingORM.ListByExpirationRange(ctx, fromExpiration, toExpiration, offset, limit)
+}
+
+func (c CachedORM) GetAddressUsage(ctx context.Context, address *ubig.Big) (*AddressUsage, error) {
+	return c.underlayingORM.GetAddressUsage(ctx, address)
+}
+
 // deleteRowFromSnapshotCache will clean the cache for every snapshot that would involve a given row
 // in case of an error parsing a key it will also delete the key from the cache
 func (c CachedORM) deleteRowFromSnapshotCache(row *Row) {
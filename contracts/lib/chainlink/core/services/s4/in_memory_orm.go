@@ -142,3 +142,68 @@ func (o *inMemoryOrm) GetUnconfirmedRows(ctx context.Context, limit uint) ([]*Ro
 
 	return rows, nil
 }
+
+func (o *inMemoryOrm) ListAll(ctx context.Context, offset, limit int) ([]*SnapshotRow, int, error) {
+	return o.list(offset, limit, func(*Row) bool { return true }, func(a, b *Row) bool {
+		if c := a.Address.Cmp(b.Address); c != 0 {
+			return c < 0
+		}
+		return a.SlotId < b.SlotId
+	})
+}
+
+func (o *inMemoryOrm) ListByExpirationRange(ctx context.Context, fromExpiration, toExpiration int64, offset, limit int) ([]*SnapshotRow, int, error) {
+	return o.list(offset, limit, func(row *Row) bool {
+		return fromExpiration <= row.Expiration && row.Expiration < toExpiration
+	}, func(a, b *Row) bool {
+		if a.Expiration != b.Expiration {
+			return a.Expiration < b.Expiration
+		}
+		if c := a.Address.Cmp(b.Address); c != 0 {
+			return c < 0
+		}
+		return a.SlotId < b.SlotId
+	})
+}
+
+func (o *inMemoryOrm) list(offset, limit int, filter func(*Row) bool, less func(a, b *Row) bool) ([]*SnapshotRow, int, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	var matching []*Row
+	for _, mrow := range o.rows {
+		if filter(mrow.Row) {
+			matching = append(matching, mrow.Row)
+		}
+	}
+	sort.Slice(matching, func(i, j int) bool {
+		return less(matching[i], matching[j])
+	})
+
+	rows := make([]*SnapshotRow, 0)
+	for i := offset; i < len(matching) && len(rows) < limit; i++ {
+		rows = append(rows, &SnapshotRow{
+			Address:     big.New(matching[i].Address.ToInt()),
+			SlotId:      matching[i].SlotId,
+			Version:     matching[i].Version,
+			Expiration:  matching[i].Expiration,
+			Confirmed:   matching[i].Confirmed,
+			PayloadSize: uint64(len(matching[i].Payload)),
+		})
+	}
+	return rows, len(matching), nil
+}
+
+func (o *inMemoryOrm) GetAddressUsage(ctx context.Context, address *big.Big) (*AddressUsage, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	usage := &AddressUsage{}
+	for _, mrow := range o.rows {
+		if mrow.Row.Address.Cmp(address) == 0 {
+			usage.Slots++
+			usage.PayloadBytes += uint64(len(mrow.Row.Payload))
+		}
+	}
+	return usage, nil
+}
@@ -170,3 +170,43 @@ func TestInMemoryORM_GetSnapshot(t *testing.T) {
 		assert.Equal(t, 1, c)
 	}
 }
+
+func TestInMemoryORM_ListAndUsage(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	orm := s4.NewInMemoryORM()
+	now := time.Now()
+	for i := 0; i < 4; i++ {
+		var thisAddress common.Address
+		thisAddress[0] = byte(3 - i)
+
+		row := &s4.Row{
+			Address:    big.New(thisAddress.Big()),
+			SlotId:     uint(i % 2),
+			Payload:    make([]byte, i),
+			Version:    1,
+			Expiration: now.Add(time.Duration(i) * time.Minute).UnixMilli(),
+		}
+		assert.NoError(t, orm.Update(ctx, row))
+	}
+
+	rows, count, err := orm.ListAll(ctx, 1, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, count)
+	assert.Len(t, rows, 2)
+	assert.Equal(t, byte(1), rows[0].Address.ToInt().Bytes()[0])
+	assert.Equal(t, uint64(2), rows[0].PayloadSize)
+
+	rows, count, err = orm.ListByExpirationRange(ctx, now.Add(time.Minute).UnixMilli(), now.Add(3*time.Minute).UnixMilli(), 0, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Len(t, rows, 2)
+	assert.Less(t, rows[0].Expiration, rows[1].Expiration)
+
+	var address common.Address
+	address[0] = 3
+	usage, err := orm.GetAddressUsage(ctx, big.New(address.Big()))
+	assert.NoError(t, err)
+	assert.Equal(t, &s4.AddressUsage{Slots: 1, PayloadBytes: 0}, usage)
+}
@@ -145,6 +145,65 @@ func (_c *ORM_Get_Call) RunAndReturn(run func(context.Context, *big.Big, uint) (
 	return _c
 }
 
+// GetAddressUsage provides a mock function with given fields: ctx, address
+func (_m *ORM) GetAddressUsage(ctx context.Context, address *big.Big) (*s4.AddressUsage, error) {
+	ret := _m.Called(ctx, address)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAddressUsage")
+	}
+
+	var r0 *s4.AddressUsage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Big) (*s4.AddressUsage, error)); ok {
+		return rf(ctx, address)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Big) *s4.AddressUsage); ok {
+		r0 = rf(ctx, address)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s4.AddressUsage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Big) error); ok {
+		r1 = rf(ctx, address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_GetAddressUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAddressUsage'
+type ORM_GetAddressUsage_Call struct {
+	*mock.Call
+}
+
+// GetAddressUsage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - address *big.Big
+func (_e *ORM_Expecter) GetAddressUsage(ctx interface{}, address interface{}) *ORM_GetAddressUsage_Call {
+	return &ORM_GetAddressUsage_Call{Call: _e.mock.On("GetAddressUsage", ctx, address)}
+}
+
+func (_c *ORM_GetAddressUsage_Call) Run(run func(ctx context.Context, address *big.Big)) *ORM_GetAddressUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*big.Big))
+	})
+	return _c
+}
+
+func (_c *ORM_GetAddressUsage_Call) Return(_a0 *s4.AddressUsage, _a1 error) *ORM_GetAddressUsage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_GetAddressUsage_Call) RunAndReturn(run func(context.Context, *big.Big) (*s4.AddressUsage, error)) *ORM_GetAddressUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSnapshot provides a mock function with given fields: ctx, addressRange
 func (_m *ORM) GetSnapshot(ctx context.Context, addressRange *s4.AddressRange) ([]*s4.SnapshotRow, error) {
 	ret := _m.Called(ctx, addressRange)
@@ -263,6 +322,142 @@ func (_c *ORM_GetUnconfirmedRows_Call) RunAndReturn(run func(context.Context, ui
 	return _c
 }
 
+// ListAll provides a mock function with given fields: ctx, offset, limit
+func (_m *ORM) ListAll(ctx context.Context, offset int, limit int) ([]*s4.SnapshotRow, int, error) {
+	ret := _m.Called(ctx, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAll")
+	}
+
+	var r0 []*s4.SnapshotRow
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]*s4.SnapshotRow, int, error)); ok {
+		return rf(ctx, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []*s4.SnapshotRow); ok {
+		r0 = rf(ctx, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*s4.SnapshotRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) int); ok {
+		r1 = rf(ctx, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int, int) error); ok {
+		r2 = rf(ctx, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ORM_ListAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAll'
+type ORM_ListAll_Call struct {
+	*mock.Call
+}
+
+// ListAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - offset int
+//   - limit int
+func (_e *ORM_Expecter) ListAll(ctx interface{}, offset interface{}, limit interface{}) *ORM_ListAll_Call {
+	return &ORM_ListAll_Call{Call: _e.mock.On("ListAll", ctx, offset, limit)}
+}
+
+func (_c *ORM_ListAll_Call) Run(run func(ctx context.Context, offset int, limit int)) *ORM_ListAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ORM_ListAll_Call) Return(_a0 []*s4.SnapshotRow, _a1 int, _a2 error) *ORM_ListAll_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ORM_ListAll_Call) RunAndReturn(run func(context.Context, int, int) ([]*s4.SnapshotRow, int, error)) *ORM_ListAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByExpirationRange provides a mock function with given fields: ctx, fromExpiration, toExpiration, offset, limit
+func (_m *ORM) ListByExpirationRange(ctx context.Context, fromExpiration int64, toExpiration int64, offset int, limit int) ([]*s4.SnapshotRow, int, error) {
+	ret := _m.Called(ctx, fromExpiration, toExpiration, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByExpirationRange")
+	}
+
+	var r0 []*s4.SnapshotRow
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int, int) ([]*s4.SnapshotRow, int, error)); ok {
+		return rf(ctx, fromExpiration, toExpiration, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int, int) []*s4.SnapshotRow); ok {
+		r0 = rf(ctx, fromExpiration, toExpiration, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*s4.SnapshotRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, int, int) int); ok {
+		r1 = rf(ctx, fromExpiration, toExpiration, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, int64, int, int) error); ok {
+		r2 = rf(ctx, fromExpiration, toExpiration, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ORM_ListByExpirationRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByExpirationRange'
+type ORM_ListByExpirationRange_Call struct {
+	*mock.Call
+}
+
+// ListByExpirationRange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromExpiration int64
+//   - toExpiration int64
+//   - offset int
+//   - limit int
+func (_e *ORM_Expecter) ListByExpirationRange(ctx interface{}, fromExpiration interface{}, toExpiration interface{}, offset interface{}, limit interface{}) *ORM_ListByExpirationRange_Call {
+	return &ORM_ListByExpirationRange_Call{Call: _e.mock.On("ListByExpirationRange", ctx, fromExpiration, toExpiration, offset, limit)}
+}
+
+func (_c *ORM_ListByExpirationRange_Call) Run(run func(ctx context.Context, fromExpiration int64, toExpiration int64, offset int, limit int)) *ORM_ListByExpirationRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *ORM_ListByExpirationRange_Call) Return(_a0 []*s4.SnapshotRow, _a1 int, _a2 error) *ORM_ListByExpirationRange_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ORM_ListByExpirationRange_Call) RunAndReturn(run func(context.Context, int64, int64, int, int) ([]*s4.SnapshotRow, int, error)) *ORM_ListByExpirationRange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, row
 func (_m *ORM) Update(ctx context.Context, row *s4.Row) error {
 	ret := _m.Called(ctx, row)
@@ -197,6 +197,142 @@ func (_c *Storage_List_Call) RunAndReturn(run func(context.Context, common.Addre
 	return _c
 }
 
+// ListAll provides a mock function with given fields: ctx, offset, limit
+func (_m *Storage) ListAll(ctx context.Context, offset int, limit int) ([]*s4.SnapshotRow, int, error) {
+	ret := _m.Called(ctx, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAll")
+	}
+
+	var r0 []*s4.SnapshotRow
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]*s4.SnapshotRow, int, error)); ok {
+		return rf(ctx, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []*s4.SnapshotRow); ok {
+		r0 = rf(ctx, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*s4.SnapshotRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) int); ok {
+		r1 = rf(ctx, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int, int) error); ok {
+		r2 = rf(ctx, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Storage_ListAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAll'
+type Storage_ListAll_Call struct {
+	*mock.Call
+}
+
+// ListAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - offset int
+//   - limit int
+func (_e *Storage_Expecter) ListAll(ctx interface{}, offset interface{}, limit interface{}) *Storage_ListAll_Call {
+	return &Storage_ListAll_Call{Call: _e.mock.On("ListAll", ctx, offset, limit)}
+}
+
+func (_c *Storage_ListAll_Call) Run(run func(ctx context.Context, offset int, limit int)) *Storage_ListAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *Storage_ListAll_Call) Return(_a0 []*s4.SnapshotRow, _a1 int, _a2 error) *Storage_ListAll_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Storage_ListAll_Call) RunAndReturn(run func(context.Context, int, int) ([]*s4.SnapshotRow, int, error)) *Storage_ListAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByExpirationRange provides a mock function with given fields: ctx, fromExpiration, toExpiration, offset, limit
+func (_m *Storage) ListByExpirationRange(ctx context.Context, fromExpiration int64, toExpiration int64, offset int, limit int) ([]*s4.SnapshotRow, int, error) {
+	ret := _m.Called(ctx, fromExpiration, toExpiration, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByExpirationRange")
+	}
+
+	var r0 []*s4.SnapshotRow
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int, int) ([]*s4.SnapshotRow, int, error)); ok {
+		return rf(ctx, fromExpiration, toExpiration, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int, int) []*s4.SnapshotRow); ok {
+		r0 = rf(ctx, fromExpiration, toExpiration, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*s4.SnapshotRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, int, int) int); ok {
+		r1 = rf(ctx, fromExpiration, toExpiration, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, int64, int, int) error); ok {
+		r2 = rf(ctx, fromExpiration, toExpiration, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Storage_ListByExpirationRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByExpirationRange'
+type Storage_ListByExpirationRange_Call struct {
+	*mock.Call
+}
+
+// ListByExpirationRange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromExpiration int64
+//   - toExpiration int64
+//   - offset int
+//   - limit int
+func (_e *Storage_Expecter) ListByExpirationRange(ctx interface{}, fromExpiration interface{}, toExpiration interface{}, offset interface{}, limit interface{}) *Storage_ListByExpirationRange_Call {
+	return &Storage_ListByExpirationRange_Call{Call: _e.mock.On("ListByExpirationRange", ctx, fromExpiration, toExpiration, offset, limit)}
+}
+
+func (_c *Storage_ListByExpirationRange_Call) Run(run func(ctx context.Context, fromExpiration int64, toExpiration int64, offset int, limit int)) *Storage_ListByExpirationRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *Storage_ListByExpirationRange_Call) Return(_a0 []*s4.SnapshotRow, _a1 int, _a2 error) *Storage_ListByExpirationRange_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Storage_ListByExpirationRange_Call) RunAndReturn(run func(context.Context, int64, int64, int, int) ([]*s4.SnapshotRow, int, error)) *Storage_ListByExpirationRange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Put provides a mock function with given fields: ctx, key, record, signature
 func (_m *Storage) Put(ctx context.Context, key *s4.Key, record *s4.Record, signature []byte) error {
 	ret := _m.Called(ctx, key, record, signature)
@@ -246,6 +382,65 @@ func (_c *Storage_Put_Call) RunAndReturn(run func(context.Context, *s4.Key, *s4.
 	return _c
 }
 
+// Usage provides a mock function with given fields: ctx, address
+func (_m *Storage) Usage(ctx context.Context, address common.Address) (*s4.Usage, error) {
+	ret := _m.Called(ctx, address)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Usage")
+	}
+
+	var r0 *s4.Usage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address) (*s4.Usage, error)); ok {
+		return rf(ctx, address)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address) *s4.Usage); ok {
+		r0 = rf(ctx, address)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s4.Usage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address) error); ok {
+		r1 = rf(ctx, address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storage_Usage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Usage'
+type Storage_Usage_Call struct {
+	*mock.Call
+}
+
+// Usage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - address common.Address
+func (_e *Storage_Expecter) Usage(ctx interface{}, address interface{}) *Storage_Usage_Call {
+	return &Storage_Usage_Call{Call: _e.mock.On("Usage", ctx, address)}
+}
+
+func (_c *Storage_Usage_Call) Run(run func(ctx context.Context, address common.Address)) *Storage_Usage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address))
+	})
+	return _c
+}
+
+func (_c *Storage_Usage_Call) Return(_a0 *s4.Usage, _a1 error) *Storage_Usage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storage_Usage_Call) RunAndReturn(run func(context.Context, common.Address) (*s4.Usage, error)) *Storage_Usage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewStorage creates a new instance of Storage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewStorage(t interface {
@@ -28,6 +28,12 @@ type SnapshotRow struct {
 	PayloadSize uint64
 }
 
+// AddressUsage is the storage used by an address, returned by GetAddressUsage.
+type AddressUsage struct {
+	Slots        uint
+	PayloadBytes uint64
+}
+
 // ORM represents S4 persistence layer.
 // All functions are thread-safe.
 type ORM interface {
@@ -54,6 +60,18 @@ type ORM interface {
 	// GetUnconfirmedRows selects all non-expired, non-confirmed rows ordered by UpdatedAt.
 	// The number of returned rows is limited to the given limit.
 	GetUnconfirmedRows(ctx context.Context, limit uint) ([]*Row, error)
+
+	// ListAll selects all row versions, including expired ones, ordered by (Address, SlotId).
+	// At most limit rows are returned, starting at offset, along with the total number of rows.
+	ListAll(ctx context.Context, offset, limit int) ([]*SnapshotRow, int, error)
+
+	// ListByExpirationRange selects row versions having fromExpiration <= Expiration < toExpiration,
+	// ordered by Expiration and paginated like ListAll.
+	ListByExpirationRange(ctx context.Context, fromExpiration, toExpiration int64, offset, limit int) ([]*SnapshotRow, int, error)
+
+	// GetAddressUsage returns the slots and payload bytes used by the given address,
+	// including expired rows which are not deleted yet.
+	GetAddressUsage(ctx context.Context, address *big.Big) (*AddressUsage, error)
 }
 
 func (r Row) Clone() *Row {
@@ -104,3 +104,50 @@ WHERE namespace = $1 AND confirmed IS FALSE ORDER BY updated_at LIMIT $2;`, o.ta
 	}
 	return rows, nil
 }
+
+func (o *orm) ListAll(ctx context.Context, offset, limit int) ([]*SnapshotRow, int, error) {
+	var count int
+	stmt := fmt.Sprintf(`SELECT count(*) FROM %s WHERE namespace = $1;`, o.tableName)
+	if err := o.ds.GetContext(ctx, &count, stmt, o.namespace); err != nil {
+		return nil, 0, err
+	}
+
+	rows := make([]*SnapshotRow, 0)
+	stmt = fmt.Sprintf(`SELECT address, slot_id, version, expiration, confirmed, octet_length(payload) AS payload_size FROM %s
+WHERE namespace = $1 ORDER BY address, slot_id OFFSET $2 LIMIT $3;`, o.tableName)
+	if err := o.ds.SelectContext(ctx, &rows, stmt, o.namespace, offset, limit); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, 0, err
+		}
+	}
+	return rows, count, nil
+}
+
+func (o *orm) ListByExpirationRange(ctx context.Context, fromExpiration, toExpiration int64, offset, limit int) ([]*SnapshotRow, int, error) {
+	var count int
+	stmt := fmt.Sprintf(`SELECT count(*) FROM %s WHERE namespace = $1 AND expiration >= $2 AND expiration < $3;`, o.tableName)
+	if err := o.ds.GetContext(ctx, &count, stmt, o.namespace, fromExpiration, toExpiration); err != nil {
+		return nil, 0, err
+	}
+
+	rows := make([]*SnapshotRow, 0)
+	stmt = fmt.Sprintf(`SELECT address, slot_id, version, expiration, confirmed, octet_length(payload) AS payload_size FROM %s
+WHERE namespace = $1 AND expiration >= $2 AND expiration < $3 ORDER BY expiration, address, slot_id OFFSET $4 LIMIT $5;`, o.tableName)
+	if err := o.ds.SelectContext(ctx, &rows, stmt, o.namespace, fromExpiration, toExpiration, offset, limit); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, 0, err
+		}
+	}
+	return rows, count, nil
+}
+
+func (o *orm) GetAddressUsage(ctx context.Context, address *big.Big) (*AddressUsage, error) {
+	usage := &AddressUsage{}
+
+	stmt := fmt.Sprintf(`SELECT count(*) AS slots, COALESCE(SUM(octet_length(payload)), 0) AS payload_bytes FROM %s
+WHERE namespace = $1 AND address = $2;`, o.tableName)
+	if err := o.ds.GetContext(ctx, usage, stmt, o.namespace, address); err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
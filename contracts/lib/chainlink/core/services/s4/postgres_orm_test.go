@@ -285,3 +285,58 @@ func TestPostgresORM_BigIntVersion(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, row, gotRow)
 }
+
+func TestPostgresORM_ListAndUsage(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	orm := setupORM(t, "test")
+	rows := generateTestRows(t, 5)
+	now := time.Now()
+	for i, row := range rows {
+		row.Expiration = now.Add(time.Duration(i) * time.Minute).UnixMilli()
+		assert.NoError(t, orm.Update(ctx, row))
+	}
+
+	t.Run("ListAll", func(t *testing.T) {
+		page, count, err := orm.ListAll(ctx, 0, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, count)
+		assert.Len(t, page, 3)
+		for i := 1; i < len(page); i++ {
+			assert.Negative(t, page[i-1].Address.Cmp(page[i].Address))
+		}
+
+		page, count, err = orm.ListAll(ctx, 3, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, count)
+		assert.Len(t, page, 2)
+		assert.Equal(t, uint64(32), page[0].PayloadSize)
+	})
+
+	t.Run("ListByExpirationRange", func(t *testing.T) {
+		page, count, err := orm.ListByExpirationRange(ctx, rows[1].Expiration, rows[4].Expiration, 0, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, count)
+		assert.Len(t, page, 3)
+		for i, row := range page {
+			assert.Equal(t, rows[i+1].Address, row.Address)
+			assert.Equal(t, rows[i+1].Expiration, row.Expiration)
+		}
+	})
+
+	t.Run("GetAddressUsage", func(t *testing.T) {
+		row := rows[0].Clone()
+		row.SlotId = 2
+		row.Payload = cltest.MustRandomBytes(t, 10)
+		assert.NoError(t, orm.Update(ctx, row))
+
+		usage, err := orm.GetAddressUsage(ctx, row.Address)
+		assert.NoError(t, err)
+		assert.Equal(t, &s4.AddressUsage{Slots: 2, PayloadBytes: 42}, usage)
+
+		usage, err = orm.GetAddressUsage(ctx, big.New(testutils.NewAddress().Big()))
+		assert.NoError(t, err)
+		assert.Equal(t, &s4.AddressUsage{}, usage)
+	})
+}
@@ -44,6 +44,19 @@ type Metadata struct {
 	Signature []byte
 }
 
+// Usage is the storage used by an address, along with the limits set by Constraints.
+type Usage struct {
+	Address common.Address
+	// SlotsUsed is the number of slots having data, including expired data which is not deleted yet
+	SlotsUsed uint
+	// MaxSlots is Constraints.MaxSlotsPerUser
+	MaxSlots uint
+	// PayloadBytes is the total size of the payloads in SlotsUsed
+	PayloadBytes uint64
+	// MaxPayloadBytes is the size of MaxSlots payloads of Constraints.MaxPayloadSizeBytes
+	MaxPayloadBytes uint64
+}
+
 // Storage represents S4 storage access interface.
 // All functions are thread-safe.
 type Storage interface {
@@ -62,6 +75,17 @@ type Storage interface {
 	// List returns a snapshot for the specified address.
 	// Slots having no data are not returned.
 	List(ctx context.Context, address common.Address) ([]*SnapshotRow, error)
+
+	// ListAll returns a page of the snapshot of all addresses, including expired rows,
+	// and the total number of rows.
+	ListAll(ctx context.Context, offset, limit int) ([]*SnapshotRow, int, error)
+
+	// ListByExpirationRange returns a page of the snapshot rows expiring at or after fromExpiration,
+	// and before toExpiration (unix time in milliseconds), and the total number of such rows.
+	ListByExpirationRange(ctx context.Context, fromExpiration, toExpiration int64, offset, limit int) ([]*SnapshotRow, int, error)
+
+	// Usage returns the storage used by the specified address.
+	Usage(ctx context.Context, address common.Address) (*Usage, error)
 }
 
 type storage struct {
@@ -125,6 +149,28 @@ func (s *storage) List(ctx context.Context, address common.Address) ([]*Snapshot
 	return s.orm.GetSnapshot(ctx, sar)
 }
 
+func (s *storage) ListAll(ctx context.Context, offset, limit int) ([]*SnapshotRow, int, error) {
+	return s.orm.ListAll(ctx, offset, limit)
+}
+
+func (s *storage) ListByExpirationRange(ctx context.Context, fromExpiration, toExpiration int64, offset, limit int) ([]*SnapshotRow, int, error) {
+	return s.orm.ListByExpirationRange(ctx, fromExpiration, toExpiration, offset, limit)
+}
+
+func (s *storage) Usage(ctx context.Context, address common.Address) (*Usage, error) {
+	addressUsage, err := s.orm.GetAddressUsage(ctx, big.New(address.Big()))
+	if err != nil {
+		return nil, err
+	}
+	return &Usage{
+		Address:         address,
+		SlotsUsed:       addressUsage.Slots,
+		MaxSlots:        s.contraints.MaxSlotsPerUser,
+		PayloadBytes:    addressUsage.PayloadBytes,
+		MaxPayloadBytes: uint64(s.contraints.MaxSlotsPerUser) * uint64(s.contraints.MaxPayloadSizeBytes),
+	}, nil
+}
+
 func (s *storage) Put(ctx context.Context, key *Key, record *Record, signature []byte) error {
 	if key.SlotId >= s.contraints.MaxSlotsPerUser {
 		return ErrSlotIdTooBig
@@ -235,3 +235,21 @@ func TestStorage_List(t *testing.T) {
 		}
 	}
 }
+
+func TestStorage_Usage(t *testing.T) {
+	t.Parallel()
+
+	ormMock, storage := setupTestStorage(t, time.Now())
+	address := testutils.NewAddress()
+	ormMock.On("GetAddressUsage", mock.Anything, big.New(address.Big())).Return(&s4.AddressUsage{Slots: 2, PayloadBytes: 40}, nil)
+
+	usage, err := storage.Usage(testutils.Context(t), address)
+	require.NoError(t, err)
+	assert.Equal(t, &s4.Usage{
+		Address:         address,
+		SlotsUsed:       2,
+		MaxSlots:        constraints.MaxSlotsPerUser,
+		PayloadBytes:    40,
+		MaxPayloadBytes: 160,
+	}, usage)
+}
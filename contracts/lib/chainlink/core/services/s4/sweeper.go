@@ -0,0 +1,224 @@
+package s4
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+var (
+	promSweeperDeletedRows = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "s4_sweeper_deleted_rows",
+		Help: "Metric to track the number of expired S4 rows deleted by the sweeper per namespace",
+	}, []string{"namespace"})
+	promSweeperErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "s4_sweeper_errors",
+		Help: "Metric to track the number of failed S4 sweeps per namespace",
+	}, []string{"namespace"})
+	promSweeperDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "s4_sweeper_duration_seconds",
+		Help:    "Metric to track the duration of S4 sweeps per namespace",
+		Buckets: []float64{0.01, 0.05, 0.1, 0.5, 1, 5, 10, 30},
+	}, []string{"namespace"})
+)
+
+// Sweeper periodically deletes expired rows, so they don't linger until the S4 reporting plugin prunes them.
+type Sweeper interface {
+	services.Service
+
+	// Sweep deletes all rows expired by now, in batches.
+	// Returns the number of deleted rows.
+	Sweep(ctx context.Context) (int64, error)
+}
+
+type sweeper struct {
+	services.StateMachine
+
+	orm       ORM
+	namespace string
+	interval  time.Duration
+	batchSize uint
+	clock     clockwork.Clock
+	closeWait sync.WaitGroup
+	stopCh    services.StopChan
+	lggr      logger.Logger
+}
+
+var _ Sweeper = (*sweeper)(nil)
+
+// NewSweeper returns a Sweeper deleting the expired rows of orm every interval, batchSize rows at a time.
+// The namespace is only used to label metrics.
+func NewSweeper(lggr logger.Logger, orm ORM, namespace string, interval time.Duration, batchSize uint, clock clockwork.Clock) (Sweeper, error) {
+	if interval <= 0 {
+		return nil, errors.New("sweeper interval must be positive")
+	}
+	if batchSize == 0 {
+		return nil, errors.New("sweeper batch size must be positive")
+	}
+	return &sweeper{
+		orm:       orm,
+		namespace: namespace,
+		interval:  interval,
+		batchSize: batchSize,
+		clock:     clock,
+		stopCh:    make(services.StopChan),
+		lggr:      lggr.Named("S4Sweeper").With("namespace", namespace),
+	}, nil
+}
+
+func (s *sweeper) Start(context.Context) error {
+	return s.StartOnce("S4Sweeper", func() error {
+		s.closeWait.Add(1)
+		go s.run()
+		return nil
+	})
+}
+
+func (s *sweeper) Close() error {
+	return s.StopOnce("S4Sweeper", func() error {
+		close(s.stopCh)
+		s.closeWait.Wait()
+		return nil
+	})
+}
+
+func (s *sweeper) Name() string { return s.lggr.Name() }
+
+func (s *sweeper) HealthReport() map[string]error {
+	return map[string]error{s.Name(): s.Healthy()}
+}
+
+func (s *sweeper) run() {
+	defer s.closeWait.Done()
+	ticker := s.clock.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stopCh:
+			return
+		case <-ticker.Chan():
+			ctx, cancel := s.stopCh.CtxWithTimeout(s.interval)
+			count, err := s.Sweep(ctx)
+			cancel()
+			if err != nil {
+				s.lggr.Errorw("failed to delete expired rows", "deleted", count, "err", err)
+			} else if count > 0 {
+				s.lggr.Debugw("deleted expired rows", "deleted", count)
+			}
+		}
+	}
+}
+
+func (s *sweeper) Sweep(ctx context.Context) (int64, error) {
+	start := s.clock.Now()
+	defer func() {
+		promSweeperDuration.WithLabelValues(s.namespace).Observe(s.clock.Since(start).Seconds())
+	}()
+
+	var total int64
+	for {
+		count, err := s.orm.DeleteExpired(ctx, s.batchSize, start.UTC())
+		total += count
+		promSweeperDeletedRows.WithLabelValues(s.namespace).Add(float64(count))
+		if err != nil {
+			promSweeperErrors.WithLabelValues(s.namespace).Inc()
+			return total, err
+		}
+		// a partial batch means there is nothing left to delete
+		if count < int64(s.batchSize) {
+			return total, nil
+		}
+	}
+}
+
+// SweeperPool runs a single Sweeper per namespace, shared by all the jobs storing rows in it, as the rows of a
+// namespace are not scoped by job.
+type SweeperPool struct {
+	mu       sync.Mutex
+	sweepers map[string]*pooledSweeper
+}
+
+type pooledSweeper struct {
+	sweeper Sweeper
+	refs    int
+}
+
+// NewSweeperPool returns a SweeperPool, which is meant to be shared by all the jobs of a node.
+func NewSweeperPool() *SweeperPool {
+	return &SweeperPool{sweepers: make(map[string]*pooledSweeper)}
+}
+
+// Sweeper returns a service which runs the Sweeper of the namespace while it is started. The first service of a
+// namespace to be started runs its sweeper, with its own interval and batch size, until all the services of the
+// namespace are closed.
+func (p *SweeperPool) Sweeper(lggr logger.Logger, orm ORM, namespace string, interval time.Duration, batchSize uint, clock clockwork.Clock) (services.Service, error) {
+	sweeper, err := NewSweeper(lggr, orm, namespace, interval, batchSize, clock)
+	if err != nil {
+		return nil, err
+	}
+	return &sweeperRef{pool: p, namespace: namespace, sweeper: sweeper}, nil
+}
+
+func (p *SweeperPool) acquire(ctx context.Context, namespace string, sweeper Sweeper) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if pooled, ok := p.sweepers[namespace]; ok {
+		pooled.refs++
+		return nil
+	}
+	if err := sweeper.Start(ctx); err != nil {
+		return err
+	}
+	p.sweepers[namespace] = &pooledSweeper{sweeper: sweeper, refs: 1}
+	return nil
+}
+
+func (p *SweeperPool) release(namespace string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	pooled, ok := p.sweepers[namespace]
+	if !ok {
+		return nil
+	}
+	pooled.refs--
+	if pooled.refs > 0 {
+		return nil
+	}
+	delete(p.sweepers, namespace)
+	return pooled.sweeper.Close()
+}
+
+// sweeperRef holds a reference to the Sweeper of a namespace in a SweeperPool.
+type sweeperRef struct {
+	services.StateMachine
+
+	pool      *SweeperPool
+	namespace string
+	sweeper   Sweeper
+}
+
+func (r *sweeperRef) Start(ctx context.Context) error {
+	return r.StartOnce("S4SweeperRef", func() error {
+		return r.pool.acquire(ctx, r.namespace, r.sweeper)
+	})
+}
+
+func (r *sweeperRef) Close() error {
+	return r.StopOnce("S4SweeperRef", func() error {
+		return r.pool.release(r.namespace)
+	})
+}
+
+func (r *sweeperRef) Name() string { return r.sweeper.Name() }
+
+func (r *sweeperRef) HealthReport() map[string]error {
+	return map[string]error{r.Name(): r.Healthy()}
+}
@@ -0,0 +1,97 @@
+package s4_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/s4"
+	"github.com/smartcontractkit/chainlink/v2/core/services/s4/mocks"
+)
+
+func TestSweeper_Sweep(t *testing.T) {
+	t.Parallel()
+
+	orm := mocks.NewORM(t)
+	clock := clockwork.NewFakeClock()
+	sweeper, err := s4.NewSweeper(logger.TestLogger(t), orm, "test", time.Minute, 10, clock)
+	require.NoError(t, err)
+
+	// deletes batches until a partial one
+	orm.On("DeleteExpired", mock.Anything, uint(10), clock.Now().UTC()).Return(int64(10), nil).Twice()
+	orm.On("DeleteExpired", mock.Anything, uint(10), clock.Now().UTC()).Return(int64(3), nil).Once()
+
+	deleted, err := sweeper.Sweep(testutils.Context(t))
+	require.NoError(t, err)
+	assert.Equal(t, int64(23), deleted)
+}
+
+func TestSweeper_Run(t *testing.T) {
+	t.Parallel()
+
+	orm := mocks.NewORM(t)
+	clock := clockwork.NewFakeClock()
+	sweeper, err := s4.NewSweeper(logger.TestLogger(t), orm, "test", time.Minute, 10, clock)
+	require.NoError(t, err)
+
+	swept := make(chan struct{})
+	orm.On("DeleteExpired", mock.Anything, uint(10), mock.Anything).Return(int64(0), nil).Run(func(mock.Arguments) {
+		swept <- struct{}{}
+	})
+
+	servicetest.Run(t, sweeper)
+	clock.BlockUntil(1)
+	clock.Advance(time.Minute)
+	<-swept
+}
+
+func TestSweeper_InvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	_, err := s4.NewSweeper(logger.TestLogger(t), s4.NewInMemoryORM(), "test", 0, 10, clockwork.NewFakeClock())
+	assert.Error(t, err)
+	_, err = s4.NewSweeper(logger.TestLogger(t), s4.NewInMemoryORM(), "test", time.Minute, 0, clockwork.NewFakeClock())
+	assert.Error(t, err)
+}
+
+func TestSweeperPool(t *testing.T) {
+	t.Parallel()
+
+	orm := mocks.NewORM(t)
+	clock := clockwork.NewFakeClock()
+	pool := s4.NewSweeperPool()
+	ctx := testutils.Context(t)
+
+	job1, err := pool.Sweeper(logger.TestLogger(t), orm, "test", time.Minute, 10, clock)
+	require.NoError(t, err)
+	job2, err := pool.Sweeper(logger.TestLogger(t), orm, "test", time.Minute, 10, clock)
+	require.NoError(t, err)
+	_, err = pool.Sweeper(logger.TestLogger(t), orm, "test", 0, 10, clock)
+	require.Error(t, err)
+
+	swept := make(chan struct{})
+	orm.On("DeleteExpired", mock.Anything, uint(10), mock.Anything).Return(int64(0), nil).Run(func(mock.Arguments) {
+		swept <- struct{}{}
+	})
+
+	// a single ticker runs for both jobs
+	require.NoError(t, job1.Start(ctx))
+	require.NoError(t, job2.Start(ctx))
+	clock.BlockUntil(1)
+	clock.Advance(time.Minute)
+	<-swept
+
+	// the sweeper keeps running until the last job is closed
+	require.NoError(t, job1.Close())
+	clock.Advance(time.Minute)
+	<-swept
+	require.NoError(t, job2.Close())
+	clock.BlockUntil(0)
+}
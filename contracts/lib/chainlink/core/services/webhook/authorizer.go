@@ -20,12 +20,16 @@ type Authorizer interface {
 
 var (
 	_ Authorizer = &eiAuthorizer{}
+	_ Authorizer = &triggerTokenAuthorizer{}
 	_ Authorizer = &alwaysAuthorizer{}
 	_ Authorizer = &neverAuthorizer{}
 )
 
-func NewAuthorizer(ds sqlutil.DataSource, user *sessions.User, ei *bridges.ExternalInitiator) Authorizer {
-	if user != nil {
+func NewAuthorizer(ds sqlutil.DataSource, user *sessions.User, ei *bridges.ExternalInitiator, token *TriggerToken) Authorizer {
+	// requests authenticated by a trigger token also carry a user with the run role, which must not be trusted
+	if token != nil {
+		return &triggerTokenAuthorizer{*token}
+	} else if user != nil {
 		return &alwaysAuthorizer{}
 	} else if ei != nil {
 		return NewEIAuthorizer(ds, *ei)
@@ -61,6 +65,14 @@ SELECT EXISTS (
 	return can, nil
 }
 
+type triggerTokenAuthorizer struct {
+	token TriggerToken
+}
+
+func (ta *triggerTokenAuthorizer) CanRun(_ context.Context, _ AuthorizerConfig, jobUUID uuid.UUID) (bool, error) {
+	return ta.token.ExternalJobID == jobUUID, nil
+}
+
 type alwaysAuthorizer struct{}
 
 func (*alwaysAuthorizer) CanRun(context.Context, AuthorizerConfig, uuid.UUID) (bool, error) {
@@ -42,7 +42,7 @@ func Test_Authorizer(t *testing.T) {
 	require.NoError(t, err)
 
 	t.Run("no user no ei never authorizes", func(t *testing.T) {
-		a := webhook.NewAuthorizer(db, nil, nil)
+		a := webhook.NewAuthorizer(db, nil, nil, nil)
 
 		can, err := a.CanRun(testutils.Context(t), nil, jobWithFooAndBarEI.ExternalJobID)
 		require.NoError(t, err)
@@ -56,7 +56,7 @@ func Test_Authorizer(t *testing.T) {
 	})
 
 	t.Run("with user no ei always authorizes", func(t *testing.T) {
-		a := webhook.NewAuthorizer(db, &sessions.User{}, nil)
+		a := webhook.NewAuthorizer(db, &sessions.User{}, nil, nil)
 
 		can, err := a.CanRun(testutils.Context(t), nil, jobWithFooAndBarEI.ExternalJobID)
 		require.NoError(t, err)
@@ -70,7 +70,7 @@ func Test_Authorizer(t *testing.T) {
 	})
 
 	t.Run("no user with ei authorizes conditionally", func(t *testing.T) {
-		a := webhook.NewAuthorizer(db, nil, &eiFoo)
+		a := webhook.NewAuthorizer(db, nil, &eiFoo, nil)
 
 		can, err := a.CanRun(testutils.Context(t), eiEnabledCfg{}, jobWithFooAndBarEI.ExternalJobID)
 		require.NoError(t, err)
@@ -88,4 +88,19 @@ func Test_Authorizer(t *testing.T) {
 		require.NoError(t, err)
 		assert.False(t, can)
 	})
+
+	t.Run("with trigger token authorizes only its job", func(t *testing.T) {
+		token := &webhook.TriggerToken{JobID: jobWithNoEI.ID, ExternalJobID: jobWithNoEI.ExternalJobID}
+		a := webhook.NewAuthorizer(db, &sessions.User{Role: sessions.UserRoleRun}, nil, token)
+
+		can, err := a.CanRun(testutils.Context(t), eiEnabledCfg{}, jobWithNoEI.ExternalJobID)
+		require.NoError(t, err)
+		assert.True(t, can)
+		can, err = a.CanRun(testutils.Context(t), eiEnabledCfg{}, jobWithFooAndBarEI.ExternalJobID)
+		require.NoError(t, err)
+		assert.False(t, can)
+		can, err = a.CanRun(testutils.Context(t), eiEnabledCfg{}, uuid.New())
+		require.NoError(t, err)
+		assert.False(t, can)
+	})
 }
@@ -3,6 +3,7 @@ package webhook
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -25,17 +26,30 @@ type (
 	}
 
 	JobRunner interface {
-		RunJob(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable) (int64, error)
+		// RunJob runs the webhook job with jobUUID. If idempotencyKey is set, the run started for the same key
+		// within the idempotency window of the job is returned instead of starting a new one.
+		RunJob(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable, idempotencyKey string) (int64, error)
 	}
 )
 
+const (
+	// DefaultIdempotencyWindow is the idempotency window of webhook jobs which do not set idempotencyWindow.
+	DefaultIdempotencyWindow = 24 * time.Hour
+	// DefaultIdempotencyKeyLease is the idempotency key lease of webhook jobs which do not set idempotencyKeyLease.
+	// The lease of a key is renewed while its run is in progress, so a key is only claimed again once its run
+	// stopped without being recorded, e.g. because the node stopped.
+	DefaultIdempotencyKeyLease = time.Minute
+	// idempotencyKeySweepInterval is how often the expired idempotency keys of a job are deleted.
+	idempotencyKeySweepInterval = time.Hour
+)
+
 var _ job.Delegate = (*Delegate)(nil)
 
-func NewDelegate(runner pipeline.Runner, externalInitiatorManager ExternalInitiatorManager, lggr logger.Logger) *Delegate {
+func NewDelegate(runner pipeline.Runner, externalInitiatorManager ExternalInitiatorManager, orm ORM, lggr logger.Logger) *Delegate {
 	lggr = lggr.Named("Webhook")
 	return &Delegate{
 		externalInitiatorManager: externalInitiatorManager,
-		webhookJobRunner:         newWebhookJobRunner(runner, lggr),
+		webhookJobRunner:         newWebhookJobRunner(runner, orm, lggr),
 		lggr:                     lggr,
 		stopCh:                   make(services.StopChan),
 	}
@@ -80,6 +94,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) ([]job.Ser
 	service := &pseudoService{
 		spec:             spec,
 		webhookJobRunner: d.webhookJobRunner,
+		stopCh:           make(services.StopChan),
 	}
 	return []job.ServiceCtx{service}, nil
 }
@@ -87,31 +102,82 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) ([]job.Ser
 type pseudoService struct {
 	spec             job.Job
 	webhookJobRunner *webhookJobRunner
+	stopCh           services.StopChan
+	wg               sync.WaitGroup
 }
 
 // Start starts PseudoService.
-func (s pseudoService) Start(context.Context) error {
+func (s *pseudoService) Start(context.Context) error {
 	// add the spec to the webhookJobRunner
-	return s.webhookJobRunner.addSpec(s.spec)
+	if err := s.webhookJobRunner.addSpec(s.spec); err != nil {
+		return err
+	}
+	s.wg.Add(1)
+	go s.sweepIdempotencyKeys()
+	return nil
 }
 
-func (s pseudoService) Close() error {
+func (s *pseudoService) Close() error {
+	close(s.stopCh)
+	s.wg.Wait()
 	// remove the spec from the webhookJobRunner
 	s.webhookJobRunner.rmSpec(s.spec)
 	return nil
 }
 
+// sweepIdempotencyKeys periodically deletes the idempotency keys of the job which have expired.
+func (s *pseudoService) sweepIdempotencyKeys() {
+	defer s.wg.Done()
+	ctx, cancel := s.stopCh.NewCtx()
+	defer cancel()
+
+	ticker := services.NewTicker(idempotencyKeySweepInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			since := time.Now().Add(-idempotencyWindow(s.spec))
+			n, err := s.webhookJobRunner.orm.DeleteExpiredIdempotencyKeys(ctx, s.spec.ID, since)
+			if err != nil {
+				s.webhookJobRunner.lggr.Errorw("Failed to delete expired idempotency keys", "jobID", s.spec.ID, "err", err)
+			} else if n > 0 {
+				s.webhookJobRunner.lggr.Debugw("Deleted expired idempotency keys", "jobID", s.spec.ID, "count", n)
+			}
+		}
+	}
+}
+
+// idempotencyWindow returns the time for which the idempotency keys of the webhook job are kept.
+func idempotencyWindow(spec job.Job) time.Duration {
+	if spec.WebhookSpec != nil && spec.WebhookSpec.IdempotencyWindow > 0 {
+		return spec.WebhookSpec.IdempotencyWindow.Duration()
+	}
+	return DefaultIdempotencyWindow
+}
+
+// idempotencyKeyLease returns the time for which an idempotency key is leased to its run, between renewals.
+func idempotencyKeyLease(spec job.Job) time.Duration {
+	if spec.WebhookSpec != nil && spec.WebhookSpec.IdempotencyKeyLease > 0 {
+		return spec.WebhookSpec.IdempotencyKeyLease.Duration()
+	}
+	return DefaultIdempotencyKeyLease
+}
+
 type webhookJobRunner struct {
 	specsByUUID   map[uuid.UUID]registeredJob
 	muSpecsByUUID sync.RWMutex
 	runner        pipeline.Runner
+	orm           ORM
 	lggr          logger.Logger
 }
 
-func newWebhookJobRunner(runner pipeline.Runner, lggr logger.Logger) *webhookJobRunner {
+func newWebhookJobRunner(runner pipeline.Runner, orm ORM, lggr logger.Logger) *webhookJobRunner {
 	return &webhookJobRunner{
 		specsByUUID: make(map[uuid.UUID]registeredJob),
 		runner:      runner,
+		orm:         orm,
 		lggr:        lggr.Named("JobRunner"),
 	}
 }
@@ -149,9 +215,13 @@ func (r *webhookJobRunner) spec(externalJobID uuid.UUID) (registeredJob, bool) {
 	return spec, exists
 }
 
-var ErrJobNotExists = errors.New("job does not exist")
+var (
+	ErrJobNotExists = errors.New("job does not exist")
+	// ErrIdempotencyKeyInProgress is returned when the run started for an idempotency key has not finished yet.
+	ErrIdempotencyKeyInProgress = errors.New("a run with this idempotency key is still in progress")
+)
 
-func (r *webhookJobRunner) RunJob(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable) (int64, error) {
+func (r *webhookJobRunner) RunJob(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable, idempotencyKey string) (int64, error) {
 	spec, exists := r.spec(jobUUID)
 	if !exists {
 		return 0, ErrJobNotExists
@@ -165,6 +235,28 @@ func (r *webhookJobRunner) RunJob(ctx context.Context, jobUUID uuid.UUID, reques
 	ctx, cancel := spec.chRemove.Ctx(ctx)
 	defer cancel()
 
+	stopRenewal := func() {}
+	if idempotencyKey != "" {
+		lease := idempotencyKeyLease(spec.Job)
+		now := time.Now()
+		leaseExpiresAt := now.Add(lease)
+		runID, reserved, err := r.orm.ReserveIdempotencyKey(ctx, spec.ID, idempotencyKey, now.Add(-idempotencyWindow(spec.Job)), leaseExpiresAt)
+		if err != nil {
+			return 0, err
+		}
+		if !reserved {
+			if runID == 0 {
+				return 0, ErrIdempotencyKeyInProgress
+			}
+			jobLggr.Debugw("Returning existing run for idempotency key", "runID", runID)
+			return runID, nil
+		}
+		// the lease is renewed until the run is recorded or the key released, so that another request can not
+		// start a second run for the key while this one is still in progress
+		stopRenewal = r.renewIdempotencyKeyLease(ctx, jobLggr, spec.ID, idempotencyKey, lease)
+		defer stopRenewal()
+	}
+
 	vars := pipeline.NewVarsFrom(map[string]interface{}{
 		"jobSpec": map[string]interface{}{
 			"databaseID":    spec.ID,
@@ -180,12 +272,56 @@ func (r *webhookJobRunner) RunJob(ctx context.Context, jobUUID uuid.UUID, reques
 	run := pipeline.NewRun(*spec.PipelineSpec, vars)
 
 	_, err := r.runner.Run(ctx, run, true, nil)
+	stopRenewal()
 	if err != nil {
 		jobLggr.Errorw("Error running pipeline for webhook job", "err", err)
+		if idempotencyKey != "" {
+			// the key is released so that the request can be retried
+			if rmErr := r.orm.DeleteIdempotencyKey(context.WithoutCancel(ctx), spec.ID, idempotencyKey); rmErr != nil {
+				jobLggr.Errorw("Failed to release idempotency key", "err", rmErr)
+			}
+		}
 		return 0, err
 	}
 	if run.ID == 0 {
 		panic("expected run to have non-zero id")
 	}
+	if idempotencyKey != "" {
+		// the run succeeded, so failing to record it only means that a retry may run the job again
+		if err = r.orm.SetIdempotencyKeyRun(ctx, spec.ID, idempotencyKey, run.ID); err != nil {
+			jobLggr.Errorw("Failed to record run for idempotency key", "runID", run.ID, "err", err)
+		}
+	}
 	return run.ID, nil
 }
+
+// renewIdempotencyKeyLease renews the lease of the idempotency key every third of the lease, until the returned
+// function is called.
+func (r *webhookJobRunner) renewIdempotencyKeyLease(ctx context.Context, lggr logger.Logger, jobID int32, key string, lease time.Duration) (stop func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := services.NewTicker(lease / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				renewed, err := r.orm.RenewIdempotencyKeyLease(ctx, jobID, key, time.Now().Add(lease))
+				if err != nil {
+					lggr.Errorw("Failed to renew idempotency key lease", "err", err)
+				} else if !renewed {
+					lggr.Warnw("Idempotency key lease was lost while its run is in progress, a repeated request may run the job again")
+					return
+				}
+			}
+		}
+	}()
+	return sync.OnceFunc(func() {
+		cancel()
+		wg.Wait()
+	})
+}
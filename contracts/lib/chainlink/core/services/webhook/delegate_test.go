@@ -1,13 +1,17 @@
 package webhook_test
 
 import (
+	"context"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/pkg/errors"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
@@ -19,6 +23,7 @@ import (
 	pipelinemocks "github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	webhookmocks "github.com/smartcontractkit/chainlink/v2/core/services/webhook/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
 func TestWebhookDelegate(t *testing.T) {
@@ -49,7 +54,7 @@ func TestWebhookDelegate(t *testing.T) {
 		}
 		runner    = pipelinemocks.NewRunner(t)
 		eiManager = new(webhookmocks.ExternalInitiatorManager)
-		delegate  = webhook.NewDelegate(runner, eiManager, logger.TestLogger(t))
+		delegate  = webhook.NewDelegate(runner, eiManager, newIdempotencyORM(), logger.TestLogger(t))
 	)
 
 	services, err := delegate.ServicesForSpec(ctx, *spec)
@@ -58,7 +63,7 @@ func TestWebhookDelegate(t *testing.T) {
 	service := services[0]
 
 	// Should error before service is started
-	_, err = delegate.WebhookJobRunner().RunJob(ctx, spec.ExternalJobID, requestBody, meta)
+	_, err = delegate.WebhookJobRunner().RunJob(ctx, spec.ExternalJobID, requestBody, meta, "")
 	require.Error(t, err)
 	require.Equal(t, webhook.ErrJobNotExists, errors.Cause(err))
 
@@ -75,7 +80,7 @@ func TestWebhookDelegate(t *testing.T) {
 			require.Equal(t, vars, run.Inputs.Val)
 		}).Once()
 
-	runID, err := delegate.WebhookJobRunner().RunJob(ctx, spec.ExternalJobID, requestBody, meta)
+	runID, err := delegate.WebhookJobRunner().RunJob(ctx, spec.ExternalJobID, requestBody, meta, "")
 	require.NoError(t, err)
 	require.Equal(t, int64(123), runID)
 
@@ -85,13 +90,161 @@ func TestWebhookDelegate(t *testing.T) {
 	runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
 		Return(false, expectedErr).Once()
 
-	_, err = delegate.WebhookJobRunner().RunJob(ctx, spec.ExternalJobID, requestBody, meta)
+	_, err = delegate.WebhookJobRunner().RunJob(ctx, spec.ExternalJobID, requestBody, meta, "")
 	require.Equal(t, expectedErr, errors.Cause(err))
 
 	// Should error after service is stopped
 	err = service.Close()
 	require.NoError(t, err)
 
-	_, err = delegate.WebhookJobRunner().RunJob(ctx, spec.ExternalJobID, requestBody, meta)
+	_, err = delegate.WebhookJobRunner().RunJob(ctx, spec.ExternalJobID, requestBody, meta, "")
 	require.Equal(t, webhook.ErrJobNotExists, errors.Cause(err))
 }
+
+type idempotencyKey struct {
+	jobID int32
+	key   string
+}
+
+// idempotencyORM is an in memory webhook.ORM
+type idempotencyORM struct {
+	mu       sync.Mutex
+	keys     map[idempotencyKey]int64
+	renewals int
+}
+
+func newIdempotencyORM() *idempotencyORM {
+	return &idempotencyORM{keys: make(map[idempotencyKey]int64)}
+}
+
+func (o *idempotencyORM) ReserveIdempotencyKey(_ context.Context, jobID int32, key string, _, _ time.Time) (int64, bool, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if runID, ok := o.keys[idempotencyKey{jobID, key}]; ok {
+		return runID, false, nil
+	}
+	o.keys[idempotencyKey{jobID, key}] = 0
+	return 0, true, nil
+}
+
+func (o *idempotencyORM) RenewIdempotencyKeyLease(_ context.Context, jobID int32, key string, _ time.Time) (bool, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	runID, ok := o.keys[idempotencyKey{jobID, key}]
+	if !ok || runID != 0 {
+		return false, nil
+	}
+	o.renewals++
+	return true, nil
+}
+
+func (o *idempotencyORM) SetIdempotencyKeyRun(_ context.Context, jobID int32, key string, runID int64) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.keys[idempotencyKey{jobID, key}] = runID
+	return nil
+}
+
+func (o *idempotencyORM) DeleteIdempotencyKey(_ context.Context, jobID int32, key string) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	delete(o.keys, idempotencyKey{jobID, key})
+	return nil
+}
+
+func (o *idempotencyORM) DeleteExpiredIdempotencyKeys(context.Context, int32, time.Time) (int64, error) {
+	return 0, nil
+}
+
+func TestWebhookDelegate_IdempotencyKey(t *testing.T) {
+	ctx := testutils.Context(t)
+	var (
+		spec = &job.Job{
+			ID:            123,
+			Type:          job.Webhook,
+			SchemaVersion: 1,
+			ExternalJobID: uuid.New(),
+			WebhookSpec:   &job.WebhookSpec{},
+			PipelineSpec:  &pipeline.Spec{},
+		}
+		meta     = jsonserializable.JSONSerializable{}
+		runner   = pipelinemocks.NewRunner(t)
+		orm      = newIdempotencyORM()
+		delegate = webhook.NewDelegate(runner, new(webhookmocks.ExternalInitiatorManager), orm, logger.TestLogger(t))
+	)
+
+	services, err := delegate.ServicesForSpec(ctx, *spec)
+	require.NoError(t, err)
+	require.NoError(t, services[0].Start(ctx))
+	t.Cleanup(func() { require.NoError(t, services[0].Close()) })
+
+	// A failed run releases the key
+	runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+		Return(false, errors.New("foo bar")).Once()
+	_, err = delegate.WebhookJobRunner().RunJob(ctx, spec.ExternalJobID, "foo", meta, "key-1")
+	require.Error(t, err)
+	require.Empty(t, orm.keys)
+
+	runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+		Return(false, nil).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*pipeline.Run).ID = int64(123)
+		}).Once()
+	runID, err := delegate.WebhookJobRunner().RunJob(ctx, spec.ExternalJobID, "foo", meta, "key-1")
+	require.NoError(t, err)
+	require.Equal(t, int64(123), runID)
+
+	// Repeated requests return the first run without running the pipeline again
+	runID, err = delegate.WebhookJobRunner().RunJob(ctx, spec.ExternalJobID, "foo", meta, "key-1")
+	require.NoError(t, err)
+	require.Equal(t, int64(123), runID)
+
+	// Requests are rejected while the run of their key is in progress
+	orm.keys[idempotencyKey{spec.ID, "key-2"}] = 0
+	_, err = delegate.WebhookJobRunner().RunJob(ctx, spec.ExternalJobID, "foo", meta, "key-2")
+	require.ErrorIs(t, err, webhook.ErrIdempotencyKeyInProgress)
+}
+
+func TestWebhookDelegate_IdempotencyKeyLease(t *testing.T) {
+	ctx := testutils.Context(t)
+	var (
+		lease = 30 * time.Millisecond
+		spec  = &job.Job{
+			ID:            123,
+			Type:          job.Webhook,
+			SchemaVersion: 1,
+			ExternalJobID: uuid.New(),
+			WebhookSpec:   &job.WebhookSpec{IdempotencyKeyLease: models.Interval(lease)},
+			PipelineSpec:  &pipeline.Spec{},
+		}
+		meta     = jsonserializable.JSONSerializable{}
+		runner   = pipelinemocks.NewRunner(t)
+		orm      = newIdempotencyORM()
+		delegate = webhook.NewDelegate(runner, new(webhookmocks.ExternalInitiatorManager), orm, logger.TestLogger(t))
+	)
+
+	services, err := delegate.ServicesForSpec(ctx, *spec)
+	require.NoError(t, err)
+	require.NoError(t, services[0].Start(ctx))
+	t.Cleanup(func() { require.NoError(t, services[0].Close()) })
+
+	// A run which takes longer than the lease is not cancelled, and its lease is renewed meanwhile
+	runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+		Return(false, nil).
+		Run(func(args mock.Arguments) {
+			runCtx := args.Get(0).(context.Context)
+			select {
+			case <-runCtx.Done():
+				t.Error("run was cancelled")
+			case <-time.After(10 * lease):
+			}
+			args.Get(1).(*pipeline.Run).ID = int64(123)
+		}).Once()
+	runID, err := delegate.WebhookJobRunner().RunJob(ctx, spec.ExternalJobID, "foo", meta, "key")
+	require.NoError(t, err)
+	require.Equal(t, int64(123), runID)
+
+	orm.mu.Lock()
+	defer orm.mu.Unlock()
+	assert.Positive(t, orm.renewals)
+}
@@ -0,0 +1,117 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+// ORM persists the idempotency keys of webhook job runs.
+type ORM interface {
+	// ReserveIdempotencyKey claims key for a new run of the job with jobID, until leaseExpiresAt. If the key was
+	// already claimed after since, it returns false and the ID of the run started with the key, which is zero while
+	// that run is in progress. Keys whose lease expired before their run was recorded, and keys whose run was
+	// pruned, are claimed again.
+	ReserveIdempotencyKey(ctx context.Context, jobID int32, key string, since, leaseExpiresAt time.Time) (runID int64, reserved bool, err error)
+	// RenewIdempotencyKeyLease extends the lease of a reserved key whose run is in progress to leaseExpiresAt. It
+	// returns false if the key is no longer reserved.
+	RenewIdempotencyKeyLease(ctx context.Context, jobID int32, key string, leaseExpiresAt time.Time) (bool, error)
+	// SetIdempotencyKeyRun records the run started with a reserved key, and ends its lease.
+	SetIdempotencyKeyRun(ctx context.Context, jobID int32, key string, runID int64) error
+	// DeleteIdempotencyKey releases a reserved key whose run could not be started.
+	DeleteIdempotencyKey(ctx context.Context, jobID int32, key string) error
+	// DeleteExpiredIdempotencyKeys deletes the keys of the job with jobID which were claimed before since, or whose
+	// run was never recorded or was pruned, unless they are still leased.
+	DeleteExpiredIdempotencyKeys(ctx context.Context, jobID int32, since time.Time) (int64, error)
+}
+
+type orm struct {
+	ds sqlutil.DataSource
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(ds sqlutil.DataSource) ORM {
+	return &orm{ds: ds}
+}
+
+func (o *orm) ReserveIdempotencyKey(ctx context.Context, jobID int32, key string, since, leaseExpiresAt time.Time) (runID int64, reserved bool, err error) {
+	// a key claimed before since has expired, and is claimed again. So is a key without a run once its lease is
+	// over: either its run never finished, or the run was pruned and there is nothing left to return.
+	var id int32
+	err = o.ds.GetContext(ctx, &id, `
+INSERT INTO webhook_idempotency_keys (job_id, idempotency_key, created_at, lease_expires_at) VALUES ($1, $2, NOW(), $4)
+ON CONFLICT (job_id, idempotency_key) DO UPDATE SET pipeline_run_id = NULL, created_at = NOW(), lease_expires_at = $4
+WHERE webhook_idempotency_keys.created_at < $3
+OR (webhook_idempotency_keys.pipeline_run_id IS NULL AND (webhook_idempotency_keys.lease_expires_at IS NULL OR webhook_idempotency_keys.lease_expires_at < NOW()))
+RETURNING job_id`, jobID, key, since, leaseExpiresAt)
+	if err == nil {
+		return 0, true, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return 0, false, errors.Wrap(err, "ReserveIdempotencyKey failed")
+	}
+
+	var existing sql.NullInt64
+	err = o.ds.GetContext(ctx, &existing, `SELECT pipeline_run_id FROM webhook_idempotency_keys WHERE job_id = $1 AND idempotency_key = $2`, jobID, key)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "ReserveIdempotencyKey failed")
+	}
+	return existing.Int64, false, nil
+}
+
+func (o *orm) RenewIdempotencyKeyLease(ctx context.Context, jobID int32, key string, leaseExpiresAt time.Time) (bool, error) {
+	res, err := o.ds.ExecContext(ctx, `
+UPDATE webhook_idempotency_keys SET lease_expires_at = $3
+WHERE job_id = $1 AND idempotency_key = $2 AND pipeline_run_id IS NULL AND lease_expires_at IS NOT NULL`, jobID, key, leaseExpiresAt)
+	if err != nil {
+		return false, errors.Wrap(err, "RenewIdempotencyKeyLease failed")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, errors.Wrap(err, "RenewIdempotencyKeyLease failed")
+	}
+	return n > 0, nil
+}
+
+func (o *orm) SetIdempotencyKeyRun(ctx context.Context, jobID int32, key string, runID int64) error {
+	res, err := o.ds.ExecContext(ctx, `
+UPDATE webhook_idempotency_keys SET pipeline_run_id = $3, lease_expires_at = NULL
+WHERE job_id = $1 AND idempotency_key = $2 AND EXISTS (SELECT 1 FROM pipeline_runs WHERE id = $3)`, jobID, key, runID)
+	var pqErr *pgconn.PgError
+	if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+		// the run was pruned concurrently
+		return o.DeleteIdempotencyKey(ctx, jobID, key)
+	} else if err != nil {
+		return errors.Wrap(err, "SetIdempotencyKeyRun failed")
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return errors.Wrap(err, "SetIdempotencyKeyRun failed")
+	} else if n == 0 {
+		// the run was pruned already, so the key has no run to return and is released
+		return o.DeleteIdempotencyKey(ctx, jobID, key)
+	}
+	return nil
+}
+
+func (o *orm) DeleteIdempotencyKey(ctx context.Context, jobID int32, key string) error {
+	_, err := o.ds.ExecContext(ctx, `DELETE FROM webhook_idempotency_keys WHERE job_id = $1 AND idempotency_key = $2`, jobID, key)
+	return errors.Wrap(err, "DeleteIdempotencyKey failed")
+}
+
+func (o *orm) DeleteExpiredIdempotencyKeys(ctx context.Context, jobID int32, since time.Time) (int64, error) {
+	res, err := o.ds.ExecContext(ctx, `
+DELETE FROM webhook_idempotency_keys
+WHERE job_id = $1
+AND (created_at < $2 OR pipeline_run_id IS NULL)
+AND (lease_expires_at IS NULL OR lease_expires_at < NOW())`, jobID, since)
+	if err != nil {
+		return 0, errors.Wrap(err, "DeleteExpiredIdempotencyKeys failed")
+	}
+	return res.RowsAffected()
+}
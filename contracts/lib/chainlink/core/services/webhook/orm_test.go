@@ -0,0 +1,149 @@
+package webhook_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
+)
+
+func TestORM_IdempotencyKeys(t *testing.T) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	orm := webhook.NewORM(db)
+
+	jb, _ := cltest.MustInsertWebhookSpec(t, db)
+	run := cltest.MustInsertPipelineRun(t, db)
+	since := time.Now().Add(-time.Hour)
+	lease := time.Now().Add(time.Hour)
+
+	_, reserved, err := orm.ReserveIdempotencyKey(ctx, jb.ID, "key", since, lease)
+	require.NoError(t, err)
+	assert.True(t, reserved)
+
+	// in progress
+	runID, reserved, err := orm.ReserveIdempotencyKey(ctx, jb.ID, "key", since, lease)
+	require.NoError(t, err)
+	assert.False(t, reserved)
+	assert.Zero(t, runID)
+
+	require.NoError(t, orm.SetIdempotencyKeyRun(ctx, jb.ID, "key", run.ID))
+	runID, reserved, err = orm.ReserveIdempotencyKey(ctx, jb.ID, "key", since, lease)
+	require.NoError(t, err)
+	assert.False(t, reserved)
+	assert.Equal(t, run.ID, runID)
+
+	// keys are scoped to their job
+	other, _ := cltest.MustInsertWebhookSpec(t, db)
+	_, reserved, err = orm.ReserveIdempotencyKey(ctx, other.ID, "key", since, lease)
+	require.NoError(t, err)
+	assert.True(t, reserved)
+
+	// keys claimed before the window are claimed again
+	_, reserved, err = orm.ReserveIdempotencyKey(ctx, jb.ID, "key", time.Now().Add(time.Second), lease)
+	require.NoError(t, err)
+	assert.True(t, reserved)
+
+	require.NoError(t, orm.DeleteIdempotencyKey(ctx, jb.ID, "key"))
+	_, reserved, err = orm.ReserveIdempotencyKey(ctx, jb.ID, "key", since, lease)
+	require.NoError(t, err)
+	assert.True(t, reserved)
+
+	// keys whose lease expired before their run was recorded are claimed again
+	_, reserved, err = orm.ReserveIdempotencyKey(ctx, jb.ID, "leased", since, time.Now().Add(-time.Second))
+	require.NoError(t, err)
+	require.True(t, reserved)
+	_, reserved, err = orm.ReserveIdempotencyKey(ctx, jb.ID, "leased", since, lease)
+	require.NoError(t, err)
+	assert.True(t, reserved)
+
+	// keys whose run was pruned are claimed again
+	pruned := cltest.MustInsertPipelineRun(t, db)
+	_, reserved, err = orm.ReserveIdempotencyKey(ctx, jb.ID, "pruned", since, lease)
+	require.NoError(t, err)
+	require.True(t, reserved)
+	require.NoError(t, orm.SetIdempotencyKeyRun(ctx, jb.ID, "pruned", pruned.ID))
+	_, err = db.ExecContext(ctx, `DELETE FROM pipeline_runs WHERE id = $1`, pruned.ID)
+	require.NoError(t, err)
+	_, reserved, err = orm.ReserveIdempotencyKey(ctx, jb.ID, "pruned", since, lease)
+	require.NoError(t, err)
+	assert.True(t, reserved)
+
+	// recording a run which was pruned already releases the key
+	require.NoError(t, orm.SetIdempotencyKeyRun(ctx, jb.ID, "pruned", pruned.ID))
+	_, reserved, err = orm.ReserveIdempotencyKey(ctx, jb.ID, "pruned", since, lease)
+	require.NoError(t, err)
+	assert.True(t, reserved)
+}
+
+func TestORM_RenewIdempotencyKeyLease(t *testing.T) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	orm := webhook.NewORM(db)
+
+	jb, _ := cltest.MustInsertWebhookSpec(t, db)
+	run := cltest.MustInsertPipelineRun(t, db)
+	since := time.Now().Add(-time.Hour)
+
+	_, reserved, err := orm.ReserveIdempotencyKey(ctx, jb.ID, "key", since, time.Now().Add(-time.Second))
+	require.NoError(t, err)
+	require.True(t, reserved)
+
+	// a renewed lease keeps the key reserved past the original lease
+	renewed, err := orm.RenewIdempotencyKeyLease(ctx, jb.ID, "key", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.True(t, renewed)
+	runID, reserved, err := orm.ReserveIdempotencyKey(ctx, jb.ID, "key", since, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.False(t, reserved)
+	assert.Zero(t, runID)
+
+	// keys whose run was recorded, or which were released, are not renewed
+	require.NoError(t, orm.SetIdempotencyKeyRun(ctx, jb.ID, "key", run.ID))
+	renewed, err = orm.RenewIdempotencyKeyLease(ctx, jb.ID, "key", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.False(t, renewed)
+
+	renewed, err = orm.RenewIdempotencyKeyLease(ctx, jb.ID, "missing", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.False(t, renewed)
+}
+
+func TestORM_DeleteExpiredIdempotencyKeys(t *testing.T) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	orm := webhook.NewORM(db)
+
+	jb, _ := cltest.MustInsertWebhookSpec(t, db)
+	run := cltest.MustInsertPipelineRun(t, db)
+	since := time.Now().Add(-time.Hour)
+	lease := time.Now().Add(time.Hour)
+
+	for _, key := range []string{"done", "leased"} {
+		_, reserved, err := orm.ReserveIdempotencyKey(ctx, jb.ID, key, since, lease)
+		require.NoError(t, err)
+		require.True(t, reserved)
+	}
+	require.NoError(t, orm.SetIdempotencyKeyRun(ctx, jb.ID, "done", run.ID))
+
+	// keys within the window are kept
+	n, err := orm.DeleteExpiredIdempotencyKeys(ctx, jb.ID, since)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+
+	// keys which are still leased are kept past the window
+	n, err = orm.DeleteExpiredIdempotencyKeys(ctx, jb.ID, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+
+	runID, reserved, err := orm.ReserveIdempotencyKey(ctx, jb.ID, "leased", since, lease)
+	require.NoError(t, err)
+	assert.False(t, reserved)
+	assert.Zero(t, runID)
+}
@@ -0,0 +1,392 @@
+package webhook
+
+import (
+	"container/heap"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+)
+
+// DefaultReplayWindow is how far the timestamp of a signed webhook request may be from the current time.
+const DefaultReplayWindow = 5 * time.Minute
+
+const secretKeyLabel = "chainlink webhook trigger token secrets"
+
+var (
+	ErrTriggerTokenNotFound     = errors.New("webhook trigger token not found")
+	ErrTriggerTokenWrongJob     = errors.New("webhook trigger token is not allowed to run this job")
+	ErrTriggerIPNotAllowed      = errors.New("source IP is not allowed by webhook trigger token")
+	ErrTriggerInvalidTimestamp  = errors.New("webhook timestamp is invalid")
+	ErrTriggerExpired           = errors.New("webhook timestamp is outside of the replay window")
+	ErrTriggerInvalidSignature  = errors.New("webhook signature is invalid")
+	ErrTriggerReplayed          = errors.New("webhook request has already been received")
+	ErrTriggerRateLimitExceeded = errors.New("webhook trigger rate limit exceeded")
+)
+
+// TriggerToken authorizes signed requests to run a single webhook job.
+type TriggerToken struct {
+	ID            int64
+	JobID         int32
+	ExternalJobID uuid.UUID
+	AccessKey     string
+	// Secret is only set on tokens returned by CreateTriggerToken and Verify. It is stored encrypted, in
+	// EncryptedSecret.
+	Secret          string `db:"-"`
+	EncryptedSecret []byte `db:"encrypted_secret"`
+	// AllowedIPs are the IPs and CIDR ranges requests are accepted from. Empty allows any source.
+	AllowedIPs pq.StringArray `db:"allowed_ips"`
+	// RateLimit is the number of requests accepted per minute. Zero is unlimited.
+	RateLimit uint32
+	CreatedAt time.Time
+	RevokedAt *time.Time
+}
+
+// TriggerTokenRequest is the request to create a trigger token.
+type TriggerTokenRequest struct {
+	AllowedIPs []string `json:"allowedIPs"`
+	RateLimit  uint32   `json:"rateLimit"`
+}
+
+// AllowsIP returns true if requests from ip are accepted by the token.
+func (t TriggerToken) AllowsIP(ip string) bool {
+	if len(t.AllowedIPs) == 0 {
+		return true
+	}
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, allowed := range t.AllowedIPs {
+		if strings.Contains(allowed, "/") {
+			if _, ipNet, err := net.ParseCIDR(allowed); err == nil && ipNet.Contains(parsed) {
+				return true
+			}
+		} else if parsed.Equal(net.ParseIP(allowed)) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateAllowedIPs returns an error if any of ips is neither an IP nor a CIDR range.
+func ValidateAllowedIPs(ips []string) error {
+	for _, ip := range ips {
+		if strings.Contains(ip, "/") {
+			if _, _, err := net.ParseCIDR(ip); err != nil {
+				return errors.Errorf("invalid CIDR range %q", ip)
+			}
+		} else if net.ParseIP(ip) == nil {
+			return errors.Errorf("invalid IP %q", ip)
+		}
+	}
+	return nil
+}
+
+// SecretKey derives the key which encrypts the secrets of trigger tokens at rest from the keystore password of the
+// node, see ReencryptSecrets.
+func SecretKey(keystorePassword string) []byte {
+	mac := hmac.New(sha256.New, []byte(keystorePassword))
+	mac.Write([]byte(secretKeyLabel))
+	return mac.Sum(nil)
+}
+
+// sealSecret encrypts secret with AES-256-GCM, bound to the access key of its token. The nonce is prepended.
+func sealSecret(key []byte, accessKey, secret string) ([]byte, error) {
+	aead, err := secretAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, []byte(secret), []byte(accessKey)), nil
+}
+
+// openSecret decrypts a secret sealed by sealSecret.
+func openSecret(key []byte, accessKey string, sealed []byte) (string, error) {
+	aead, err := secretAEAD(key)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", errors.New("encrypted secret is too short")
+	}
+	secret, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], []byte(accessKey))
+	if err != nil {
+		return "", errors.New("failed to decrypt secret: wrong keystore password, or the secret is corrupted")
+	}
+	return string(secret), nil
+}
+
+func secretAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// ReencryptSecrets re-encrypts the secrets of the active trigger tokens from the key derived from oldPassword to the
+// key derived from newPassword. It is a keystore.RotationHook, so that the secrets can still be decrypted once the
+// keystore password is rotated.
+func ReencryptSecrets(ctx context.Context, ds sqlutil.DataSource, oldPassword, newPassword string) error {
+	var tokens []TriggerToken
+	err := ds.SelectContext(ctx, &tokens, `SELECT id, access_key, encrypted_secret FROM webhook_trigger_tokens WHERE revoked_at IS NULL`)
+	if err != nil {
+		return errors.Wrap(err, "failed to load webhook trigger tokens")
+	}
+	oldKey, newKey := SecretKey(oldPassword), SecretKey(newPassword)
+	for _, t := range tokens {
+		secret, err := openSecret(oldKey, t.AccessKey, t.EncryptedSecret)
+		if err != nil {
+			return errors.Wrapf(err, "webhook trigger token %s", t.AccessKey)
+		}
+		sealed, err := sealSecret(newKey, t.AccessKey, secret)
+		if err != nil {
+			return err
+		}
+		if _, err = ds.ExecContext(ctx, `UPDATE webhook_trigger_tokens SET encrypted_secret = $1 WHERE id = $2`, sealed, t.ID); err != nil {
+			return errors.Wrapf(err, "failed to save webhook trigger token %s", t.AccessKey)
+		}
+	}
+	return nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of timestamp and body, keyed by secret. Callers send it in the
+// X-Chainlink-Webhook-Signature header, along with timestamp in the X-Chainlink-Webhook-Timestamp header.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SignedRequest is a webhook job run request authenticated by a trigger token.
+type SignedRequest struct {
+	AccessKey string
+	// Timestamp is the unix time in seconds at which the request was signed.
+	Timestamp string
+	Signature string
+	Body      []byte
+	ClientIP  string
+	JobID     uuid.UUID
+}
+
+// TriggerTokenManager manages the trigger tokens of webhook jobs and verifies the requests signed with them.
+type TriggerTokenManager interface {
+	CreateTriggerToken(ctx context.Context, jobID int32, allowedIPs []string, rateLimit uint32) (*TriggerToken, error)
+	TriggerTokens(ctx context.Context, jobID int32) ([]TriggerToken, error)
+	RevokeTriggerToken(ctx context.Context, accessKey string) error
+	// Verify checks the token, source IP, timestamp, signature and rate limit of req, and records the outcome
+	// in the audit log.
+	Verify(ctx context.Context, req SignedRequest) (*TriggerToken, error)
+}
+
+type triggerTokenManager struct {
+	ds           sqlutil.DataSource
+	auditLogger  audit.AuditLogger
+	secretKey    []byte
+	replayWindow time.Duration
+	now          func() time.Time
+
+	mu       sync.Mutex
+	limiters map[int64]*rate.Limiter
+	// seen holds the signatures received within the replay window, by their expiry, and expiries orders them so that
+	// they are evicted as soon as they leave the window
+	seen     map[string]time.Time
+	expiries seenQueue
+}
+
+type seenSignature struct {
+	key    string
+	expiry time.Time
+}
+
+// seenQueue is a heap of the signatures received within the replay window, earliest expiry first.
+type seenQueue []seenSignature
+
+func (q seenQueue) Len() int           { return len(q) }
+func (q seenQueue) Less(i, j int) bool { return q[i].expiry.Before(q[j].expiry) }
+func (q seenQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *seenQueue) Push(x any)        { *q = append(*q, x.(seenSignature)) }
+func (q *seenQueue) Pop() any {
+	old := *q
+	last := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return last
+}
+
+var _ TriggerTokenManager = (*triggerTokenManager)(nil)
+
+// NewTriggerTokenManager returns the concrete triggerTokenManager, which encrypts the secrets of trigger tokens with
+// secretKey, see SecretKey.
+func NewTriggerTokenManager(ds sqlutil.DataSource, auditLogger audit.AuditLogger, secretKey []byte) *triggerTokenManager {
+	return &triggerTokenManager{
+		ds:           ds,
+		auditLogger:  auditLogger,
+		secretKey:    secretKey,
+		replayWindow: DefaultReplayWindow,
+		now:          time.Now,
+		limiters:     make(map[int64]*rate.Limiter),
+		seen:         make(map[string]time.Time),
+	}
+}
+
+// CreateTriggerToken creates a new trigger token for the webhook job with jobID. The secret of the token can
+// only be read from the returned value.
+func (m *triggerTokenManager) CreateTriggerToken(ctx context.Context, jobID int32, allowedIPs []string, rateLimit uint32) (*TriggerToken, error) {
+	if err := ValidateAllowedIPs(allowedIPs); err != nil {
+		return nil, err
+	}
+	if allowedIPs == nil {
+		allowedIPs = []string{}
+	}
+	token := auth.NewToken()
+	sealed, err := sealSecret(m.secretKey, token.AccessKey, token.Secret)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encrypt secret")
+	}
+	var t TriggerToken
+	err = m.ds.GetContext(ctx, &t, `
+WITH inserted AS (
+	INSERT INTO webhook_trigger_tokens (job_id, access_key, encrypted_secret, allowed_ips, rate_limit, created_at)
+	SELECT id, $2, $3, $4, $5, NOW() FROM jobs WHERE id = $1 AND type = 'webhook'
+	RETURNING *
+)
+SELECT inserted.*, jobs.external_job_id FROM inserted JOIN jobs ON jobs.id = inserted.job_id`,
+		jobID, token.AccessKey, sealed, pq.StringArray(allowedIPs), rateLimit)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrJobNotExists
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "CreateTriggerToken failed")
+	}
+	t.Secret = token.Secret
+	return &t, nil
+}
+
+// TriggerTokens returns the active trigger tokens of the job with jobID.
+func (m *triggerTokenManager) TriggerTokens(ctx context.Context, jobID int32) (tokens []TriggerToken, err error) {
+	err = m.ds.SelectContext(ctx, &tokens, `
+SELECT webhook_trigger_tokens.*, jobs.external_job_id FROM webhook_trigger_tokens
+JOIN jobs ON jobs.id = webhook_trigger_tokens.job_id
+WHERE webhook_trigger_tokens.job_id = $1 AND webhook_trigger_tokens.revoked_at IS NULL
+ORDER BY webhook_trigger_tokens.id`, jobID)
+	return tokens, errors.Wrap(err, "TriggerTokens failed")
+}
+
+// RevokeTriggerToken revokes the active trigger token with accessKey.
+func (m *triggerTokenManager) RevokeTriggerToken(ctx context.Context, accessKey string) error {
+	var id int64
+	err := m.ds.GetContext(ctx, &id, `UPDATE webhook_trigger_tokens SET revoked_at = NOW() WHERE access_key = $1 AND revoked_at IS NULL RETURNING id`, accessKey)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrTriggerTokenNotFound
+	}
+	if err != nil {
+		return errors.Wrap(err, "RevokeTriggerToken failed")
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.limiters, id)
+	return nil
+}
+
+func (m *triggerTokenManager) Verify(ctx context.Context, req SignedRequest) (*TriggerToken, error) {
+	data := map[string]interface{}{
+		"accessKey": req.AccessKey,
+		"jobID":     req.JobID.String(),
+		"clientIP":  req.ClientIP,
+	}
+	t, err := m.verify(ctx, req)
+	if err != nil {
+		data["reason"] = err.Error()
+		m.auditLogger.Audit(audit.WebhookTriggerRejected, audit.WithContext(ctx, data))
+		return nil, err
+	}
+	m.auditLogger.Audit(audit.WebhookTriggerAccepted, audit.WithContext(ctx, data))
+	return t, nil
+}
+
+func (m *triggerTokenManager) verify(ctx context.Context, req SignedRequest) (*TriggerToken, error) {
+	var t TriggerToken
+	err := m.ds.GetContext(ctx, &t, `
+SELECT webhook_trigger_tokens.*, jobs.external_job_id FROM webhook_trigger_tokens
+JOIN jobs ON jobs.id = webhook_trigger_tokens.job_id
+WHERE webhook_trigger_tokens.access_key = $1 AND webhook_trigger_tokens.revoked_at IS NULL`, req.AccessKey)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrTriggerTokenNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "finding webhook trigger token")
+	}
+
+	if t.ExternalJobID != req.JobID {
+		return nil, ErrTriggerTokenWrongJob
+	}
+	if !t.AllowsIP(req.ClientIP) {
+		return nil, ErrTriggerIPNotAllowed
+	}
+
+	if t.Secret, err = openSecret(m.secretKey, t.AccessKey, t.EncryptedSecret); err != nil {
+		return nil, errors.Wrap(err, "webhook trigger token")
+	}
+
+	timestamp, err := strconv.ParseInt(req.Timestamp, 10, 64)
+	if err != nil {
+		return nil, ErrTriggerInvalidTimestamp
+	}
+	now := m.now()
+	signedAt := time.Unix(timestamp, 0)
+	if signedAt.Before(now.Add(-m.replayWindow)) || signedAt.After(now.Add(m.replayWindow)) {
+		return nil, ErrTriggerExpired
+	}
+	// hex signatures are case insensitive, the same signature in another case is a replay
+	signature := strings.ToLower(req.Signature)
+	if !hmac.Equal([]byte(Sign(t.Secret, timestamp, req.Body)), []byte(signature)) {
+		return nil, ErrTriggerInvalidSignature
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for len(m.expiries) > 0 && now.After(m.expiries[0].expiry) {
+		delete(m.seen, heap.Pop(&m.expiries).(seenSignature).key)
+	}
+	key := fmt.Sprintf("%s:%s", t.AccessKey, signature)
+	if _, ok := m.seen[key]; ok {
+		return nil, ErrTriggerReplayed
+	}
+	if t.RateLimit > 0 {
+		limiter, ok := m.limiters[t.ID]
+		if !ok || limiter.Burst() != int(t.RateLimit) {
+			limiter = rate.NewLimiter(rate.Every(time.Minute/time.Duration(t.RateLimit)), int(t.RateLimit))
+			m.limiters[t.ID] = limiter
+		}
+		if !limiter.AllowN(now, 1) {
+			return nil, ErrTriggerRateLimitExceeded
+		}
+	}
+	m.seen[key] = signedAt.Add(m.replayWindow)
+	heap.Push(&m.expiries, seenSignature{key: key, expiry: m.seen[key]})
+	return &t, nil
+}
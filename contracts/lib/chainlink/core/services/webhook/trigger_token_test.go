@@ -0,0 +1,182 @@
+package webhook_test
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
+)
+
+type recordingAuditLogger struct {
+	audit.AuditLogger
+
+	mu     sync.Mutex
+	events []audit.EventID
+}
+
+func (r *recordingAuditLogger) Audit(eventID audit.EventID, _ audit.Data) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, eventID)
+}
+
+func signedRequest(token *webhook.TriggerToken, timestamp time.Time, body string) webhook.SignedRequest {
+	return webhook.SignedRequest{
+		AccessKey: token.AccessKey,
+		Timestamp: strconv.FormatInt(timestamp.Unix(), 10),
+		Signature: webhook.Sign(token.Secret, timestamp.Unix(), []byte(body)),
+		Body:      []byte(body),
+		ClientIP:  "10.0.0.1",
+		JobID:     token.ExternalJobID,
+	}
+}
+
+func Test_TriggerTokenManager(t *testing.T) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	auditLogger := &recordingAuditLogger{AuditLogger: audit.NoopLogger}
+	m := webhook.NewTriggerTokenManager(db, auditLogger, webhook.SecretKey("password"))
+
+	jb, _ := cltest.MustInsertWebhookSpec(t, db)
+	otherJob, _ := cltest.MustInsertWebhookSpec(t, db)
+
+	t.Run("creates and lists tokens", func(t *testing.T) {
+		token, err := m.CreateTriggerToken(ctx, jb.ID, []string{"10.0.0.0/24"}, 0)
+		require.NoError(t, err)
+		assert.Equal(t, jb.ExternalJobID, token.ExternalJobID)
+		assert.NotEmpty(t, token.AccessKey)
+		assert.NotEmpty(t, token.Secret)
+
+		tokens, err := m.TriggerTokens(ctx, jb.ID)
+		require.NoError(t, err)
+		require.Len(t, tokens, 1)
+		assert.Equal(t, token.AccessKey, tokens[0].AccessKey)
+		assert.Equal(t, []string{"10.0.0.0/24"}, []string(tokens[0].AllowedIPs))
+
+		_, err = m.CreateTriggerToken(ctx, jb.ID, []string{"not-an-ip"}, 0)
+		require.Error(t, err)
+		_, err = m.CreateTriggerToken(ctx, jb.ID+1000, nil, 0)
+		require.ErrorIs(t, err, webhook.ErrJobNotExists)
+	})
+
+	t.Run("verifies signed requests", func(t *testing.T) {
+		token, err := m.CreateTriggerToken(ctx, jb.ID, nil, 0)
+		require.NoError(t, err)
+
+		req := signedRequest(token, time.Now(), `{"foo":"bar"}`)
+		verified, err := m.Verify(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, token.ID, verified.ID)
+
+		_, err = m.Verify(ctx, req)
+		require.ErrorIs(t, err, webhook.ErrTriggerReplayed)
+
+		upper := req
+		upper.Signature = strings.ToUpper(req.Signature)
+		_, err = m.Verify(ctx, upper)
+		require.ErrorIs(t, err, webhook.ErrTriggerReplayed)
+
+		tampered := signedRequest(token, time.Now(), `{"foo":"bar"}`)
+		tampered.Body = []byte(`{"foo":"baz"}`)
+		_, err = m.Verify(ctx, tampered)
+		require.ErrorIs(t, err, webhook.ErrTriggerInvalidSignature)
+
+		_, err = m.Verify(ctx, signedRequest(token, time.Now().Add(-2*webhook.DefaultReplayWindow), `{}`))
+		require.ErrorIs(t, err, webhook.ErrTriggerExpired)
+
+		wrongJob := signedRequest(token, time.Now(), `{}`)
+		wrongJob.JobID = otherJob.ExternalJobID
+		_, err = m.Verify(ctx, wrongJob)
+		require.ErrorIs(t, err, webhook.ErrTriggerTokenWrongJob)
+
+		unknown := signedRequest(token, time.Now(), `{}`)
+		unknown.AccessKey = "unknown"
+		_, err = m.Verify(ctx, unknown)
+		require.ErrorIs(t, err, webhook.ErrTriggerTokenNotFound)
+	})
+
+	t.Run("enforces allowed IPs", func(t *testing.T) {
+		token, err := m.CreateTriggerToken(ctx, jb.ID, []string{"10.0.0.0/24", "192.168.1.1"}, 0)
+		require.NoError(t, err)
+
+		_, err = m.Verify(ctx, signedRequest(token, time.Now(), `{"ip":1}`))
+		require.NoError(t, err)
+
+		req := signedRequest(token, time.Now(), `{"ip":2}`)
+		req.ClientIP = "192.168.1.1"
+		_, err = m.Verify(ctx, req)
+		require.NoError(t, err)
+
+		req = signedRequest(token, time.Now(), `{"ip":3}`)
+		req.ClientIP = "10.0.1.1"
+		_, err = m.Verify(ctx, req)
+		require.ErrorIs(t, err, webhook.ErrTriggerIPNotAllowed)
+	})
+
+	t.Run("enforces rate limit", func(t *testing.T) {
+		token, err := m.CreateTriggerToken(ctx, jb.ID, nil, 2)
+		require.NoError(t, err)
+
+		_, err = m.Verify(ctx, signedRequest(token, time.Now(), `{"n":1}`))
+		require.NoError(t, err)
+		_, err = m.Verify(ctx, signedRequest(token, time.Now(), `{"n":2}`))
+		require.NoError(t, err)
+		_, err = m.Verify(ctx, signedRequest(token, time.Now(), `{"n":3}`))
+		require.ErrorIs(t, err, webhook.ErrTriggerRateLimitExceeded)
+	})
+
+	t.Run("revokes tokens", func(t *testing.T) {
+		token, err := m.CreateTriggerToken(ctx, otherJob.ID, nil, 0)
+		require.NoError(t, err)
+
+		require.NoError(t, m.RevokeTriggerToken(ctx, token.AccessKey))
+		require.ErrorIs(t, m.RevokeTriggerToken(ctx, token.AccessKey), webhook.ErrTriggerTokenNotFound)
+
+		_, err = m.Verify(ctx, signedRequest(token, time.Now(), `{}`))
+		require.ErrorIs(t, err, webhook.ErrTriggerTokenNotFound)
+
+		tokens, err := m.TriggerTokens(ctx, otherJob.ID)
+		require.NoError(t, err)
+		assert.Empty(t, tokens)
+	})
+
+	t.Run("encrypts secrets at rest", func(t *testing.T) {
+		token, err := m.CreateTriggerToken(ctx, otherJob.ID, nil, 0)
+		require.NoError(t, err)
+
+		var stored []byte
+		require.NoError(t, db.GetContext(ctx, &stored, `SELECT encrypted_secret FROM webhook_trigger_tokens WHERE access_key = $1`, token.AccessKey))
+		assert.NotContains(t, string(stored), token.Secret)
+
+		tokens, err := m.TriggerTokens(ctx, otherJob.ID)
+		require.NoError(t, err)
+		require.Len(t, tokens, 1)
+		assert.Empty(t, tokens[0].Secret)
+
+		require.NoError(t, webhook.ReencryptSecrets(ctx, db, "password", "new password"))
+		_, err = m.Verify(ctx, signedRequest(token, time.Now(), `{"key":"old"}`))
+		require.Error(t, err)
+
+		rotated := webhook.NewTriggerTokenManager(db, auditLogger, webhook.SecretKey("new password"))
+		verified, err := rotated.Verify(ctx, signedRequest(token, time.Now(), `{"key":"new"}`))
+		require.NoError(t, err)
+		assert.Equal(t, token.Secret, verified.Secret)
+
+		require.Error(t, webhook.ReencryptSecrets(ctx, db, "password", "other password"))
+	})
+
+	auditLogger.mu.Lock()
+	defer auditLogger.mu.Unlock()
+	assert.Contains(t, auditLogger.events, audit.WebhookTriggerAccepted)
+	assert.Contains(t, auditLogger.events, audit.WebhookTriggerRejected)
+}
@@ -17,7 +17,9 @@ type TOMLWebhookSpecExternalInitiator struct {
 }
 
 type TOMLWebhookSpec struct {
-	ExternalInitiators []TOMLWebhookSpecExternalInitiator `toml:"externalInitiators"`
+	ExternalInitiators  []TOMLWebhookSpecExternalInitiator `toml:"externalInitiators"`
+	IdempotencyWindow   models.Interval                    `toml:"idempotencyWindow"`
+	IdempotencyKeyLease models.Interval                    `toml:"idempotencyKeyLease"`
 }
 
 func ValidatedWebhookSpec(ctx context.Context, tomlString string, externalInitiatorManager ExternalInitiatorManager) (jb job.Job, err error) {
@@ -61,6 +63,8 @@ func ValidatedWebhookSpec(ctx context.Context, tomlString string, externalInitia
 
 	jb.WebhookSpec = &job.WebhookSpec{
 		ExternalInitiatorWebhookSpecs: externalInitiatorWebhookSpecs,
+		IdempotencyWindow:             tomlSpec.IdempotencyWindow,
+		IdempotencyKeyLease:           tomlSpec.IdempotencyKeyLease,
 	}
 
 	return jb, nil
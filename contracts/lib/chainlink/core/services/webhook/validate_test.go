@@ -2,6 +2,7 @@ package webhook_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/pkg/errors"
@@ -47,6 +48,24 @@ func TestValidatedWebJobSpec(t *testing.T) {
 				require.Equal(t, "0eec7e1d-d0d2-476c-a1a8-72dfb6633f46", s.ExternalJobID.String())
 			},
 		},
+		{
+			name: "with idempotency window",
+			toml: `
+			type                = "webhook"
+			schemaVersion       = 1
+			idempotencyWindow   = "1h"
+			idempotencyKeyLease = "5m"
+			observationSource = """
+				ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+			"""
+			`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, s.WebhookSpec)
+				assert.Equal(t, time.Hour, s.WebhookSpec.IdempotencyWindow.Duration())
+				assert.Equal(t, 5*time.Minute, s.WebhookSpec.IdempotencyKeyLease.Duration())
+			},
+		},
 		{
 			name: "invalid job name",
 			toml: `
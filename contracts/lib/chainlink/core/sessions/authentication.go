@@ -26,6 +26,12 @@ var ErrNotSupported = fmt.Errorf("functionality not supported with current authe
 // ErrEmptySessionID captures the empty case error message
 var ErrEmptySessionID = errors.New("session ID cannot be empty")
 
+// ErrCustomRoleNotFound is returned when a custom role does not exist
+var ErrCustomRoleNotFound = errors.New("custom role not found")
+
+// ErrCustomRoleAssigned is returned when deleting a custom role still assigned to users
+var ErrCustomRoleAssigned = errors.New("custom role is assigned")
+
 // BasicAdminUsersORM is the interface that defines the functionality required for supporting basic admin functionality
 // adjacent to the identity provider authentication provider implementation. It is currently implemented by the local
 // users/sessions ORM containing local admin CLI actions. This is separate from the AuthenticationProvider,
@@ -59,5 +65,14 @@ type AuthenticationProvider interface {
 	GetUserWebAuthn(ctx context.Context, email string) ([]WebAuthn, error)
 	SaveWebAuthn(ctx context.Context, token *WebAuthn) error
 
+	ListCustomRoles(ctx context.Context) ([]CustomRole, error)
+	FindCustomRole(ctx context.Context, name string) (CustomRole, error)
+	CreateCustomRole(ctx context.Context, role *CustomRole) error
+	UpdateCustomRole(ctx context.Context, role *CustomRole) error
+	DeleteCustomRole(ctx context.Context, name string) error
+	// SetCustomRole assigns the custom role named role to the user, or its API token if token is set. An empty role
+	// removes the assignment.
+	SetCustomRole(ctx context.Context, email, role string, token bool) (User, error)
+
 	FindExternalInitiator(ctx context.Context, eia *auth.Token) (initiator *bridges.ExternalInitiator, err error)
 }
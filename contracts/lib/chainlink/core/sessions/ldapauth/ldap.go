@@ -614,6 +614,36 @@ func (l *ldapAuthenticator) FindExternalInitiator(ctx context.Context, eia *auth
 	return exi, err
 }
 
+// ListCustomRoles is not supported, roles are assigned by LDAP group
+func (l *ldapAuthenticator) ListCustomRoles(ctx context.Context) ([]sessions.CustomRole, error) {
+	return nil, sessions.ErrNotSupported
+}
+
+// FindCustomRole is not supported, roles are assigned by LDAP group
+func (l *ldapAuthenticator) FindCustomRole(ctx context.Context, name string) (sessions.CustomRole, error) {
+	return sessions.CustomRole{}, sessions.ErrNotSupported
+}
+
+// CreateCustomRole is not supported, roles are assigned by LDAP group
+func (l *ldapAuthenticator) CreateCustomRole(ctx context.Context, role *sessions.CustomRole) error {
+	return sessions.ErrNotSupported
+}
+
+// UpdateCustomRole is not supported, roles are assigned by LDAP group
+func (l *ldapAuthenticator) UpdateCustomRole(ctx context.Context, role *sessions.CustomRole) error {
+	return sessions.ErrNotSupported
+}
+
+// DeleteCustomRole is not supported, roles are assigned by LDAP group
+func (l *ldapAuthenticator) DeleteCustomRole(ctx context.Context, name string) error {
+	return sessions.ErrNotSupported
+}
+
+// SetCustomRole is not supported, roles are assigned by LDAP group
+func (l *ldapAuthenticator) SetCustomRole(ctx context.Context, email, role string, token bool) (sessions.User, error) {
+	return sessions.User{}, sessions.ErrNotSupported
+}
+
 // localLoginFallback tests the credentials provided against the 'local' authentication method
 // This covers the case of local CLI API calls requiring local login separate from the LDAP server
 func (l *ldapAuthenticator) localLoginFallback(ctx context.Context, sr sessions.SessionRequest) (sessions.User, error) {
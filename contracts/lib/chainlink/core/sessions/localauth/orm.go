@@ -3,11 +3,14 @@ package localauth
 import (
 	"context"
 	"crypto/subtle"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"strings"
 	"time"
 
 	pkgerrors "github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/mathutil"
@@ -47,16 +50,37 @@ func (o *orm) FindUser(ctx context.Context, email string) (sessions.User, error)
 // FindUserByAPIToken will attempt to return an API user via the user's table token_key column.
 func (o *orm) FindUserByAPIToken(ctx context.Context, apiToken string) (user sessions.User, err error) {
 	sql := "SELECT * FROM users WHERE token_key = $1"
-	err = o.ds.GetContext(ctx, &user, sql, apiToken)
+	if err = o.ds.GetContext(ctx, &user, sql, apiToken); err != nil {
+		return
+	}
+	err = o.loadRolePermissions(ctx, &user, user.CustomRole, user.TokenCustomRole)
 	return
 }
 
 func (o *orm) findUser(ctx context.Context, email string) (user sessions.User, err error) {
 	sql := "SELECT * FROM users WHERE lower(email) = lower($1)"
-	err = o.ds.GetContext(ctx, &user, sql, email)
+	if err = o.ds.GetContext(ctx, &user, sql, email); err != nil {
+		return
+	}
+	err = o.loadRolePermissions(ctx, &user, user.CustomRole)
 	return
 }
 
+// loadRolePermissions sets the permissions of the given custom roles on user.
+func (o *orm) loadRolePermissions(ctx context.Context, user *sessions.User, roles ...null.String) error {
+	for _, role := range roles {
+		if !role.Valid {
+			continue
+		}
+		var permissions sessions.Permissions
+		if err := o.ds.GetContext(ctx, &permissions, "SELECT permissions FROM custom_roles WHERE name = $1", role.String); err != nil {
+			return pkgerrors.Wrapf(err, "failed to load custom role %s", role.String)
+		}
+		user.RolePermissions = append(user.RolePermissions, permissions)
+	}
+	return nil
+}
+
 // ListUsers will load and return all user rows from the db.
 func (o *orm) ListUsers(ctx context.Context) (users []sessions.User, err error) {
 	sql := "SELECT * FROM users ORDER BY email ASC;"
@@ -365,3 +389,86 @@ func (o *orm) FindExternalInitiator(
 	err := o.ds.GetContext(ctx, exi, `SELECT * FROM external_initiators WHERE access_key = $1`, eia.AccessKey)
 	return exi, err
 }
+
+// ListCustomRoles returns all custom roles, by name.
+func (o *orm) ListCustomRoles(ctx context.Context) (roles []sessions.CustomRole, err error) {
+	err = o.ds.SelectContext(ctx, &roles, "SELECT * FROM custom_roles ORDER BY name ASC")
+	return
+}
+
+// FindCustomRole returns the custom role with name.
+func (o *orm) FindCustomRole(ctx context.Context, name string) (role sessions.CustomRole, err error) {
+	err = o.ds.GetContext(ctx, &role, "SELECT * FROM custom_roles WHERE name = $1", name)
+	if errors.Is(err, sql.ErrNoRows) {
+		err = sessions.ErrCustomRoleNotFound
+	}
+	return
+}
+
+// CreateCustomRole creates a new custom role.
+func (o *orm) CreateCustomRole(ctx context.Context, role *sessions.CustomRole) error {
+	q := `INSERT INTO custom_roles (name, description, permissions, created_at, updated_at) VALUES ($1, $2, $3, now(), now()) RETURNING *`
+	return o.ds.GetContext(ctx, role, q, role.Name, role.Description, role.Permissions)
+}
+
+// UpdateCustomRole overwrites the description and permissions of a custom role. They apply to the next request of
+// every user the role is assigned to.
+func (o *orm) UpdateCustomRole(ctx context.Context, role *sessions.CustomRole) error {
+	q := `UPDATE custom_roles SET description = $2, permissions = $3, updated_at = now() WHERE name = $1 RETURNING *`
+	err := o.ds.GetContext(ctx, role, q, role.Name, role.Description, role.Permissions)
+	if errors.Is(err, sql.ErrNoRows) {
+		return sessions.ErrCustomRoleNotFound
+	}
+	return err
+}
+
+// DeleteCustomRole deletes a custom role, unless it is assigned to any user or API token.
+func (o *orm) DeleteCustomRole(ctx context.Context, name string) error {
+	return sqlutil.TransactDataSource(ctx, o.ds, nil, func(tx sqlutil.DataSource) error {
+		var assigned int
+		if err := tx.GetContext(ctx, &assigned, "SELECT count(*) FROM users WHERE custom_role = $1 OR token_custom_role = $1", name); err != nil {
+			return err
+		}
+		if assigned > 0 {
+			return pkgerrors.Wrapf(sessions.ErrCustomRoleAssigned, "%s is assigned to %d users", name, assigned)
+		}
+		res, err := tx.ExecContext(ctx, "DELETE FROM custom_roles WHERE name = $1", name)
+		if err != nil {
+			return err
+		}
+		if n, err := res.RowsAffected(); err != nil {
+			return err
+		} else if n == 0 {
+			return sessions.ErrCustomRoleNotFound
+		}
+		return nil
+	})
+}
+
+// SetCustomRole assigns a custom role to a user, or its API token.
+func (o *orm) SetCustomRole(ctx context.Context, email, role string, token bool) (user sessions.User, err error) {
+	column := "custom_role"
+	if token {
+		column = "token_custom_role"
+	}
+	err = sqlutil.TransactDataSource(ctx, o.ds, nil, func(tx sqlutil.DataSource) error {
+		if role != "" {
+			var exists bool
+			if err := tx.GetContext(ctx, &exists, "SELECT EXISTS (SELECT 1 FROM custom_roles WHERE name = $1)", role); err != nil {
+				return err
+			}
+			if !exists {
+				return sessions.ErrCustomRoleNotFound
+			}
+		}
+		q := "UPDATE users SET " + column + " = $1, updated_at = now() WHERE lower(email) = lower($2) RETURNING *"
+		if err := tx.GetContext(ctx, &user, q, null.NewString(role, role != ""), email); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return pkgerrors.New("no matching user for provided email")
+			}
+			return err
+		}
+		return nil
+	})
+	return
+}
@@ -309,3 +309,74 @@ func TestOrm_GenerateAuthToken(t *testing.T) {
 	assert.Empty(t, dbUser.TokenSalt.ValueOrZero())
 	assert.Empty(t, dbUser.TokenHashedSecret.ValueOrZero())
 }
+
+func TestORM_CustomRoles(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	_, orm := setupORM(t)
+	user := cltest.MustRandomUser(t)
+	require.NoError(t, orm.CreateUser(ctx, &user))
+
+	bridges, err := sessions.NewCustomRole("bridges", "manages bridges", []string{"view:*", "edit:bridge_types"})
+	require.NoError(t, err)
+	require.NoError(t, orm.CreateCustomRole(ctx, &bridges))
+	assert.False(t, bridges.CreatedAt.IsZero())
+	readOnly, err := sessions.NewCustomRole("read-only", "", []string{"view:*"})
+	require.NoError(t, err)
+	require.NoError(t, orm.CreateCustomRole(ctx, &readOnly))
+	require.Error(t, orm.CreateCustomRole(ctx, &readOnly))
+
+	roles, err := orm.ListCustomRoles(ctx)
+	require.NoError(t, err)
+	require.Len(t, roles, 2)
+	assert.Equal(t, "bridges", roles[0].Name)
+	assert.Equal(t, bridges.Permissions, roles[0].Permissions)
+
+	t.Run("loads permissions of assigned roles", func(t *testing.T) {
+		_, err = orm.SetCustomRole(ctx, user.Email, "missing", false)
+		require.ErrorIs(t, err, sessions.ErrCustomRoleNotFound)
+
+		updated, err := orm.SetCustomRole(ctx, user.Email, "bridges", false)
+		require.NoError(t, err)
+		assert.Equal(t, "bridges", updated.CustomRole.String)
+		_, err = orm.SetCustomRole(ctx, user.Email, "read-only", true)
+		require.NoError(t, err)
+		token, err := orm.CreateAndSetAuthToken(ctx, &user)
+		require.NoError(t, err)
+
+		found, err := orm.FindUser(ctx, user.Email)
+		require.NoError(t, err)
+		assert.Equal(t, []sessions.Permissions{bridges.Permissions}, found.RolePermissions)
+		assert.True(t, found.Can(sessions.UserRoleEdit, "bridge_types"))
+
+		found, err = orm.FindUserByAPIToken(ctx, token.AccessKey)
+		require.NoError(t, err)
+		assert.Equal(t, []sessions.Permissions{bridges.Permissions, readOnly.Permissions}, found.RolePermissions)
+		assert.False(t, found.Can(sessions.UserRoleEdit, "bridge_types"))
+	})
+
+	t.Run("updates roles", func(t *testing.T) {
+		bridges.Permissions, err = sessions.ParsePermissions([]string{"view:bridge_types"})
+		require.NoError(t, err)
+		require.NoError(t, orm.UpdateCustomRole(ctx, &bridges))
+
+		found, err := orm.FindUser(ctx, user.Email)
+		require.NoError(t, err)
+		assert.False(t, found.Can(sessions.UserRoleEdit, "bridge_types"))
+
+		missing := sessions.CustomRole{Name: "missing", Permissions: bridges.Permissions}
+		require.ErrorIs(t, orm.UpdateCustomRole(ctx, &missing), sessions.ErrCustomRoleNotFound)
+	})
+
+	t.Run("deletes unassigned roles", func(t *testing.T) {
+		require.ErrorIs(t, orm.DeleteCustomRole(ctx, "bridges"), sessions.ErrCustomRoleAssigned)
+
+		_, err = orm.SetCustomRole(ctx, user.Email, "", false)
+		require.NoError(t, err)
+		require.NoError(t, orm.DeleteCustomRole(ctx, "bridges"))
+		require.ErrorIs(t, orm.DeleteCustomRole(ctx, "bridges"), sessions.ErrCustomRoleNotFound)
+		_, err = orm.FindCustomRole(ctx, "bridges")
+		require.ErrorIs(t, err, sessions.ErrCustomRoleNotFound)
+	})
+}
@@ -189,6 +189,53 @@ func (_c *AuthenticationProvider_CreateAndSetAuthToken_Call) RunAndReturn(run fu
 	return _c
 }
 
+// CreateCustomRole provides a mock function with given fields: ctx, role
+func (_m *AuthenticationProvider) CreateCustomRole(ctx context.Context, role *sessions.CustomRole) error {
+	ret := _m.Called(ctx, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCustomRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sessions.CustomRole) error); ok {
+		r0 = rf(ctx, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthenticationProvider_CreateCustomRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCustomRole'
+type AuthenticationProvider_CreateCustomRole_Call struct {
+	*mock.Call
+}
+
+// CreateCustomRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role *sessions.CustomRole
+func (_e *AuthenticationProvider_Expecter) CreateCustomRole(ctx interface{}, role interface{}) *AuthenticationProvider_CreateCustomRole_Call {
+	return &AuthenticationProvider_CreateCustomRole_Call{Call: _e.mock.On("CreateCustomRole", ctx, role)}
+}
+
+func (_c *AuthenticationProvider_CreateCustomRole_Call) Run(run func(ctx context.Context, role *sessions.CustomRole)) *AuthenticationProvider_CreateCustomRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*sessions.CustomRole))
+	})
+	return _c
+}
+
+func (_c *AuthenticationProvider_CreateCustomRole_Call) Return(_a0 error) *AuthenticationProvider_CreateCustomRole_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthenticationProvider_CreateCustomRole_Call) RunAndReturn(run func(context.Context, *sessions.CustomRole) error) *AuthenticationProvider_CreateCustomRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateSession provides a mock function with given fields: ctx, sr
 func (_m *AuthenticationProvider) CreateSession(ctx context.Context, sr sessions.SessionRequest) (string, error) {
 	ret := _m.Called(ctx, sr)
@@ -340,6 +387,53 @@ func (_c *AuthenticationProvider_DeleteAuthToken_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// DeleteCustomRole provides a mock function with given fields: ctx, name
+func (_m *AuthenticationProvider) DeleteCustomRole(ctx context.Context, name string) error {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCustomRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthenticationProvider_DeleteCustomRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCustomRole'
+type AuthenticationProvider_DeleteCustomRole_Call struct {
+	*mock.Call
+}
+
+// DeleteCustomRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *AuthenticationProvider_Expecter) DeleteCustomRole(ctx interface{}, name interface{}) *AuthenticationProvider_DeleteCustomRole_Call {
+	return &AuthenticationProvider_DeleteCustomRole_Call{Call: _e.mock.On("DeleteCustomRole", ctx, name)}
+}
+
+func (_c *AuthenticationProvider_DeleteCustomRole_Call) Run(run func(ctx context.Context, name string)) *AuthenticationProvider_DeleteCustomRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AuthenticationProvider_DeleteCustomRole_Call) Return(_a0 error) *AuthenticationProvider_DeleteCustomRole_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthenticationProvider_DeleteCustomRole_Call) RunAndReturn(run func(context.Context, string) error) *AuthenticationProvider_DeleteCustomRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteUser provides a mock function with given fields: ctx, email
 func (_m *AuthenticationProvider) DeleteUser(ctx context.Context, email string) error {
 	ret := _m.Called(ctx, email)
@@ -434,6 +528,63 @@ func (_c *AuthenticationProvider_DeleteUserSession_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// FindCustomRole provides a mock function with given fields: ctx, name
+func (_m *AuthenticationProvider) FindCustomRole(ctx context.Context, name string) (sessions.CustomRole, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindCustomRole")
+	}
+
+	var r0 sessions.CustomRole
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (sessions.CustomRole, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) sessions.CustomRole); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(sessions.CustomRole)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthenticationProvider_FindCustomRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindCustomRole'
+type AuthenticationProvider_FindCustomRole_Call struct {
+	*mock.Call
+}
+
+// FindCustomRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *AuthenticationProvider_Expecter) FindCustomRole(ctx interface{}, name interface{}) *AuthenticationProvider_FindCustomRole_Call {
+	return &AuthenticationProvider_FindCustomRole_Call{Call: _e.mock.On("FindCustomRole", ctx, name)}
+}
+
+func (_c *AuthenticationProvider_FindCustomRole_Call) Run(run func(ctx context.Context, name string)) *AuthenticationProvider_FindCustomRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AuthenticationProvider_FindCustomRole_Call) Return(_a0 sessions.CustomRole, _a1 error) *AuthenticationProvider_FindCustomRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthenticationProvider_FindCustomRole_Call) RunAndReturn(run func(context.Context, string) (sessions.CustomRole, error)) *AuthenticationProvider_FindCustomRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindExternalInitiator provides a mock function with given fields: ctx, eia
 func (_m *AuthenticationProvider) FindExternalInitiator(ctx context.Context, eia *auth.Token) (*bridges.ExternalInitiator, error) {
 	ret := _m.Called(ctx, eia)
@@ -666,6 +817,64 @@ func (_c *AuthenticationProvider_GetUserWebAuthn_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// ListCustomRoles provides a mock function with given fields: ctx
+func (_m *AuthenticationProvider) ListCustomRoles(ctx context.Context) ([]sessions.CustomRole, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCustomRoles")
+	}
+
+	var r0 []sessions.CustomRole
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]sessions.CustomRole, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []sessions.CustomRole); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sessions.CustomRole)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthenticationProvider_ListCustomRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCustomRoles'
+type AuthenticationProvider_ListCustomRoles_Call struct {
+	*mock.Call
+}
+
+// ListCustomRoles is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *AuthenticationProvider_Expecter) ListCustomRoles(ctx interface{}) *AuthenticationProvider_ListCustomRoles_Call {
+	return &AuthenticationProvider_ListCustomRoles_Call{Call: _e.mock.On("ListCustomRoles", ctx)}
+}
+
+func (_c *AuthenticationProvider_ListCustomRoles_Call) Run(run func(ctx context.Context)) *AuthenticationProvider_ListCustomRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *AuthenticationProvider_ListCustomRoles_Call) Return(_a0 []sessions.CustomRole, _a1 error) *AuthenticationProvider_ListCustomRoles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthenticationProvider_ListCustomRoles_Call) RunAndReturn(run func(context.Context) ([]sessions.CustomRole, error)) *AuthenticationProvider_ListCustomRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListUsers provides a mock function with given fields: ctx
 func (_m *AuthenticationProvider) ListUsers(ctx context.Context) ([]sessions.User, error) {
 	ret := _m.Called(ctx)
@@ -879,6 +1088,65 @@ func (_c *AuthenticationProvider_SetAuthToken_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// SetCustomRole provides a mock function with given fields: ctx, email, role, token
+func (_m *AuthenticationProvider) SetCustomRole(ctx context.Context, email string, role string, token bool) (sessions.User, error) {
+	ret := _m.Called(ctx, email, role, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCustomRole")
+	}
+
+	var r0 sessions.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool) (sessions.User, error)); ok {
+		return rf(ctx, email, role, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool) sessions.User); ok {
+		r0 = rf(ctx, email, role, token)
+	} else {
+		r0 = ret.Get(0).(sessions.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, bool) error); ok {
+		r1 = rf(ctx, email, role, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthenticationProvider_SetCustomRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCustomRole'
+type AuthenticationProvider_SetCustomRole_Call struct {
+	*mock.Call
+}
+
+// SetCustomRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+//   - role string
+//   - token bool
+func (_e *AuthenticationProvider_Expecter) SetCustomRole(ctx interface{}, email interface{}, role interface{}, token interface{}) *AuthenticationProvider_SetCustomRole_Call {
+	return &AuthenticationProvider_SetCustomRole_Call{Call: _e.mock.On("SetCustomRole", ctx, email, role, token)}
+}
+
+func (_c *AuthenticationProvider_SetCustomRole_Call) Run(run func(ctx context.Context, email string, role string, token bool)) *AuthenticationProvider_SetCustomRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *AuthenticationProvider_SetCustomRole_Call) Return(_a0 sessions.User, _a1 error) *AuthenticationProvider_SetCustomRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthenticationProvider_SetCustomRole_Call) RunAndReturn(run func(context.Context, string, string, bool) (sessions.User, error)) *AuthenticationProvider_SetCustomRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetPassword provides a mock function with given fields: ctx, user, newPassword
 func (_m *AuthenticationProvider) SetPassword(ctx context.Context, user *sessions.User, newPassword string) error {
 	ret := _m.Called(ctx, user, newPassword)
@@ -975,6 +1243,53 @@ func (_c *AuthenticationProvider_TestPassword_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// UpdateCustomRole provides a mock function with given fields: ctx, role
+func (_m *AuthenticationProvider) UpdateCustomRole(ctx context.Context, role *sessions.CustomRole) error {
+	ret := _m.Called(ctx, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCustomRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sessions.CustomRole) error); ok {
+		r0 = rf(ctx, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthenticationProvider_UpdateCustomRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCustomRole'
+type AuthenticationProvider_UpdateCustomRole_Call struct {
+	*mock.Call
+}
+
+// UpdateCustomRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role *sessions.CustomRole
+func (_e *AuthenticationProvider_Expecter) UpdateCustomRole(ctx interface{}, role interface{}) *AuthenticationProvider_UpdateCustomRole_Call {
+	return &AuthenticationProvider_UpdateCustomRole_Call{Call: _e.mock.On("UpdateCustomRole", ctx, role)}
+}
+
+func (_c *AuthenticationProvider_UpdateCustomRole_Call) Run(run func(ctx context.Context, role *sessions.CustomRole)) *AuthenticationProvider_UpdateCustomRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*sessions.CustomRole))
+	})
+	return _c
+}
+
+func (_c *AuthenticationProvider_UpdateCustomRole_Call) Return(_a0 error) *AuthenticationProvider_UpdateCustomRole_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthenticationProvider_UpdateCustomRole_Call) RunAndReturn(run func(context.Context, *sessions.CustomRole) error) *AuthenticationProvider_UpdateCustomRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateRole provides a mock function with given fields: ctx, email, newRole
 func (_m *AuthenticationProvider) UpdateRole(ctx context.Context, email string, newRole string) (sessions.User, error) {
 	ret := _m.Called(ctx, email, newRole)
@@ -0,0 +1,182 @@
+package sessions
+
+import (
+	"database/sql/driver"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	pkgerrors "github.com/pkg/errors"
+)
+
+// AllResources matches every resource in a Permission.
+const AllResources = "*"
+
+// Permission allows, or denies, an action on a resource. Resources are named after the first segment of their REST API
+// path, e.g. "bridge_types", "jobs" or "keys", and may be narrowed to the second segment, usually an ID, e.g. "jobs/42"
+// or "keys/eth". Actions are the built-in roles, and each implies the actions of the roles below it, so "edit" also
+// allows "run" and "view".
+//
+// Permissions are written as "<action>:<resource>[/<id>]", prefixed with "!" to deny, e.g. "edit:bridge_types",
+// "run:jobs/42" or "!view:config".
+type Permission struct {
+	Deny     bool
+	Action   UserRole
+	Resource string
+	ID       string
+}
+
+var resourceRegex = regexp.MustCompile(`^(\*|[a-z0-9_]+)$`)
+
+// ParsePermission parses a Permission from s.
+func ParsePermission(s string) (p Permission, err error) {
+	rest, deny := strings.CutPrefix(s, "!")
+	action, resource, ok := strings.Cut(rest, ":")
+	if !ok {
+		return p, pkgerrors.Errorf("invalid permission %q: must be <action>:<resource>[/<id>]", s)
+	}
+	p.Deny = deny
+	if p.Action, err = GetUserRole(action); err != nil {
+		return p, pkgerrors.Wrapf(err, "invalid permission %q", s)
+	}
+	p.Resource, p.ID, _ = strings.Cut(resource, "/")
+	if !resourceRegex.MatchString(p.Resource) {
+		return p, pkgerrors.Errorf("invalid permission %q: resource must be %s or lowercase letters, digits and underscores", s, AllResources)
+	}
+	if p.Resource == AllResources && p.ID != "" {
+		return p, pkgerrors.Errorf("invalid permission %q: %s cannot have an ID", s, AllResources)
+	}
+	return p, nil
+}
+
+func (p Permission) String() string {
+	var s string
+	if p.Deny {
+		s = "!"
+	}
+	s += string(p.Action) + ":" + p.Resource
+	if p.ID != "" {
+		s += "/" + p.ID
+	}
+	return s
+}
+
+// matches returns true if p allows or denies action on resource, with any of ids.
+func (p Permission) matches(action UserRole, resource string, ids []string) bool {
+	if p.Resource != AllResources && p.Resource != resource {
+		return false
+	}
+	if p.ID != "" && !slices.Contains(ids, p.ID) {
+		return false
+	}
+	if p.Deny {
+		// denying an action also denies the actions implying it
+		return roleRank(action) >= roleRank(p.Action)
+	}
+	return roleRank(action) <= roleRank(p.Action)
+}
+
+// Permissions are the permissions of a CustomRole. They are stored as an array of strings.
+type Permissions []Permission
+
+// ParsePermissions parses Permissions from ss.
+func ParsePermissions(ss []string) (Permissions, error) {
+	ps := make(Permissions, 0, len(ss))
+	for _, s := range ss {
+		p, err := ParsePermission(strings.TrimSpace(s))
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, p)
+	}
+	return ps, nil
+}
+
+// Allows returns true if any permission allows action on resource, with any of ids, and none denies it.
+func (ps Permissions) Allows(action UserRole, resource string, ids ...string) (allowed bool) {
+	for _, p := range ps {
+		if p.matches(action, resource, ids) {
+			if p.Deny {
+				return false
+			}
+			allowed = true
+		}
+	}
+	return allowed
+}
+
+// Strings returns the permissions in their string form.
+func (ps Permissions) Strings() []string {
+	ss := make([]string, len(ps))
+	for i, p := range ps {
+		ss[i] = p.String()
+	}
+	return ss
+}
+
+// Scan implements sql.Scanner.
+func (ps *Permissions) Scan(value interface{}) error {
+	var ss pq.StringArray
+	if err := ss.Scan(value); err != nil {
+		return err
+	}
+	parsed, err := ParsePermissions(ss)
+	if err != nil {
+		return err
+	}
+	*ps = parsed
+	return nil
+}
+
+// Value implements driver.Valuer.
+func (ps Permissions) Value() (driver.Value, error) {
+	return pq.StringArray(ps.Strings()).Value()
+}
+
+// CustomRole is a named set of permissions, which limits what the built-in role of the users, or API tokens, it is
+// assigned to allows.
+type CustomRole struct {
+	Name        string
+	Description string
+	Permissions Permissions
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+var customRoleNameRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// NewCustomRole returns a CustomRole after validating its name and permissions.
+func NewCustomRole(name, description string, permissions []string) (CustomRole, error) {
+	if !customRoleNameRegex.MatchString(name) {
+		return CustomRole{}, pkgerrors.Errorf("invalid role name %q: must be lowercase letters, digits, '_' and '-'", name)
+	}
+	if _, err := GetUserRole(name); err == nil {
+		return CustomRole{}, pkgerrors.Errorf("invalid role name %q: built-in roles cannot be redefined", name)
+	}
+	if len(permissions) == 0 {
+		return CustomRole{}, pkgerrors.New("a role must have at least one permission")
+	}
+	ps, err := ParsePermissions(permissions)
+	if err != nil {
+		return CustomRole{}, err
+	}
+	return CustomRole{Name: name, Description: description, Permissions: ps}, nil
+}
+
+// roleRank orders the built-in roles by the actions they allow.
+func roleRank(role UserRole) int {
+	switch role {
+	case UserRoleView:
+		return 0
+	case UserRoleRun:
+		return 1
+	case UserRoleEdit:
+		return 2
+	case UserRoleAdmin:
+		return 3
+	default:
+		return -1
+	}
+}
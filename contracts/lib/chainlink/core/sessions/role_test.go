@@ -0,0 +1,118 @@
+package sessions_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+func TestParsePermission(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input   string
+		want    sessions.Permission
+		wantErr string
+	}{
+		{"edit:bridge_types", sessions.Permission{Action: sessions.UserRoleEdit, Resource: "bridge_types"}, ""},
+		{"run:jobs/42", sessions.Permission{Action: sessions.UserRoleRun, Resource: "jobs", ID: "42"}, ""},
+		{"!view:config", sessions.Permission{Deny: true, Action: sessions.UserRoleView, Resource: "config"}, ""},
+		{"admin:*", sessions.Permission{Action: sessions.UserRoleAdmin, Resource: sessions.AllResources}, ""},
+		{"edit", sessions.Permission{}, "must be <action>:<resource>"},
+		{"export:config", sessions.Permission{}, "invalid permission"},
+		{"view:Config", sessions.Permission{}, "resource must be"},
+		{"view:*/42", sessions.Permission{}, "cannot have an ID"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			p, err := sessions.ParsePermission(test.input)
+			if test.wantErr != "" {
+				require.ErrorContains(t, err, test.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.want, p)
+			assert.Equal(t, test.input, p.String())
+		})
+	}
+}
+
+func TestPermissions_Allows(t *testing.T) {
+	t.Parallel()
+
+	ps, err := sessions.ParsePermissions([]string{"view:*", "edit:bridge_types", "run:jobs/1", "run:jobs/2", "!view:keys/eth"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		action   sessions.UserRole
+		resource string
+		ids      []string
+		want     bool
+	}{
+		{"view anything", sessions.UserRoleView, "features", nil, true},
+		{"edit bridges", sessions.UserRoleEdit, "bridge_types", []string{"my_bridge"}, true},
+		{"run bridges", sessions.UserRoleRun, "bridge_types", nil, true},
+		{"cannot administer bridges", sessions.UserRoleAdmin, "bridge_types", nil, false},
+		{"run listed job", sessions.UserRoleRun, "jobs", []string{"2"}, true},
+		{"cannot run other jobs", sessions.UserRoleRun, "jobs", []string{"3"}, false},
+		{"cannot edit listed job", sessions.UserRoleEdit, "jobs", []string{"1"}, false},
+		{"view other keys", sessions.UserRoleView, "keys", []string{"csa"}, true},
+		{"cannot view denied keys", sessions.UserRoleView, "keys", []string{"eth"}, false},
+		{"cannot edit anything", sessions.UserRoleEdit, "config", nil, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, ps.Allows(test.action, test.resource, test.ids...))
+		})
+	}
+}
+
+func TestNewCustomRole(t *testing.T) {
+	t.Parallel()
+
+	role, err := sessions.NewCustomRole("bridge-operator", "manages bridges", []string{"view:*", " edit:bridge_types"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"view:*", "edit:bridge_types"}, role.Permissions.Strings())
+
+	_, err = sessions.NewCustomRole("Bridges", "", []string{"view:*"})
+	require.ErrorContains(t, err, "invalid role name")
+	_, err = sessions.NewCustomRole("admin", "", []string{"view:*"})
+	require.ErrorContains(t, err, "built-in roles cannot be redefined")
+	_, err = sessions.NewCustomRole("empty", "", nil)
+	require.ErrorContains(t, err, "at least one permission")
+	_, err = sessions.NewCustomRole("bad", "", []string{"view:keys", "read:jobs"})
+	require.ErrorContains(t, err, "read:jobs")
+}
+
+func TestUser_Can(t *testing.T) {
+	t.Parallel()
+
+	bridges, err := sessions.ParsePermissions([]string{"admin:*", "!edit:keys"})
+	require.NoError(t, err)
+	readOnly, err := sessions.ParsePermissions([]string{"view:*"})
+	require.NoError(t, err)
+
+	user := sessions.User{Role: sessions.UserRoleEdit}
+	assert.True(t, user.Can(sessions.UserRoleEdit, "keys"))
+	assert.False(t, user.Can(sessions.UserRoleAdmin, "bridge_types"))
+
+	user.CustomRole = null.StringFrom("bridges")
+	assert.False(t, user.Can(sessions.UserRoleView, "bridge_types"), "permissions must be loaded")
+
+	user.RolePermissions = []sessions.Permissions{bridges}
+	assert.True(t, user.Can(sessions.UserRoleEdit, "bridge_types"))
+	assert.True(t, user.Can(sessions.UserRoleView, "keys"))
+	assert.False(t, user.Can(sessions.UserRoleEdit, "keys"))
+	assert.False(t, user.Can(sessions.UserRoleAdmin, "bridge_types"), "custom roles cannot exceed the user's role")
+
+	user.RolePermissions = append(user.RolePermissions, readOnly)
+	assert.True(t, user.Can(sessions.UserRoleView, "bridge_types"))
+	assert.False(t, user.Can(sessions.UserRoleEdit, "bridge_types"), "API token roles further limit the user's")
+}
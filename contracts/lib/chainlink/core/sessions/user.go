@@ -21,6 +21,30 @@ type User struct {
 	TokenSalt         null.String
 	TokenHashedSecret null.String
 	UpdatedAt         time.Time
+	// CustomRole and TokenCustomRole name the custom roles limiting the user, and the user's API token.
+	CustomRole      null.String
+	TokenCustomRole null.String
+
+	// RolePermissions are the permissions of the custom roles limiting the user as authenticated. They are set by the
+	// AuthenticationProvider, and each must allow an action for Can to allow it.
+	RolePermissions []Permissions `db:"-"`
+}
+
+// Can returns true if the user's role, and every custom role limiting it, allow action on resource with any of ids.
+// Users with a custom role are denied everything until its permissions are loaded.
+func (u *User) Can(action UserRole, resource string, ids ...string) bool {
+	if roleRank(action) > roleRank(u.Role) {
+		return false
+	}
+	if u.CustomRole.Valid && u.RolePermissions == nil {
+		return false
+	}
+	for _, ps := range u.RolePermissions {
+		if !ps.Allows(action, resource, ids...) {
+			return false
+		}
+	}
+	return true
 }
 
 type UserRole string
@@ -30,6 +30,21 @@ const (
 	// ExternalInitiatorSecretHeader is the header name for the secret used by
 	// external initiators to authenticate
 	ExternalInitiatorSecretHeader = "X-Chainlink-EA-Secret"
+	// WebhookAccessKeyHeader is the header name for the access key of the
+	// trigger token used to run a webhook job
+	WebhookAccessKeyHeader = "X-Chainlink-Webhook-Key"
+	// WebhookTimestampHeader is the header name for the unix time in seconds
+	// at which a webhook request was signed
+	WebhookTimestampHeader = "X-Chainlink-Webhook-Timestamp"
+	// WebhookSignatureHeader is the header name for the HMAC signature of a
+	// webhook request
+	WebhookSignatureHeader = "X-Chainlink-Webhook-Signature"
+	// IdempotencyKeyHeader is the header name for the key which deduplicates
+	// repeated webhook job run requests
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// RequestIDHeader is the header name for the ID of an API request, which
+	// is recorded in the audit log events of the request
+	RequestIDHeader = "X-Request-Id"
 )
 
 func buildPrettyVersion() string {
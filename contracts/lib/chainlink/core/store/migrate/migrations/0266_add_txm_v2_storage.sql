@@ -0,0 +1,55 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE evm.txm_v2_txes (
+    id BIGSERIAL PRIMARY KEY,
+    chain_id NUMERIC(78,0) NOT NULL,
+    idempotency_key TEXT,
+    nonce BIGINT,
+    from_address BYTEA NOT NULL,
+    to_address BYTEA NOT NULL,
+    value NUMERIC(78,0) NOT NULL,
+    data BYTEA NOT NULL DEFAULT '\x',
+    specified_gas_limit BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    initial_broadcast_at TIMESTAMPTZ,
+    last_broadcast_at TIMESTAMPTZ,
+    state TEXT NOT NULL CHECK (state IN ('unstarted', 'unconfirmed', 'confirmed', 'fatal_error')),
+    is_purgeable BOOLEAN NOT NULL DEFAULT FALSE,
+    attempt_count INTEGER NOT NULL DEFAULT 0,
+    meta JSONB,
+    subject UUID,
+    pipeline_task_run_id UUID,
+    min_confirmations INTEGER,
+    signal_callback BOOLEAN NOT NULL DEFAULT FALSE,
+    callback_completed BOOLEAN NOT NULL DEFAULT FALSE,
+    CONSTRAINT chk_txm_v2_txes_nonce CHECK (state = 'unstarted' OR state = 'fatal_error' OR nonce IS NOT NULL)
+);
+
+CREATE UNIQUE INDEX idx_txm_v2_txes_idempotency_key ON evm.txm_v2_txes (chain_id, idempotency_key) WHERE idempotency_key IS NOT NULL;
+CREATE UNIQUE INDEX idx_txm_v2_txes_unconfirmed_nonce ON evm.txm_v2_txes (chain_id, from_address, nonce) WHERE state = 'unconfirmed';
+CREATE INDEX idx_txm_v2_txes_state ON evm.txm_v2_txes (chain_id, from_address, state, id);
+
+CREATE TABLE evm.txm_v2_tx_attempts (
+    id BIGSERIAL PRIMARY KEY,
+    tx_id BIGINT NOT NULL REFERENCES evm.txm_v2_txes (id) ON DELETE CASCADE,
+    hash BYTEA NOT NULL,
+    gas_price NUMERIC(78,0),
+    gas_tip_cap NUMERIC(78,0),
+    gas_fee_cap NUMERIC(78,0),
+    gas_limit BIGINT NOT NULL,
+    tx_type SMALLINT NOT NULL,
+    signed_raw_tx BYTEA,
+    created_at TIMESTAMPTZ NOT NULL,
+    broadcast_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_txm_v2_tx_attempts_tx_id ON evm.txm_v2_tx_attempts (tx_id);
+CREATE INDEX idx_txm_v2_tx_attempts_hash ON evm.txm_v2_tx_attempts (hash);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS evm.txm_v2_tx_attempts;
+DROP TABLE IF EXISTS evm.txm_v2_txes;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE bridge_types
+    ADD COLUMN cache_fresh_ttl BIGINT NOT NULL DEFAULT 0 CHECK (cache_fresh_ttl >= 0),
+    ADD COLUMN cache_stale_ttl BIGINT NOT NULL DEFAULT 0 CHECK (cache_stale_ttl >= 0),
+    ADD COLUMN coalesce_requests BOOLEAN NOT NULL DEFAULT FALSE;
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE bridge_types
+    DROP COLUMN cache_fresh_ttl,
+    DROP COLUMN cache_stale_ttl,
+    DROP COLUMN coalesce_requests;
+-- +goose StatementEnd
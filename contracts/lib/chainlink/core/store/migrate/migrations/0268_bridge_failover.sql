@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE bridge_types
+    ADD COLUMN backup_urls TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN health_check_path TEXT NOT NULL DEFAULT '',
+    ADD COLUMN circuit_breaker_threshold BIGINT NOT NULL DEFAULT 0 CHECK (circuit_breaker_threshold >= 0),
+    ADD COLUMN circuit_breaker_cooldown BIGINT NOT NULL DEFAULT 0 CHECK (circuit_breaker_cooldown >= 0);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE bridge_types
+    DROP COLUMN backup_urls,
+    DROP COLUMN health_check_path,
+    DROP COLUMN circuit_breaker_threshold,
+    DROP COLUMN circuit_breaker_cooldown;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE cron_specs
+    ADD COLUMN time_zone TEXT NOT NULL DEFAULT '',
+    ADD COLUMN missed_run_policy TEXT NOT NULL DEFAULT '',
+    ADD COLUMN max_missed_runs BIGINT NOT NULL DEFAULT 0 CHECK (max_missed_runs >= 0),
+    ADD COLUMN concurrency_policy TEXT NOT NULL DEFAULT '',
+    ADD COLUMN jitter BIGINT NOT NULL DEFAULT 0 CHECK (jitter >= 0),
+    ADD COLUMN last_fired_at TIMESTAMP WITH TIME ZONE;
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE cron_specs
+    DROP COLUMN time_zone,
+    DROP COLUMN missed_run_policy,
+    DROP COLUMN max_missed_runs,
+    DROP COLUMN concurrency_policy,
+    DROP COLUMN jitter,
+    DROP COLUMN last_fired_at;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE jobs
+    ADD COLUMN retention_max_runs BIGINT CHECK (retention_max_runs >= 0),
+    ADD COLUMN retention_max_age BIGINT NOT NULL DEFAULT 0 CHECK (retention_max_age >= 0),
+    ADD COLUMN retention_keep_errored_only BOOLEAN NOT NULL DEFAULT FALSE;
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE jobs
+    DROP COLUMN retention_max_runs,
+    DROP COLUMN retention_max_age,
+    DROP COLUMN retention_keep_errored_only;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE webhook_trigger_tokens (
+    id BIGSERIAL PRIMARY KEY,
+    job_id INT NOT NULL REFERENCES jobs (id) ON DELETE CASCADE,
+    access_key TEXT NOT NULL UNIQUE,
+    secret TEXT NOT NULL,
+    allowed_ips TEXT[] NOT NULL DEFAULT '{}',
+    rate_limit BIGINT NOT NULL DEFAULT 0 CHECK (rate_limit >= 0),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    revoked_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_webhook_trigger_tokens_job_id ON webhook_trigger_tokens (job_id);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE webhook_trigger_tokens;
+-- +goose StatementEnd
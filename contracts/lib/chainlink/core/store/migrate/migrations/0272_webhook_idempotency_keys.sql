@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE webhook_specs ADD COLUMN idempotency_window BIGINT NOT NULL DEFAULT 0 CHECK (idempotency_window >= 0);
+
+CREATE TABLE webhook_idempotency_keys (
+    job_id INT NOT NULL REFERENCES jobs (id) ON DELETE CASCADE,
+    idempotency_key TEXT NOT NULL,
+    pipeline_run_id BIGINT REFERENCES pipeline_runs (id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (job_id, idempotency_key)
+);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE webhook_idempotency_keys;
+
+ALTER TABLE webhook_specs DROP COLUMN idempotency_window;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE custom_roles (
+    name TEXT PRIMARY KEY CHECK (name ~ '^[a-z0-9][a-z0-9_-]*$' AND name NOT IN ('admin', 'edit', 'run', 'view')),
+    description TEXT NOT NULL DEFAULT '',
+    permissions TEXT[] NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+ALTER TABLE users
+    ADD COLUMN custom_role TEXT REFERENCES custom_roles (name) ON DELETE RESTRICT,
+    ADD COLUMN token_custom_role TEXT REFERENCES custom_roles (name) ON DELETE RESTRICT;
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP COLUMN custom_role, DROP COLUMN token_custom_role;
+
+DROP TABLE custom_roles;
+-- +goose StatementEnd
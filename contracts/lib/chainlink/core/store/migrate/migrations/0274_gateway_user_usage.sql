@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE gateway_user_usage (
+    don_id TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    day DATE NOT NULL,
+    requests BIGINT NOT NULL,
+    PRIMARY KEY (don_id, user_id, day)
+);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE gateway_user_usage;
+-- +goose StatementEnd
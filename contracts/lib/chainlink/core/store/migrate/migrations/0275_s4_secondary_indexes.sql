@@ -0,0 +1,10 @@
+-- +goose Up
+
+-- GetUnconfirmedRows selects unconfirmed rows ordered by updated_at, which the boolean index does not help with
+DROP INDEX IF EXISTS "s4".shared_namespace_confirmed_idx;
+CREATE INDEX shared_namespace_unconfirmed_updated_at_idx ON "s4".shared(namespace, updated_at) WHERE confirmed IS FALSE;
+
+-- +goose Down
+
+DROP INDEX IF EXISTS "s4".shared_namespace_unconfirmed_updated_at_idx;
+CREATE INDEX shared_namespace_confirmed_idx ON "s4".shared(namespace, confirmed);
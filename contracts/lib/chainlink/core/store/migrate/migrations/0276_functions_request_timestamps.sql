@@ -0,0 +1,18 @@
+-- +goose Up
+
+-- see 0183_functions_new_fields.sql for previous changes
+ALTER TABLE functions_requests
+  ADD COLUMN finalized_at timestamp with time zone,
+  ADD COLUMN confirmed_at timestamp with time zone,
+  ADD COLUMN timed_out_at timestamp with time zone;
+
+CREATE INDEX idx_functions_requests_contract_address_received_at ON functions_requests (contract_address, received_at);
+
+-- +goose Down
+
+DROP INDEX IF EXISTS idx_functions_requests_contract_address_received_at;
+
+ALTER TABLE functions_requests
+  DROP COLUMN finalized_at,
+  DROP COLUMN confirmed_at,
+  DROP COLUMN timed_out_at;
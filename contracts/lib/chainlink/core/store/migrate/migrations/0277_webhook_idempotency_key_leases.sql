@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- see 0272_webhook_idempotency_keys.sql for previous changes
+ALTER TABLE webhook_idempotency_keys
+    ADD COLUMN lease_expires_at TIMESTAMP WITH TIME ZONE,
+    DROP CONSTRAINT webhook_idempotency_keys_pipeline_run_id_fkey,
+    ADD CONSTRAINT webhook_idempotency_keys_pipeline_run_id_fkey FOREIGN KEY (pipeline_run_id) REFERENCES pipeline_runs (id) ON DELETE SET NULL;
+
+CREATE INDEX idx_webhook_idempotency_keys_created_at ON webhook_idempotency_keys (created_at);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_webhook_idempotency_keys_created_at;
+
+DELETE FROM webhook_idempotency_keys WHERE pipeline_run_id IS NULL AND lease_expires_at IS NULL;
+
+ALTER TABLE webhook_idempotency_keys
+    DROP COLUMN lease_expires_at,
+    DROP CONSTRAINT webhook_idempotency_keys_pipeline_run_id_fkey,
+    ADD CONSTRAINT webhook_idempotency_keys_pipeline_run_id_fkey FOREIGN KEY (pipeline_run_id) REFERENCES pipeline_runs (id) ON DELETE CASCADE;
+-- +goose StatementEnd
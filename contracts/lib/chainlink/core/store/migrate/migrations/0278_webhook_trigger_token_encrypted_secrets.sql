@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- see 0271_webhook_trigger_tokens.sql for previous changes
+-- Secrets are encrypted with a key derived from the keystore password, which migrations do not have, so tokens
+-- with a plaintext secret are revoked and must be created again.
+ALTER TABLE webhook_trigger_tokens ADD COLUMN encrypted_secret BYTEA NOT NULL DEFAULT ''::bytea;
+ALTER TABLE webhook_trigger_tokens ALTER COLUMN encrypted_secret DROP DEFAULT;
+UPDATE webhook_trigger_tokens SET revoked_at = NOW() WHERE revoked_at IS NULL;
+ALTER TABLE webhook_trigger_tokens DROP COLUMN secret;
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE webhook_trigger_tokens ADD COLUMN secret TEXT NOT NULL DEFAULT '';
+ALTER TABLE webhook_trigger_tokens ALTER COLUMN secret DROP DEFAULT;
+UPDATE webhook_trigger_tokens SET revoked_at = NOW() WHERE revoked_at IS NULL;
+ALTER TABLE webhook_trigger_tokens DROP COLUMN encrypted_secret;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- see 0277_webhook_idempotency_key_leases.sql for previous changes
+ALTER TABLE webhook_specs ADD COLUMN idempotency_key_lease BIGINT NOT NULL DEFAULT 0 CHECK (idempotency_key_lease >= 0);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE webhook_specs DROP COLUMN idempotency_key_lease;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX idx_txes_job_id ON evm.txes (evm_chain_id, (meta->>'JobID'), created_at) WHERE meta->>'JobID' IS NOT NULL;
+
+CREATE INDEX idx_txm_v2_txes_job_id ON evm.txm_v2_txes (chain_id, (meta->>'JobID'), created_at) WHERE meta->>'JobID' IS NOT NULL;
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS evm.idx_txm_v2_txes_job_id;
+
+DROP INDEX IF EXISTS evm.idx_txes_job_id;
+-- +goose StatementEnd
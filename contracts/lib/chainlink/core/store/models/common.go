@@ -258,6 +258,17 @@ type SendEtherRequest struct {
 	WaitAttemptTimeout *time.Duration `json:"waitAttemptTimeout"`
 }
 
+// SpeedUpTransactionRequest represents a request to re-send an unconfirmed
+// EVM transaction with operator chosen fees. Legacy transactions require
+// GasPrice, EIP-1559 transactions require GasTipCap and GasFeeCap. A zero
+// GasLimit keeps the limit of the original transaction.
+type SpeedUpTransactionRequest struct {
+	GasPrice  *assets.Wei `json:"gasPrice"`
+	GasTipCap *assets.Wei `json:"gasTipCap"`
+	GasFeeCap *assets.Wei `json:"gasFeeCap"`
+	GasLimit  uint64      `json:"gasLimit"`
+}
+
 // AddressCollection is an array of common.Address
 // serializable to and from a database.
 type AddressCollection []common.Address
@@ -513,6 +513,15 @@ func (m *KeyedMutex) LockInt64(key int64) func() {
 	return mtx.Unlock
 }
 
+// Lock locks the value for read/write. The key must be comparable.
+func (m *KeyedMutex) Lock(key any) func() {
+	value, _ := m.mutexes.LoadOrStore(key, new(sync.Mutex))
+	mtx := value.(*sync.Mutex)
+	mtx.Lock()
+
+	return mtx.Unlock
+}
+
 // BoxOutput formats its arguments as fmt.Printf, and encloses them in a box of
 // arrows pointing at their content, in order to better highlight it. See
 // ExampleBoxOutput
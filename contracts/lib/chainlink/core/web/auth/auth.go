@@ -1,16 +1,25 @@
 package auth
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
+	"io"
 	"net/http"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/gin-contrib/sessions"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/static"
 )
@@ -33,6 +42,12 @@ const (
 
 	// SessionExternalInitiatorKey is the External Initiator key in the session map
 	SessionExternalInitiatorKey = "external_initiator"
+
+	// SessionWebhookTriggerTokenKey is the webhook trigger token key in the session map
+	SessionWebhookTriggerTokenKey = "webhook_trigger_token"
+
+	// jobFinderKey is the JobFinder key in the gin context
+	jobFinderKey = "job_finder"
 )
 
 // Authenticator defines the interface to authenticate requests against a
@@ -152,6 +167,47 @@ func AuthenticateExternalInitiator(c *gin.Context, store Authenticator) error {
 
 var _ authMethod = AuthenticateExternalInitiator
 
+// AuthenticateWebhookTriggerToken returns an authMethod which authenticates a
+// webhook job run request signed with a trigger token of the job.
+func AuthenticateWebhookTriggerToken(tokens webhook.TriggerTokenManager) authMethod {
+	return func(c *gin.Context, _ Authenticator) error {
+		accessKey := c.GetHeader(static.WebhookAccessKeyHeader)
+		if accessKey == "" {
+			return auth.ErrorAuthFailed
+		}
+		jobUUID, err := uuid.Parse(c.Param("ID"))
+		if err != nil {
+			return errors.New("webhook trigger tokens can only run jobs by external job ID")
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			return errors.Wrap(err, "reading request body")
+		}
+		// the body is read again by the handler
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		token, err := tokens.Verify(c.Request.Context(), webhook.SignedRequest{
+			AccessKey: accessKey,
+			Timestamp: c.GetHeader(static.WebhookTimestampHeader),
+			Signature: c.GetHeader(static.WebhookSignatureHeader),
+			Body:      body,
+			ClientIP:  c.ClientIP(),
+			JobID:     jobUUID,
+		})
+		if err != nil {
+			return err
+		}
+
+		// Trigger tokens inherently assume the role of 'run', and are limited to
+		// their job by the webhook authorizer
+		c.Set(SessionWebhookTriggerTokenKey, token)
+		c.Set(SessionUserKey, &clsessions.User{Role: clsessions.UserRoleRun})
+
+		return nil
+	}
+}
+
 // Authenticate is middleware which authenticates the request by attempting to
 // authenticate using all the provided methods.
 func Authenticate(store Authenticator, methods ...authMethod) gin.HandlerFunc {
@@ -163,17 +219,42 @@ func Authenticate(store Authenticator, methods ...authMethod) gin.HandlerFunc {
 				break
 			}
 		}
+		if errors.Is(err, webhook.ErrTriggerRateLimitExceeded) {
+			c.Abort()
+			jsonAPIError(c, http.StatusTooManyRequests, err)
+
+			return
+		}
 		if err != nil {
 			c.Abort()
 			jsonAPIError(c, http.StatusUnauthorized, err)
 
 			return
 		}
+		if user, ok := GetAuthenticatedUser(c); ok && !authorize(c, user, clsessions.UserRoleView) {
+			return
+		}
 
+		c.Request = c.Request.WithContext(audit.WithActor(c.Request.Context(), authenticatedActor(c)))
 		c.Next()
 	}
 }
 
+// authenticatedActor returns the audit log actor of an authenticated request.
+func authenticatedActor(c *gin.Context) audit.Actor {
+	var actor audit.Actor
+	if user, ok := GetAuthenticatedUser(c); ok {
+		actor = audit.Actor{Name: user.Email, Role: string(user.Role)}
+	}
+	if ei, ok := GetAuthenticatedExternalInitiator(c); ok {
+		actor.Name = "external initiator " + ei.Name
+	}
+	if token, ok := GetAuthenticatedWebhookTriggerToken(c); ok {
+		actor.Name = "webhook trigger token " + token.AccessKey
+	}
+	return actor
+}
+
 // GetAuthenticatedUser extracts the authentication user from the context.
 func GetAuthenticatedUser(c *gin.Context) (*clsessions.User, bool) {
 	obj, ok := c.Get(SessionUserKey)
@@ -197,6 +278,17 @@ func GetAuthenticatedExternalInitiator(c *gin.Context) (*bridges.ExternalInitiat
 	return obj.(*bridges.ExternalInitiator), ok
 }
 
+// GetAuthenticatedWebhookTriggerToken extracts the webhook trigger token from
+// the context.
+func GetAuthenticatedWebhookTriggerToken(c *gin.Context) (*webhook.TriggerToken, bool) {
+	obj, ok := c.Get(SessionWebhookTriggerTokenKey)
+	if !ok {
+		return nil, false
+	}
+
+	return obj.(*webhook.TriggerToken), ok
+}
+
 // RequiresRunRole extracts the user object from the context, and asserts the user's role is at least
 // 'run'
 func RequiresRunRole(handler func(*gin.Context)) func(*gin.Context) {
@@ -212,6 +304,9 @@ func RequiresRunRole(handler func(*gin.Context)) func(*gin.Context) {
 			jsonAPIError(c, http.StatusUnauthorized, errors.New("Unauthorized"))
 			return
 		}
+		if !authorize(c, user, clsessions.UserRoleRun) {
+			return
+		}
 		handler(c)
 	}
 }
@@ -231,6 +326,9 @@ func RequiresEditRole(handler func(*gin.Context)) func(*gin.Context) {
 			jsonAPIError(c, http.StatusUnauthorized, errors.New("Unauthorized"))
 			return
 		}
+		if !authorize(c, user, clsessions.UserRoleEdit) {
+			return
+		}
 		handler(c)
 	}
 }
@@ -250,6 +348,104 @@ func RequiresAdminRole(handler func(*gin.Context)) func(*gin.Context) {
 			jsonAPIError(c, http.StatusForbidden, errors.New("Forbidden"))
 			return
 		}
+		if !authorize(c, user, clsessions.UserRoleAdmin) {
+			return
+		}
 		handler(c)
 	}
 }
+
+// unrestrictedResources are allowed to every authenticated user, whatever their custom role, so they can always check
+// their login and log out.
+var unrestrictedResources = []string{"ping", "sessions"}
+
+// authorize asserts the custom roles of user allow action on the resource of the matched route, aborting the request
+// with 403 (Forbidden) otherwise.
+func authorize(c *gin.Context, user *clsessions.User, action clsessions.UserRole) bool {
+	resource, ids := routeResource(c)
+	if jobs, ok := c.Get(jobFinderKey); ok && resource == "jobs" && len(ids) == 1 && len(user.RolePermissions) > 0 {
+		var err error
+		if ids, err = JobIDs(c.Request.Context(), jobs.(JobFinder), ids[0]); err != nil {
+			c.Abort()
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return false
+		}
+	}
+	if slices.Contains(unrestrictedResources, resource) || user.Can(action, resource, ids...) {
+		return true
+	}
+	c.Abort()
+	required := clsessions.Permission{Action: action, Resource: resource}
+	addForbiddenErrorHeaders(c, required.String(), user.CustomRole.String, user.Email)
+	jsonAPIError(c, http.StatusForbidden, errors.New("Forbidden"))
+	return false
+}
+
+// routeResource returns the resource of the matched route, as named by custom role permissions: the first segment of
+// its path after /v2. The second segment, or the value of its parameter, is returned as the resource ID.
+func routeResource(c *gin.Context) (resource string, ids []string) {
+	path := strings.TrimPrefix(strings.TrimPrefix(c.FullPath(), "/"), "v2/")
+	segments := strings.SplitN(path, "/", 3)
+	resource = segments[0]
+	if len(segments) > 1 {
+		id := segments[1]
+		if name, ok := strings.CutPrefix(id, ":"); ok {
+			id = c.Param(name)
+		}
+		if id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return resource, ids
+}
+
+// JobFinder finds jobs by either of their IDs.
+type JobFinder interface {
+	FindJob(ctx context.Context, id int32) (job.Job, error)
+	FindJobByExternalJobID(ctx context.Context, externalJobID uuid.UUID) (job.Job, error)
+}
+
+// WithJobFinder is middleware which lets the authorization of the routes of a job find both of its IDs, so custom role
+// permissions on a job apply whether the route addresses it by its ID or by its external job ID.
+func WithJobFinder(jobs JobFinder) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(jobFinderKey, jobs)
+		c.Next()
+	}
+}
+
+// JobIDs returns the ID and the external job ID of the job addressed by id, which may be either of them. Only id is
+// returned if there is no such job.
+func JobIDs(ctx context.Context, jobs JobFinder, id string) ([]string, error) {
+	var jb job.Job
+	var err error
+	if externalJobID, pErr := uuid.Parse(id); pErr == nil {
+		jb, err = jobs.FindJobByExternalJobID(ctx, externalJobID)
+	} else if jobID, pErr := strconv.ParseInt(id, 10, 32); pErr == nil {
+		jb, err = jobs.FindJob(ctx, int32(jobID))
+	} else {
+		return []string{id}, nil
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return []string{id}, nil
+	} else if err != nil {
+		return nil, errors.Wrap(err, "finding job")
+	}
+	return JobPermissionIDs(jb), nil
+}
+
+// JobPermissionIDs returns the IDs custom role permissions may name jb by.
+func JobPermissionIDs(jb job.Job) []string {
+	return []string{strconv.FormatInt(int64(jb.ID), 10), jb.ExternalJobID.String()}
+}
+
+// VisibleJobs returns the jobs the custom roles of user allow it to view.
+func VisibleJobs(user *clsessions.User, jobs []job.Job) []job.Job {
+	visible := make([]job.Job, 0, len(jobs))
+	for _, jb := range jobs {
+		if user.Can(clsessions.UserRoleView, "jobs", JobPermissionIDs(jb)...) {
+			visible = append(visible, jb)
+		}
+	}
+	return visible
+}
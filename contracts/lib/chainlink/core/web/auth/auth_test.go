@@ -2,6 +2,7 @@ package auth_test
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"io"
 	"net/http"
@@ -13,10 +14,12 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
 	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
@@ -206,6 +209,70 @@ func TestRequireAuth_Error(t *testing.T) {
 	assert.Equal(t, http.StatusText(http.StatusUnauthorized), http.StatusText(w.Code))
 }
 
+type jobFinder []job.Job
+
+func (f jobFinder) FindJob(ctx context.Context, id int32) (job.Job, error) {
+	for _, jb := range f {
+		if jb.ID == id {
+			return jb, nil
+		}
+	}
+	return job.Job{}, sql.ErrNoRows
+}
+
+func (f jobFinder) FindJobByExternalJobID(ctx context.Context, externalJobID uuid.UUID) (job.Job, error) {
+	for _, jb := range f {
+		if jb.ExternalJobID == externalJobID {
+			return jb, nil
+		}
+	}
+	return job.Job{}, sql.ErrNoRows
+}
+
+func TestAuthenticate_CustomRole(t *testing.T) {
+	user := cltest.MustRandomUser(t)
+	user.Role = sessions.UserRoleEdit
+	key, secret := uuid.New().String(), uuid.New().String()
+	require.NoError(t, user.SetAuthToken(&auth.Token{AccessKey: key, Secret: secret}))
+	user.CustomRole = null.StringFrom("bridges")
+	jobs := jobFinder{{ID: 2, ExternalJobID: uuid.New()}, {ID: 3, ExternalJobID: uuid.New()}}
+	permissions, err := sessions.ParsePermissions([]string{"view:*", "edit:bridge_types", "!view:jobs/2", "!view:jobs/" + jobs[1].ExternalJobID.String()})
+	require.NoError(t, err)
+	user.RolePermissions = []sessions.Permissions{permissions}
+
+	router := gin.New()
+	router.Use(webauth.WithJobFinder(jobs))
+	v2 := router.Group("/v2", webauth.Authenticate(userFindSuccesser{user: user}, webauth.AuthenticateByToken))
+	ok := func(c *gin.Context) { c.String(http.StatusOK, "") }
+	v2.GET("/jobs/:ID", ok)
+	v2.POST("/jobs", webauth.RequiresEditRole(ok))
+	v2.PATCH("/bridge_types/:BridgeName", webauth.RequiresEditRole(ok))
+	v2.GET("/ping", ok)
+
+	for _, test := range []struct {
+		verb, path string
+		want       int
+	}{
+		{"GET", "/v2/jobs/1", http.StatusOK},
+		{"GET", "/v2/jobs/2", http.StatusForbidden},
+		{"GET", "/v2/jobs/" + jobs[0].ExternalJobID.String(), http.StatusForbidden},
+		{"GET", "/v2/jobs/3", http.StatusForbidden},
+		{"GET", "/v2/jobs/" + uuid.NewString(), http.StatusOK},
+		{"POST", "/v2/jobs", http.StatusForbidden},
+		{"PATCH", "/v2/bridge_types/my_bridge", http.StatusOK},
+		{"GET", "/v2/ping", http.StatusOK},
+	} {
+		t.Run(test.verb+" "+test.path, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := mustRequest(t, test.verb, test.path, nil)
+			req.Header.Set(webauth.APIKey, key)
+			req.Header.Set(webauth.APISecret, secret)
+			router.ServeHTTP(w, req)
+			assert.Equal(t, test.want, w.Code)
+		})
+	}
+}
+
 // Test RBAC (Role based access control) of each route and their required user roles
 // Admin is omitted from the fields here since admin should be able to access all routes
 type routeRules struct {
@@ -222,6 +289,11 @@ var routesRolesMap = [...]routeRules{
 	{"POST", "/v2/users", false, false, false},
 	{"PATCH", "/v2/users", false, false, false},
 	{"DELETE", "/v2/users/MOCK", false, false, false},
+	{"GET", "/v2/roles", false, false, false},
+	{"POST", "/v2/roles", false, false, false},
+	{"PATCH", "/v2/roles/MOCK", false, false, false},
+	{"DELETE", "/v2/roles/MOCK", false, false, false},
+	{"PATCH", "/v2/users/MOCK/custom_role", false, false, false},
 	{"PATCH", "/v2/user/password", true, true, true},
 	{"POST", "/v2/user/token", true, true, true},
 	{"POST", "/v2/user/token/delete", true, true, true},
@@ -6,6 +6,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 
 	"github.com/gin-contrib/sessions"
 	"github.com/gin-gonic/gin"
@@ -53,6 +54,7 @@ func AuthenticateGQL(authenticator Authenticator, lggr logger.Logger) gin.Handle
 //
 // There shouldn't be a need to do this outside of testing
 func WithGQLAuthenticatedSession(ctx context.Context, user clsessions.User, sessionID string) context.Context {
+	ctx = audit.WithActor(ctx, audit.Actor{Name: user.Email, Role: string(user.Role)})
 	return context.WithValue(
 		ctx,
 		sessionUserKey{},
@@ -50,6 +50,12 @@ func ValidateBridgeType(bt *bridges.BridgeTypeRequest) error {
 		bt.MinimumContractPayment.Cmp(assets.NewLinkFromJuels(0)) < 0 {
 		fe.Add("MinimumContractPayment must be positive")
 	}
+	if bt.CacheFreshTTL < 0 || bt.CacheStaleTTL < 0 {
+		fe.Add("CacheFreshTTL and CacheStaleTTL must not be negative")
+	}
+	if err := bridges.ValidateFailoverPolicy(bt.FailoverPolicy); err != nil {
+		fe.Merge(err)
+	}
 	return fe.CoerceEmptyToNil()
 }
 
@@ -99,12 +105,13 @@ func (btc *BridgeTypesController) Create(c *gin.Context) {
 	resource := presenters.NewBridgeResource(*bt)
 	resource.IncomingToken = bta.IncomingToken
 
-	btc.App.GetAuditLogger().Audit(audit.BridgeCreated, map[string]interface{}{
+	btc.App.GetAuditLogger().Audit(audit.BridgeCreated, audit.WithContext(c.Request.Context(), map[string]interface{}{
 		"bridgeName":                   bta.Name,
 		"bridgeConfirmations":          bta.Confirmations,
 		"bridgeMinimumContractPayment": bta.MinimumContractPayment,
 		"bridgeURL":                    bta.URL,
-	})
+		"bridge":                       bt.Redacted(),
+	}))
 
 	jsonAPIResponse(c, resource, "bridge")
 }
@@ -143,7 +150,9 @@ func (btc *BridgeTypesController) Show(c *gin.Context) {
 		return
 	}
 
-	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
+	resource := presenters.NewBridgeResource(bt)
+	resource.Health = btc.App.BridgeHealth(bt)
+	jsonAPIResponse(c, resource, "bridge")
 }
 
 // Update can change the restricted attributes for a bridge
@@ -169,6 +178,9 @@ func (btc *BridgeTypesController) Update(c *gin.Context) {
 		return
 	}
 
+	// the cache and failover policies are kept unless the request changes them
+	btr.CachePolicy = bt.CachePolicy
+	btr.FailoverPolicy = bt.FailoverPolicy
 	if err := c.ShouldBindJSON(btr); err != nil {
 		jsonAPIError(c, http.StatusUnprocessableEntity, err)
 		return
@@ -177,17 +189,19 @@ func (btc *BridgeTypesController) Update(c *gin.Context) {
 		jsonAPIError(c, http.StatusBadRequest, err)
 		return
 	}
+	before := bt
 	if err := orm.UpdateBridgeType(ctx, &bt, btr); err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
 
-	btc.App.GetAuditLogger().Audit(audit.BridgeUpdated, map[string]interface{}{
+	btc.App.GetAuditLogger().Audit(audit.BridgeUpdated, audit.WithContext(c.Request.Context(), map[string]interface{}{
 		"bridgeName":                   bt.Name,
 		"bridgeConfirmations":          bt.Confirmations,
 		"bridgeMinimumContractPayment": bt.MinimumContractPayment,
 		"bridgeURL":                    bt.URL,
-	})
+		"diff":                         audit.Diff(before.Redacted(), bt.Redacted()),
+	}))
 
 	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
 }
@@ -227,7 +241,7 @@ func (btc *BridgeTypesController) Destroy(c *gin.Context) {
 		return
 	}
 
-	btc.App.GetAuditLogger().Audit(audit.BridgeDeleted, map[string]interface{}{"name": name})
+	btc.App.GetAuditLogger().Audit(audit.BridgeDeleted, audit.WithContext(c.Request.Context(), map[string]interface{}{"name": name, "bridge": bt.Redacted()}))
 
 	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
 }
@@ -85,6 +85,24 @@ func TestValidateBridgeType(t *testing.T) {
 			},
 			models.NewJSONAPIErrorsWith("MinimumContractPayment must be positive"),
 		},
+		{
+			"invalid backup URL",
+			bridges.BridgeTypeRequest{
+				Name:           "adapterwithbackups",
+				URL:            cltest.WebURL(t, "http://chainlink_cmc-adapter_1:8080"),
+				FailoverPolicy: bridges.FailoverPolicy{BackupURLs: []string{"not-a-url"}},
+			},
+			models.NewJSONAPIErrorsWith(`backup URL "not-a-url" must be an absolute URL`),
+		},
+		{
+			"invalid health check path",
+			bridges.BridgeTypeRequest{
+				Name:           "adapterwithhealthcheck",
+				URL:            cltest.WebURL(t, "http://chainlink_cmc-adapter_1:8080"),
+				FailoverPolicy: bridges.FailoverPolicy{HealthCheckPath: "health"},
+			},
+			models.NewJSONAPIErrorsWith(`health check path "health" must start with /`),
+		},
 		{
 			"existing core adapter (no longer fails since core adapters no longer exist)",
 			bridges.BridgeTypeRequest{
@@ -277,9 +295,10 @@ func TestBridgeController_Show(t *testing.T) {
 	client := app.NewHTTPClient(nil)
 
 	bt := &bridges.BridgeType{
-		Name:          bridges.MustParseBridgeName(testutils.RandomizeName("showbridge")),
-		URL:           cltest.WebURL(t, "https://testing.com/bridges"),
-		Confirmations: 0,
+		Name:           bridges.MustParseBridgeName(testutils.RandomizeName("showbridge")),
+		URL:            cltest.WebURL(t, "https://testing.com/bridges"),
+		Confirmations:  0,
+		FailoverPolicy: bridges.FailoverPolicy{BackupURLs: []string{"https://backup.testing.com/bridges"}},
 	}
 	ctx := testutils.Context(t)
 	require.NoError(t, app.BridgeORM().CreateBridgeType(ctx, bt))
@@ -293,6 +312,10 @@ func TestBridgeController_Show(t *testing.T) {
 	assert.Equal(t, bt.Name.String(), resource.Name, "should have the same name")
 	assert.Equal(t, bt.URL.String(), resource.URL, "should have the same URL")
 	assert.Equal(t, bt.Confirmations, resource.Confirmations, "should have the same Confirmations")
+	assert.Equal(t, []string{"https://backup.testing.com/bridges"}, resource.BackupURLs)
+	require.Len(t, resource.Health, 2)
+	assert.Equal(t, "https://backup.testing.com/bridges", resource.Health[1].URL)
+	assert.Equal(t, bridges.CircuitClosed, resource.Health[1].State)
 
 	resp, cleanup = client.Get("/v2/bridge_types/nosuchbridge")
 	t.Cleanup(cleanup)
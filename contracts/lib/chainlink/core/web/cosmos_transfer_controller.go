@@ -86,9 +86,9 @@ func (tc *CosmosTransfersController) Create(c *gin.Context) {
 
 	resource := presenters.NewCosmosMsgResource("cosmos_transfer_"+uuid.New().String(), tr.CosmosChainID, "")
 	resource.State = "unstarted"
-	tc.App.GetAuditLogger().Audit(audit.CosmosTransactionCreated, map[string]interface{}{
+	tc.App.GetAuditLogger().Audit(audit.CosmosTransactionCreated, audit.WithContext(c.Request.Context(), map[string]interface{}{
 		"cosmosTransactionResource": resource,
-	})
+	}))
 
 	jsonAPIResponse(c, resource, "cosmos_msg")
 }
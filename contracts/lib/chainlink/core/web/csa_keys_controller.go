@@ -46,10 +46,10 @@ func (ctrl *CSAKeysController) Create(c *gin.Context) {
 		return
 	}
 
-	ctrl.App.GetAuditLogger().Audit(audit.CSAKeyCreated, map[string]interface{}{
+	ctrl.App.GetAuditLogger().Audit(audit.CSAKeyCreated, audit.WithContext(c.Request.Context(), map[string]interface{}{
 		"CSAPublicKey": key.PublicKey,
 		"CSVersion":    key.Version,
-	})
+	}))
 
 	jsonAPIResponse(c, presenters.NewCSAKeyResource(key), "csaKeys")
 }
@@ -71,10 +71,10 @@ func (ctrl *CSAKeysController) Import(c *gin.Context) {
 		return
 	}
 
-	ctrl.App.GetAuditLogger().Audit(audit.CSAKeyImported, map[string]interface{}{
+	ctrl.App.GetAuditLogger().Audit(audit.CSAKeyImported, audit.WithContext(c.Request.Context(), map[string]interface{}{
 		"CSAPublicKey": key.PublicKey,
 		"CSVersion":    key.Version,
-	})
+	}))
 
 	jsonAPIResponse(c, presenters.NewCSAKeyResource(key), "csaKey")
 }
@@ -92,6 +92,6 @@ func (ctrl *CSAKeysController) Export(c *gin.Context) {
 		return
 	}
 
-	ctrl.App.GetAuditLogger().Audit(audit.CSAKeyExported, map[string]interface{}{"keyID": keyID})
+	ctrl.App.GetAuditLogger().Audit(audit.CSAKeyExported, audit.WithContext(c.Request.Context(), map[string]interface{}{"keyID": keyID}))
 	c.Data(http.StatusOK, MediaType, bytes)
 }
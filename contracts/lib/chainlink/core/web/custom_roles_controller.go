@@ -0,0 +1,184 @@
+package web
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgconn"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	clsession "github.com/smartcontractkit/chainlink/v2/core/sessions"
+	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// CustomRolesController manages custom roles, and their assignment to API users.
+type CustomRolesController struct {
+	App chainlink.Application
+}
+
+// CustomRoleRequest defines the request to create, or update, a custom role.
+type CustomRoleRequest struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
+// AssignCustomRoleRequest defines the request to assign a custom role to an API user, or their API token. An empty
+// CustomRole removes the assignment.
+type AssignCustomRoleRequest struct {
+	CustomRole string `json:"customRole"`
+	Token      bool   `json:"token"`
+}
+
+// Index lists all custom roles.
+func (crc *CustomRolesController) Index(c *gin.Context) {
+	roles, err := crc.App.AuthenticationProvider().ListCustomRoles(c.Request.Context())
+	if err != nil {
+		crc.jsonAPIError(c, err, "unable to list custom roles")
+		return
+	}
+	jsonAPIResponse(c, presenters.NewCustomRoleResources(roles), "customRoles")
+}
+
+// Create creates a new custom role.
+func (crc *CustomRolesController) Create(c *gin.Context) {
+	ctx := c.Request.Context()
+	var request CustomRoleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	role, err := clsession.NewCustomRole(request.Name, request.Description, request.Permissions)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	if err = crc.App.AuthenticationProvider().CreateCustomRole(ctx, &role); err != nil {
+		// If this is a duplicate key error (code 23505), return a nicer error message
+		var pgErr *pgconn.PgError
+		if ok := errors.As(err, &pgErr); ok && pgErr.Code == "23505" {
+			jsonAPIError(c, http.StatusBadRequest, errors.Errorf("custom role %s already exists", request.Name))
+			return
+		}
+		crc.jsonAPIError(c, err, "error creating custom role")
+		return
+	}
+
+	crc.App.GetAuditLogger().Audit(audit.CustomRoleCreated, audit.WithContext(ctx, map[string]interface{}{
+		"customRole":  role.Name,
+		"permissions": role.Permissions.Strings(),
+	}))
+
+	jsonAPIResponseWithStatus(c, presenters.NewCustomRoleResource(role), "customRole", http.StatusCreated)
+}
+
+// Update replaces the description and permissions of a custom role.
+func (crc *CustomRolesController) Update(c *gin.Context) {
+	ctx := c.Request.Context()
+	var request CustomRoleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	role, err := clsession.NewCustomRole(c.Param("name"), request.Description, request.Permissions)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	// the previous permissions are only recorded in the audit log, so a failed lookup is left to UpdateCustomRole to report
+	var oldPermissions []string
+	if before, ferr := crc.App.AuthenticationProvider().FindCustomRole(ctx, role.Name); ferr == nil {
+		oldPermissions = before.Permissions.Strings()
+	}
+
+	if err = crc.App.AuthenticationProvider().UpdateCustomRole(ctx, &role); err != nil {
+		crc.jsonAPIError(c, err, "error updating custom role")
+		return
+	}
+
+	crc.App.GetAuditLogger().Audit(audit.CustomRoleUpdated, audit.WithContext(ctx, map[string]interface{}{
+		"customRole": role.Name,
+		"diff":       map[string]audit.Change{"permissions": {Before: oldPermissions, After: role.Permissions.Strings()}},
+	}))
+
+	jsonAPIResponse(c, presenters.NewCustomRoleResource(role), "customRole")
+}
+
+// Delete deletes a custom role, which must not be assigned to any API user.
+func (crc *CustomRolesController) Delete(c *gin.Context) {
+	ctx := c.Request.Context()
+	name := c.Param("name")
+
+	role, err := crc.App.AuthenticationProvider().FindCustomRole(ctx, name)
+	if err != nil {
+		crc.jsonAPIError(c, err, "error finding custom role")
+		return
+	}
+	if err = crc.App.AuthenticationProvider().DeleteCustomRole(ctx, name); err != nil {
+		crc.jsonAPIError(c, err, "error deleting custom role")
+		return
+	}
+
+	crc.App.GetAuditLogger().Audit(audit.CustomRoleDeleted, audit.WithContext(ctx, map[string]interface{}{
+		"customRole": role.Name,
+	}))
+
+	jsonAPIResponse(c, presenters.NewCustomRoleResource(role), "customRole")
+}
+
+// Assign assigns a custom role to an API user, or their API token.
+func (crc *CustomRolesController) Assign(c *gin.Context) {
+	ctx := c.Request.Context()
+	email := c.Param("email")
+	var request AssignCustomRoleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Don't allow current admin user to edit self
+	sessionUser, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+	if strings.EqualFold(sessionUser.Email, email) {
+		jsonAPIError(c, http.StatusBadRequest, errors.New("can not change state or permissions of current admin user"))
+		return
+	}
+
+	user, err := crc.App.AuthenticationProvider().SetCustomRole(ctx, email, request.CustomRole, request.Token)
+	if err != nil {
+		crc.jsonAPIError(c, err, "error assigning custom role")
+		return
+	}
+
+	crc.App.GetAuditLogger().Audit(audit.CustomRoleAssigned, audit.WithContext(ctx, map[string]interface{}{
+		"user":       user.Email,
+		"customRole": request.CustomRole,
+		"token":      request.Token,
+	}))
+
+	jsonAPIResponse(c, presenters.NewUserResource(user), "user")
+}
+
+func (crc *CustomRolesController) jsonAPIError(c *gin.Context, err error, msg string) {
+	switch {
+	case errors.Is(err, clsession.ErrNotSupported):
+		jsonAPIError(c, http.StatusBadRequest, errUnsupportedForAuth)
+	case errors.Is(err, clsession.ErrCustomRoleNotFound):
+		jsonAPIError(c, http.StatusNotFound, err)
+	case errors.Is(err, clsession.ErrCustomRoleAssigned):
+		jsonAPIError(c, http.StatusConflict, err)
+	default:
+		crc.App.GetLogger().Errorw(msg, "err", err)
+		jsonAPIError(c, http.StatusInternalServerError, errors.Wrap(err, msg))
+	}
+}
@@ -0,0 +1,100 @@
+package web_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestCustomRolesController(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(nil)
+	limited := &cltest.User{Role: sessions.UserRoleEdit}
+	limitedClient := app.NewHTTPClient(limited)
+
+	t.Run("creates roles", func(t *testing.T) {
+		body := `{"name": "bridges", "description": "manages bridges", "permissions": ["view:*", "edit:bridge_types"]}`
+		resp, cleanup := client.Post("/v2/roles", bytes.NewBufferString(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusCreated)
+		var role presenters.CustomRoleResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &role))
+		assert.Equal(t, []string{"view:*", "edit:bridge_types"}, role.Permissions)
+
+		resp, cleanup = client.Post("/v2/roles", bytes.NewBufferString(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+
+		resp, cleanup = client.Post("/v2/roles", bytes.NewBufferString(`{"name": "invalid", "permissions": ["export:config"]}`))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+
+		resp, cleanup = client.Get("/v2/roles")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+		var roles []presenters.CustomRoleResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &roles))
+		require.Len(t, roles, 1)
+	})
+
+	t.Run("enforces assigned roles", func(t *testing.T) {
+		resp, cleanup := client.Patch("/v2/users/"+limited.Email+"/custom_role", bytes.NewBufferString(`{"customRole": "missing"}`))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+
+		resp, cleanup = client.Patch("/v2/users/"+limited.Email+"/custom_role", bytes.NewBufferString(`{"customRole": "bridges"}`))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+		var user presenters.UserResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &user))
+		assert.Equal(t, "bridges", user.CustomRole)
+
+		resp, cleanup = limitedClient.Post("/v2/bridge_types", bytes.NewBufferString(`{}`))
+		t.Cleanup(cleanup)
+		assert.NotEqual(t, http.StatusForbidden, resp.StatusCode)
+
+		resp, cleanup = limitedClient.Post("/v2/external_initiators", bytes.NewBufferString(`{}`))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusForbidden)
+	})
+
+	t.Run("updates roles", func(t *testing.T) {
+		resp, cleanup := client.Patch("/v2/roles/bridges", bytes.NewBufferString(`{"permissions": ["view:*"]}`))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		resp, cleanup = limitedClient.Post("/v2/bridge_types", bytes.NewBufferString(`{}`))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusForbidden)
+
+		resp, cleanup = client.Patch("/v2/roles/missing", bytes.NewBufferString(`{"permissions": ["view:*"]}`))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+
+	t.Run("deletes unassigned roles", func(t *testing.T) {
+		resp, cleanup := client.Delete("/v2/roles/bridges")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusConflict)
+
+		resp, cleanup = client.Patch("/v2/users/"+limited.Email+"/custom_role", bytes.NewBufferString(`{"customRole": ""}`))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		resp, cleanup = client.Delete("/v2/roles/bridges")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+	})
+}
@@ -150,10 +150,10 @@ func (ekc *ETHKeysController) Create(c *gin.Context) {
 	c.Set("key", key)
 	c.Set("state", state)
 
-	ekc.app.GetAuditLogger().Audit(audit.KeyCreated, map[string]interface{}{
+	ekc.app.GetAuditLogger().Audit(audit.KeyCreated, audit.WithContext(c.Request.Context(), map[string]interface{}{
 		"type": "ethereum",
 		"id":   key.ID(),
-	})
+	}))
 }
 
 // Delete an ETH key bundle (irreversible!)
@@ -193,10 +193,10 @@ func (ekc *ETHKeysController) Delete(c *gin.Context) {
 	c.Set("key", key)
 	c.Set("state", state)
 
-	ekc.app.GetAuditLogger().Audit(audit.KeyDeleted, map[string]interface{}{
+	ekc.app.GetAuditLogger().Audit(audit.KeyDeleted, audit.WithContext(c.Request.Context(), map[string]interface{}{
 		"type": "ethereum",
 		"id":   keyID,
-	})
+	}))
 }
 
 // Import imports a key
@@ -232,10 +232,10 @@ func (ekc *ETHKeysController) Import(c *gin.Context) {
 	c.Set("state", state)
 	c.Status(http.StatusCreated)
 
-	ekc.app.GetAuditLogger().Audit(audit.KeyImported, map[string]interface{}{
+	ekc.app.GetAuditLogger().Audit(audit.KeyImported, audit.WithContext(c.Request.Context(), map[string]interface{}{
 		"type": "ethereum",
 		"id":   key.ID(),
-	})
+	}))
 }
 
 func (ekc *ETHKeysController) Export(c *gin.Context) {
@@ -250,10 +250,10 @@ func (ekc *ETHKeysController) Export(c *gin.Context) {
 		return
 	}
 
-	ekc.app.GetAuditLogger().Audit(audit.KeyExported, map[string]interface{}{
+	ekc.app.GetAuditLogger().Audit(audit.KeyExported, audit.WithContext(c.Request.Context(), map[string]interface{}{
 		"type": "ethereum",
 		"id":   id,
-	})
+	}))
 
 	c.Data(http.StatusOK, MediaType, bytes)
 }
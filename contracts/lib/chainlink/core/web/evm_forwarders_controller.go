@@ -64,11 +64,11 @@ func (cc *EVMForwardersController) Track(c *gin.Context) {
 		return
 	}
 
-	cc.App.GetAuditLogger().Audit(audit.ForwarderCreated, map[string]interface{}{
+	cc.App.GetAuditLogger().Audit(audit.ForwarderCreated, audit.WithContext(c.Request.Context(), map[string]interface{}{
 		"forwarderID":         fwd.ID,
 		"forwarderAddress":    fwd.Address,
 		"forwarderEVMChainID": fwd.EVMChainID,
-	})
+	}))
 	jsonAPIResponseWithStatus(c, presenters.NewEVMForwarderResource(fwd), "forwarder", http.StatusCreated)
 }
 
@@ -80,7 +80,9 @@ func (cc *EVMForwardersController) Delete(c *gin.Context) {
 		return
 	}
 
+	var deleted map[string]interface{}
 	filterCleanup := func(tx sqlutil.DataSource, evmChainID int64, addr common.Address) error {
+		deleted = map[string]interface{}{"address": addr, "evmChainID": evmChainID}
 		chain, err2 := cc.App.GetRelayers().LegacyEVMChains().Get(big.NewInt(evmChainID).String())
 		if err2 != nil {
 			// If the chain id doesn't even exist, or logpoller is disabled, then there isn't any filter to clean up.  Returning an error
@@ -103,6 +105,6 @@ func (cc *EVMForwardersController) Delete(c *gin.Context) {
 		return
 	}
 
-	cc.App.GetAuditLogger().Audit(audit.ForwarderDeleted, map[string]interface{}{"id": id})
+	cc.App.GetAuditLogger().Audit(audit.ForwarderDeleted, audit.WithContext(c.Request.Context(), map[string]interface{}{"id": id, "forwarder": deleted}))
 	jsonAPIResponseWithStatus(c, nil, "forwarder", http.StatusNoContent)
 }
@@ -4,7 +4,12 @@ import (
 	"database/sql"
 	"net/http"
 
+	"github.com/smartcontractkit/chainlink-integrations/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -47,3 +52,113 @@ func (tc *TransactionsController) Show(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(*ethTxAttempt), "transaction")
 }
+
+// Cancel replaces an unconfirmed Ethereum Transaction with a zero value
+// self-transfer at the same nonce, using bumped fees.
+// Example:
+//
+//	"<application>/transactions/evm/:TxHash/cancel"
+func (tc *TransactionsController) Cancel(c *gin.Context) {
+	hash := common.HexToHash(c.Param("TxHash"))
+
+	replacer, ok := tc.txReplacer(c, hash)
+	if !ok {
+		return
+	}
+	attempt, err := replacer.CancelTransaction(c, hash)
+	if err != nil {
+		replacementError(c, err)
+		return
+	}
+
+	tc.App.GetAuditLogger().Audit(audit.EthTransactionCancelled, audit.WithContext(c.Request.Context(), map[string]interface{}{
+		"txHash":      hash,
+		"attemptHash": attempt.Hash,
+	}))
+
+	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(attempt), "transaction")
+}
+
+// SpeedUp re-sends an unconfirmed Ethereum Transaction with the fees chosen
+// by the operator.
+// Example:
+//
+//	"<application>/transactions/evm/:TxHash/speedup"
+func (tc *TransactionsController) SpeedUp(c *gin.Context) {
+	hash := common.HexToHash(c.Param("TxHash"))
+
+	var req models.SpeedUpTransactionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	if req.GasPrice == nil && (req.GasTipCap == nil || req.GasFeeCap == nil) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("either gasPrice or both gasTipCap and gasFeeCap must be set"))
+		return
+	}
+
+	replacer, ok := tc.txReplacer(c, hash)
+	if !ok {
+		return
+	}
+	fee := gas.EvmFee{
+		GasPrice:   req.GasPrice,
+		DynamicFee: gas.DynamicFee{GasTipCap: req.GasTipCap, GasFeeCap: req.GasFeeCap},
+	}
+	attempt, err := replacer.SpeedUpTransaction(c, hash, fee, req.GasLimit)
+	if err != nil {
+		replacementError(c, err)
+		return
+	}
+
+	tc.App.GetAuditLogger().Audit(audit.EthTransactionSpedUp, audit.WithContext(c.Request.Context(), map[string]interface{}{
+		"txHash":      hash,
+		"attemptHash": attempt.Hash,
+		"fee":         attempt.TxFee,
+		"gasLimit":    attempt.ChainSpecificFeeLimit,
+	}))
+
+	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(attempt), "transaction")
+}
+
+func (tc *TransactionsController) txReplacer(c *gin.Context, hash common.Hash) (txmgr.TxReplacer, bool) {
+	etx, err := tc.App.TxmStorageService().FindTxByHash(c, hash)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+		return nil, false
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return nil, false
+	}
+
+	chain, err := getChain(tc.App.GetRelayers().LegacyEVMChains(), etx.ChainID.String())
+	if err != nil {
+		if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMultipleChains) || errors.Is(err, ErrMissingChainID) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return nil, false
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return nil, false
+	}
+
+	replacer, err := legacyevm.TxReplacer(chain)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return nil, false
+	}
+	return replacer, true
+}
+
+func replacementError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+	case errors.Is(err, txmgr.ErrTxNotReplaceable), errors.Is(err, txmgr.ErrReplacementPending):
+		jsonAPIError(c, http.StatusConflict, err)
+	case errors.Is(err, txmgr.ErrReplacementNotSupported):
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+	default:
+		jsonAPIError(c, http.StatusBadRequest, errors.Errorf("transaction replacement failed: %v", err))
+	}
+}
@@ -67,9 +67,10 @@ func (tc *EVMTransfersController) Create(c *gin.Context) {
 		return
 	}
 
-	tc.App.GetAuditLogger().Audit(audit.EthTransactionCreated, map[string]interface{}{
-		"ethTX": etx,
-	})
+	tc.App.GetAuditLogger().Audit(audit.EthTransactionCreated, audit.WithContext(c.Request.Context(), map[string]interface{}{
+		"ethTX":   etx,
+		"request": tr,
+	}))
 
 	// skip waiting for txmgr to create TxAttempt
 	if tr.SkipWaitTxAttempt {
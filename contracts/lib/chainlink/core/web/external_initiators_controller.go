@@ -89,11 +89,11 @@ func (eic *ExternalInitiatorsController) Create(c *gin.Context) {
 		return
 	}
 
-	eic.App.GetAuditLogger().Audit(audit.ExternalInitiatorCreated, map[string]interface{}{
+	eic.App.GetAuditLogger().Audit(audit.ExternalInitiatorCreated, audit.WithContext(c.Request.Context(), map[string]interface{}{
 		"externalInitiatorID":   ei.ID,
 		"externalInitiatorName": ei.Name,
 		"externalInitiatorURL":  ei.URL,
-	})
+	}))
 
 	resp := presenters.NewExternalInitiatorAuthentication(*ei, *eia)
 	jsonAPIResponseWithStatus(c, resp, "external initiator authentication", http.StatusCreated)
@@ -113,6 +113,11 @@ func (eic *ExternalInitiatorsController) Destroy(c *gin.Context) {
 		return
 	}
 
-	eic.App.GetAuditLogger().Audit(audit.ExternalInitiatorDeleted, map[string]interface{}{"name": name})
+	eic.App.GetAuditLogger().Audit(audit.ExternalInitiatorDeleted, audit.WithContext(c.Request.Context(), map[string]interface{}{
+		"name":                  name,
+		"externalInitiatorID":   exi.ID,
+		"externalInitiatorName": exi.Name,
+		"externalInitiatorURL":  exi.URL,
+	}))
 	jsonAPIResponseWithStatus(c, nil, "external initiator", http.StatusNoContent)
 }
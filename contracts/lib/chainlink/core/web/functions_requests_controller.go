@@ -0,0 +1,123 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/functions"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// FunctionsRequestsController lets operators inspect the lifecycle of the Functions requests of a contract.
+type FunctionsRequestsController struct {
+	App chainlink.Application
+}
+
+// Index lists the requests of a contract, most recently received first. The requests may be filtered by state, with
+// a comma separated list of states, and by the time window (RFC3339) they were received in.
+// Example:
+// "GET <application>/functions/requests?contract=0x...&state=Finalized,Confirmed&from=2024-01-01T00:00:00Z"
+func (frc *FunctionsRequestsController) Index(c *gin.Context, size, page, offset int) {
+	orm, ok := frc.orm(c)
+	if !ok {
+		return
+	}
+	filter, ok := requestFilter(c)
+	if !ok {
+		return
+	}
+
+	requests, count, err := orm.FindRequests(c.Request.Context(), filter, offset, size)
+	paginatedResponse(c, "functionsRequests", size, page, presenters.NewFunctionsRequestResources(requests), count, err)
+}
+
+// Show returns a request of a contract, with the time spent between each of its state transitions.
+// Example:
+// "GET <application>/functions/requests/:requestID?contract=0x..."
+func (frc *FunctionsRequestsController) Show(c *gin.Context) {
+	orm, ok := frc.orm(c)
+	if !ok {
+		return
+	}
+	requestID, err := functions.ParseRequestID(c.Param("requestID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	request, err := orm.FindById(c.Request.Context(), requestID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("request not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewFunctionsRequestResource(*request), "functionsRequests")
+}
+
+// Errors counts the failed requests of a contract per error type. The requests may be filtered as in Index.
+// Example:
+// "GET <application>/functions/request_errors?contract=0x...&from=2024-01-01T00:00:00Z"
+func (frc *FunctionsRequestsController) Errors(c *gin.Context) {
+	orm, ok := frc.orm(c)
+	if !ok {
+		return
+	}
+	filter, ok := requestFilter(c)
+	if !ok {
+		return
+	}
+
+	counts, err := orm.CountErrorTypes(c.Request.Context(), filter)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewFunctionsRequestErrorResources(counts), "functionsRequestErrors")
+}
+
+// orm returns the Functions ORM of the contract given in the query, or writes an error response.
+func (frc *FunctionsRequestsController) orm(c *gin.Context) (functions.ORM, bool) {
+	contract := c.Query("contract")
+	if !common.IsHexAddress(contract) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("bad contract address %q", contract))
+		return nil, false
+	}
+	return functions.NewORM(frc.App.GetDB(), common.HexToAddress(contract)), true
+}
+
+// requestFilter parses the state, from and to query parameters, or writes an error response.
+func requestFilter(c *gin.Context) (filter functions.RequestFilter, ok bool) {
+	if states := c.Query("state"); states != "" {
+		for _, s := range strings.Split(states, ",") {
+			state, err := functions.ParseRequestState(strings.TrimSpace(s))
+			if err != nil {
+				jsonAPIError(c, http.StatusUnprocessableEntity, err)
+				return filter, false
+			}
+			filter.States = append(filter.States, state)
+		}
+	}
+	var err error
+	if from := c.Query("from"); from != "" {
+		if filter.ReceivedAfter, err = time.Parse(time.RFC3339, from); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "bad from"))
+			return filter, false
+		}
+	}
+	if to := c.Query("to"); to != "" {
+		if filter.ReceivedBefore, err = time.Parse(time.RFC3339, to); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "bad to"))
+			return filter, false
+		}
+	}
+	return filter, true
+}
@@ -0,0 +1,94 @@
+package web_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/functions"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestFunctionsRequestsController(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+	client := app.NewHTTPClient(nil)
+
+	contract := testutils.NewAddress()
+	orm := functions.NewORM(app.GetDB(), contract)
+	receivedAt := time.Now().Add(-time.Minute).Round(time.Second)
+	var ids []functions.RequestID
+	for i := 0; i < 2; i++ {
+		id := functions.RequestID(testutils.Random32Byte())
+		txHash := common.Hash(testutils.Random32Byte())
+		require.NoError(t, orm.CreateRequest(ctx, &functions.Request{
+			RequestID:     id,
+			RequestTxHash: &txHash,
+			ReceivedAt:    receivedAt.Add(time.Duration(i) * time.Second),
+		}))
+		ids = append(ids, id)
+	}
+	require.NoError(t, orm.SetError(ctx, ids[0], functions.USER_ERROR, []byte("boom"), receivedAt.Add(5*time.Second), true))
+	require.NoError(t, orm.SetFinalized(ctx, ids[0], nil, []byte("boom")))
+
+	t.Run("lists requests", func(t *testing.T) {
+		resp, cleanup := client.Get(fmt.Sprintf("/v2/functions/requests?contract=%s&state=Finalized", contract.Hex()))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+		var requests []presenters.FunctionsRequestResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &requests))
+		require.Len(t, requests, 1)
+		assert.Equal(t, "0x"+ids[0].String(), requests[0].RequestID)
+		assert.Equal(t, "UserError", requests[0].ErrorType)
+		assert.Equal(t, "5s", requests[0].ResultDuration)
+		assert.NotEmpty(t, requests[0].FinalizeDuration)
+		assert.Empty(t, requests[0].ConfirmDuration)
+	})
+
+	t.Run("shows a request", func(t *testing.T) {
+		resp, cleanup := client.Get(fmt.Sprintf("/v2/functions/requests/0x%s?contract=%s", ids[1], contract.Hex()))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+		var request presenters.FunctionsRequestResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &request))
+		assert.Equal(t, "InProgress", request.State)
+	})
+
+	t.Run("counts errors", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/functions/request_errors?contract=" + contract.Hex())
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+		var errs []presenters.FunctionsRequestErrorResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &errs))
+		require.Len(t, errs, 1)
+		assert.Equal(t, 1, errs[0].Count)
+	})
+
+	for _, tc := range []struct {
+		name   string
+		path   string
+		status int
+	}{
+		{"missing contract", "/v2/functions/requests", http.StatusUnprocessableEntity},
+		{"bad state", "/v2/functions/requests?state=Done&contract=" + contract.Hex(), http.StatusUnprocessableEntity},
+		{"bad time", "/v2/functions/request_errors?from=yesterday&contract=" + contract.Hex(), http.StatusUnprocessableEntity},
+		{"bad request ID", "/v2/functions/requests/0x1234?contract=" + contract.Hex(), http.StatusUnprocessableEntity},
+		{"missing request", fmt.Sprintf("/v2/functions/requests/0x%s?contract=%s", ids[0], testutils.NewAddress().Hex()), http.StatusNotFound},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, cleanup := client.Get(tc.path)
+			t.Cleanup(cleanup)
+			cltest.AssertServerResponse(t, resp, tc.status)
+		})
+	}
+}
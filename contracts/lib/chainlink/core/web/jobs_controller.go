@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"math"
 	"net/http"
 	"strings"
 	"time"
@@ -32,6 +33,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -49,7 +51,7 @@ func (jc *JobsController) Index(c *gin.Context, size, page, offset int) {
 		size = 1000
 	}
 
-	jobs, count, err := jc.App.JobORM().FindJobs(c.Request.Context(), offset, size)
+	jobs, count, err := jc.findVisibleJobs(c, offset, size)
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
@@ -62,6 +64,23 @@ func (jc *JobsController) Index(c *gin.Context, size, page, offset int) {
 	paginatedResponse(c, "jobs", size, page, resources, count, err)
 }
 
+// findVisibleJobs returns a page of the jobs the authenticated user may view. Users with a custom role are only shown
+// the jobs it allows, so the page is taken after filtering all jobs.
+func (jc *JobsController) findVisibleJobs(c *gin.Context, offset, size int) ([]job.Job, int, error) {
+	user, ok := auth.GetAuthenticatedUser(c)
+	if !ok || len(user.RolePermissions) == 0 {
+		return jc.App.JobORM().FindJobs(c.Request.Context(), offset, size)
+	}
+	jobs, _, err := jc.App.JobORM().FindJobs(c.Request.Context(), 0, math.MaxInt32)
+	if err != nil {
+		return nil, 0, err
+	}
+	jobs = auth.VisibleJobs(user, jobs)
+	count := len(jobs)
+	jobs = jobs[min(offset, count):min(offset+size, count)]
+	return jobs, count, nil
+}
+
 // Show returns the details of a job
 // :ID could be both job ID and external job ID
 // Example:
@@ -127,7 +146,7 @@ func (jc *JobsController) Create(c *gin.Context) {
 
 	jbj, err := json.Marshal(jb)
 	if err == nil {
-		jc.App.GetAuditLogger().Audit(audit.JobCreated, map[string]interface{}{"job": string(jbj)})
+		jc.App.GetAuditLogger().Audit(audit.JobCreated, audit.WithContext(c.Request.Context(), map[string]interface{}{"job": string(jbj)}))
 	} else {
 		jc.App.GetLogger().Errorw("Could not send audit log for JobCreation", "err", err)
 	}
@@ -157,7 +176,7 @@ func (jc *JobsController) Delete(c *gin.Context) {
 		return
 	}
 
-	jc.App.GetAuditLogger().Audit(audit.JobDeleted, map[string]interface{}{"id": j.ID})
+	jc.App.GetAuditLogger().Audit(audit.JobDeleted, audit.WithContext(c.Request.Context(), map[string]interface{}{"id": j.ID}))
 	jsonAPIResponseWithStatus(c, nil, "job", http.StatusNoContent)
 }
 
@@ -191,6 +210,17 @@ func (jc *JobsController) Update(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
 	defer cancel()
 
+	// Some changes, e.g. the members of gateway DONs, are applied to the running job without restarting it.
+	updated, err := jc.App.JobSpawner().UpdateJob(ctx, nil, &jb)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if updated {
+		jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+		return
+	}
+
 	// If the provided job id is not matching any job, delete will fail with 404 leaving state unchanged.
 	err = jc.App.DeleteJob(ctx, jb.ID)
 	// Error can be either come from ORM or from the activeJobs map.
@@ -37,6 +37,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/tomlutils"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
@@ -567,6 +568,44 @@ func TestJobsController_Index_HappyPath(t *testing.T) {
 	runOCRJobSpecAssertions(t, ocrJobSpecFromFile, resources[1])
 }
 
+func TestJobsController_CustomRole(t *testing.T) {
+	app, client, ocrJob, jobID, _, jobID2 := setupJobSpecsControllerTestsWithJobs(t)
+
+	body := fmt.Sprintf(`{"name": "no-ocr", "permissions": ["view:*", "!view:jobs/%s"]}`, ocrJob.ExternalJobID)
+	resp, cleanup := client.Post("/v2/roles", bytes.NewBufferString(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+
+	limited := &cltest.User{Role: sessions.UserRoleView}
+	limitedClient := app.NewHTTPClient(limited)
+	resp, cleanup = client.Patch("/v2/users/"+limited.Email+"/custom_role", bytes.NewBufferString(`{"customRole": "no-ocr"}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	t.Run("denies the job by either ID", func(t *testing.T) {
+		for _, id := range []string{fmt.Sprint(jobID), ocrJob.ExternalJobID.String()} {
+			resp, cleanup := limitedClient.Get("/v2/jobs/" + id)
+			t.Cleanup(cleanup)
+			cltest.AssertServerResponse(t, resp, http.StatusForbidden)
+		}
+
+		resp, cleanup := limitedClient.Get(fmt.Sprintf("/v2/jobs/%d", jobID2))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+	})
+
+	t.Run("lists only the allowed jobs", func(t *testing.T) {
+		resp, cleanup := limitedClient.Get("/v2/jobs")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var resources []presenters.JobResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resources))
+		require.Len(t, resources, 1)
+		assert.Equal(t, fmt.Sprint(jobID2), resources[0].ID)
+	})
+}
+
 func TestJobsController_Show_HappyPath(t *testing.T) {
 	_, client, ocrJobSpecFromFile, jobID, ereJobSpecFromFile, jobID2 := setupJobSpecsControllerTestsWithJobs(t)
 
@@ -692,6 +731,44 @@ func TestJobsController_Update_HappyPath(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusOK)
 }
 
+func TestJobsController_Update_GatewayMembers(t *testing.T) {
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+	client := app.NewHTTPClient(nil)
+
+	externalJobID := uuid.New()
+	gatewaySpec := func(lastMember string) string {
+		return fmt.Sprintf(testspecs.GatewaySpec, externalJobID, "gateway", "0xABA5eDc1a551E55b1A570c0e1f1055e5BE11eca1",
+			"0xABA5eDc1a551E55b1A570c0e1f1055e5BE11eca2", "0xABA5eDc1a551E55b1A570c0e1f1055e5BE11eca3", lastMember, "/node", 0, "/user", 0)
+	}
+	body, err := json.Marshal(web.CreateJobRequest{TOML: gatewaySpec("0xABA5eDc1a551E55b1A570c0e1f1055e5BE11eca4")})
+	require.NoError(t, err)
+	response, cleanup := client.Post("/v2/jobs", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	var created presenters.JobResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &created))
+	jobID, err := strconv.ParseInt(created.ID, 10, 32)
+	require.NoError(t, err)
+	jb, err := app.JobORM().FindJob(ctx, int32(jobID))
+	require.NoError(t, err)
+
+	body, err = json.Marshal(web.UpdateJobRequest{TOML: gatewaySpec("0xABA5eDc1a551E55b1A570c0e1f1055e5BE11eca5")})
+	require.NoError(t, err)
+	response, cleanup = client.Put("/v2/jobs/"+created.ID, bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	// the members are applied to the running job, which keeps its spec
+	updated, err := app.JobORM().FindJob(ctx, jb.ID)
+	require.NoError(t, err)
+	assert.Equal(t, jb.GatewaySpecID, updated.GatewaySpecID)
+	assert.Contains(t, string(updated.GatewaySpec.GatewayConfig.Bytes()), "0xABA5eDc1a551E55b1A570c0e1f1055e5BE11eca5")
+	require.Contains(t, app.JobSpawner().ActiveJobs(), jb.ID)
+	assert.Equal(t, updated.GatewaySpec.GatewayConfig, app.JobSpawner().ActiveJobs()[jb.ID].GatewaySpec.GatewayConfig)
+}
+
 func TestJobsController_Update_NonExistentID(t *testing.T) {
 	ctx := testutils.Context(t)
 	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
@@ -0,0 +1,87 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// KeyBundleController exports and imports every key in the keystore at once
+type KeyBundleController struct {
+	App chainlink.Application
+}
+
+// Export exports every key into a single encrypted bundle
+// Example:
+// "POST <application>/keys/export-all?newpassword=<password>"
+func (ctrl *KeyBundleController) Export(c *gin.Context) {
+	defer ctrl.App.GetLogger().ErrorIfFn(c.Request.Body.Close, "Error closing Export request body")
+
+	newPassword := c.Query("newpassword")
+	if newPassword == "" {
+		jsonAPIError(c, http.StatusBadRequest, errors.New("newpassword is required"))
+		return
+	}
+
+	bytes, err := ctrl.App.GetKeyStore().ExportAll(c.Request.Context(), newPassword)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctrl.App.GetAuditLogger().Audit(audit.KeyBundleExported, audit.WithContext(c.Request.Context(), map[string]interface{}{}))
+	c.Data(http.StatusOK, MediaType, bytes)
+}
+
+// Import imports every key of a bundle created by Export. With dryRun set, the keys are only listed, along with
+// whether they conflict with existing keys.
+// Example:
+// "POST <application>/keys/import-all?oldpassword=<password>&dryRun=true"
+func (ctrl *KeyBundleController) Import(c *gin.Context) {
+	defer ctrl.App.GetLogger().ErrorIfFn(c.Request.Body.Close, "Error closing Import request body")
+
+	var dryRun bool
+	if s, has := c.GetQuery("dryRun"); has {
+		var err error
+		dryRun, err = strconv.ParseBool(s)
+		if err != nil {
+			jsonAPIError(c, http.StatusBadRequest, fmt.Errorf("invalid bool for dryRun: %v", err))
+			return
+		}
+	}
+
+	bytes, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	oldPassword := c.Query("oldpassword")
+	entries, err := ctrl.App.GetKeyStore().ImportAll(c.Request.Context(), bytes, oldPassword, dryRun)
+	if errors.Is(err, keystore.ErrKeyBundleConflict) {
+		jsonAPIError(c, http.StatusConflict, fmt.Errorf("%w: use a dry run to list the conflicting keys", err))
+		return
+	} else if errors.Is(err, keystore.ErrInvalidKeyBundle) {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	if !dryRun {
+		ctrl.App.GetAuditLogger().Audit(audit.KeyBundleImported, audit.WithContext(c.Request.Context(), map[string]interface{}{
+			"keys": len(entries),
+		}))
+	}
+
+	jsonAPIResponse(c, presenters.NewKeyBundleEntryResources(entries), "keyBundleEntries")
+}
@@ -0,0 +1,62 @@
+package web_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestKeyBundleController_ExportImport(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+	require.NoError(t, app.KeyStore.OCR().Add(ctx, cltest.DefaultOCRKey))
+	require.NoError(t, app.KeyStore.P2P().Add(ctx, cltest.DefaultP2PKey))
+	client := app.NewHTTPClient(nil)
+
+	response, cleanup := client.Post("/v2/keys/export-all", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusBadRequest)
+
+	response, cleanup = client.Post("/v2/keys/export-all?newpassword=bundlepassword", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	bundle, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+
+	response, cleanup = client.Post("/v2/keys/import-all?oldpassword=bundlepassword&dryRun=true", bytes.NewReader(bundle))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	var entries []presenters.KeyBundleEntryResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &entries))
+	require.NotEmpty(t, entries)
+	ids := map[string]string{}
+	for _, e := range entries {
+		// the single CSA and Workflow keys of the node are the same keys
+		single := e.KeyType == "CSA" || e.KeyType == "Workflow"
+		assert.Equal(t, !single, e.Conflict, e.KeyType)
+		assert.Equal(t, single, e.Exists, e.KeyType)
+		ids[e.KeyType] = e.ID
+	}
+	assert.Equal(t, cltest.DefaultOCRKey.ID(), ids["OCR"])
+	assert.Equal(t, cltest.DefaultP2PKey.ID(), ids["P2P"])
+
+	response, cleanup = client.Post("/v2/keys/import-all?oldpassword=bundlepassword", bytes.NewReader(bundle))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusConflict)
+
+	response, cleanup = client.Post("/v2/keys/import-all?oldpassword=wrongpassword&dryRun=true", bytes.NewReader(bundle))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusBadRequest)
+}
@@ -81,10 +81,10 @@ func (kc *keysController[K, R]) Create(c *gin.Context) {
 		return
 	}
 
-	kc.auditLogger.Audit(audit.KeyCreated, map[string]interface{}{
+	kc.auditLogger.Audit(audit.KeyCreated, audit.WithContext(c.Request.Context(), map[string]interface{}{
 		"type": kc.typ,
 		"id":   key.ID(),
-	})
+	}))
 
 	jsonAPIResponse(c, kc.newResource(key), kc.resourceName)
 }
@@ -103,10 +103,10 @@ func (kc *keysController[K, R]) Delete(c *gin.Context) {
 		return
 	}
 
-	kc.auditLogger.Audit(audit.KeyDeleted, map[string]interface{}{
+	kc.auditLogger.Audit(audit.KeyDeleted, audit.WithContext(c.Request.Context(), map[string]interface{}{
 		"type": kc.typ,
 		"id":   key.ID(),
-	})
+	}))
 
 	jsonAPIResponse(c, kc.newResource(key), kc.resourceName)
 }
@@ -127,10 +127,10 @@ func (kc *keysController[K, R]) Import(c *gin.Context) {
 		return
 	}
 
-	kc.auditLogger.Audit(audit.KeyImported, map[string]interface{}{
+	kc.auditLogger.Audit(audit.KeyImported, audit.WithContext(c.Request.Context(), map[string]interface{}{
 		"type": kc.typ,
 		"id":   key.ID(),
-	})
+	}))
 
 	jsonAPIResponse(c, kc.newResource(key), kc.resourceName)
 }
@@ -146,10 +146,10 @@ func (kc *keysController[K, R]) Export(c *gin.Context) {
 		return
 	}
 
-	kc.auditLogger.Audit(audit.KeyExported, map[string]interface{}{
+	kc.auditLogger.Audit(audit.KeyExported, audit.WithContext(c.Request.Context(), map[string]interface{}{
 		"type": kc.typ,
 		"id":   keyID,
-	})
+	}))
 
 	c.Data(http.StatusOK, MediaType, bytes)
 }
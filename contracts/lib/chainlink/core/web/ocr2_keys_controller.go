@@ -46,14 +46,14 @@ func (ocr2kc *OCR2KeysController) Create(c *gin.Context) {
 		return
 	}
 
-	ocr2kc.App.GetAuditLogger().Audit(audit.OCR2KeyBundleCreated, map[string]interface{}{
+	ocr2kc.App.GetAuditLogger().Audit(audit.OCR2KeyBundleCreated, audit.WithContext(c.Request.Context(), map[string]interface{}{
 		"ocr2KeyID":                        key.ID(),
 		"ocr2KeyChainType":                 key.ChainType(),
 		"ocr2KeyConfigEncryptionPublicKey": key.ConfigEncryptionPublicKey(),
 		"ocr2KeyOffchainPublicKey":         key.OffchainPublicKey(),
 		"ocr2KeyMaxSignatureLength":        key.MaxSignatureLength(),
 		"ocr2KeyPublicKey":                 key.PublicKey(),
-	})
+	}))
 	jsonAPIResponse(c, presenters.NewOCR2KeysBundleResource(key), "offChainReporting2KeyBundle")
 }
 
@@ -74,7 +74,7 @@ func (ocr2kc *OCR2KeysController) Delete(c *gin.Context) {
 		return
 	}
 
-	ocr2kc.App.GetAuditLogger().Audit(audit.OCR2KeyBundleDeleted, map[string]interface{}{"id": id})
+	ocr2kc.App.GetAuditLogger().Audit(audit.OCR2KeyBundleDeleted, audit.WithContext(c.Request.Context(), map[string]interface{}{"id": id}))
 	jsonAPIResponse(c, presenters.NewOCR2KeysBundleResource(key), "offChainReporting2KeyBundle")
 }
 
@@ -97,14 +97,14 @@ func (ocr2kc *OCR2KeysController) Import(c *gin.Context) {
 		return
 	}
 
-	ocr2kc.App.GetAuditLogger().Audit(audit.OCR2KeyBundleImported, map[string]interface{}{
+	ocr2kc.App.GetAuditLogger().Audit(audit.OCR2KeyBundleImported, audit.WithContext(c.Request.Context(), map[string]interface{}{
 		"ocr2KeyID":                        keyBundle.ID(),
 		"ocr2KeyChainType":                 keyBundle.ChainType(),
 		"ocr2KeyConfigEncryptionPublicKey": keyBundle.ConfigEncryptionPublicKey(),
 		"ocr2KeyOffchainPublicKey":         keyBundle.OffchainPublicKey(),
 		"ocr2KeyMaxSignatureLength":        keyBundle.MaxSignatureLength(),
 		"ocr2KeyPublicKey":                 keyBundle.PublicKey(),
-	})
+	}))
 
 	jsonAPIResponse(c, presenters.NewOCR2KeysBundleResource(keyBundle), "offChainReporting2KeyBundle")
 }
@@ -123,6 +123,6 @@ func (ocr2kc *OCR2KeysController) Export(c *gin.Context) {
 		return
 	}
 
-	ocr2kc.App.GetAuditLogger().Audit(audit.OCR2KeyBundleExported, map[string]interface{}{"keyID": stringID})
+	ocr2kc.App.GetAuditLogger().Audit(audit.OCR2KeyBundleExported, audit.WithContext(c.Request.Context(), map[string]interface{}{"keyID": stringID}))
 	c.Data(http.StatusOK, MediaType, bytes)
 }
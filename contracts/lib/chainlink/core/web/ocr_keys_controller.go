@@ -39,10 +39,10 @@ func (ocrkc *OCRKeysController) Create(c *gin.Context) {
 		return
 	}
 
-	ocrkc.App.GetAuditLogger().Audit(audit.OCRKeyBundleCreated, map[string]interface{}{
+	ocrkc.App.GetAuditLogger().Audit(audit.OCRKeyBundleCreated, audit.WithContext(c.Request.Context(), map[string]interface{}{
 		"ocrKeyBundleID":                      key.ID(),
 		"ocrKeyBundlePublicKeyAddressOnChain": key.PublicKeyAddressOnChain(),
-	})
+	}))
 	jsonAPIResponse(c, presenters.NewOCRKeysBundleResource(key), "offChainReportingKeyBundle")
 }
 
@@ -64,7 +64,7 @@ func (ocrkc *OCRKeysController) Delete(c *gin.Context) {
 		return
 	}
 
-	ocrkc.App.GetAuditLogger().Audit(audit.OCRKeyBundleDeleted, map[string]interface{}{"id": id})
+	ocrkc.App.GetAuditLogger().Audit(audit.OCRKeyBundleDeleted, audit.WithContext(c.Request.Context(), map[string]interface{}{"id": id}))
 	jsonAPIResponse(c, presenters.NewOCRKeysBundleResource(key), "offChainReportingKeyBundle")
 }
 
@@ -87,11 +87,11 @@ func (ocrkc *OCRKeysController) Import(c *gin.Context) {
 		return
 	}
 
-	ocrkc.App.GetAuditLogger().Audit(audit.OCRKeyBundleImported, map[string]interface{}{
+	ocrkc.App.GetAuditLogger().Audit(audit.OCRKeyBundleImported, audit.WithContext(c.Request.Context(), map[string]interface{}{
 		"OCRID":                      encryptedOCRKeyBundle.GetID(),
 		"OCRPublicKeyAddressOnChain": encryptedOCRKeyBundle.PublicKeyAddressOnChain(),
 		"OCRPublicKeyOffChain":       encryptedOCRKeyBundle.PublicKeyOffChain(),
-	})
+	}))
 
 	jsonAPIResponse(c, encryptedOCRKeyBundle, "offChainReportingKeyBundle")
 }
@@ -110,6 +110,6 @@ func (ocrkc *OCRKeysController) Export(c *gin.Context) {
 		return
 	}
 
-	ocrkc.App.GetAuditLogger().Audit(audit.OCRKeyBundleExported, map[string]interface{}{"keyID": stringID})
+	ocrkc.App.GetAuditLogger().Audit(audit.OCRKeyBundleExported, audit.WithContext(c.Request.Context(), map[string]interface{}{"keyID": stringID}))
 	c.Data(http.StatusOK, MediaType, bytes)
 }
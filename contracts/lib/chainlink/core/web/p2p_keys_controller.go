@@ -42,13 +42,13 @@ func (p2pkc *P2PKeysController) Create(c *gin.Context) {
 		return
 	}
 
-	p2pkc.App.GetAuditLogger().Audit(audit.KeyCreated, map[string]interface{}{
+	p2pkc.App.GetAuditLogger().Audit(audit.KeyCreated, audit.WithContext(c.Request.Context(), map[string]interface{}{
 		"type":         "p2p",
 		"id":           key.ID(),
 		"p2pPublicKey": key.PublicKeyHex(),
 		"p2pPeerID":    key.PeerID(),
 		"p2pType":      keyType,
-	})
+	}))
 	jsonAPIResponse(c, presenters.NewP2PKeyResource(key), "p2pKey")
 }
 
@@ -74,10 +74,10 @@ func (p2pkc *P2PKeysController) Delete(c *gin.Context) {
 		return
 	}
 
-	p2pkc.App.GetAuditLogger().Audit(audit.KeyDeleted, map[string]interface{}{
+	p2pkc.App.GetAuditLogger().Audit(audit.KeyDeleted, audit.WithContext(c.Request.Context(), map[string]interface{}{
 		"type": "p2p",
 		"id":   keyID,
-	})
+	}))
 
 	jsonAPIResponse(c, presenters.NewP2PKeyResource(key), "p2pKey")
 }
@@ -101,13 +101,13 @@ func (p2pkc *P2PKeysController) Import(c *gin.Context) {
 		return
 	}
 
-	p2pkc.App.GetAuditLogger().Audit(audit.KeyImported, map[string]interface{}{
+	p2pkc.App.GetAuditLogger().Audit(audit.KeyImported, audit.WithContext(c.Request.Context(), map[string]interface{}{
 		"type":         "p2p",
 		"id":           key.ID(),
 		"p2pPublicKey": key.PublicKeyHex(),
 		"p2pPeerID":    key.PeerID(),
 		"p2pType":      keyType,
-	})
+	}))
 
 	jsonAPIResponse(c, presenters.NewP2PKeyResource(key), "p2pKey")
 }
@@ -131,10 +131,10 @@ func (p2pkc *P2PKeysController) Export(c *gin.Context) {
 		return
 	}
 
-	p2pkc.App.GetAuditLogger().Audit(audit.KeyExported, map[string]interface{}{
+	p2pkc.App.GetAuditLogger().Audit(audit.KeyExported, audit.WithContext(c.Request.Context(), map[string]interface{}{
 		"type": "p2p",
 		"id":   keyID,
-	})
+	}))
 
 	c.Data(http.StatusOK, MediaType, bytes)
 }
@@ -1,6 +1,7 @@
 package web
 
 import (
+	"database/sql"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -16,10 +17,14 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/v2/core/static"
 	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
+// maxIdempotencyKeyLength is the maximum length of the idempotency key of a webhook job run request.
+const maxIdempotencyKeyLength = 255
+
 // PipelineRunsController manages V2 job run requests.
 type PipelineRunsController struct {
 	App chainlink.Application
@@ -109,34 +114,45 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 
 	user, isUser := auth.GetAuthenticatedUser(c)
 	ei, _ := auth.GetAuthenticatedExternalInitiator(c)
-	authorizer := webhook.NewAuthorizer(prc.App.GetDB(), user, ei)
+	token, _ := auth.GetAuthenticatedWebhookTriggerToken(c)
+	authorizer := webhook.NewAuthorizer(prc.App.GetDB(), user, ei, token)
 
 	// Is it a UUID? Then process it as a webhook job
 	jobUUID, err := uuid.Parse(idStr)
 	if err == nil {
+		idempotencyKey := c.GetHeader(static.IdempotencyKeyHeader)
+		if len(idempotencyKey) > maxIdempotencyKeyLength {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("%s must be at most %d characters", static.IdempotencyKeyHeader, maxIdempotencyKeyLength))
+			return
+		}
 		canRun, err2 := authorizer.CanRun(ctx, prc.App.GetConfig().JobPipeline(), jobUUID)
 		if err2 != nil {
 			jsonAPIError(c, http.StatusInternalServerError, err2)
 			return
 		}
 		if canRun {
-			jobRunID, err3 := prc.App.RunWebhookJobV2(ctx, jobUUID, string(bodyBytes), jsonserializable.JSONSerializable{})
+			jobRunID, err3 := prc.App.RunWebhookJobV2(ctx, jobUUID, string(bodyBytes), jsonserializable.JSONSerializable{}, idempotencyKey)
 			if errors.Is(err3, webhook.ErrJobNotExists) {
 				jsonAPIError(c, http.StatusNotFound, err3)
 				return
+			} else if errors.Is(err3, webhook.ErrIdempotencyKeyInProgress) {
+				jsonAPIError(c, http.StatusConflict, err3)
+				return
 			} else if err3 != nil {
 				jsonAPIError(c, http.StatusInternalServerError, err3)
 				return
 			}
 			respondWithPipelineRun(jobRunID)
+		} else if token != nil {
+			jsonAPIError(c, http.StatusUnauthorized, errors.Errorf("webhook trigger token %s is not allowed to run job %s", token.AccessKey, jobUUID))
 		} else {
 			jsonAPIError(c, http.StatusUnauthorized, errors.Errorf("external initiator %s is not allowed to run job %s", ei.Name, jobUUID))
 		}
 		return
 	}
 
-	// only users are allowed to run jobs using int IDs - EIs not allowed
-	if isUser {
+	// only users are allowed to run jobs using int IDs - EIs and trigger tokens not allowed
+	if isUser && token == nil {
 		// Is it an int32? Then process it regardless of type
 		var jobID int32
 		jobID64, err := strconv.ParseInt(idStr, 10, 32)
@@ -155,6 +171,69 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 	jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("bad job ID"))
 }
 
+// Simulate dry-runs the pipeline of a job against the given vars and returns
+// a trace of every task. Nothing is persisted.
+// Example:
+// "POST <application>/jobs/:ID/simulate"
+func (prc *PipelineRunsController) Simulate(c *gin.Context) {
+	jobID, err := strconv.ParseInt(c.Param("ID"), 10, 32)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("bad job ID"))
+		return
+	}
+
+	var req pipeline.SimulateRequest
+	if c.Request.ContentLength != 0 {
+		if err = json.NewDecoder(c.Request.Body).Decode(&req); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to unmarshal JSON body"))
+			return
+		}
+	}
+
+	sim, err := prc.App.SimulateJobV2(c.Request.Context(), int32(jobID), req.Vars, req.Stubs)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Job not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	prc.App.GetAuditLogger().Audit(audit.JobSimulated, audit.WithContext(c.Request.Context(), map[string]interface{}{"jobID": jobID}))
+	jsonAPIResponse(c, presenters.NewSimulationResource(int32(jobID), *sim, prc.App.GetLogger()), "simulation")
+}
+
+// Prune deletes the runs of a job which are outside of its run retention policy.
+// Example:
+// "DELETE <application>/jobs/:ID/runs"
+func (prc *PipelineRunsController) Prune(c *gin.Context) {
+	jobID, err := strconv.ParseInt(c.Param("ID"), 10, 32)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("bad job ID"))
+		return
+	}
+
+	ctx := c.Request.Context()
+	if _, err = prc.App.JobORM().FindJob(ctx, int32(jobID)); err != nil {
+		if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("Job not found"))
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	deleted, err := prc.App.PipelineORM().PruneRuns(ctx, int32(jobID))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	prc.App.GetAuditLogger().Audit(audit.JobRunsPruned, audit.WithContext(c.Request.Context(), map[string]interface{}{"jobID": jobID, "deleted": deleted}))
+	jsonAPIResponse(c, presenters.NewPrunedRunsResource(int32(jobID), deleted), "prunedRuns")
+}
+
 // Resume finishes a task and resumes the pipeline run.
 // Example:
 // "PATCH <application>/jobs/:ID/runs/:runID"
@@ -183,6 +262,6 @@ func (prc *PipelineRunsController) Resume(c *gin.Context) {
 		return
 	}
 
-	prc.App.GetAuditLogger().Audit(audit.UnauthedRunResumed, map[string]interface{}{"runID": c.Param("runID")})
+	prc.App.GetAuditLogger().Audit(audit.UnauthedRunResumed, audit.WithContext(c.Request.Context(), map[string]interface{}{"runID": c.Param("runID")}))
 	c.Status(http.StatusOK)
 }
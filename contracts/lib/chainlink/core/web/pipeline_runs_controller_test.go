@@ -1,6 +1,7 @@
 package web_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -250,6 +251,61 @@ func TestPipelineRunsController_ShowRun_InvalidID(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
 }
 
+func TestPipelineRunsController_Simulate_HappyPath(t *testing.T) {
+	client, jobID, _ := setupPipelineRunsControllerTests(t)
+
+	body := `{"vars": {"foo": "bar"}}`
+	response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%d/simulate", jobID), bytes.NewBufferString(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	var parsedResponse presenters.SimulationResource
+	err := web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &parsedResponse)
+	require.NoError(t, err)
+	assert.Equal(t, strconv.Itoa(int(jobID)), parsedResponse.ID)
+	require.Len(t, parsedResponse.Trace, 8)
+	require.Len(t, parsedResponse.Outputs, 1)
+	assert.Equal(t, "3", *parsedResponse.Outputs[0])
+
+	var failed []string
+	for _, tt := range parsedResponse.Trace {
+		if tt.Error != nil {
+			failed = append(failed, tt.DotID)
+		}
+	}
+	assert.Equal(t, []string{"ds3"}, failed)
+
+	response, cleanup = client.Post("/v2/jobs/12345/simulate", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
+func TestPipelineRunsController_Prune(t *testing.T) {
+	client, jobID, runIDs := setupPipelineRunsControllerTests(t)
+
+	response, cleanup := client.Delete(fmt.Sprintf("/v2/jobs/%d/runs", jobID))
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	var parsedResponse presenters.PrunedRunsResource
+	err := web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &parsedResponse)
+	require.NoError(t, err)
+	assert.Equal(t, strconv.Itoa(int(jobID)), parsedResponse.ID)
+	// the job has no run retention policy, and its runs are well below the node wide limit
+	assert.Zero(t, parsedResponse.Deleted)
+
+	response, cleanup = client.Get(fmt.Sprintf("/v2/jobs/%d/runs", jobID))
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	var runs []presenters.PipelineRunResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &runs))
+	assert.Len(t, runs, len(runIDs))
+
+	response, cleanup = client.Delete("/v2/jobs/12345/runs")
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
 func setupPipelineRunsControllerTests(t *testing.T) (cltest.HTTPClientCleaner, int32, []int64) {
 	t.Parallel()
 	ctx := testutils.Context(t)
@@ -5,6 +5,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
 // BridgeResource represents a Bridge JSONAPI resource.
@@ -14,10 +15,19 @@ type BridgeResource struct {
 	URL           string `json:"url"`
 	Confirmations uint32 `json:"confirmations"`
 	// The IncomingToken is only provided when creating a Bridge
-	IncomingToken          string       `json:"incomingToken,omitempty"`
-	OutgoingToken          string       `json:"outgoingToken"`
-	MinimumContractPayment *assets.Link `json:"minimumContractPayment"`
-	CreatedAt              time.Time    `json:"createdAt"`
+	IncomingToken           string          `json:"incomingToken,omitempty"`
+	OutgoingToken           string          `json:"outgoingToken"`
+	MinimumContractPayment  *assets.Link    `json:"minimumContractPayment"`
+	CacheFreshTTL           models.Interval `json:"cacheFreshTTL"`
+	CacheStaleTTL           models.Interval `json:"cacheStaleTTL"`
+	CoalesceRequests        bool            `json:"coalesceRequests"`
+	BackupURLs              []string        `json:"backupURLs"`
+	HealthCheckPath         string          `json:"healthCheckPath"`
+	CircuitBreakerThreshold uint32          `json:"circuitBreakerThreshold"`
+	CircuitBreakerCooldown  models.Interval `json:"circuitBreakerCooldown"`
+	CreatedAt               time.Time       `json:"createdAt"`
+	// Health is only provided when showing a single Bridge
+	Health []bridges.URLHealth `json:"health,omitempty"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -29,12 +39,19 @@ func (r BridgeResource) GetName() string {
 func NewBridgeResource(b bridges.BridgeType) *BridgeResource {
 	return &BridgeResource{
 		// Uses the name as the id...Should change this to the id
-		JAID:                   NewJAID(b.Name.String()),
-		Name:                   b.Name.String(),
-		URL:                    b.URL.String(),
-		Confirmations:          b.Confirmations,
-		OutgoingToken:          b.OutgoingToken,
-		MinimumContractPayment: b.MinimumContractPayment,
-		CreatedAt:              b.CreatedAt,
+		JAID:                    NewJAID(b.Name.String()),
+		Name:                    b.Name.String(),
+		URL:                     b.URL.String(),
+		Confirmations:           b.Confirmations,
+		OutgoingToken:           b.OutgoingToken,
+		MinimumContractPayment:  b.MinimumContractPayment,
+		CacheFreshTTL:           b.CacheFreshTTL,
+		CacheStaleTTL:           b.CacheStaleTTL,
+		CoalesceRequests:        b.CoalesceRequests,
+		BackupURLs:              append([]string{}, b.BackupURLs...),
+		HealthCheckPath:         b.HealthCheckPath,
+		CircuitBreakerThreshold: b.CircuitBreakerThreshold,
+		CircuitBreakerCooldown:  b.CircuitBreakerCooldown,
+		CreatedAt:               b.CreatedAt,
 	}
 }
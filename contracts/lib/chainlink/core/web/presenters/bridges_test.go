@@ -27,7 +27,18 @@ func TestBridgeResource(t *testing.T) {
 		Confirmations:          1,
 		OutgoingToken:          "vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 		MinimumContractPayment: assets.NewLinkFromJuels(1),
-		CreatedAt:              timestamp,
+		CachePolicy: bridges.CachePolicy{
+			CacheFreshTTL:    models.Interval(time.Minute),
+			CacheStaleTTL:    models.Interval(30 * time.Second),
+			CoalesceRequests: true,
+		},
+		FailoverPolicy: bridges.FailoverPolicy{
+			BackupURLs:              []string{"https://backup.example.com/api"},
+			HealthCheckPath:         "/health",
+			CircuitBreakerThreshold: 3,
+			CircuitBreakerCooldown:  models.Interval(time.Minute),
+		},
+		CreatedAt: timestamp,
 	}
 
 	r := NewBridgeResource(bridge)
@@ -46,6 +57,13 @@ func TestBridgeResource(t *testing.T) {
 			"confirmations":1,
 			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 			"minimumContractPayment":"1",
+			"cacheFreshTTL":"1m0s",
+			"cacheStaleTTL":"30s",
+			"coalesceRequests":true,
+			"backupURLs":["https://backup.example.com/api"],
+			"healthCheckPath":"/health",
+			"circuitBreakerThreshold":3,
+			"circuitBreakerCooldown":"1m0s",
 			"createdAt":"2000-01-01T00:00:00Z"
 		}
 	}
@@ -71,6 +89,13 @@ func TestBridgeResource(t *testing.T) {
 			"incomingToken": "cd+OfGXy3UHEDAlD0y27F6/rJE14X1UI",
 			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 			"minimumContractPayment":"1",
+			"cacheFreshTTL":"1m0s",
+			"cacheStaleTTL":"30s",
+			"coalesceRequests":true,
+			"backupURLs":["https://backup.example.com/api"],
+			"healthCheckPath":"/health",
+			"circuitBreakerThreshold":3,
+			"circuitBreakerCooldown":"1m0s",
 			"createdAt":"2000-01-01T00:00:00Z"
 		}
 	}
@@ -0,0 +1,43 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+// CustomRoleResource represents a CustomRole JSONAPI resource.
+type CustomRoleResource struct {
+	JAID
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Permissions []string  `json:"permissions"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r CustomRoleResource) GetName() string {
+	return "customRoles"
+}
+
+// NewCustomRoleResource constructs a new CustomRoleResource.
+func NewCustomRoleResource(role sessions.CustomRole) *CustomRoleResource {
+	return &CustomRoleResource{
+		JAID:        NewJAID(role.Name),
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: role.Permissions.Strings(),
+		CreatedAt:   role.CreatedAt,
+		UpdatedAt:   role.UpdatedAt,
+	}
+}
+
+// NewCustomRoleResources constructs a slice of CustomRoleResources.
+func NewCustomRoleResources(roles []sessions.CustomRole) []CustomRoleResource {
+	rs := []CustomRoleResource{}
+	for _, role := range roles {
+		rs = append(rs, *NewCustomRoleResource(role))
+	}
+	return rs
+}
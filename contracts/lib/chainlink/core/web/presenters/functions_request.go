@@ -0,0 +1,118 @@
+package presenters
+
+import (
+	"sort"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/functions"
+)
+
+// FunctionsRequestResource represents a Functions request JSONAPI resource. The durations are the time spent between
+// the state transitions of the request, and are empty until the transition happened.
+type FunctionsRequestResource struct {
+	JAID
+	RequestID        string     `json:"requestID"`
+	RequestTxHash    string     `json:"requestTxHash"`
+	State            string     `json:"state"`
+	ErrorType        string     `json:"errorType"`
+	Error            string     `json:"error"`
+	ResultSize       int        `json:"resultSize"`
+	CallbackGasLimit *uint32    `json:"callbackGasLimit"`
+	ReceivedAt       time.Time  `json:"receivedAt"`
+	ResultReadyAt    *time.Time `json:"resultReadyAt"`
+	FinalizedAt      *time.Time `json:"finalizedAt"`
+	ConfirmedAt      *time.Time `json:"confirmedAt"`
+	TimedOutAt       *time.Time `json:"timedOutAt"`
+	// ResultDuration is the time from receiving the request to its result, or error, being ready.
+	ResultDuration string `json:"resultDuration"`
+	// FinalizeDuration is the time from the previous transition to the DON finalizing the report.
+	FinalizeDuration string `json:"finalizeDuration"`
+	// ConfirmDuration is the time from the previous transition to the response being confirmed on-chain.
+	ConfirmDuration string `json:"confirmDuration"`
+	// TimeoutDuration is the time from the previous transition to the request timing out.
+	TimeoutDuration string `json:"timeoutDuration"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r FunctionsRequestResource) GetName() string {
+	return "functionsRequests"
+}
+
+// NewFunctionsRequestResource constructs a new FunctionsRequestResource.
+func NewFunctionsRequestResource(request functions.Request) FunctionsRequestResource {
+	id := "0x" + request.RequestID.String()
+	r := FunctionsRequestResource{
+		JAID:             NewJAID(id),
+		RequestID:        id,
+		State:            request.State.String(),
+		Error:            string(request.Error),
+		ResultSize:       len(request.Result),
+		CallbackGasLimit: request.CallbackGasLimit,
+		ReceivedAt:       request.ReceivedAt,
+		ResultReadyAt:    request.ResultReadyAt,
+		FinalizedAt:      request.FinalizedAt,
+		ConfirmedAt:      request.ConfirmedAt,
+		TimedOutAt:       request.TimedOutAt,
+	}
+	if request.RequestTxHash != nil {
+		r.RequestTxHash = request.RequestTxHash.Hex()
+	}
+	if request.ErrorType != nil && *request.ErrorType != functions.NONE {
+		r.ErrorType = request.ErrorType.String()
+	}
+
+	durations := request.TransitionDurations()
+	r.ResultDuration = formatDuration(durations.Result)
+	r.FinalizeDuration = formatDuration(durations.Finalize)
+	r.ConfirmDuration = formatDuration(durations.Confirm)
+	r.TimeoutDuration = formatDuration(durations.Timeout)
+	return r
+}
+
+// formatDuration formats the duration of a state transition, which is empty until the transition happened.
+func formatDuration(d *time.Duration) string {
+	if d == nil {
+		return ""
+	}
+	return d.String()
+}
+
+// NewFunctionsRequestResources constructs a slice of FunctionsRequestResources.
+func NewFunctionsRequestResources(requests []functions.Request) []FunctionsRequestResource {
+	rs := []FunctionsRequestResource{}
+	for _, request := range requests {
+		rs = append(rs, NewFunctionsRequestResource(request))
+	}
+	return rs
+}
+
+// FunctionsRequestErrorResource represents the number of failed Functions requests per error type JSONAPI resource.
+type FunctionsRequestErrorResource struct {
+	JAID
+	ErrorType string `json:"errorType"`
+	Count     int    `json:"count"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r FunctionsRequestErrorResource) GetName() string {
+	return "functionsRequestErrors"
+}
+
+// NewFunctionsRequestErrorResources constructs a slice of FunctionsRequestErrorResources, ordered by error type.
+func NewFunctionsRequestErrorResources(counts map[functions.ErrType]int) []FunctionsRequestErrorResource {
+	errTypes := make([]functions.ErrType, 0, len(counts))
+	for errType := range counts {
+		errTypes = append(errTypes, errType)
+	}
+	sort.Slice(errTypes, func(i, j int) bool { return errTypes[i] < errTypes[j] })
+
+	rs := []FunctionsRequestErrorResource{}
+	for _, errType := range errTypes {
+		rs = append(rs, FunctionsRequestErrorResource{
+			JAID:      NewJAID(errType.String()),
+			ErrorType: errType.String(),
+			Count:     counts[errType],
+		})
+	}
+	return rs
+}
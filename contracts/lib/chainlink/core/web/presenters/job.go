@@ -240,33 +240,49 @@ func NewKeeperSpec(spec *job.KeeperSpec) *KeeperSpec {
 
 // WebhookSpec defines the spec details of a Webhook Job
 type WebhookSpec struct {
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	IdempotencyWindow   models.Interval `json:"idempotencyWindow"`
+	IdempotencyKeyLease models.Interval `json:"idempotencyKeyLease"`
+	CreatedAt           time.Time       `json:"createdAt"`
+	UpdatedAt           time.Time       `json:"updatedAt"`
 }
 
 // NewWebhookSpec generates a new WebhookSpec from a job.WebhookSpec
 func NewWebhookSpec(spec *job.WebhookSpec) *WebhookSpec {
 	return &WebhookSpec{
-		CreatedAt: spec.CreatedAt,
-		UpdatedAt: spec.UpdatedAt,
+		IdempotencyWindow:   spec.IdempotencyWindow,
+		IdempotencyKeyLease: spec.IdempotencyKeyLease,
+		CreatedAt:           spec.CreatedAt,
+		UpdatedAt:           spec.UpdatedAt,
 	}
 }
 
 // CronSpec defines the spec details of a Cron Job
 type CronSpec struct {
-	CronSchedule string    `json:"schedule"`
-	CreatedAt    time.Time `json:"createdAt"`
-	UpdatedAt    time.Time `json:"updatedAt"`
-	EVMChainID   *big.Big  `json:"evmChainID"`
+	CronSchedule      string                `json:"schedule"`
+	TimeZone          string                `json:"timeZone"`
+	MissedRunPolicy   job.MissedRunPolicy   `json:"missedRunPolicy"`
+	MaxMissedRuns     uint32                `json:"maxMissedRuns"`
+	ConcurrencyPolicy job.ConcurrencyPolicy `json:"concurrencyPolicy"`
+	Jitter            models.Interval       `json:"jitter"`
+	LastFiredAt       *time.Time            `json:"lastFiredAt"`
+	CreatedAt         time.Time             `json:"createdAt"`
+	UpdatedAt         time.Time             `json:"updatedAt"`
+	EVMChainID        *big.Big              `json:"evmChainID"`
 }
 
 // NewCronSpec generates a new CronSpec from a job.CronSpec
 func NewCronSpec(spec *job.CronSpec) *CronSpec {
 	return &CronSpec{
-		CronSchedule: spec.CronSchedule,
-		CreatedAt:    spec.CreatedAt,
-		UpdatedAt:    spec.UpdatedAt,
-		EVMChainID:   spec.EVMChainID,
+		CronSchedule:      spec.CronSchedule,
+		TimeZone:          spec.TimeZone,
+		MissedRunPolicy:   spec.MissedRunPolicy,
+		MaxMissedRuns:     spec.MaxMissedRuns,
+		ConcurrencyPolicy: spec.ConcurrencyPolicy,
+		Jitter:            spec.Jitter,
+		LastFiredAt:       spec.LastFiredAt,
+		CreatedAt:         spec.CreatedAt,
+		UpdatedAt:         spec.UpdatedAt,
+		EVMChainID:        spec.EVMChainID,
 	}
 }
 
@@ -536,9 +552,29 @@ type JobResource struct {
 	StandardCapabilitiesSpec *StandardCapabilitiesSpec `json:"standardCapabilitiesSpec"`
 	CCIPSpec                 *CCIPSpec                 `json:"ccipSpec"`
 	PipelineSpec             PipelineSpec              `json:"pipelineSpec"`
+	RunRetention             *RunRetention             `json:"runRetention,omitempty"`
 	Errors                   []JobError                `json:"errors"`
 }
 
+// RunRetention defines the retention policy for the runs of a job
+type RunRetention struct {
+	MaxRuns         clnull.Uint32   `json:"maxRuns"`
+	MaxAge          models.Interval `json:"maxAge"`
+	KeepErroredOnly bool            `json:"keepErroredOnly"`
+}
+
+// NewRunRetention generates a new RunRetention from a pipeline.RunRetention, or nil if the job has no policy
+func NewRunRetention(r pipeline.RunRetention) *RunRetention {
+	if r == (pipeline.RunRetention{}) {
+		return nil
+	}
+	return &RunRetention{
+		MaxRuns:         r.RetentionMaxRuns,
+		MaxAge:          r.RetentionMaxAge,
+		KeepErroredOnly: r.RetentionKeepErroredOnly,
+	}
+}
+
 // NewJobResource initializes a new JSONAPI job resource
 func NewJobResource(j job.Job) *JobResource {
 	resource := &JobResource{
@@ -552,6 +588,7 @@ func NewJobResource(j job.Job) *JobResource {
 		MaxTaskDuration:   j.MaxTaskDuration,
 		PipelineSpec:      NewPipelineSpec(j.PipelineSpec),
 		ExternalJobID:     j.ExternalJobID,
+		RunRetention:      NewRunRetention(j.RunRetention),
 	}
 
 	switch j.Type {
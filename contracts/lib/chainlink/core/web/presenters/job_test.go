@@ -375,10 +375,15 @@ func TestJob(t *testing.T) {
 			job: job.Job{
 				ID: 1,
 				CronSpec: &job.CronSpec{
-					CronSchedule: cronSchedule,
-					CreatedAt:    timestamp,
-					UpdatedAt:    timestamp,
-					EVMChainID:   evmChainID,
+					CronSchedule:      cronSchedule,
+					MissedRunPolicy:   job.MissedRunAll,
+					MaxMissedRuns:     5,
+					ConcurrencyPolicy: job.ConcurrencyForbid,
+					Jitter:            models.Interval(10 * time.Second),
+					LastFiredAt:       &timestamp,
+					CreatedAt:         timestamp,
+					UpdatedAt:         timestamp,
+					EVMChainID:        evmChainID,
 				},
 				ExternalJobID: uuid.MustParse("0EEC7E1D-D0D2-476C-A1A8-72DFB6633F46"),
 				PipelineSpec: &pipeline.Spec{
@@ -408,6 +413,12 @@ func TestJob(t *testing.T) {
                         },
                         "cronSpec": {
                             "schedule": "%s",
+                            "timeZone": "",
+                            "missedRunPolicy": "runAll",
+                            "maxMissedRuns": 5,
+                            "concurrencyPolicy": "forbid",
+                            "jitter": "10s",
+                            "lastFiredAt": "2000-01-01T00:00:00Z",
                             "createdAt":"2000-01-01T00:00:00Z",
                             "updatedAt":"2000-01-01T00:00:00Z",
                             "evmChainID":"42"
@@ -438,8 +449,10 @@ func TestJob(t *testing.T) {
 			job: job.Job{
 				ID: 1,
 				WebhookSpec: &job.WebhookSpec{
-					CreatedAt: timestamp,
-					UpdatedAt: timestamp,
+					IdempotencyWindow:   models.Interval(time.Hour),
+					IdempotencyKeyLease: models.Interval(5 * time.Minute),
+					CreatedAt:           timestamp,
+					UpdatedAt:           timestamp,
 				},
 				ExternalJobID: uuid.MustParse("0eec7e1d-d0d2-476c-a1a8-72dfb6633f46"),
 				PipelineSpec: &pipeline.Spec{
@@ -450,6 +463,10 @@ func TestJob(t *testing.T) {
 				SchemaVersion:   1,
 				Name:            null.StringFrom("test"),
 				MaxTaskDuration: models.Interval(1 * time.Minute),
+				RunRetention: pipeline.RunRetention{
+					RetentionMaxRuns: clnull.Uint32From(50),
+					RetentionMaxAge:  models.Interval(24 * time.Hour),
+				},
 			},
 			want: `
 			{
@@ -468,9 +485,16 @@ func TestJob(t *testing.T) {
 							"jobID": 0
 						},
 						"webhookSpec": {
+							"idempotencyWindow":"1h0m0s",
+							"idempotencyKeyLease":"5m0s",
 							"createdAt":"2000-01-01T00:00:00Z",
 							"updatedAt":"2000-01-01T00:00:00Z"
 						},
+						"runRetention": {
+							"maxRuns": 50,
+							"maxAge": "24h0m0s",
+							"keepErroredOnly": false
+						},
 						"workflowSpec": null,
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
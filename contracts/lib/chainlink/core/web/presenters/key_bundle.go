@@ -0,0 +1,39 @@
+package presenters
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+)
+
+// KeyBundleEntryResource represents a key of an exported key bundle JSONAPI resource.
+type KeyBundleEntryResource struct {
+	JAID
+	KeyType  string `json:"keyType"`
+	Conflict bool   `json:"conflict"`
+	Exists   bool   `json:"exists"`
+	Replaces string `json:"replaces,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (KeyBundleEntryResource) GetName() string {
+	return "keyBundleEntries"
+}
+
+// NewKeyBundleEntryResource constructs a new KeyBundleEntryResource
+func NewKeyBundleEntryResource(entry keystore.KeyBundleEntry) *KeyBundleEntryResource {
+	return &KeyBundleEntryResource{
+		JAID:     NewJAID(entry.ID),
+		KeyType:  entry.Type,
+		Conflict: entry.Conflict,
+		Exists:   entry.Exists,
+		Replaces: entry.Replaces,
+	}
+}
+
+// NewKeyBundleEntryResources constructs a slice of KeyBundleEntryResources
+func NewKeyBundleEntryResources(entries []keystore.KeyBundleEntry) []KeyBundleEntryResource {
+	rs := []KeyBundleEntryResource{}
+	for _, entry := range entries {
+		rs = append(rs, *NewKeyBundleEntryResource(entry))
+	}
+	return rs
+}
@@ -104,3 +104,97 @@ func NewPipelineRunResources(prs []pipeline.Run, lggr logger.Logger) []PipelineR
 
 	return out
 }
+
+// SimulationResource is the result of a dry-run of the pipeline of a job
+type SimulationResource struct {
+	JAID
+	Outputs     []*string           `json:"outputs"`
+	AllErrors   []*string           `json:"allErrors"`
+	FatalErrors []*string           `json:"fatalErrors"`
+	Trace       []TaskTraceResource `json:"trace"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r SimulationResource) GetName() string {
+	return "simulation"
+}
+
+// TaskTraceResource describes the execution of a single task in a simulation
+type TaskTraceResource struct {
+	DotID    string                            `json:"dotId"`
+	Type     pipeline.TaskType                 `json:"type"`
+	Params   jsonserializable.JSONSerializable `json:"params"`
+	Inputs   []TaskResultResource              `json:"inputs"`
+	Output   jsonserializable.JSONSerializable `json:"output"`
+	Error    *string                           `json:"error"`
+	Duration string                            `json:"duration"`
+	Retries  uint                              `json:"retries"`
+	Stubbed  bool                              `json:"stubbed"`
+}
+
+// TaskResultResource is the value or error a task was given as input
+type TaskResultResource struct {
+	Value jsonserializable.JSONSerializable `json:"value"`
+	Error *string                           `json:"error"`
+}
+
+func NewSimulationResource(jobID int32, sim pipeline.Simulation, lggr logger.Logger) SimulationResource {
+	lggr = lggr.Named("SimulationResource")
+	outputs, err := sim.Run.StringOutputs()
+	if err != nil {
+		lggr.Errorw(err.Error(), "out", sim.Run.Outputs)
+	}
+
+	trace := make([]TaskTraceResource, len(sim.Trace))
+	for i, tt := range sim.Trace {
+		inputs := make([]TaskResultResource, len(tt.Inputs))
+		for j, input := range tt.Inputs {
+			inputs[j] = TaskResultResource{Value: input.OutputDB(), Error: errorString(input.Error)}
+		}
+		trace[i] = TaskTraceResource{
+			DotID:    tt.DotID,
+			Type:     tt.Type,
+			Params:   jsonserializable.JSONSerializable{Val: tt.Params, Valid: tt.Params != nil},
+			Inputs:   inputs,
+			Output:   pipeline.Result{Value: tt.Output}.OutputDB(),
+			Error:    errorString(tt.Error),
+			Duration: tt.Duration.String(),
+			Retries:  tt.Retries,
+			Stubbed:  tt.Stubbed,
+		}
+	}
+
+	return SimulationResource{
+		JAID:        NewJAIDInt32(jobID),
+		Outputs:     outputs,
+		AllErrors:   sim.Run.StringAllErrors(),
+		FatalErrors: sim.Run.StringFatalErrors(),
+		Trace:       trace,
+	}
+}
+
+func errorString(err error) *string {
+	if err == nil {
+		return nil
+	}
+	s := err.Error()
+	return &s
+}
+
+// PrunedRunsResource is the result of pruning the runs of a job
+type PrunedRunsResource struct {
+	JAID
+	Deleted int64 `json:"deleted"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PrunedRunsResource) GetName() string {
+	return "prunedRuns"
+}
+
+func NewPrunedRunsResource(jobID int32, deleted int64) PrunedRunsResource {
+	return PrunedRunsResource{
+		JAID:    NewJAIDInt32(jobID),
+		Deleted: deleted,
+	}
+}
@@ -0,0 +1,39 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services"
+)
+
+// ProfileResource represents a profile gathered by the nurse JSONAPI resource.
+type ProfileResource struct {
+	JAID
+	Type      string    `json:"type"`
+	Size      int64     `json:"size"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// NewProfileResource constructs a new ProfileResource.
+func NewProfileResource(p services.Profile) ProfileResource {
+	return ProfileResource{
+		JAID:      NewJAID(p.Name),
+		Type:      p.Type,
+		Size:      p.Size,
+		CreatedAt: p.CreatedAt,
+	}
+}
+
+// NewProfileResources constructs a slice of ProfileResources.
+func NewProfileResources(profiles []services.Profile) []ProfileResource {
+	rs := []ProfileResource{}
+	for _, p := range profiles {
+		rs = append(rs, NewProfileResource(p))
+	}
+	return rs
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ProfileResource) GetName() string {
+	return "profiles"
+}
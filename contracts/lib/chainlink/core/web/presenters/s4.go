@@ -0,0 +1,73 @@
+package presenters
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/s4"
+)
+
+// S4RowResource represents a row of S4 storage JSONAPI resource. The payload is not included.
+type S4RowResource struct {
+	JAID
+	Address     string `json:"address"`
+	SlotID      uint   `json:"slotID"`
+	Version     uint64 `json:"version"`
+	Expiration  int64  `json:"expiration"`
+	Confirmed   bool   `json:"confirmed"`
+	PayloadSize uint64 `json:"payloadSize"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r S4RowResource) GetName() string {
+	return "s4Rows"
+}
+
+// NewS4RowResource constructs a new S4RowResource.
+func NewS4RowResource(row s4.SnapshotRow) S4RowResource {
+	address := common.BigToAddress(row.Address.ToInt()).Hex()
+	return S4RowResource{
+		JAID:        NewJAID(fmt.Sprintf("%s-%d", address, row.SlotId)),
+		Address:     address,
+		SlotID:      row.SlotId,
+		Version:     row.Version,
+		Expiration:  row.Expiration,
+		Confirmed:   row.Confirmed,
+		PayloadSize: row.PayloadSize,
+	}
+}
+
+// NewS4RowResources constructs a slice of S4RowResources.
+func NewS4RowResources(rows []*s4.SnapshotRow) []S4RowResource {
+	rs := []S4RowResource{}
+	for _, row := range rows {
+		rs = append(rs, NewS4RowResource(*row))
+	}
+	return rs
+}
+
+// S4UsageResource represents the S4 storage used by an address JSONAPI resource.
+type S4UsageResource struct {
+	JAID
+	SlotsUsed       uint   `json:"slotsUsed"`
+	MaxSlots        uint   `json:"maxSlots"`
+	PayloadBytes    uint64 `json:"payloadBytes"`
+	MaxPayloadBytes uint64 `json:"maxPayloadBytes"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r S4UsageResource) GetName() string {
+	return "s4Usages"
+}
+
+// NewS4UsageResource constructs a new S4UsageResource.
+func NewS4UsageResource(usage s4.Usage) *S4UsageResource {
+	return &S4UsageResource{
+		JAID:            NewJAID(usage.Address.Hex()),
+		SlotsUsed:       usage.SlotsUsed,
+		MaxSlots:        usage.MaxSlots,
+		PayloadBytes:    usage.PayloadBytes,
+		MaxPayloadBytes: usage.MaxPayloadBytes,
+	}
+}
@@ -12,6 +12,8 @@ type UserResource struct {
 	Email             string            `json:"email"`
 	Role              sessions.UserRole `json:"role"`
 	HasActiveApiToken string            `json:"hasActiveApiToken"`
+	CustomRole        string            `json:"customRole,omitempty"`
+	TokenCustomRole   string            `json:"tokenCustomRole,omitempty"`
 	CreatedAt         time.Time         `json:"createdAt"`
 	UpdatedAt         time.Time         `json:"updatedAt"`
 }
@@ -34,6 +36,8 @@ func NewUserResource(u sessions.User) *UserResource {
 		Email:             u.Email,
 		Role:              u.Role,
 		HasActiveApiToken: hasToken,
+		CustomRole:        u.CustomRole.String,
+		TokenCustomRole:   u.TokenCustomRole.String,
 		CreatedAt:         u.CreatedAt,
 		UpdatedAt:         u.UpdatedAt,
 	}
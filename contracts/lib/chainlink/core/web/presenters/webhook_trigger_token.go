@@ -0,0 +1,53 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
+)
+
+// WebhookTriggerTokenResource represents a webhook trigger token JSONAPI resource.
+type WebhookTriggerTokenResource struct {
+	JAID
+	JobID         int32     `json:"jobID"`
+	ExternalJobID uuid.UUID `json:"externalJobID"`
+	AccessKey     string    `json:"accessKey"`
+	// Secret is only returned when the token is created
+	Secret     string    `json:"secret,omitempty"`
+	AllowedIPs []string  `json:"allowedIPs"`
+	RateLimit  uint32    `json:"rateLimit"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// NewWebhookTriggerTokenResource constructs a new WebhookTriggerTokenResource, without its secret.
+func NewWebhookTriggerTokenResource(t webhook.TriggerToken) WebhookTriggerTokenResource {
+	allowedIPs := []string(t.AllowedIPs)
+	if allowedIPs == nil {
+		allowedIPs = []string{}
+	}
+	return WebhookTriggerTokenResource{
+		JAID:          NewJAID(t.AccessKey),
+		JobID:         t.JobID,
+		ExternalJobID: t.ExternalJobID,
+		AccessKey:     t.AccessKey,
+		AllowedIPs:    allowedIPs,
+		RateLimit:     t.RateLimit,
+		CreatedAt:     t.CreatedAt,
+	}
+}
+
+// NewWebhookTriggerTokenResources constructs a slice of WebhookTriggerTokenResources.
+func NewWebhookTriggerTokenResources(tokens []webhook.TriggerToken) []WebhookTriggerTokenResource {
+	rs := []WebhookTriggerTokenResource{}
+	for _, t := range tokens {
+		rs = append(rs, NewWebhookTriggerTokenResource(t))
+	}
+	return rs
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r WebhookTriggerTokenResource) GetName() string {
+	return "webhookTriggerTokens"
+}
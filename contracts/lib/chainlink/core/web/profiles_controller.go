@@ -0,0 +1,105 @@
+package web
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// ProfilesController manages the profiles gathered by the nurse, when the
+// resources of the node cross the thresholds configured in [AutoPprof].
+type ProfilesController struct {
+	App chainlink.Application
+}
+
+// Index lists the gathered profiles, newest first.
+// Example:
+// "GET <application>/profiles"
+func (pc *ProfilesController) Index(c *gin.Context) {
+	profiles, err := services.ListProfiles(pc.App.GetConfig().AutoPprof().ProfileRoot())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewProfileResources(profiles), "profiles")
+}
+
+// Show downloads a profile.
+// Example:
+// "GET <application>/profiles/:name"
+func (pc *ProfilesController) Show(c *gin.Context) {
+	name := c.Param("name")
+	f, err := services.OpenProfile(pc.App.GetConfig().AutoPprof().ProfileRoot(), name)
+	if errors.Is(err, services.ErrProfileNotFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.DataFromReader(http.StatusOK, info.Size(), "application/octet-stream", f, map[string]string{
+		"Content-Disposition": fmt.Sprintf(`attachment; filename="%s"`, name),
+	})
+}
+
+// HeapDiff downloads the difference between the last two heap profiles, which
+// can be inspected with `go tool pprof`.
+// Example:
+// "GET <application>/profiles/heap_diff"
+func (pc *ProfilesController) HeapDiff(c *gin.Context) {
+	diff, err := services.HeapProfileDiff(pc.App.GetConfig().AutoPprof().ProfileRoot())
+	if errors.Is(err, services.ErrNotEnoughHeapProfiles) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err = diff.Write(&buf); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="heap_diff.pprof"`)
+	c.Data(http.StatusOK, "application/octet-stream", buf.Bytes())
+}
+
+// Destroy deletes a profile.
+// Example:
+// "DELETE <application>/profiles/:name"
+func (pc *ProfilesController) Destroy(c *gin.Context) {
+	name := c.Param("name")
+	err := services.DeleteProfile(pc.App.GetConfig().AutoPprof().ProfileRoot(), name)
+	if errors.Is(err, services.ErrProfileNotFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	pc.App.GetAuditLogger().Audit(audit.ProfileDeleted, audit.WithContext(c.Request.Context(), map[string]interface{}{"name": name}))
+	jsonAPIResponseWithStatus(c, nil, "profile", http.StatusNoContent)
+}
@@ -0,0 +1,73 @@
+package web_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestProfilesController(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.AutoPprof.ProfileRoot = &root
+	})
+	app := cltest.NewApplicationWithConfig(t, cfg)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	name := fmt.Sprintf("%d.goroutine.pprof", time.Now().UnixMicro())
+	require.NoError(t, os.WriteFile(filepath.Join(root, name), []byte("profile"), 0600))
+
+	// Index
+	resp, cleanup := client.Get("/v2/profiles")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var profiles []presenters.ProfileResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &profiles))
+	require.Len(t, profiles, 1)
+	assert.Equal(t, name, profiles[0].ID)
+	assert.Equal(t, "goroutine", profiles[0].Type)
+	assert.Equal(t, int64(7), profiles[0].Size)
+
+	// Show
+	resp, cleanup = client.Get("/v2/profiles/" + name)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	b, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "profile", string(b))
+
+	resp, cleanup = client.Get("/v2/profiles/missing.heap.pprof")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+
+	// HeapDiff
+	resp, cleanup = client.Get("/v2/profiles/heap_diff")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+
+	// Destroy
+	resp, cleanup = client.Delete("/v2/profiles/" + name)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+	assert.NoFileExists(t, filepath.Join(root, name))
+
+	resp, cleanup = client.Delete("/v2/profiles/" + name)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
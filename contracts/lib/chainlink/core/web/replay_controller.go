@@ -8,6 +8,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-integrations/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 )
 
@@ -62,6 +63,12 @@ func (bdc *ReplayController) ReplayFromBlock(c *gin.Context) {
 		return
 	}
 
+	bdc.App.GetAuditLogger().Audit(audit.ReplayFromBlockStarted, audit.WithContext(c.Request.Context(), map[string]interface{}{
+		"evmChainID":  chainID.String(),
+		"blockNumber": blockNumber,
+		"force":       force,
+	}))
+
 	response := ReplayResponse{
 		Message:    "Replay started",
 		EVMChainID: big.New(chainID),
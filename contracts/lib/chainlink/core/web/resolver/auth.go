@@ -4,20 +4,26 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/graph-gophers/graphql-go"
+
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 )
 
-// Authenticates the user from the session cookie, presence of user inherently provides 'view' access.
-func authenticateUser(ctx context.Context) error {
-	if _, ok := auth.GetGQLAuthenticatedSession(ctx); !ok {
+// Authenticates the user from the session cookie, presence of user inherently provides 'view' access, unless limited by
+// a custom role.
+//
+// Resources, and their IDs, are named as in the REST API, so custom role permissions apply to both.
+func authenticateUser(ctx context.Context, resource string, ids ...string) error {
+	session, ok := auth.GetGQLAuthenticatedSession(ctx)
+	if !ok {
 		return unauthorizedError{}
 	}
-	return nil
+	return authorizeUser(session.User, sessions.UserRoleView, resource, ids)
 }
 
 // Authenticates the user from the session cookie and asserts at least 'run' role.
-func authenticateUserCanRun(ctx context.Context) error {
+func authenticateUserCanRun(ctx context.Context, resource string, ids ...string) error {
 	session, ok := auth.GetGQLAuthenticatedSession(ctx)
 	if !ok {
 		return unauthorizedError{}
@@ -25,11 +31,11 @@ func authenticateUserCanRun(ctx context.Context) error {
 	if session.User.Role == sessions.UserRoleView {
 		return RoleNotPermittedErr{session.User.Role}
 	}
-	return nil
+	return authorizeUser(session.User, sessions.UserRoleRun, resource, ids)
 }
 
 // Authenticates the user from the session cookie and asserts at least 'edit' role.
-func authenticateUserCanEdit(ctx context.Context) error {
+func authenticateUserCanEdit(ctx context.Context, resource string, ids ...string) error {
 	session, ok := auth.GetGQLAuthenticatedSession(ctx)
 	if !ok {
 		return unauthorizedError{}
@@ -39,11 +45,11 @@ func authenticateUserCanEdit(ctx context.Context) error {
 		return RoleNotPermittedErr{session.User.Role}
 	default:
 	}
-	return nil
+	return authorizeUser(session.User, sessions.UserRoleEdit, resource, ids)
 }
 
 // Authenticates the user from the session cookie and asserts has 'admin' role
-func authenticateUserIsAdmin(ctx context.Context) error {
+func authenticateUserIsAdmin(ctx context.Context, resource string, ids ...string) error {
 	session, ok := auth.GetGQLAuthenticatedSession(ctx)
 	if !ok {
 		return unauthorizedError{}
@@ -51,9 +57,27 @@ func authenticateUserIsAdmin(ctx context.Context) error {
 	if session.User.Role != sessions.UserRoleAdmin {
 		return RoleNotPermittedErr{session.User.Role}
 	}
+	return authorizeUser(session.User, sessions.UserRoleAdmin, resource, ids)
+}
+
+// authorizeUser asserts the custom roles of user allow action on resource.
+func authorizeUser(user *sessions.User, action sessions.UserRole, resource string, ids []string) error {
+	if !user.Can(action, resource, ids...) {
+		return PermissionNotGrantedErr{Role: user.CustomRole.String, Permission: sessions.Permission{Action: action, Resource: resource}}
+	}
 	return nil
 }
 
+// jobIDs returns both IDs of the job addressed by id to users with a custom role, so its permissions on the job apply
+// whichever ID they name it by.
+func (r *Resolver) jobIDs(ctx context.Context, id graphql.ID) ([]string, error) {
+	session, ok := auth.GetGQLAuthenticatedSession(ctx)
+	if !ok || len(session.User.RolePermissions) == 0 {
+		return []string{string(id)}, nil
+	}
+	return auth.JobIDs(ctx, r.App.JobORM(), string(id))
+}
+
 type unauthorizedError struct{}
 
 func (e unauthorizedError) Error() string {
@@ -73,3 +97,12 @@ type RoleNotPermittedErr struct {
 func (e RoleNotPermittedErr) Error() string {
 	return fmt.Sprintf("Not permitted with current role: %s", e.Role)
 }
+
+type PermissionNotGrantedErr struct {
+	Role       string
+	Permission sessions.Permission
+}
+
+func (e PermissionNotGrantedErr) Error() string {
+	return fmt.Sprintf("Not permitted with current custom role %s: requires %s", e.Role, e.Permission)
+}
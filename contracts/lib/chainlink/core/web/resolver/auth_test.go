@@ -0,0 +1,37 @@
+package resolver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
+)
+
+func TestAuthenticateUser_CustomRole(t *testing.T) {
+	t.Parallel()
+
+	permissions, err := sessions.ParsePermissions([]string{"view:*", "edit:bridge_types", "!view:keys/eth"})
+	require.NoError(t, err)
+	user := sessions.User{
+		Email:           "gqltester@chain.link",
+		Role:            sessions.UserRoleAdmin,
+		CustomRole:      null.StringFrom("bridges"),
+		RolePermissions: []sessions.Permissions{permissions},
+	}
+	ctx := auth.WithGQLAuthenticatedSession(testutils.Context(t), user, "gqltesterSession")
+
+	require.NoError(t, authenticateUser(ctx, "jobs"))
+	require.NoError(t, authenticateUserCanEdit(ctx, "bridge_types", "my_bridge"))
+	require.NoError(t, authenticateUser(ctx, "keys", "csa"))
+
+	err = authenticateUser(ctx, "keys", "eth", "evm")
+	require.Error(t, err)
+	assert.Equal(t, "Not permitted with current custom role bridges: requires view:keys", err.Error())
+	assert.IsType(t, PermissionNotGrantedErr{}, authenticateUserCanRun(ctx, "jobs", "1"))
+	assert.IsType(t, PermissionNotGrantedErr{}, authenticateUserIsAdmin(ctx, "bridge_types"))
+}
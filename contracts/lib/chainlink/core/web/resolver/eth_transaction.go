@@ -168,3 +168,95 @@ func (r *EthTransactionsPayloadResolver) Results() []*EthTransactionResolver {
 func (r *EthTransactionsPayloadResolver) Metadata() *PaginationMetadataResolver {
 	return NewPaginationMetadata(r.total)
 }
+
+// -- CancelEthTransaction Mutation --
+
+type CancelEthTransactionPayloadResolver struct {
+	tx  *txmgr.Tx
+	err error
+	NotFoundErrorUnionType
+}
+
+func NewCancelEthTransactionPayload(tx *txmgr.Tx, err error) *CancelEthTransactionPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "transaction not found"}
+
+	return &CancelEthTransactionPayloadResolver{tx: tx, err: err, NotFoundErrorUnionType: e}
+}
+
+func (r *CancelEthTransactionPayloadResolver) ToCancelEthTransactionSuccess() (*CancelEthTransactionSuccessResolver, bool) {
+	if r.tx != nil {
+		return &CancelEthTransactionSuccessResolver{tx: *r.tx}, true
+	}
+
+	return nil, false
+}
+
+func (r *CancelEthTransactionPayloadResolver) ToReplaceEthTransactionError() (*ReplaceEthTransactionErrorResolver, bool) {
+	if r.err != nil && !isNotFoundError(r.err) {
+		return NewReplaceEthTransactionError(r.err.Error()), true
+	}
+
+	return nil, false
+}
+
+type CancelEthTransactionSuccessResolver struct {
+	tx txmgr.Tx
+}
+
+func (r *CancelEthTransactionSuccessResolver) Transaction() *EthTransactionResolver {
+	return NewEthTransaction(r.tx)
+}
+
+// -- SpeedUpEthTransaction Mutation --
+
+type SpeedUpEthTransactionPayloadResolver struct {
+	tx  *txmgr.Tx
+	err error
+	NotFoundErrorUnionType
+}
+
+func NewSpeedUpEthTransactionPayload(tx *txmgr.Tx, err error) *SpeedUpEthTransactionPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "transaction not found"}
+
+	return &SpeedUpEthTransactionPayloadResolver{tx: tx, err: err, NotFoundErrorUnionType: e}
+}
+
+func (r *SpeedUpEthTransactionPayloadResolver) ToSpeedUpEthTransactionSuccess() (*SpeedUpEthTransactionSuccessResolver, bool) {
+	if r.tx != nil {
+		return &SpeedUpEthTransactionSuccessResolver{tx: *r.tx}, true
+	}
+
+	return nil, false
+}
+
+func (r *SpeedUpEthTransactionPayloadResolver) ToReplaceEthTransactionError() (*ReplaceEthTransactionErrorResolver, bool) {
+	if r.err != nil && !isNotFoundError(r.err) {
+		return NewReplaceEthTransactionError(r.err.Error()), true
+	}
+
+	return nil, false
+}
+
+type SpeedUpEthTransactionSuccessResolver struct {
+	tx txmgr.Tx
+}
+
+func (r *SpeedUpEthTransactionSuccessResolver) Transaction() *EthTransactionResolver {
+	return NewEthTransaction(r.tx)
+}
+
+type ReplaceEthTransactionErrorResolver struct {
+	message string
+}
+
+func NewReplaceEthTransactionError(message string) *ReplaceEthTransactionErrorResolver {
+	return &ReplaceEthTransactionErrorResolver{message: message}
+}
+
+func (r *ReplaceEthTransactionErrorResolver) Message() string {
+	return r.message
+}
+
+func (r *ReplaceEthTransactionErrorResolver) Code() ErrorCode {
+	return ErrorCodeUnprocessable
+}
@@ -0,0 +1,246 @@
+package resolver
+
+import (
+	"sort"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/functions"
+)
+
+// FunctionsRequestResolver resolves the FunctionsRequest type.
+type FunctionsRequestResolver struct {
+	request   functions.Request
+	durations functions.TransitionDurations
+}
+
+func NewFunctionsRequest(request functions.Request) *FunctionsRequestResolver {
+	return &FunctionsRequestResolver{request: request, durations: request.TransitionDurations()}
+}
+
+func NewFunctionsRequests(requests []functions.Request) []*FunctionsRequestResolver {
+	var resolvers []*FunctionsRequestResolver
+	for _, request := range requests {
+		resolvers = append(resolvers, NewFunctionsRequest(request))
+	}
+
+	return resolvers
+}
+
+// ID resolves the request ID.
+func (r *FunctionsRequestResolver) ID() graphql.ID {
+	return graphql.ID("0x" + r.request.RequestID.String())
+}
+
+// RequestTxHash resolves the hash of the transaction which sent the request.
+func (r *FunctionsRequestResolver) RequestTxHash() *string {
+	if r.request.RequestTxHash == nil {
+		return nil
+	}
+	hash := r.request.RequestTxHash.Hex()
+	return &hash
+}
+
+// State resolves the request's state.
+func (r *FunctionsRequestResolver) State() string {
+	return r.request.State.String()
+}
+
+// ErrorType resolves the type of the request's error, if it failed.
+func (r *FunctionsRequestResolver) ErrorType() *string {
+	if r.request.ErrorType == nil || *r.request.ErrorType == functions.NONE {
+		return nil
+	}
+	errorType := r.request.ErrorType.String()
+	return &errorType
+}
+
+// Error resolves the request's error.
+func (r *FunctionsRequestResolver) Error() string {
+	return string(r.request.Error)
+}
+
+// ResultSize resolves the size of the request's result.
+func (r *FunctionsRequestResolver) ResultSize() int32 {
+	return int32(len(r.request.Result))
+}
+
+// CallbackGasLimit resolves the request's callback gas limit.
+func (r *FunctionsRequestResolver) CallbackGasLimit() *int32 {
+	if r.request.CallbackGasLimit == nil {
+		return nil
+	}
+	limit := int32(*r.request.CallbackGasLimit)
+	return &limit
+}
+
+// ReceivedAt resolves when the request was received.
+func (r *FunctionsRequestResolver) ReceivedAt() graphql.Time {
+	return graphql.Time{Time: r.request.ReceivedAt}
+}
+
+// ResultReadyAt resolves when the request's result, or error, was ready.
+func (r *FunctionsRequestResolver) ResultReadyAt() *graphql.Time {
+	return transitionTime(r.request.ResultReadyAt)
+}
+
+// FinalizedAt resolves when the DON finalized the request's report.
+func (r *FunctionsRequestResolver) FinalizedAt() *graphql.Time {
+	return transitionTime(r.request.FinalizedAt)
+}
+
+// ConfirmedAt resolves when the request's response was confirmed on-chain.
+func (r *FunctionsRequestResolver) ConfirmedAt() *graphql.Time {
+	return transitionTime(r.request.ConfirmedAt)
+}
+
+// TimedOutAt resolves when the request timed out.
+func (r *FunctionsRequestResolver) TimedOutAt() *graphql.Time {
+	return transitionTime(r.request.TimedOutAt)
+}
+
+// ResultDuration resolves the time from receiving the request to its result, or error, being ready.
+func (r *FunctionsRequestResolver) ResultDuration() *string {
+	return transitionDuration(r.durations.Result)
+}
+
+// FinalizeDuration resolves the time from the previous transition to the DON finalizing the report.
+func (r *FunctionsRequestResolver) FinalizeDuration() *string {
+	return transitionDuration(r.durations.Finalize)
+}
+
+// ConfirmDuration resolves the time from the previous transition to the response being confirmed on-chain.
+func (r *FunctionsRequestResolver) ConfirmDuration() *string {
+	return transitionDuration(r.durations.Confirm)
+}
+
+// TimeoutDuration resolves the time from the previous transition to the request timing out.
+func (r *FunctionsRequestResolver) TimeoutDuration() *string {
+	return transitionDuration(r.durations.Timeout)
+}
+
+func transitionTime(t *time.Time) *graphql.Time {
+	if t == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *t}
+}
+
+func transitionDuration(d *time.Duration) *string {
+	if d == nil {
+		return nil
+	}
+	s := d.String()
+	return &s
+}
+
+// FunctionsRequestPayloadResolver resolves a single Functions request response
+type FunctionsRequestPayloadResolver struct {
+	request *functions.Request
+	NotFoundErrorUnionType
+}
+
+func NewFunctionsRequestPayload(request *functions.Request, err error) *FunctionsRequestPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "functions request not found"}
+
+	return &FunctionsRequestPayloadResolver{request: request, NotFoundErrorUnionType: e}
+}
+
+// ToFunctionsRequest implements the FunctionsRequest union type of the payload
+func (r *FunctionsRequestPayloadResolver) ToFunctionsRequest() (*FunctionsRequestResolver, bool) {
+	if r.err == nil {
+		return NewFunctionsRequest(*r.request), true
+	}
+
+	return nil, false
+}
+
+// FunctionsRequestsPayloadResolver resolves a page of Functions requests
+type FunctionsRequestsPayloadResolver struct {
+	requests []functions.Request
+	total    int32
+}
+
+func NewFunctionsRequestsPayload(requests []functions.Request, total int32) *FunctionsRequestsPayloadResolver {
+	return &FunctionsRequestsPayloadResolver{
+		requests: requests,
+		total:    total,
+	}
+}
+
+// Results returns the Functions requests.
+func (r *FunctionsRequestsPayloadResolver) Results() []*FunctionsRequestResolver {
+	return NewFunctionsRequests(r.requests)
+}
+
+// Metadata returns the pagination metadata.
+func (r *FunctionsRequestsPayloadResolver) Metadata() *PaginationMetadataResolver {
+	return NewPaginationMetadata(r.total)
+}
+
+// FunctionsRequestErrorCountResolver resolves the FunctionsRequestErrorCount type.
+type FunctionsRequestErrorCountResolver struct {
+	errorType functions.ErrType
+	count     int
+}
+
+// ErrorType resolves the error type.
+func (r *FunctionsRequestErrorCountResolver) ErrorType() string {
+	return r.errorType.String()
+}
+
+// Count resolves the number of failed requests of the error type.
+func (r *FunctionsRequestErrorCountResolver) Count() int32 {
+	return int32(r.count)
+}
+
+// FunctionsRequestErrorsPayloadResolver resolves the number of failed Functions requests per error type
+type FunctionsRequestErrorsPayloadResolver struct {
+	counts map[functions.ErrType]int
+}
+
+func NewFunctionsRequestErrorsPayload(counts map[functions.ErrType]int) *FunctionsRequestErrorsPayloadResolver {
+	return &FunctionsRequestErrorsPayloadResolver{counts: counts}
+}
+
+// Results returns the error counts, ordered by error type.
+func (r *FunctionsRequestErrorsPayloadResolver) Results() []*FunctionsRequestErrorCountResolver {
+	resolvers := []*FunctionsRequestErrorCountResolver{}
+	for errType, count := range r.counts {
+		resolvers = append(resolvers, &FunctionsRequestErrorCountResolver{errorType: errType, count: count})
+	}
+	sort.Slice(resolvers, func(i, j int) bool { return resolvers[i].errorType < resolvers[j].errorType })
+
+	return resolvers
+}
+
+// functionsRequestFilterInput is the FunctionsRequestFilter input type.
+type functionsRequestFilterInput struct {
+	States         *[]string
+	ReceivedAfter  *graphql.Time
+	ReceivedBefore *graphql.Time
+}
+
+// toRequestFilter parses the filter, which matches all requests when nil.
+func (f *functionsRequestFilterInput) toRequestFilter() (filter functions.RequestFilter, err error) {
+	if f == nil {
+		return filter, nil
+	}
+	if f.States != nil {
+		for _, s := range *f.States {
+			state, err := functions.ParseRequestState(s)
+			if err != nil {
+				return filter, err
+			}
+			filter.States = append(filter.States, state)
+		}
+	}
+	if f.ReceivedAfter != nil {
+		filter.ReceivedAfter = f.ReceivedAfter.Time
+	}
+	if f.ReceivedBefore != nil {
+		filter.ReceivedBefore = f.ReceivedBefore.Time
+	}
+	return filter, nil
+}
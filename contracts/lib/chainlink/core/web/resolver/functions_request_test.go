@@ -0,0 +1,211 @@
+package resolver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/functions"
+)
+
+var (
+	functionsContract  = testutils.NewAddress()
+	functionsRequestID = functions.RequestID{1}
+)
+
+// seedFunctionsRequests stores a failed request and a request in progress in the database of the application.
+func seedFunctionsRequests(ctx context.Context, f *gqlTestFramework) {
+	db := pgtest.NewSqlxDB(f.t)
+	f.App.On("GetDB").Return(db)
+
+	orm := functions.NewORM(db, functionsContract)
+	gasLimit := uint32(300_000)
+	require.NoError(f.t, orm.CreateRequest(ctx, &functions.Request{RequestID: functionsRequestID, ReceivedAt: f.Timestamp(), CallbackGasLimit: &gasLimit}))
+	require.NoError(f.t, orm.SetError(ctx, functionsRequestID, functions.USER_ERROR, []byte("boom"), f.Timestamp().Add(5*time.Second), true))
+	require.NoError(f.t, orm.CreateRequest(ctx, &functions.Request{RequestID: functions.RequestID{2}, ReceivedAt: f.Timestamp().Add(time.Second)}))
+}
+
+func TestResolver_FunctionsRequests(t *testing.T) {
+	t.Parallel()
+
+	query := fmt.Sprintf(`
+		query GetFunctionsRequests {
+			functionsRequests(contract: "%s", filter: {states: ["ResultReady"]}) {
+				results {
+					id
+					state
+					errorType
+					error
+					resultSize
+					callbackGasLimit
+					resultDuration
+					finalizeDuration
+				}
+				metadata {
+					total
+				}
+			}
+		}`, functionsContract.Hex())
+	badStateQuery := fmt.Sprintf(`
+		query GetFunctionsRequests {
+			functionsRequests(contract: "%s", filter: {states: ["Done"]}) {
+				metadata {
+					total
+				}
+			}
+		}`, functionsContract.Hex())
+	stateErr := fmt.Errorf("unknown request state %q", "Done")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "functionsRequests"),
+		{
+			name:          "success",
+			authenticated: true,
+			before:        seedFunctionsRequests,
+			query:         query,
+			result: fmt.Sprintf(`
+			{
+				"functionsRequests": {
+					"results": [{
+						"id": "0x%s",
+						"state": "ResultReady",
+						"errorType": "UserError",
+						"error": "boom",
+						"resultSize": 0,
+						"callbackGasLimit": 300000,
+						"resultDuration": "5s",
+						"finalizeDuration": null
+					}],
+					"metadata": {
+						"total": 1
+					}
+				}
+			}`, functionsRequestID),
+		},
+		{
+			name:          "bad state",
+			authenticated: true,
+			query:         badStateQuery,
+			result:        `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					ResolverError: stateErr,
+					Path:          []interface{}{"functionsRequests"},
+					Message:       stateErr.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_FunctionsRequest(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetFunctionsRequest($contract: String!, $id: ID!) {
+			functionsRequest(contract: $contract, id: $id) {
+				... on FunctionsRequest {
+					id
+					state
+					resultDuration
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+	contractErr := fmt.Errorf("bad contract address %q", "0x123")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: map[string]interface{}{"contract": functionsContract.Hex(), "id": "0x" + functionsRequestID.String()}}, "functionsRequest"),
+		{
+			name:          "success",
+			authenticated: true,
+			before:        seedFunctionsRequests,
+			query:         query,
+			variables:     map[string]interface{}{"contract": functionsContract.Hex(), "id": "0x" + functionsRequestID.String()},
+			result: fmt.Sprintf(`
+			{
+				"functionsRequest": {
+					"id": "0x%s",
+					"state": "ResultReady",
+					"resultDuration": "5s"
+				}
+			}`, functionsRequestID),
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before:        seedFunctionsRequests,
+			query:         query,
+			variables:     map[string]interface{}{"contract": testutils.NewAddress().Hex(), "id": "0x" + functionsRequestID.String()},
+			result: `
+			{
+				"functionsRequest": {
+					"message": "functions request not found",
+					"code": "NOT_FOUND"
+				}
+			}`,
+		},
+		{
+			name:          "bad contract",
+			authenticated: true,
+			query:         query,
+			variables:     map[string]interface{}{"contract": "0x123", "id": "0x" + functionsRequestID.String()},
+			result:        `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					ResolverError: contractErr,
+					Path:          []interface{}{"functionsRequest"},
+					Message:       contractErr.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_FunctionsRequestErrors(t *testing.T) {
+	t.Parallel()
+
+	query := fmt.Sprintf(`
+		query GetFunctionsRequestErrors {
+			functionsRequestErrors(contract: "%s") {
+				results {
+					errorType
+					count
+				}
+			}
+		}`, functionsContract.Hex())
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "functionsRequestErrors"),
+		{
+			name:          "success",
+			authenticated: true,
+			before:        seedFunctionsRequests,
+			query:         query,
+			result: `
+			{
+				"functionsRequestErrors": {
+					"results": [{
+						"errorType": "UserError",
+						"count": 1
+					}]
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -85,6 +85,12 @@ func ValidateBridgeType(bt *bridges.BridgeTypeRequest) error {
 		bt.MinimumContractPayment.Cmp(assets.NewLinkFromJuels(0)) < 0 {
 		return errors.New("MinimumContractPayment must be positive")
 	}
+	if bt.CacheFreshTTL < 0 || bt.CacheStaleTTL < 0 {
+		return errors.New("CacheFreshTTL and CacheStaleTTL must not be negative")
+	}
+	if err := bridges.ValidateFailoverPolicy(bt.FailoverPolicy); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 )
 
@@ -101,6 +102,19 @@ func (r *JobResolver) Spec() *SpecResolver {
 	return NewSpec(r.j)
 }
 
+// WebhookTriggerTokens resolves the job's active webhook trigger tokens, to users who can edit the job.
+func (r *JobResolver) WebhookTriggerTokens(ctx context.Context) ([]*WebhookTriggerTokenResolver, error) {
+	if err := authenticateUserCanEdit(ctx, "jobs", stringutils.FromInt32(r.j.ID), r.j.ExternalJobID.String()); err != nil {
+		return nil, err
+	}
+	tokens, err := r.app.GetWebhookTriggerTokenManager().TriggerTokens(ctx, r.j.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWebhookTriggerTokens(tokens), nil
+}
+
 // Runs fetches the runs for a Job.
 func (r *JobResolver) Runs(ctx context.Context, args struct {
 	Offset *int32
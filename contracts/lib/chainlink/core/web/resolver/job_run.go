@@ -267,3 +267,66 @@ func (r *RunJobCannotRunErrorResolver) Code() ErrorCode {
 func (r *RunJobCannotRunErrorResolver) Message() string {
 	return r.message
 }
+
+// -- SimulateJob Mutation --
+
+type SimulateJobPayloadResolver struct {
+	sim *pipeline.Simulation
+	err error
+	NotFoundErrorUnionType
+}
+
+func NewSimulateJobPayload(sim *pipeline.Simulation, err error) *SimulateJobPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "job not found"}
+
+	return &SimulateJobPayloadResolver{sim: sim, err: err, NotFoundErrorUnionType: e}
+}
+
+func (r *SimulateJobPayloadResolver) ToSimulateJobSuccess() (*SimulateJobSuccessResolver, bool) {
+	if r.sim != nil {
+		return &SimulateJobSuccessResolver{sim: *r.sim}, true
+	}
+
+	return nil, false
+}
+
+func (r *SimulateJobPayloadResolver) ToSimulateJobError() (*SimulateJobErrorResolver, bool) {
+	if r.err != nil && !isNotFoundError(r.err) {
+		return &SimulateJobErrorResolver{message: r.err.Error(), code: ErrorCodeUnprocessable}, true
+	}
+
+	return nil, false
+}
+
+type SimulateJobSuccessResolver struct {
+	sim pipeline.Simulation
+}
+
+func (r *SimulateJobSuccessResolver) Outputs() []*string {
+	return NewJobRun(*r.sim.Run, nil).Outputs()
+}
+
+func (r *SimulateJobSuccessResolver) AllErrors() []string {
+	return NewJobRun(*r.sim.Run, nil).AllErrors()
+}
+
+func (r *SimulateJobSuccessResolver) FatalErrors() []string {
+	return NewJobRun(*r.sim.Run, nil).FatalErrors()
+}
+
+func (r *SimulateJobSuccessResolver) Trace() []*TaskTraceResolver {
+	return NewTaskTraces(r.sim.Trace)
+}
+
+type SimulateJobErrorResolver struct {
+	message string
+	code    ErrorCode
+}
+
+func (r *SimulateJobErrorResolver) Code() ErrorCode {
+	return r.code
+}
+
+func (r *SimulateJobErrorResolver) Message() string {
+	return r.message
+}
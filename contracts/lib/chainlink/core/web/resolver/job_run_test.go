@@ -399,3 +399,124 @@ func TestResolver_RunJob(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_SimulateJob(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation SimulateJob($id: ID!, $input: SimulateJobInput!) {
+			simulateJob(id: $id, input: $input) {
+				... on SimulateJobSuccess {
+					outputs
+					allErrors
+					fatalErrors
+					trace {
+						dotID
+						type
+						params
+						inputs
+						output
+						error
+						retries
+						stubbed
+					}
+				}
+				... on SimulateJobError {
+					code
+					message
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	id := int32(12)
+	variables := map[string]interface{}{
+		"id": stringutils.FromInt32(id),
+		"input": map[string]interface{}{
+			"vars":  `{"foo": "bar"}`,
+			"stubs": `{"ds": {"value": "1"}}`,
+		},
+	}
+	vars := map[string]interface{}{"foo": "bar"}
+	stubs := map[string]pipeline.TaskStub{"ds": {Value: "1"}}
+
+	outputs := jsonserializable.JSONSerializable{}
+	err := outputs.UnmarshalJSON([]byte(`["1"]`))
+	require.NoError(t, err)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "simulateJob"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("SimulateJobV2", mock.Anything, id, vars, stubs).Return(&pipeline.Simulation{
+					Run: &pipeline.Run{Outputs: outputs},
+					Trace: []pipeline.TaskTrace{{
+						DotID:   "ds",
+						Type:    pipeline.TaskTypeHTTP,
+						Params:  map[string]interface{}{"url": "https://example.com"},
+						Output:  "1",
+						Stubbed: true,
+					}},
+				}, nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"simulateJob": {
+						"outputs": ["1"],
+						"allErrors": [],
+						"fatalErrors": [],
+						"trace": [{
+							"dotID": "ds",
+							"type": "http",
+							"params": "{\"url\":\"https://example.com\"}",
+							"inputs": [],
+							"output": "\"1\"",
+							"error": null,
+							"retries": 0,
+							"stubbed": true
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "not found job error",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("SimulateJobV2", mock.Anything, id, vars, stubs).Return(nil, errors.Wrap(sql.ErrNoRows, "job ID 12"))
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"simulateJob": {
+						"code": "NOT_FOUND",
+						"message": "job not found"
+					}
+				}`,
+		},
+		{
+			name:          "invalid stubs",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("SimulateJobV2", mock.Anything, id, vars, stubs).Return(nil, errors.New("cannot stub task ds: no such task"))
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"simulateJob": {
+						"code": "UNPROCESSABLE",
+						"message": "cannot stub task ds: no such task"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
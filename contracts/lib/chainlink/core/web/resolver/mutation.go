@@ -6,18 +6,24 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"strconv"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/graph-gophers/graphql-go"
 	"github.com/pkg/errors"
 	"go.uber.org/zap/zapcore"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
+	evmassets "github.com/smartcontractkit/chainlink-integrations/evm/assets"
+	"github.com/smartcontractkit/chainlink-integrations/evm/gas"
 
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	ccip "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/validate"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/blockhashstore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/blockheaderfeeder"
@@ -38,6 +44,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/standardcapabilities"
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
@@ -63,7 +70,7 @@ type createBridgeInput struct {
 
 // CreateBridge creates a new bridge.
 func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBridgeInput }) (*CreateBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, "bridge_types"); err != nil {
 		return nil, err
 	}
 
@@ -102,18 +109,19 @@ func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBr
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.BridgeCreated, map[string]interface{}{
+	r.App.GetAuditLogger().Audit(audit.BridgeCreated, audit.WithContext(ctx, map[string]interface{}{
 		"bridgeName":                   bta.Name,
 		"bridgeConfirmations":          bta.Confirmations,
 		"bridgeMinimumContractPayment": bta.MinimumContractPayment,
 		"bridgeURL":                    bta.URL,
-	})
+		"bridge":                       bt.Redacted(),
+	}))
 
 	return NewCreateBridgePayload(*bt, bta.IncomingToken), nil
 }
 
 func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, "keys", "csa"); err != nil {
 		return nil, err
 	}
 
@@ -126,10 +134,10 @@ func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolv
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.CSAKeyCreated, map[string]interface{}{
+	r.App.GetAuditLogger().Audit(audit.CSAKeyCreated, audit.WithContext(ctx, map[string]interface{}{
 		"CSAPublicKey": key.PublicKey,
 		"CSVersion":    key.Version,
-	})
+	}))
 
 	return NewCreateCSAKeyPayload(&key, nil), nil
 }
@@ -137,7 +145,7 @@ func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolv
 func (r *Resolver) DeleteCSAKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteCSAKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, "keys", "csa"); err != nil {
 		return nil, err
 	}
 
@@ -150,7 +158,7 @@ func (r *Resolver) DeleteCSAKey(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.CSAKeyDeleted, map[string]interface{}{"id": args.ID})
+	r.App.GetAuditLogger().Audit(audit.CSAKeyDeleted, audit.WithContext(ctx, map[string]interface{}{"id": args.ID}))
 
 	return NewDeleteCSAKeyPayload(key, nil), nil
 }
@@ -180,7 +188,7 @@ type createFeedsManagerChainConfigInput struct {
 func (r *Resolver) CreateFeedsManagerChainConfig(ctx context.Context, args struct {
 	Input *createFeedsManagerChainConfigInput
 }) (*CreateFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, "feeds_managers"); err != nil {
 		return nil, err
 	}
 
@@ -257,7 +265,7 @@ func (r *Resolver) CreateFeedsManagerChainConfig(ctx context.Context, args struc
 	}
 
 	fmj, _ := json.Marshal(ccfg)
-	r.App.GetAuditLogger().Audit(audit.FeedsManChainConfigCreated, map[string]interface{}{"feedsManager": fmj})
+	r.App.GetAuditLogger().Audit(audit.FeedsManChainConfigCreated, audit.WithContext(ctx, map[string]interface{}{"feedsManager": fmj}))
 
 	return NewCreateFeedsManagerChainConfigPayload(ccfg, nil, nil), nil
 }
@@ -265,7 +273,7 @@ func (r *Resolver) CreateFeedsManagerChainConfig(ctx context.Context, args struc
 func (r *Resolver) DeleteFeedsManagerChainConfig(ctx context.Context, args struct {
 	ID string
 }) (*DeleteFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, "feeds_managers"); err != nil {
 		return nil, err
 	}
 
@@ -293,7 +301,7 @@ func (r *Resolver) DeleteFeedsManagerChainConfig(ctx context.Context, args struc
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.FeedsManChainConfigDeleted, map[string]interface{}{"id": args.ID})
+	r.App.GetAuditLogger().Audit(audit.FeedsManChainConfigDeleted, audit.WithContext(ctx, map[string]interface{}{"id": args.ID}))
 
 	return NewDeleteFeedsManagerChainConfigPayload(ccfg, nil), nil
 }
@@ -321,7 +329,7 @@ func (r *Resolver) UpdateFeedsManagerChainConfig(ctx context.Context, args struc
 	ID    string
 	Input *updateFeedsManagerChainConfigInput
 }) (*UpdateFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, "feeds_managers"); err != nil {
 		return nil, err
 	}
 
@@ -372,6 +380,15 @@ func (r *Resolver) UpdateFeedsManagerChainConfig(ctx context.Context, args struc
 		}
 	}
 
+	before, err := fsvc.GetChainConfig(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewUpdateFeedsManagerChainConfigPayload(nil, err, nil), nil
+		}
+
+		return nil, err
+	}
+
 	id, err = fsvc.UpdateChainConfig(ctx, params)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -391,7 +408,10 @@ func (r *Resolver) UpdateFeedsManagerChainConfig(ctx context.Context, args struc
 	}
 
 	fmj, _ := json.Marshal(ccfg)
-	r.App.GetAuditLogger().Audit(audit.FeedsManChainConfigUpdated, map[string]interface{}{"feedsManager": fmj})
+	r.App.GetAuditLogger().Audit(audit.FeedsManChainConfigUpdated, audit.WithContext(ctx, map[string]interface{}{
+		"feedsManager": fmj,
+		"diff":         audit.Diff(before, ccfg),
+	}))
 
 	return NewUpdateFeedsManagerChainConfigPayload(ccfg, nil, nil), nil
 }
@@ -405,7 +425,7 @@ type createFeedsManagerInput struct {
 func (r *Resolver) CreateFeedsManager(ctx context.Context, args struct {
 	Input *createFeedsManagerInput
 }) (*CreateFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, "feeds_managers"); err != nil {
 		return nil, err
 	}
 
@@ -442,7 +462,7 @@ func (r *Resolver) CreateFeedsManager(ctx context.Context, args struct {
 	}
 
 	mgrj, _ := json.Marshal(mgr)
-	r.App.GetAuditLogger().Audit(audit.FeedsManCreated, map[string]interface{}{"mgrj": mgrj})
+	r.App.GetAuditLogger().Audit(audit.FeedsManCreated, audit.WithContext(ctx, map[string]interface{}{"mgrj": mgrj}))
 
 	return NewCreateFeedsManagerPayload(mgr, nil, nil), nil
 }
@@ -458,7 +478,7 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 	ID    graphql.ID
 	Input updateBridgeInput
 }) (*UpdateBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, "bridge_types", string(args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -497,21 +517,27 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 		return nil, err
 	}
 
+	// The cache and failover policies cannot be set through this mutation, so they are kept as is
+	btr.CachePolicy = bridge.CachePolicy
+	btr.FailoverPolicy = bridge.FailoverPolicy
+
 	// Update the bridge
 	if err := ValidateBridgeType(btr); err != nil {
 		return nil, err
 	}
 
+	before := bridge
 	if err := orm.UpdateBridgeType(ctx, &bridge, btr); err != nil {
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.BridgeUpdated, map[string]interface{}{
+	r.App.GetAuditLogger().Audit(audit.BridgeUpdated, audit.WithContext(ctx, map[string]interface{}{
 		"bridgeName":                   bridge.Name,
 		"bridgeConfirmations":          bridge.Confirmations,
 		"bridgeMinimumContractPayment": bridge.MinimumContractPayment,
 		"bridgeURL":                    bridge.URL,
-	})
+		"diff":                         audit.Diff(before.Redacted(), bridge.Redacted()),
+	}))
 
 	return NewUpdateBridgePayload(&bridge, nil), nil
 }
@@ -526,7 +552,7 @@ func (r *Resolver) UpdateFeedsManager(ctx context.Context, args struct {
 	ID    graphql.ID
 	Input *updateFeedsManagerInput
 }) (*UpdateFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, "feeds_managers", string(args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -565,7 +591,7 @@ func (r *Resolver) UpdateFeedsManager(ctx context.Context, args struct {
 	}
 
 	mgrj, _ := json.Marshal(mgr)
-	r.App.GetAuditLogger().Audit(audit.FeedsManUpdated, map[string]interface{}{"mgrj": mgrj})
+	r.App.GetAuditLogger().Audit(audit.FeedsManUpdated, audit.WithContext(ctx, map[string]interface{}{"mgrj": mgrj}))
 
 	return NewUpdateFeedsManagerPayload(mgr, nil, nil), nil
 }
@@ -574,7 +600,7 @@ func (r *Resolver) EnableFeedsManager(ctx context.Context, args struct {
 	ID graphql.ID
 },
 ) (*EnableFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, "feeds_managers", string(args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -588,7 +614,7 @@ func (r *Resolver) EnableFeedsManager(ctx context.Context, args struct {
 	mgr, err := feedsService.EnableManager(ctx, id)
 
 	mgrj, _ := json.Marshal(mgr)
-	r.App.GetAuditLogger().Audit(audit.FeedsManEnabled, map[string]interface{}{"mgrj": mgrj})
+	r.App.GetAuditLogger().Audit(audit.FeedsManEnabled, audit.WithContext(ctx, map[string]interface{}{"mgrj": mgrj}))
 	return NewEnableFeedsManagerPayload(mgr, err), nil
 }
 
@@ -596,7 +622,7 @@ func (r *Resolver) DisableFeedsManager(ctx context.Context, args struct {
 	ID graphql.ID
 },
 ) (*DisableFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, "feeds_managers", string(args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -610,12 +636,12 @@ func (r *Resolver) DisableFeedsManager(ctx context.Context, args struct {
 	mgr, err := feedsService.DisableManager(ctx, id)
 
 	mgrj, _ := json.Marshal(mgr)
-	r.App.GetAuditLogger().Audit(audit.FeedsManDisabled, map[string]interface{}{"mgrj": mgrj})
+	r.App.GetAuditLogger().Audit(audit.FeedsManDisabled, audit.WithContext(ctx, map[string]interface{}{"mgrj": mgrj}))
 	return NewDisableFeedsManagerPayload(mgr, err), nil
 }
 
 func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundlePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, "keys", "ocr"); err != nil {
 		return nil, err
 	}
 
@@ -624,10 +650,10 @@ func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundleP
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.OCRKeyBundleCreated, map[string]interface{}{
+	r.App.GetAuditLogger().Audit(audit.OCRKeyBundleCreated, audit.WithContext(ctx, map[string]interface{}{
 		"ocrKeyBundleID":                      key.ID(),
 		"ocrKeyBundlePublicKeyAddressOnChain": key.PublicKeyAddressOnChain(),
-	})
+	}))
 
 	return NewCreateOCRKeyBundlePayload(&key), nil
 }
@@ -635,7 +661,7 @@ func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundleP
 func (r *Resolver) DeleteOCRKeyBundle(ctx context.Context, args struct {
 	ID string
 }) (*DeleteOCRKeyBundlePayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, "keys", "ocr"); err != nil {
 		return nil, err
 	}
 
@@ -647,14 +673,14 @@ func (r *Resolver) DeleteOCRKeyBundle(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.OCRKeyBundleDeleted, map[string]interface{}{"id": args.ID})
+	r.App.GetAuditLogger().Audit(audit.OCRKeyBundleDeleted, audit.WithContext(ctx, map[string]interface{}{"id": args.ID}))
 	return NewDeleteOCRKeyBundlePayloadResolver(deletedKey, nil), nil
 }
 
 func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, "bridge_types", string(args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -685,12 +711,12 @@ func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.BridgeDeleted, map[string]interface{}{"name": bt.Name})
+	r.App.GetAuditLogger().Audit(audit.BridgeDeleted, audit.WithContext(ctx, map[string]interface{}{"name": bt.Name, "bridge": bt.Redacted()}))
 	return NewDeleteBridgePayload(&bt, nil), nil
 }
 
 func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, "keys", "p2p"); err != nil {
 		return nil, err
 	}
 
@@ -700,13 +726,13 @@ func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolv
 	}
 
 	const keyType = "Ed25519"
-	r.App.GetAuditLogger().Audit(audit.KeyCreated, map[string]interface{}{
+	r.App.GetAuditLogger().Audit(audit.KeyCreated, audit.WithContext(ctx, map[string]interface{}{
 		"type":         "p2p",
 		"id":           key.ID(),
 		"p2pPublicKey": key.PublicKeyHex(),
 		"p2pPeerID":    key.PeerID(),
 		"p2pType":      keyType,
-	})
+	}))
 
 	return NewCreateP2PKeyPayload(key), nil
 }
@@ -714,7 +740,7 @@ func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolv
 func (r *Resolver) DeleteP2PKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteP2PKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, "keys", "p2p"); err != nil {
 		return nil, err
 	}
 
@@ -731,16 +757,16 @@ func (r *Resolver) DeleteP2PKey(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.KeyDeleted, map[string]interface{}{
+	r.App.GetAuditLogger().Audit(audit.KeyDeleted, audit.WithContext(ctx, map[string]interface{}{
 		"type": "p2p",
 		"id":   args.ID,
-	})
+	}))
 
 	return NewDeleteP2PKeyPayload(key, nil), nil
 }
 
 func (r *Resolver) CreateVRFKey(ctx context.Context) (*CreateVRFKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, "keys", "vrf"); err != nil {
 		return nil, err
 	}
 
@@ -749,12 +775,12 @@ func (r *Resolver) CreateVRFKey(ctx context.Context) (*CreateVRFKeyPayloadResolv
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.KeyCreated, map[string]interface{}{
+	r.App.GetAuditLogger().Audit(audit.KeyCreated, audit.WithContext(ctx, map[string]interface{}{
 		"type":                "vrf",
 		"id":                  key.ID(),
 		"vrfPublicKey":        key.PublicKey,
 		"vrfPublicKeyAddress": key.PublicKey.Address(),
-	})
+	}))
 
 	return NewCreateVRFKeyPayloadResolver(key), nil
 }
@@ -762,7 +788,7 @@ func (r *Resolver) CreateVRFKey(ctx context.Context) (*CreateVRFKeyPayloadResolv
 func (r *Resolver) DeleteVRFKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteVRFKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, "keys", "vrf"); err != nil {
 		return nil, err
 	}
 
@@ -774,10 +800,10 @@ func (r *Resolver) DeleteVRFKey(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.KeyDeleted, map[string]interface{}{
+	r.App.GetAuditLogger().Audit(audit.KeyDeleted, audit.WithContext(ctx, map[string]interface{}{
 		"type": "vrf",
 		"id":   args.ID,
-	})
+	}))
 
 	return NewDeleteVRFKeyPayloadResolver(key, nil), nil
 }
@@ -787,7 +813,7 @@ func (r *Resolver) ApproveJobProposalSpec(ctx context.Context, args struct {
 	ID    graphql.ID
 	Force *bool
 }) (*ApproveJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, "job_proposals"); err != nil {
 		return nil, err
 	}
 
@@ -817,7 +843,7 @@ func (r *Resolver) ApproveJobProposalSpec(ctx context.Context, args struct {
 	}
 
 	specj, _ := json.Marshal(spec)
-	r.App.GetAuditLogger().Audit(audit.JobProposalSpecApproved, map[string]interface{}{"spec": specj})
+	r.App.GetAuditLogger().Audit(audit.JobProposalSpecApproved, audit.WithContext(ctx, map[string]interface{}{"spec": specj}))
 
 	return NewApproveJobProposalSpecPayload(spec, err), nil
 }
@@ -826,7 +852,7 @@ func (r *Resolver) ApproveJobProposalSpec(ctx context.Context, args struct {
 func (r *Resolver) CancelJobProposalSpec(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*CancelJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, "job_proposals"); err != nil {
 		return nil, err
 	}
 
@@ -852,7 +878,7 @@ func (r *Resolver) CancelJobProposalSpec(ctx context.Context, args struct {
 	}
 
 	specj, _ := json.Marshal(spec)
-	r.App.GetAuditLogger().Audit(audit.JobProposalSpecCanceled, map[string]interface{}{"spec": specj})
+	r.App.GetAuditLogger().Audit(audit.JobProposalSpecCanceled, audit.WithContext(ctx, map[string]interface{}{"spec": specj}))
 
 	return NewCancelJobProposalSpecPayload(spec, err), nil
 }
@@ -861,7 +887,7 @@ func (r *Resolver) CancelJobProposalSpec(ctx context.Context, args struct {
 func (r *Resolver) RejectJobProposalSpec(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*RejectJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, "job_proposals"); err != nil {
 		return nil, err
 	}
 
@@ -887,7 +913,7 @@ func (r *Resolver) RejectJobProposalSpec(ctx context.Context, args struct {
 	}
 
 	specj, _ := json.Marshal(spec)
-	r.App.GetAuditLogger().Audit(audit.JobProposalSpecRejected, map[string]interface{}{"spec": specj})
+	r.App.GetAuditLogger().Audit(audit.JobProposalSpecRejected, audit.WithContext(ctx, map[string]interface{}{"spec": specj}))
 
 	return NewRejectJobProposalSpecPayload(spec, err), nil
 }
@@ -897,7 +923,7 @@ func (r *Resolver) UpdateJobProposalSpecDefinition(ctx context.Context, args str
 	ID    graphql.ID
 	Input *struct{ Definition string }
 }) (*UpdateJobProposalSpecDefinitionPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, "job_proposals"); err != nil {
 		return nil, err
 	}
 
@@ -925,7 +951,7 @@ func (r *Resolver) UpdateJobProposalSpecDefinition(ctx context.Context, args str
 	}
 
 	specj, _ := json.Marshal(spec)
-	r.App.GetAuditLogger().Audit(audit.JobProposalSpecUpdated, map[string]interface{}{"spec": specj})
+	r.App.GetAuditLogger().Audit(audit.JobProposalSpecUpdated, audit.WithContext(ctx, map[string]interface{}{"spec": specj}))
 
 	return NewUpdateJobProposalSpecDefinitionPayload(spec, err), nil
 }
@@ -933,7 +959,7 @@ func (r *Resolver) UpdateJobProposalSpecDefinition(ctx context.Context, args str
 func (r *Resolver) UpdateUserPassword(ctx context.Context, args struct {
 	Input UpdatePasswordInput
 }) (*UpdatePasswordPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "user"); err != nil {
 		return nil, err
 	}
 
@@ -948,7 +974,7 @@ func (r *Resolver) UpdateUserPassword(ctx context.Context, args struct {
 	}
 
 	if !utils.CheckPasswordHash(args.Input.OldPassword, dbUser.HashedPassword) {
-		r.App.GetAuditLogger().Audit(audit.PasswordResetAttemptFailedMismatch, map[string]interface{}{"user": dbUser.Email})
+		r.App.GetAuditLogger().Audit(audit.PasswordResetAttemptFailedMismatch, audit.WithContext(ctx, map[string]interface{}{"user": dbUser.Email}))
 
 		return NewUpdatePasswordPayload(nil, map[string]string{
 			"oldPassword": "old password does not match",
@@ -964,23 +990,23 @@ func (r *Resolver) UpdateUserPassword(ctx context.Context, args struct {
 		return nil, failedPasswordUpdateError{}
 	}
 
-	r.App.GetAuditLogger().Audit(audit.PasswordResetSuccess, map[string]interface{}{"user": dbUser.Email})
+	r.App.GetAuditLogger().Audit(audit.PasswordResetSuccess, audit.WithContext(ctx, map[string]interface{}{"user": dbUser.Email}))
 	return NewUpdatePasswordPayload(session.User, nil), nil
 }
 
 func (r *Resolver) SetSQLLogging(ctx context.Context, args struct {
 	Input struct{ Enabled bool }
 }) (*SetSQLLoggingPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, "log"); err != nil {
 		return nil, err
 	}
 
 	r.App.GetConfig().SetLogSQL(args.Input.Enabled)
 
 	if args.Input.Enabled {
-		r.App.GetAuditLogger().Audit(audit.ConfigSqlLoggingEnabled, map[string]interface{}{})
+		r.App.GetAuditLogger().Audit(audit.ConfigSqlLoggingEnabled, audit.WithContext(ctx, map[string]interface{}{}))
 	} else {
-		r.App.GetAuditLogger().Audit(audit.ConfigSqlLoggingDisabled, map[string]interface{}{})
+		r.App.GetAuditLogger().Audit(audit.ConfigSqlLoggingDisabled, audit.WithContext(ctx, map[string]interface{}{}))
 	}
 
 	return NewSetSQLLoggingPayload(args.Input.Enabled), nil
@@ -989,7 +1015,7 @@ func (r *Resolver) SetSQLLogging(ctx context.Context, args struct {
 func (r *Resolver) CreateAPIToken(ctx context.Context, args struct {
 	Input struct{ Password string }
 }) (*CreateAPITokenPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "user"); err != nil {
 		return nil, err
 	}
 
@@ -1004,7 +1030,7 @@ func (r *Resolver) CreateAPIToken(ctx context.Context, args struct {
 
 	err = r.App.AuthenticationProvider().TestPassword(ctx, dbUser.Email, args.Input.Password)
 	if err != nil {
-		r.App.GetAuditLogger().Audit(audit.APITokenCreateAttemptPasswordMismatch, map[string]interface{}{"user": dbUser.Email})
+		r.App.GetAuditLogger().Audit(audit.APITokenCreateAttemptPasswordMismatch, audit.WithContext(ctx, map[string]interface{}{"user": dbUser.Email}))
 
 		return NewCreateAPITokenPayload(nil, map[string]string{
 			"password": "incorrect password",
@@ -1016,14 +1042,71 @@ func (r *Resolver) CreateAPIToken(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.APITokenCreated, map[string]interface{}{"user": dbUser.Email})
+	r.App.GetAuditLogger().Audit(audit.APITokenCreated, audit.WithContext(ctx, map[string]interface{}{"user": dbUser.Email}))
 	return NewCreateAPITokenPayload(newToken, nil), nil
 }
 
+type createWebhookTriggerTokenInput struct {
+	AllowedIPs *[]string
+	RateLimit  *int32
+}
+
+// CreateWebhookTriggerToken creates a trigger token for a webhook job. The
+// secret of the token is only returned in this payload.
+func (r *Resolver) CreateWebhookTriggerToken(ctx context.Context, args struct {
+	JobID graphql.ID
+	Input createWebhookTriggerTokenInput
+}) (*CreateWebhookTriggerTokenPayloadResolver, error) {
+	ids, err := r.jobIDs(ctx, args.JobID)
+	if err != nil {
+		return nil, err
+	}
+	if err = authenticateUserCanEdit(ctx, "jobs", ids...); err != nil {
+		return nil, err
+	}
+
+	jobID, err := stringutils.ToInt32(string(args.JobID))
+	if err != nil {
+		return nil, err
+	}
+
+	var allowedIPs []string
+	if args.Input.AllowedIPs != nil {
+		allowedIPs = *args.Input.AllowedIPs
+	}
+	if err = webhook.ValidateAllowedIPs(allowedIPs); err != nil {
+		return NewCreateWebhookTriggerTokenPayload(nil, map[string]string{"allowedIPs": err.Error()}, nil), nil
+	}
+	var rateLimit uint32
+	if args.Input.RateLimit != nil {
+		if *args.Input.RateLimit < 0 {
+			return NewCreateWebhookTriggerTokenPayload(nil, map[string]string{"rateLimit": "must be 0 or greater"}, nil), nil
+		}
+		rateLimit = uint32(*args.Input.RateLimit)
+	}
+
+	token, err := r.App.GetWebhookTriggerTokenManager().CreateTriggerToken(ctx, jobID, allowedIPs, rateLimit)
+	if err != nil {
+		if errors.Is(err, webhook.ErrJobNotExists) {
+			return NewCreateWebhookTriggerTokenPayload(nil, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.WebhookTriggerTokenCreated, audit.WithContext(ctx, map[string]interface{}{
+		"jobID":      jobID,
+		"accessKey":  token.AccessKey,
+		"allowedIPs": allowedIPs,
+		"rateLimit":  rateLimit,
+	}))
+	return NewCreateWebhookTriggerTokenPayload(token, nil, nil), nil
+}
+
 func (r *Resolver) DeleteAPIToken(ctx context.Context, args struct {
 	Input struct{ Password string }
 }) (*DeleteAPITokenPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "user"); err != nil {
 		return nil, err
 	}
 
@@ -1038,7 +1121,7 @@ func (r *Resolver) DeleteAPIToken(ctx context.Context, args struct {
 
 	err = r.App.AuthenticationProvider().TestPassword(ctx, dbUser.Email, args.Input.Password)
 	if err != nil {
-		r.App.GetAuditLogger().Audit(audit.APITokenDeleteAttemptPasswordMismatch, map[string]interface{}{"user": dbUser.Email})
+		r.App.GetAuditLogger().Audit(audit.APITokenDeleteAttemptPasswordMismatch, audit.WithContext(ctx, map[string]interface{}{"user": dbUser.Email}))
 
 		return NewDeleteAPITokenPayload(nil, map[string]string{
 			"password": "incorrect password",
@@ -1050,7 +1133,7 @@ func (r *Resolver) DeleteAPIToken(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.APITokenDeleted, map[string]interface{}{"user": dbUser.Email})
+	r.App.GetAuditLogger().Audit(audit.APITokenDeleted, audit.WithContext(ctx, map[string]interface{}{"user": dbUser.Email}))
 
 	return NewDeleteAPITokenPayload(&auth.Token{
 		AccessKey: dbUser.TokenKey.String,
@@ -1062,7 +1145,7 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 		TOML string
 	}
 }) (*CreateJobPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, "jobs"); err != nil {
 		return nil, err
 	}
 
@@ -1132,7 +1215,7 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 	}
 
 	jbj, _ := json.Marshal(jb)
-	r.App.GetAuditLogger().Audit(audit.JobCreated, map[string]interface{}{"job": string(jbj)})
+	r.App.GetAuditLogger().Audit(audit.JobCreated, audit.WithContext(ctx, map[string]interface{}{"job": string(jbj)}))
 
 	return NewCreateJobPayload(r.App, &jb, nil), nil
 }
@@ -1140,7 +1223,11 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteJobPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	ids, err := r.jobIDs(ctx, args.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err = authenticateUserCanEdit(ctx, "jobs", ids...); err != nil {
 		return nil, err
 	}
 
@@ -1167,14 +1254,14 @@ func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.JobDeleted, map[string]interface{}{"id": args.ID})
+	r.App.GetAuditLogger().Audit(audit.JobDeleted, audit.WithContext(ctx, map[string]interface{}{"id": args.ID}))
 	return NewDeleteJobPayload(r.App, &j, nil), nil
 }
 
 func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DismissJobErrorPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, "pipeline", "job_spec_errors"); err != nil {
 		return nil, err
 	}
 
@@ -1201,14 +1288,18 @@ func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.JobErrorDismissed, map[string]interface{}{"id": args.ID})
+	r.App.GetAuditLogger().Audit(audit.JobErrorDismissed, audit.WithContext(ctx, map[string]interface{}{"id": args.ID}))
 	return NewDismissJobErrorPayload(&specErr, nil), nil
 }
 
 func (r *Resolver) RunJob(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*RunJobPayloadResolver, error) {
-	if err := authenticateUserCanRun(ctx); err != nil {
+	ids, err := r.jobIDs(ctx, args.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err = authenticateUserCanRun(ctx, "jobs", ids...); err != nil {
 		return nil, err
 	}
 
@@ -1231,14 +1322,80 @@ func (r *Resolver) RunJob(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.JobRunSet, map[string]interface{}{"jobID": args.ID, "jobRunID": jobRunID, "planRunID": plnRun})
+	r.App.GetAuditLogger().Audit(audit.JobRunSet, audit.WithContext(ctx, map[string]interface{}{"jobID": args.ID, "jobRunID": jobRunID, "planRunID": plnRun}))
 	return NewRunJobPayload(&plnRun, r.App, nil), nil
 }
 
+type simulateJobInput struct {
+	Vars  *string
+	Stubs *string
+}
+
+// SimulateJob dry-runs the pipeline of a job without persisting anything.
+func (r *Resolver) SimulateJob(ctx context.Context, args struct {
+	ID    graphql.ID
+	Input simulateJobInput
+}) (*SimulateJobPayloadResolver, error) {
+	ids, err := r.jobIDs(ctx, args.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err = authenticateUserCanRun(ctx, "jobs", ids...); err != nil {
+		return nil, err
+	}
+
+	jobID, err := stringutils.ToInt32(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	var vars map[string]interface{}
+	if args.Input.Vars != nil {
+		if err = json.Unmarshal([]byte(*args.Input.Vars), &vars); err != nil {
+			return NewSimulateJobPayload(nil, errors.Wrap(err, "invalid vars")), nil
+		}
+	}
+	var stubs map[string]pipeline.TaskStub
+	if args.Input.Stubs != nil {
+		if err = json.Unmarshal([]byte(*args.Input.Stubs), &stubs); err != nil {
+			return NewSimulateJobPayload(nil, errors.Wrap(err, "invalid stubs")), nil
+		}
+	}
+
+	sim, err := r.App.SimulateJobV2(ctx, jobID, vars, stubs)
+	if err != nil {
+		return NewSimulateJobPayload(nil, err), nil
+	}
+
+	r.App.GetAuditLogger().Audit(audit.JobSimulated, audit.WithContext(ctx, map[string]interface{}{"jobID": jobID}))
+	return NewSimulateJobPayload(sim, nil), nil
+}
+
+// RevokeWebhookTriggerToken revokes a webhook trigger token.
+func (r *Resolver) RevokeWebhookTriggerToken(ctx context.Context, args struct {
+	AccessKey string
+}) (*RevokeWebhookTriggerTokenPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx, "webhook_tokens", args.AccessKey); err != nil {
+		return nil, err
+	}
+
+	err := r.App.GetWebhookTriggerTokenManager().RevokeTriggerToken(ctx, args.AccessKey)
+	if err != nil {
+		if errors.Is(err, webhook.ErrTriggerTokenNotFound) {
+			return NewRevokeWebhookTriggerTokenPayload(args.AccessKey, err), nil
+		}
+
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.WebhookTriggerTokenRevoked, audit.WithContext(ctx, map[string]interface{}{"accessKey": args.AccessKey}))
+	return NewRevokeWebhookTriggerTokenPayload(args.AccessKey, nil), nil
+}
+
 func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 	Level LogLevel
 }) (*SetGlobalLogLevelPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, "log"); err != nil {
 		return nil, err
 	}
 
@@ -1256,7 +1413,7 @@ func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.GlobalLogLevelSet, map[string]interface{}{"logLevel": args.Level})
+	r.App.GetAuditLogger().Audit(audit.GlobalLogLevelSet, audit.WithContext(ctx, map[string]interface{}{"logLevel": args.Level}))
 	return NewSetGlobalLogLevelPayload(args.Level, nil), nil
 }
 
@@ -1264,7 +1421,7 @@ func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 func (r *Resolver) CreateOCR2KeyBundle(ctx context.Context, args struct {
 	ChainType OCR2ChainType
 }) (*CreateOCR2KeyBundlePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx, "keys", "ocr2"); err != nil {
 		return nil, err
 	}
 
@@ -1276,14 +1433,14 @@ func (r *Resolver) CreateOCR2KeyBundle(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.OCR2KeyBundleCreated, map[string]interface{}{
+	r.App.GetAuditLogger().Audit(audit.OCR2KeyBundleCreated, audit.WithContext(ctx, map[string]interface{}{
 		"ocrKeyID":                        key.ID(),
 		"ocrKeyChainType":                 key.ChainType(),
 		"ocrKeyConfigEncryptionPublicKey": key.ConfigEncryptionPublicKey(),
 		"ocrKeyOffchainPublicKey":         key.OffchainPublicKey(),
 		"ocrKeyMaxSignatureLength":        key.MaxSignatureLength(),
 		"ocrKeyPublicKey":                 key.PublicKey(),
-	})
+	}))
 
 	return NewCreateOCR2KeyBundlePayload(&key), nil
 }
@@ -1292,7 +1449,7 @@ func (r *Resolver) CreateOCR2KeyBundle(ctx context.Context, args struct {
 func (r *Resolver) DeleteOCR2KeyBundle(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteOCR2KeyBundlePayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx, "keys", "ocr2"); err != nil {
 		return nil, err
 	}
 
@@ -1307,6 +1464,127 @@ func (r *Resolver) DeleteOCR2KeyBundle(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	r.App.GetAuditLogger().Audit(audit.OCR2KeyBundleDeleted, map[string]interface{}{"id": id})
+	r.App.GetAuditLogger().Audit(audit.OCR2KeyBundleDeleted, audit.WithContext(ctx, map[string]interface{}{"id": id}))
 	return NewDeleteOCR2KeyBundlePayloadResolver(&key, nil), nil
 }
+
+// CancelEthTransaction replaces an unconfirmed transaction with a zero value
+// self-transfer at the same nonce.
+func (r *Resolver) CancelEthTransaction(ctx context.Context, args struct {
+	Hash graphql.ID
+}) (*CancelEthTransactionPayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx, "transactions", "evm"); err != nil {
+		return nil, err
+	}
+
+	hash := common.HexToHash(string(args.Hash))
+	replacer, err := r.evmTxReplacer(ctx, hash)
+	if err != nil {
+		if isNotFoundError(err) || errors.Is(err, txmgr.ErrReplacementNotSupported) {
+			return NewCancelEthTransactionPayload(nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	attempt, err := replacer.CancelTransaction(ctx, hash)
+	if err != nil {
+		return NewCancelEthTransactionPayload(nil, err), nil
+	}
+
+	r.App.GetAuditLogger().Audit(audit.EthTransactionCancelled, audit.WithContext(ctx, map[string]interface{}{
+		"txHash":      hash,
+		"attemptHash": attempt.Hash,
+	}))
+
+	return NewCancelEthTransactionPayload(&attempt.Tx, nil), nil
+}
+
+type speedUpEthTransactionInput struct {
+	GasPrice  *string
+	GasTipCap *string
+	GasFeeCap *string
+	GasLimit  *string
+}
+
+// SpeedUpEthTransaction re-sends an unconfirmed transaction with the given fees.
+func (r *Resolver) SpeedUpEthTransaction(ctx context.Context, args struct {
+	Hash  graphql.ID
+	Input speedUpEthTransactionInput
+}) (*SpeedUpEthTransactionPayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx, "transactions", "evm"); err != nil {
+		return nil, err
+	}
+
+	var fee gas.EvmFee
+	var err error
+	if fee.GasPrice, err = parseOptionalWei(args.Input.GasPrice); err != nil {
+		return nil, err
+	}
+	if fee.GasTipCap, err = parseOptionalWei(args.Input.GasTipCap); err != nil {
+		return nil, err
+	}
+	if fee.GasFeeCap, err = parseOptionalWei(args.Input.GasFeeCap); err != nil {
+		return nil, err
+	}
+	if fee.GasPrice == nil && (fee.GasTipCap == nil || fee.GasFeeCap == nil) {
+		return NewSpeedUpEthTransactionPayload(nil, errors.New("either gasPrice or both gasTipCap and gasFeeCap must be set")), nil
+	}
+	var gasLimit uint64
+	if args.Input.GasLimit != nil {
+		if gasLimit, err = strconv.ParseUint(*args.Input.GasLimit, 10, 64); err != nil {
+			return nil, err
+		}
+	}
+
+	hash := common.HexToHash(string(args.Hash))
+	replacer, err := r.evmTxReplacer(ctx, hash)
+	if err != nil {
+		if isNotFoundError(err) || errors.Is(err, txmgr.ErrReplacementNotSupported) {
+			return NewSpeedUpEthTransactionPayload(nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	attempt, err := replacer.SpeedUpTransaction(ctx, hash, fee, gasLimit)
+	if err != nil {
+		return NewSpeedUpEthTransactionPayload(nil, err), nil
+	}
+
+	r.App.GetAuditLogger().Audit(audit.EthTransactionSpedUp, audit.WithContext(ctx, map[string]interface{}{
+		"txHash":      hash,
+		"attemptHash": attempt.Hash,
+		"fee":         attempt.TxFee,
+		"gasLimit":    attempt.ChainSpecificFeeLimit,
+	}))
+
+	return NewSpeedUpEthTransactionPayload(&attempt.Tx, nil), nil
+}
+
+func (r *Resolver) evmTxReplacer(ctx context.Context, hash common.Hash) (txmgr.TxReplacer, error) {
+	etx, err := r.App.TxmStorageService().FindTxByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	chain, err := r.App.GetRelayers().LegacyEVMChains().Get(etx.ChainID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return legacyevm.TxReplacer(chain)
+}
+
+func parseOptionalWei(s *string) (*evmassets.Wei, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	wei := new(evmassets.Wei)
+	if err := wei.UnmarshalText([]byte(*s)); err != nil {
+		return nil, err
+	}
+
+	return wei, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"math"
 	"sort"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -15,16 +16,18 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/chains"
+	"github.com/smartcontractkit/chainlink/v2/core/services/functions"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
 	evmrelay "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 )
 
 // Bridge retrieves a bridges by name.
 func (r *Resolver) Bridge(ctx context.Context, args struct{ ID graphql.ID }) (*BridgePayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "bridge_types", string(args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -50,7 +53,7 @@ func (r *Resolver) Bridges(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*BridgesPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "bridge_types"); err != nil {
 		return nil, err
 	}
 
@@ -71,7 +74,7 @@ func (r *Resolver) Chain(ctx context.Context,
 		ID      graphql.ID
 		Network *string
 	}) (*ChainPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "chains"); err != nil {
 		return nil, err
 	}
 
@@ -104,7 +107,7 @@ func (r *Resolver) Chains(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*ChainsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "chains"); err != nil {
 		return nil, err
 	}
 
@@ -159,7 +162,7 @@ func sortByNetworkAndID(chains []chainlink.NetworkChainStatus) {
 
 // FeedsManager retrieves a feeds manager by id.
 func (r *Resolver) FeedsManager(ctx context.Context, args struct{ ID graphql.ID }) (*FeedsManagerPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "feeds_managers", string(args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -181,7 +184,7 @@ func (r *Resolver) FeedsManager(ctx context.Context, args struct{ ID graphql.ID
 }
 
 func (r *Resolver) FeedsManagers(ctx context.Context) (*FeedsManagersPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "feeds_managers"); err != nil {
 		return nil, err
 	}
 
@@ -193,9 +196,108 @@ func (r *Resolver) FeedsManagers(ctx context.Context) (*FeedsManagersPayloadReso
 	return NewFeedsManagersPayload(mgrs), nil
 }
 
+// FunctionsRequest retrieves a request of a Functions contract, with the time spent between its state transitions.
+func (r *Resolver) FunctionsRequest(ctx context.Context, args struct {
+	Contract string
+	ID       graphql.ID
+}) (*FunctionsRequestPayloadResolver, error) {
+	if err := authenticateUser(ctx, "functions"); err != nil {
+		return nil, err
+	}
+
+	requestID, err := functions.ParseRequestID(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+	orm, err := r.functionsORM(args.Contract)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := orm.FindById(ctx, requestID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewFunctionsRequestPayload(nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	return NewFunctionsRequestPayload(request, nil), nil
+}
+
+// FunctionsRequests retrieves a page of the requests of a Functions contract, most recently received first.
+func (r *Resolver) FunctionsRequests(ctx context.Context, args struct {
+	Contract string
+	Filter   *functionsRequestFilterInput
+	Offset   *int32
+	Limit    *int32
+}) (*FunctionsRequestsPayloadResolver, error) {
+	if err := authenticateUser(ctx, "functions"); err != nil {
+		return nil, err
+	}
+
+	filter, err := args.Filter.toRequestFilter()
+	if err != nil {
+		return nil, err
+	}
+	orm, err := r.functionsORM(args.Contract)
+	if err != nil {
+		return nil, err
+	}
+
+	offset := pageOffset(args.Offset)
+	limit := pageLimit(args.Limit)
+
+	requests, count, err := orm.FindRequests(ctx, filter, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFunctionsRequestsPayload(requests, int32(count)), nil
+}
+
+// FunctionsRequestErrors counts the failed requests of a Functions contract per error type.
+func (r *Resolver) FunctionsRequestErrors(ctx context.Context, args struct {
+	Contract string
+	Filter   *functionsRequestFilterInput
+}) (*FunctionsRequestErrorsPayloadResolver, error) {
+	if err := authenticateUser(ctx, "functions"); err != nil {
+		return nil, err
+	}
+
+	filter, err := args.Filter.toRequestFilter()
+	if err != nil {
+		return nil, err
+	}
+	orm, err := r.functionsORM(args.Contract)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := orm.CountErrorTypes(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFunctionsRequestErrorsPayload(counts), nil
+}
+
+// functionsORM returns the Functions ORM of a contract.
+func (r *Resolver) functionsORM(contract string) (functions.ORM, error) {
+	if !common.IsHexAddress(contract) {
+		return nil, fmt.Errorf("bad contract address %q", contract)
+	}
+	return functions.NewORM(r.App.GetDB(), common.HexToAddress(contract)), nil
+}
+
 // Job retrieves a job by id.
 func (r *Resolver) Job(ctx context.Context, args struct{ ID graphql.ID }) (*JobPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	ids, err := r.jobIDs(ctx, args.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err = authenticateUser(ctx, "jobs", ids...); err != nil {
 		return nil, err
 	}
 
@@ -226,13 +328,24 @@ func (r *Resolver) Jobs(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*JobsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "jobs"); err != nil {
 		return nil, err
 	}
 
 	offset := pageOffset(args.Offset)
 	limit := pageLimit(args.Limit)
 
+	// users with a custom role are only shown the jobs it allows, so the page is taken after filtering all jobs
+	if session, ok := auth.GetGQLAuthenticatedSession(ctx); ok && len(session.User.RolePermissions) > 0 {
+		jobs, _, err := r.App.JobORM().FindJobs(ctx, 0, math.MaxInt32)
+		if err != nil {
+			return nil, err
+		}
+		jobs = auth.VisibleJobs(session.User, jobs)
+		count := len(jobs)
+		return NewJobsPayload(r.App, jobs[min(offset, count):min(offset+limit, count)], int32(count)), nil
+	}
+
 	jobs, count, err := r.App.JobORM().FindJobs(ctx, offset, limit)
 	if err != nil {
 		return nil, err
@@ -242,7 +355,7 @@ func (r *Resolver) Jobs(ctx context.Context, args struct {
 }
 
 func (r *Resolver) OCRKeyBundles(ctx context.Context) (*OCRKeyBundlesPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "keys", "ocr"); err != nil {
 		return nil, err
 	}
 
@@ -255,7 +368,7 @@ func (r *Resolver) OCRKeyBundles(ctx context.Context) (*OCRKeyBundlesPayloadReso
 }
 
 func (r *Resolver) CSAKeys(ctx context.Context) (*CSAKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "keys", "csa"); err != nil {
 		return nil, err
 	}
 
@@ -269,7 +382,7 @@ func (r *Resolver) CSAKeys(ctx context.Context) (*CSAKeysPayloadResolver, error)
 
 // Features retrieves each featured enabled by boolean mapping
 func (r *Resolver) Features(ctx context.Context) (*FeaturesPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "features"); err != nil {
 		return nil, err
 	}
 
@@ -278,7 +391,7 @@ func (r *Resolver) Features(ctx context.Context) (*FeaturesPayloadResolver, erro
 
 // Node retrieves a node by ID (Name)
 func (r *Resolver) Node(ctx context.Context, args struct{ ID graphql.ID }) (*NodePayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "nodes"); err != nil {
 		return nil, err
 	}
 	r.App.GetLogger().Debug("resolver Node args %v", args)
@@ -306,7 +419,7 @@ func (r *Resolver) Node(ctx context.Context, args struct{ ID graphql.ID }) (*Nod
 }
 
 func (r *Resolver) P2PKeys(ctx context.Context) (*P2PKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "keys", "p2p"); err != nil {
 		return nil, err
 	}
 
@@ -320,7 +433,7 @@ func (r *Resolver) P2PKeys(ctx context.Context) (*P2PKeysPayloadResolver, error)
 
 // VRFKeys fetches all VRF keys.
 func (r *Resolver) VRFKeys(ctx context.Context) (*VRFKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "keys", "vrf"); err != nil {
 		return nil, err
 	}
 
@@ -336,7 +449,7 @@ func (r *Resolver) VRFKeys(ctx context.Context) (*VRFKeysPayloadResolver, error)
 func (r *Resolver) VRFKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*VRFKeyPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "keys", "vrf"); err != nil {
 		return nil, err
 	}
 
@@ -355,7 +468,7 @@ func (r *Resolver) VRFKey(ctx context.Context, args struct {
 func (r *Resolver) JobProposal(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*JobProposalPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "job_proposals", string(args.ID)); err != nil {
 		return nil, err
 	}
 
@@ -381,7 +494,7 @@ func (r *Resolver) Nodes(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*NodesPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "nodes"); err != nil {
 		return nil, err
 	}
 
@@ -406,7 +519,7 @@ func (r *Resolver) JobRuns(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*JobRunsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "pipeline"); err != nil {
 		return nil, err
 	}
 
@@ -424,7 +537,7 @@ func (r *Resolver) JobRuns(ctx context.Context, args struct {
 func (r *Resolver) JobRun(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*JobRunPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "pipeline"); err != nil {
 		return nil, err
 	}
 
@@ -446,7 +559,7 @@ func (r *Resolver) JobRun(ctx context.Context, args struct {
 }
 
 func (r *Resolver) ETHKeys(ctx context.Context) (*ETHKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "keys", "eth", "evm"); err != nil {
 		return nil, err
 	}
 
@@ -499,7 +612,7 @@ func (r *Resolver) ETHKeys(ctx context.Context) (*ETHKeysPayloadResolver, error)
 
 // ConfigV2 retrieves the Chainlink node's configuration (V2 mode)
 func (r *Resolver) ConfigV2(ctx context.Context) (*ConfigV2PayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "config"); err != nil {
 		return nil, err
 	}
 
@@ -510,7 +623,7 @@ func (r *Resolver) ConfigV2(ctx context.Context) (*ConfigV2PayloadResolver, erro
 func (r *Resolver) EthTransaction(ctx context.Context, args struct {
 	Hash graphql.ID
 }) (*EthTransactionPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "transactions", "evm"); err != nil {
 		return nil, err
 	}
 
@@ -531,7 +644,7 @@ func (r *Resolver) EthTransactions(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*EthTransactionsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "transactions", "evm"); err != nil {
 		return nil, err
 	}
 
@@ -550,7 +663,7 @@ func (r *Resolver) EthTransactionsAttempts(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*EthTransactionsAttemptsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "tx_attempts", "evm"); err != nil {
 		return nil, err
 	}
 
@@ -566,7 +679,7 @@ func (r *Resolver) EthTransactionsAttempts(ctx context.Context, args struct {
 }
 
 func (r *Resolver) GlobalLogLevel(ctx context.Context) (*GlobalLogLevelPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "log"); err != nil {
 		return nil, err
 	}
 
@@ -576,7 +689,7 @@ func (r *Resolver) GlobalLogLevel(ctx context.Context) (*GlobalLogLevelPayloadRe
 }
 
 func (r *Resolver) SolanaKeys(ctx context.Context) (*SolanaKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "keys", "solana"); err != nil {
 		return nil, err
 	}
 
@@ -589,7 +702,7 @@ func (r *Resolver) SolanaKeys(ctx context.Context) (*SolanaKeysPayloadResolver,
 }
 
 func (r *Resolver) AptosKeys(ctx context.Context) (*AptosKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "keys", "aptos"); err != nil {
 		return nil, err
 	}
 
@@ -602,7 +715,7 @@ func (r *Resolver) AptosKeys(ctx context.Context) (*AptosKeysPayloadResolver, er
 }
 
 func (r *Resolver) CosmosKeys(ctx context.Context) (*CosmosKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "keys", "cosmos"); err != nil {
 		return nil, err
 	}
 	keys, err := r.App.GetKeyStore().Cosmos().GetAll()
@@ -614,7 +727,7 @@ func (r *Resolver) CosmosKeys(ctx context.Context) (*CosmosKeysPayloadResolver,
 }
 
 func (r *Resolver) StarkNetKeys(ctx context.Context) (*StarkNetKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "keys", "starknet"); err != nil {
 		return nil, err
 	}
 	keys, err := r.App.GetKeyStore().StarkNet().GetAll()
@@ -626,7 +739,7 @@ func (r *Resolver) StarkNetKeys(ctx context.Context) (*StarkNetKeysPayloadResolv
 }
 
 func (r *Resolver) TronKeys(ctx context.Context) (*TronKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "keys", "tron"); err != nil {
 		return nil, err
 	}
 
@@ -639,7 +752,7 @@ func (r *Resolver) TronKeys(ctx context.Context) (*TronKeysPayloadResolver, erro
 }
 
 func (r *Resolver) SQLLogging(ctx context.Context) (*GetSQLLoggingPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "log"); err != nil {
 		return nil, err
 	}
 
@@ -650,7 +763,7 @@ func (r *Resolver) SQLLogging(ctx context.Context) (*GetSQLLoggingPayloadResolve
 
 // OCR2KeyBundles resolves the list of OCR2 key bundles
 func (r *Resolver) OCR2KeyBundles(ctx context.Context) (*OCR2KeyBundlesPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUser(ctx, "keys", "ocr2"); err != nil {
 		return nil, err
 	}
 
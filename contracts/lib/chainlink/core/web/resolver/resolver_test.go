@@ -60,6 +60,7 @@ type mocks struct {
 	relayerChainInterops *chainlinkMocks.FakeRelayerChainInteroperators
 	ethClient            *clienttest.Client
 	eIMgr                *webhookmocks.ExternalInitiatorManager
+	triggerTokens        *webhookmocks.TriggerTokenManager
 	balM                 *evmMonMocks.BalanceMonitor
 	txmStore             *evmtxmgrmocks.EvmTxStore
 	auditLogger          *audit.AuditLoggerService
@@ -119,6 +120,7 @@ func setupFramework(t *testing.T) *gqlTestFramework {
 		relayerChainInterops: &chainlinkMocks.FakeRelayerChainInteroperators{},
 		ethClient:            clienttest.NewClient(t),
 		eIMgr:                webhookmocks.NewExternalInitiatorManager(t),
+		triggerTokens:        webhookmocks.NewTriggerTokenManager(t),
 		balM:                 evmMonMocks.NewBalanceMonitor(t),
 		txmStore:             evmtxmgrmocks.NewEvmTxStore(t),
 		auditLogger:          &audit.AuditLoggerService{},
@@ -167,6 +167,58 @@ func (r *CronSpecResolver) EVMChainID() *string {
 	return &chainID
 }
 
+// TimeZone resolves the spec's time zone.
+func (r *CronSpecResolver) TimeZone() *string {
+	if r.spec.TimeZone == "" {
+		return nil
+	}
+
+	return &r.spec.TimeZone
+}
+
+// MissedRunPolicy resolves the spec's missed run policy.
+func (r *CronSpecResolver) MissedRunPolicy() string {
+	if r.spec.MissedRunPolicy == "" {
+		return string(job.MissedRunSkip)
+	}
+
+	return string(r.spec.MissedRunPolicy)
+}
+
+// MaxMissedRuns resolves the spec's max missed runs.
+func (r *CronSpecResolver) MaxMissedRuns() int32 {
+	return int32(r.spec.MaxMissedRuns)
+}
+
+// ConcurrencyPolicy resolves the spec's concurrency policy.
+func (r *CronSpecResolver) ConcurrencyPolicy() string {
+	if r.spec.ConcurrencyPolicy == "" {
+		return string(job.ConcurrencyAllow)
+	}
+
+	return string(r.spec.ConcurrencyPolicy)
+}
+
+// Jitter resolves the spec's jitter.
+func (r *CronSpecResolver) Jitter() *string {
+	if r.spec.Jitter.Duration() == 0 {
+		return nil
+	}
+
+	jitter := r.spec.Jitter.Duration().String()
+
+	return &jitter
+}
+
+// LastFiredAt resolves the spec's last fired timestamp.
+func (r *CronSpecResolver) LastFiredAt() *graphql.Time {
+	if r.spec.LastFiredAt == nil {
+		return nil
+	}
+
+	return &graphql.Time{Time: *r.spec.LastFiredAt}
+}
+
 // CreatedAt resolves the spec's created at timestamp.
 func (r *CronSpecResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.spec.CreatedAt}
@@ -729,6 +781,16 @@ type WebhookSpecResolver struct {
 	spec job.WebhookSpec
 }
 
+// IdempotencyWindow resolves the spec's idempotency window.
+func (r *WebhookSpecResolver) IdempotencyWindow() string {
+	return r.spec.IdempotencyWindow.Duration().String()
+}
+
+// IdempotencyKeyLease resolves the spec's idempotency key lease.
+func (r *WebhookSpecResolver) IdempotencyKeyLease() string {
+	return r.spec.IdempotencyKeyLease.Duration().String()
+}
+
 // CreatedAt resolves the spec's created at timestamp.
 func (r *WebhookSpecResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.spec.CreatedAt}
@@ -42,9 +42,14 @@ func TestResolver_CronSpec(t *testing.T) {
 				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, id).Return(job.Job{
 					Type: job.Cron,
 					CronSpec: &job.CronSpec{
-						CronSchedule: "CRON_TZ=UTC 0 0 1 1 *",
-						EVMChainID:   ubig.NewI(42),
-						CreatedAt:    f.Timestamp(),
+						CronSchedule:      "0 0 1 1 *",
+						EVMChainID:        ubig.NewI(42),
+						TimeZone:          "Europe/Berlin",
+						MissedRunPolicy:   job.MissedRunAll,
+						MaxMissedRuns:     3,
+						ConcurrencyPolicy: job.ConcurrencyReplace,
+						Jitter:            models.Interval(5 * time.Second),
+						CreatedAt:         f.Timestamp(),
 					},
 				}, nil)
 			},
@@ -57,6 +62,12 @@ func TestResolver_CronSpec(t *testing.T) {
 								... on CronSpec {
 									schedule
 									evmChainID
+									timeZone
+									missedRunPolicy
+									maxMissedRuns
+									concurrencyPolicy
+									jitter
+									lastFiredAt
 									createdAt
 								}
 							}
@@ -69,8 +80,14 @@ func TestResolver_CronSpec(t *testing.T) {
 					"job": {
 						"spec": {
 							"__typename": "CronSpec",
-							"schedule": "CRON_TZ=UTC 0 0 1 1 *",
+							"schedule": "0 0 1 1 *",
 							"evmChainID": "42",
+							"timeZone": "Europe/Berlin",
+							"missedRunPolicy": "runAll",
+							"maxMissedRuns": 3,
+							"concurrencyPolicy": "replace",
+							"jitter": "5s",
+							"lastFiredAt": null,
 							"createdAt": "2021-01-01T00:00:00Z"
 						}
 					}
@@ -698,7 +715,9 @@ func TestResolver_WebhookSpec(t *testing.T) {
 				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, id).Return(job.Job{
 					Type: job.Webhook,
 					WebhookSpec: &job.WebhookSpec{
-						CreatedAt: f.Timestamp(),
+						IdempotencyWindow:   models.Interval(time.Hour),
+						IdempotencyKeyLease: models.Interval(5 * time.Minute),
+						CreatedAt:           f.Timestamp(),
 					},
 				}, nil)
 			},
@@ -709,6 +728,8 @@ func TestResolver_WebhookSpec(t *testing.T) {
 							spec {
 								__typename
 								... on WebhookSpec {
+									idempotencyWindow
+									idempotencyKeyLease
 									createdAt
 								}
 							}
@@ -721,6 +742,8 @@ func TestResolver_WebhookSpec(t *testing.T) {
 					"job": {
 						"spec": {
 							"__typename": "WebhookSpec",
+							"idempotencyWindow": "1h0m0s",
+							"idempotencyKeyLease": "5m0s",
 							"createdAt": "2021-01-01T00:00:00Z"
 						}
 					}
@@ -1,6 +1,8 @@
 package resolver
 
 import (
+	"encoding/json"
+
 	"github.com/graph-gophers/graphql-go"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
@@ -59,3 +61,85 @@ func (r *TaskRunResolver) FinishedAt() *graphql.Time {
 func (r *TaskRunResolver) DotID() string {
 	return r.tr.GetDotID()
 }
+
+// TaskTraceResolver resolves the execution of a task in a job simulation
+type TaskTraceResolver struct {
+	tt pipeline.TaskTrace
+}
+
+func NewTaskTraces(traces []pipeline.TaskTrace) []*TaskTraceResolver {
+	resolvers := []*TaskTraceResolver{}
+
+	for _, tt := range traces {
+		resolvers = append(resolvers, &TaskTraceResolver{tt: tt})
+	}
+
+	return resolvers
+}
+
+func (r *TaskTraceResolver) DotID() string {
+	return r.tt.DotID
+}
+
+func (r *TaskTraceResolver) Type() string {
+	return string(r.tt.Type)
+}
+
+func (r *TaskTraceResolver) Params() string {
+	val, err := json.Marshal(r.tt.Params)
+	if err != nil {
+		return "error: unable to retrieve params"
+	}
+	return string(val)
+}
+
+// Inputs returns each input as a JSON object holding either its value or its error
+func (r *TaskTraceResolver) Inputs() []string {
+	inputs := []string{}
+
+	for _, input := range r.tt.Inputs {
+		var errStr *string
+		if input.Error != nil {
+			errStr = input.ErrorDB().Ptr()
+		}
+		val, err := json.Marshal(struct {
+			Value interface{} `json:"value"`
+			Error *string     `json:"error"`
+		}{input.OutputDB(), errStr})
+		if err != nil {
+			inputs = append(inputs, "error: unable to retrieve input")
+			continue
+		}
+		inputs = append(inputs, string(val))
+	}
+
+	return inputs
+}
+
+func (r *TaskTraceResolver) Output() string {
+	val, err := pipeline.Result{Value: r.tt.Output}.OutputDB().MarshalJSON()
+	if err != nil {
+		return "error: unable to retrieve output"
+	}
+	return string(val)
+}
+
+func (r *TaskTraceResolver) Error() *string {
+	if r.tt.Error == nil {
+		return nil
+	}
+	errStr := r.tt.Error.Error()
+	return &errStr
+}
+
+func (r *TaskTraceResolver) Duration() string {
+	return r.tt.Duration.String()
+}
+
+func (r *TaskTraceResolver) Retries() int32 {
+	return int32(r.tt.Retries)
+}
+
+func (r *TaskTraceResolver) Stubbed() bool {
+	return r.tt.Stubbed
+}
@@ -0,0 +1,137 @@
+package resolver
+
+import (
+	"github.com/graph-gophers/graphql-go"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
+)
+
+// WebhookTriggerTokenResolver resolves the WebhookTriggerToken type.
+type WebhookTriggerTokenResolver struct {
+	token webhook.TriggerToken
+	// withSecret is only set for newly created tokens
+	withSecret bool
+}
+
+func NewWebhookTriggerToken(token webhook.TriggerToken) *WebhookTriggerTokenResolver {
+	return &WebhookTriggerTokenResolver{token: token}
+}
+
+func NewWebhookTriggerTokens(tokens []webhook.TriggerToken) []*WebhookTriggerTokenResolver {
+	resolvers := []*WebhookTriggerTokenResolver{}
+	for _, t := range tokens {
+		resolvers = append(resolvers, NewWebhookTriggerToken(t))
+	}
+
+	return resolvers
+}
+
+func (r *WebhookTriggerTokenResolver) AccessKey() string {
+	return r.token.AccessKey
+}
+
+func (r *WebhookTriggerTokenResolver) Secret() *string {
+	if !r.withSecret {
+		return nil
+	}
+
+	return &r.token.Secret
+}
+
+func (r *WebhookTriggerTokenResolver) AllowedIPs() []string {
+	if r.token.AllowedIPs == nil {
+		return []string{}
+	}
+
+	return r.token.AllowedIPs
+}
+
+func (r *WebhookTriggerTokenResolver) RateLimit() int32 {
+	return int32(r.token.RateLimit)
+}
+
+func (r *WebhookTriggerTokenResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.token.CreatedAt}
+}
+
+// -- CreateWebhookTriggerToken Mutation --
+
+type CreateWebhookTriggerTokenPayloadResolver struct {
+	token     *webhook.TriggerToken
+	inputErrs map[string]string
+	NotFoundErrorUnionType
+}
+
+func NewCreateWebhookTriggerTokenPayload(token *webhook.TriggerToken, inputErrs map[string]string, err error) *CreateWebhookTriggerTokenPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "webhook job not found", isExpectedErrorFn: func(err error) bool {
+		return errors.Is(err, webhook.ErrJobNotExists)
+	}}
+
+	return &CreateWebhookTriggerTokenPayloadResolver{token: token, inputErrs: inputErrs, NotFoundErrorUnionType: e}
+}
+
+func (r *CreateWebhookTriggerTokenPayloadResolver) ToCreateWebhookTriggerTokenSuccess() (*CreateWebhookTriggerTokenSuccessResolver, bool) {
+	if r.token == nil {
+		return nil, false
+	}
+
+	return NewCreateWebhookTriggerTokenSuccess(*r.token), true
+}
+
+func (r *CreateWebhookTriggerTokenPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs != nil {
+		var errs []*InputErrorResolver
+
+		for path, message := range r.inputErrs {
+			errs = append(errs, NewInputError(path, message))
+		}
+
+		return NewInputErrors(errs), true
+	}
+
+	return nil, false
+}
+
+type CreateWebhookTriggerTokenSuccessResolver struct {
+	token webhook.TriggerToken
+}
+
+func NewCreateWebhookTriggerTokenSuccess(token webhook.TriggerToken) *CreateWebhookTriggerTokenSuccessResolver {
+	return &CreateWebhookTriggerTokenSuccessResolver{token}
+}
+
+func (r *CreateWebhookTriggerTokenSuccessResolver) Token() *WebhookTriggerTokenResolver {
+	return &WebhookTriggerTokenResolver{token: r.token, withSecret: true}
+}
+
+// -- RevokeWebhookTriggerToken Mutation --
+
+type RevokeWebhookTriggerTokenPayloadResolver struct {
+	accessKey string
+	NotFoundErrorUnionType
+}
+
+func NewRevokeWebhookTriggerTokenPayload(accessKey string, err error) *RevokeWebhookTriggerTokenPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "webhook trigger token not found", isExpectedErrorFn: func(err error) bool {
+		return errors.Is(err, webhook.ErrTriggerTokenNotFound)
+	}}
+
+	return &RevokeWebhookTriggerTokenPayloadResolver{accessKey: accessKey, NotFoundErrorUnionType: e}
+}
+
+func (r *RevokeWebhookTriggerTokenPayloadResolver) ToRevokeWebhookTriggerTokenSuccess() (*RevokeWebhookTriggerTokenSuccessResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	return &RevokeWebhookTriggerTokenSuccessResolver{r.accessKey}, true
+}
+
+type RevokeWebhookTriggerTokenSuccessResolver struct {
+	accessKey string
+}
+
+func (r *RevokeWebhookTriggerTokenSuccessResolver) AccessKey() string {
+	return r.accessKey
+}
@@ -0,0 +1,183 @@
+package resolver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
+)
+
+func TestResolver_CreateWebhookTriggerToken(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation CreateWebhookTriggerToken($jobID: ID!, $input: CreateWebhookTriggerTokenInput!) {
+			createWebhookTriggerToken(jobID: $jobID, input: $input) {
+				... on CreateWebhookTriggerTokenSuccess {
+					token {
+						accessKey
+						secret
+						allowedIPs
+						rateLimit
+						createdAt
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+	id := int32(12)
+	variables := map[string]interface{}{
+		"jobID": stringutils.FromInt32(id),
+		"input": map[string]interface{}{
+			"allowedIPs": []string{"10.0.0.0/24"},
+			"rateLimit":  60,
+		},
+	}
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "createWebhookTriggerToken"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.triggerTokens.On("CreateTriggerToken", mock.Anything, id, []string{"10.0.0.0/24"}, uint32(60)).Return(&webhook.TriggerToken{
+					JobID:      id,
+					AccessKey:  "access-key",
+					Secret:     "secret",
+					AllowedIPs: []string{"10.0.0.0/24"},
+					RateLimit:  60,
+					CreatedAt:  createdAt,
+				}, nil)
+				f.App.On("GetWebhookTriggerTokenManager").Return(f.Mocks.triggerTokens)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"createWebhookTriggerToken": {
+						"token": {
+							"accessKey": "access-key",
+							"secret": "secret",
+							"allowedIPs": ["10.0.0.0/24"],
+							"rateLimit": 60,
+							"createdAt": "2024-01-01T00:00:00Z"
+						}
+					}
+				}`,
+		},
+		{
+			name:          "not found job error",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.triggerTokens.On("CreateTriggerToken", mock.Anything, id, []string{"10.0.0.0/24"}, uint32(60)).Return(nil, webhook.ErrJobNotExists)
+				f.App.On("GetWebhookTriggerTokenManager").Return(f.Mocks.triggerTokens)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"createWebhookTriggerToken": {
+						"code": "NOT_FOUND",
+						"message": "webhook job not found"
+					}
+				}`,
+		},
+		{
+			name:          "invalid allowed IPs",
+			authenticated: true,
+			query:         mutation,
+			variables: map[string]interface{}{
+				"jobID": stringutils.FromInt32(id),
+				"input": map[string]interface{}{
+					"allowedIPs": []string{"not-an-ip"},
+				},
+			},
+			result: `
+				{
+					"createWebhookTriggerToken": {
+						"errors": [{
+							"path": "allowedIPs",
+							"message": "invalid IP \"not-an-ip\"",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_RevokeWebhookTriggerToken(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation RevokeWebhookTriggerToken($accessKey: String!) {
+			revokeWebhookTriggerToken(accessKey: $accessKey) {
+				... on RevokeWebhookTriggerTokenSuccess {
+					accessKey
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"accessKey": "access-key",
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "revokeWebhookTriggerToken"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.triggerTokens.On("RevokeTriggerToken", mock.Anything, "access-key").Return(nil)
+				f.App.On("GetWebhookTriggerTokenManager").Return(f.Mocks.triggerTokens)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"revokeWebhookTriggerToken": {
+						"accessKey": "access-key"
+					}
+				}`,
+		},
+		{
+			name:          "not found error",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.triggerTokens.On("RevokeTriggerToken", mock.Anything, "access-key").Return(webhook.ErrTriggerTokenNotFound)
+				f.App.On("GetWebhookTriggerTokenManager").Return(f.Mocks.triggerTokens)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"revokeWebhookTriggerToken": {
+						"code": "NOT_FOUND",
+						"message": "webhook trigger token not found"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/gin-contrib/sessions/cookie"
 	limits "github.com/gin-contrib/size"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/graph-gophers/graphql-go"
 	"github.com/graph-gophers/graphql-go/relay"
 	"github.com/pkg/errors"
@@ -37,7 +38,9 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/build"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/static"
 	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 	"github.com/smartcontractkit/chainlink/v2/core/web/resolver"
@@ -65,6 +68,7 @@ func NewRouter(app chainlink.Application, prometheus *ginprom.Prometheus) (*gin.
 		otelgin.Middleware("chainlink-web-routes",
 			otelgin.WithTracerProvider(otel.GetTracerProvider())),
 		limits.RequestSizeLimiter(config.WebServer().HTTPMaxSize()),
+		requestIDFunc(),
 		loggerFunc(app.GetLogger()),
 		gin.Recovery(),
 		cors,
@@ -83,6 +87,7 @@ func NewRouter(app chainlink.Application, prometheus *ginprom.Prometheus) (*gin.
 			rl.Authenticated(),
 		),
 		sessions.Sessions(auth.SessionName, sessionStore),
+		auth.WithJobFinder(app.JobORM()),
 	)
 
 	debugRoutes(app, api)
@@ -251,6 +256,13 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/user/token", uc.NewAPIToken)
 		authv2.POST("/user/token/delete", uc.DeleteAPIToken)
 
+		crc := CustomRolesController{app}
+		authv2.GET("/roles", auth.RequiresAdminRole(crc.Index))
+		authv2.POST("/roles", auth.RequiresAdminRole(crc.Create))
+		authv2.PATCH("/roles/:name", auth.RequiresAdminRole(crc.Update))
+		authv2.DELETE("/roles/:name", auth.RequiresAdminRole(crc.Delete))
+		authv2.PATCH("/users/:email/custom_role", auth.RequiresAdminRole(crc.Assign))
+
 		wa := NewWebAuthnController(app)
 		authv2.GET("/enroll_webauthn", wa.BeginRegistration)
 		authv2.POST("/enroll_webauthn", wa.FinishRegistration)
@@ -286,6 +298,8 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		txs := TransactionsController{app}
 		authv2.GET("/transactions/evm", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/evm/:TxHash", txs.Show)
+		authv2.POST("/transactions/evm/:TxHash/cancel", auth.RequiresAdminRole(txs.Cancel))
+		authv2.POST("/transactions/evm/:TxHash/speedup", auth.RequiresAdminRole(txs.SpeedUp))
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/:TxHash", txs.Show)
 
@@ -367,6 +381,10 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/keys/vrf/import", auth.RequiresAdminRole(vrfkc.Import))
 		authv2.POST("/keys/vrf/export/:keyID", auth.RequiresAdminRole(vrfkc.Export))
 
+		kbc := KeyBundleController{app}
+		authv2.POST("/keys/export-all", auth.RequiresAdminRole(kbc.Export))
+		authv2.POST("/keys/import-all", auth.RequiresAdminRole(kbc.Import))
+
 		jc := JobsController{app}
 		authv2.GET("/jobs", paginatedRequest(jc.Index))
 		authv2.GET("/jobs/:ID", jc.Show)
@@ -378,6 +396,25 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
+		authv2.DELETE("/jobs/:ID/runs", auth.RequiresEditRole(prc.Prune))
+		authv2.POST("/jobs/:ID/simulate", auth.RequiresRunRole(prc.Simulate))
+
+		// WebhookTriggerTokensController
+		wtc := WebhookTriggerTokensController{app}
+		authv2.GET("/jobs/:ID/webhook_tokens", auth.RequiresEditRole(wtc.Index))
+		authv2.POST("/jobs/:ID/webhook_tokens", auth.RequiresEditRole(wtc.Create))
+		authv2.DELETE("/webhook_tokens/:AccessKey", auth.RequiresEditRole(wtc.Destroy))
+
+		// S4Controller
+		s4c := S4Controller{app}
+		authv2.GET("/s4/:namespace", auth.RequiresEditRole(paginatedRequest(s4c.Index)))
+		authv2.GET("/jobs/:ID/s4/usage/:address", auth.RequiresEditRole(s4c.Usage))
+
+		// FunctionsRequestsController
+		frc := FunctionsRequestsController{app}
+		authv2.GET("/functions/requests", paginatedRequest(frc.Index))
+		authv2.GET("/functions/requests/:requestID", frc.Show)
+		authv2.GET("/functions/request_errors", frc.Errors)
 
 		// FeaturesController
 		fc := FeaturesController{app}
@@ -390,6 +427,12 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/log", lgc.Get)
 		authv2.PATCH("/log", auth.RequiresAdminRole(lgc.Patch))
 
+		pfc := ProfilesController{app}
+		authv2.GET("/profiles", pfc.Index)
+		authv2.GET("/profiles/heap_diff", pfc.HeapDiff)
+		authv2.GET("/profiles/:name", pfc.Show)
+		authv2.DELETE("/profiles/:name", auth.RequiresAdminRole(pfc.Destroy))
+
 		chains := authv2.Group("chains")
 		chainController := NewChainsController(
 			app.GetRelayers(),
@@ -423,6 +466,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 
 	ping := PingController{app}
 	userOrEI := r.Group("/v2", auth.Authenticate(app.AuthenticationProvider(),
+		auth.AuthenticateWebhookTriggerToken(app.GetWebhookTriggerTokenManager()),
 		auth.AuthenticateExternalInitiator,
 		auth.AuthenticateByToken,
 		auth.AuthenticateBySession,
@@ -505,6 +549,24 @@ func guiAssetRoutes(engine *gin.Engine, rateLimitingDisabled bool, lggr logger.S
 	engine.NoRoute(noRouteHandlers...)
 }
 
+// maxRequestIDLength is the longest request ID accepted from a client.
+const maxRequestIDLength = 128
+
+// requestIDFunc uses the request ID sent by the client, or generates one, and
+// returns it in the response and records it in the audit log events of the
+// request.
+func requestIDFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(static.RequestIDHeader)
+		if requestID == "" || len(requestID) > maxRequestIDLength {
+			requestID = uuid.NewString()
+		}
+		c.Header(static.RequestIDHeader, requestID)
+		c.Request = c.Request.WithContext(audit.WithRequestID(c.Request.Context(), requestID))
+		c.Next()
+	}
+}
+
 // Inspired by https://github.com/gin-gonic/gin/issues/961
 func loggerFunc(lggr logger.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
@@ -13,6 +14,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	clhttptest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/httptest"
+	"github.com/smartcontractkit/chainlink/v2/core/static"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
 
 	"github.com/stretchr/testify/assert"
@@ -195,3 +197,31 @@ func TestRouter_GinHelmetHeaders(t *testing.T) {
 			"wrong header for helmet's %s handler", tt.HelmetName)
 	}
 }
+
+func TestRouter_RequestID(t *testing.T) {
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	router := web.Router(t, app, nil)
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	get := func(requestID string) string {
+		req, err := http.NewRequestWithContext(ctx, "GET", ts.URL+"/health", nil)
+		require.NoError(t, err)
+		if requestID != "" {
+			req.Header.Set(static.RequestIDHeader, requestID)
+		}
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, res.Body.Close())
+		return res.Header.Get(static.RequestIDHeader)
+	}
+
+	assert.Equal(t, "my-request", get("my-request"))
+	_, err := uuid.Parse(get(""))
+	require.NoError(t, err)
+	_, err = uuid.Parse(get(strings.Repeat("a", 129)))
+	require.NoError(t, err)
+}
@@ -0,0 +1,107 @@
+package web
+
+import (
+	"database/sql"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/functions"
+	"github.com/smartcontractkit/chainlink/v2/core/services/s4"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// S4Controller lets operators inspect S4 storage, for debugging.
+type S4Controller struct {
+	App chainlink.Application
+}
+
+// Index lists the S4 rows of a namespace, without their payloads. Rows are not scoped by job, so the
+// rows of all the jobs storing data in the namespace are listed. If expirationFrom or expirationTo
+// (unix time in milliseconds) are given, only the rows expiring in [expirationFrom, expirationTo)
+// are listed, ordered by expiration.
+// Example:
+// "GET <application>/s4/functions?expirationFrom=1700000000000"
+func (sc *S4Controller) Index(c *gin.Context, size, page, offset int) {
+	fromParam, toParam := c.Query("expirationFrom"), c.Query("expirationTo")
+	from, to := int64(0), int64(math.MaxInt64)
+	var err error
+	if fromParam != "" {
+		if from, err = strconv.ParseInt(fromParam, 10, 64); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "bad expirationFrom"))
+			return
+		}
+	}
+	if toParam != "" {
+		if to, err = strconv.ParseInt(toParam, 10, 64); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "bad expirationTo"))
+			return
+		}
+	}
+
+	orm := s4.NewPostgresORM(sc.App.GetDB(), s4.SharedTableName, c.Param("namespace"))
+	var rows []*s4.SnapshotRow
+	var count int
+	if fromParam == "" && toParam == "" {
+		rows, count, err = orm.ListAll(c.Request.Context(), offset, size)
+	} else {
+		rows, count, err = orm.ListByExpirationRange(c.Request.Context(), from, to, offset, size)
+	}
+	paginatedResponse(c, "s4Rows", size, page, presenters.NewS4RowResources(rows), count, err)
+}
+
+// Usage returns the S4 storage used by an address in the namespace of a job, and the limits of the job.
+// Example:
+// "GET <application>/jobs/:ID/s4/usage/:address"
+func (sc *S4Controller) Usage(c *gin.Context) {
+	address := c.Param("address")
+	if !common.IsHexAddress(address) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("bad address %s", address))
+		return
+	}
+
+	storage, ok := sc.storage(c)
+	if !ok {
+		return
+	}
+
+	usage, err := storage.Usage(c.Request.Context(), common.HexToAddress(address))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewS4UsageResource(*usage), "s4Usages")
+}
+
+// storage returns the S4 storage of the job, or writes an error response.
+func (sc *S4Controller) storage(c *gin.Context) (s4.Storage, bool) {
+	jobID, err := strconv.ParseInt(c.Param("ID"), 10, 32)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("bad job ID"))
+		return nil, false
+	}
+
+	jb, err := sc.App.JobORM().FindJob(c.Request.Context(), int32(jobID))
+	if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		return nil, false
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return nil, false
+	}
+
+	storage, err := functions.NewJobS4Storage(sc.App.GetDB(), jb, sc.App.GetLogger())
+	if errors.Is(err, functions.ErrNoS4Storage) {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return nil, false
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return nil, false
+	}
+	return storage, true
+}
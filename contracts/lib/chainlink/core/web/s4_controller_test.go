@@ -0,0 +1,63 @@
+package web_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+func TestS4Controller_Errors(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+	client := app.NewHTTPClient(nil)
+
+	jb, err := webhook.ValidatedWebhookSpec(ctx, fmt.Sprintf(`
+type              = "webhook"
+schemaVersion     = 1
+externalJobID     = "%s"
+observationSource = """
+parse [type=jsonparse path="data,result" data="$(jobRun.requestBody)"];
+"""
+`, uuid.New()), app.GetExternalInitiatorManager())
+	require.NoError(t, err)
+	require.NoError(t, app.AddJobV2(ctx, &jb))
+
+	for _, tc := range []struct {
+		name   string
+		path   string
+		status int
+	}{
+		{"list namespace", "/v2/s4/functions?expirationFrom=0", http.StatusOK},
+		{"bad expiration", "/v2/s4/functions?expirationFrom=soon", http.StatusUnprocessableEntity},
+		{"bad job ID", fmt.Sprintf("/v2/jobs/abc/s4/usage/%s", testutils.NewAddress().Hex()), http.StatusUnprocessableEntity},
+		{"missing job", fmt.Sprintf("/v2/jobs/12345/s4/usage/%s", testutils.NewAddress().Hex()), http.StatusNotFound},
+		{"bad address", fmt.Sprintf("/v2/jobs/%d/s4/usage/0x123", jb.ID), http.StatusUnprocessableEntity},
+		{"usage of job without S4", fmt.Sprintf("/v2/jobs/%d/s4/usage/%s", jb.ID, testutils.NewAddress().Hex()), http.StatusBadRequest},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, cleanup := client.Get(tc.path)
+			t.Cleanup(cleanup)
+			cltest.AssertServerResponse(t, resp, tc.status)
+		})
+	}
+
+	t.Run("view role is forbidden", func(t *testing.T) {
+		viewClient := app.NewHTTPClient(&cltest.User{Role: sessions.UserRoleView})
+		for _, path := range []string{"/v2/s4/functions", fmt.Sprintf("/v2/jobs/%d/s4/usage/%s", jb.ID, testutils.NewAddress().Hex())} {
+			resp, cleanup := viewClient.Get(path)
+			t.Cleanup(cleanup)
+			cltest.AssertServerResponse(t, resp, http.StatusForbidden)
+		}
+	})
+}
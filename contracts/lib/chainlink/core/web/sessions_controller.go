@@ -85,7 +85,7 @@ func (sc *SessionsController) Destroy(c *gin.Context) {
 		return
 	}
 
-	sc.App.GetAuditLogger().Audit(audit.AuthSessionDeleted, map[string]interface{}{"sessionID": sessionID})
+	sc.App.GetAuditLogger().Audit(audit.AuthSessionDeleted, audit.WithContext(c.Request.Context(), map[string]interface{}{"sessionID": sessionID}))
 	jsonAPIResponse(c, Session{Authenticated: false}, "session")
 }
 
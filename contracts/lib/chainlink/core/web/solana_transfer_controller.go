@@ -77,8 +77,8 @@ func (tc *SolanaTransfersController) Create(c *gin.Context) {
 	resource.From = tr.From.String()
 	resource.To = tr.To.String()
 
-	tc.App.GetAuditLogger().Audit(audit.SolanaTransactionCreated, map[string]interface{}{
+	tc.App.GetAuditLogger().Audit(audit.SolanaTransactionCreated, audit.WithContext(c.Request.Context(), map[string]interface{}{
 		"solanaTransactionResource": resource,
-	})
+	}))
 	jsonAPIResponse(c, resource, "solana_tx")
 }
@@ -102,6 +102,11 @@ func (u *UserController) Create(c *gin.Context) {
 		return
 	}
 
+	u.App.GetAuditLogger().Audit(audit.UserCreated, audit.WithContext(ctx, map[string]interface{}{
+		"user":     user.Email,
+		"userRole": user.Role,
+	}))
+
 	jsonAPIResponse(c, presenters.NewUserResource(user), "user")
 }
 
@@ -145,6 +150,12 @@ func (u *UserController) UpdateRole(c *gin.Context) {
 		return
 	}
 
+	// the previous role is only recorded in the audit log, so a failed lookup is left to UpdateRole to report
+	var oldRole clsession.UserRole
+	if before, ferr := u.App.AuthenticationProvider().FindUser(ctx, request.Email); ferr == nil {
+		oldRole = before.Role
+	}
+
 	user, err := u.App.AuthenticationProvider().UpdateRole(ctx, request.Email, request.NewRole)
 	if err != nil {
 		if errors.Is(err, clsession.ErrNotSupported) {
@@ -155,6 +166,11 @@ func (u *UserController) UpdateRole(c *gin.Context) {
 		return
 	}
 
+	u.App.GetAuditLogger().Audit(audit.UserRoleUpdated, audit.WithContext(ctx, map[string]interface{}{
+		"user": user.Email,
+		"diff": map[string]audit.Change{"role": {Before: oldRole, After: user.Role}},
+	}))
+
 	jsonAPIResponse(c, presenters.NewUserResource(user), "user")
 }
 
@@ -195,6 +211,11 @@ func (u *UserController) Delete(c *gin.Context) {
 		return
 	}
 
+	u.App.GetAuditLogger().Audit(audit.UserDeleted, audit.WithContext(ctx, map[string]interface{}{
+		"user":     user.Email,
+		"userRole": user.Role,
+	}))
+
 	jsonAPIResponse(c, presenters.NewUserResource(user), "user")
 }
 
@@ -223,7 +244,7 @@ func (u *UserController) UpdatePassword(c *gin.Context) {
 		return
 	}
 	if !utils.CheckPasswordHash(request.OldPassword, user.HashedPassword) {
-		u.App.GetAuditLogger().Audit(audit.PasswordResetAttemptFailedMismatch, map[string]interface{}{"user": user.Email})
+		u.App.GetAuditLogger().Audit(audit.PasswordResetAttemptFailedMismatch, audit.WithContext(c.Request.Context(), map[string]interface{}{"user": user.Email}))
 		jsonAPIError(c, http.StatusConflict, errors.New("old password does not match"))
 		return
 	}
@@ -236,7 +257,7 @@ func (u *UserController) UpdatePassword(c *gin.Context) {
 		return
 	}
 
-	u.App.GetAuditLogger().Audit(audit.PasswordResetSuccess, map[string]interface{}{"user": user.Email})
+	u.App.GetAuditLogger().Audit(audit.PasswordResetSuccess, audit.WithContext(c.Request.Context(), map[string]interface{}{"user": user.Email}))
 	jsonAPIResponse(c, presenters.NewUserResource(user), "user")
 }
 
@@ -267,7 +288,7 @@ func (u *UserController) NewAPIToken(c *gin.Context) {
 	// In order to create an API token, login validation with provided password must succeed
 	err = u.App.AuthenticationProvider().TestPassword(ctx, sessionUser.Email, request.Password)
 	if err != nil {
-		u.App.GetAuditLogger().Audit(audit.APITokenCreateAttemptPasswordMismatch, map[string]interface{}{"user": user.Email})
+		u.App.GetAuditLogger().Audit(audit.APITokenCreateAttemptPasswordMismatch, audit.WithContext(c.Request.Context(), map[string]interface{}{"user": user.Email}))
 		jsonAPIError(c, http.StatusUnauthorized, errors.New("incorrect password"))
 		return
 	}
@@ -281,7 +302,7 @@ func (u *UserController) NewAPIToken(c *gin.Context) {
 		return
 	}
 
-	u.App.GetAuditLogger().Audit(audit.APITokenCreated, map[string]interface{}{"user": user.Email})
+	u.App.GetAuditLogger().Audit(audit.APITokenCreated, audit.WithContext(c.Request.Context(), map[string]interface{}{"user": user.Email}))
 	jsonAPIResponseWithStatus(c, newToken, "auth_token", http.StatusCreated)
 }
 
@@ -311,7 +332,7 @@ func (u *UserController) DeleteAPIToken(c *gin.Context) {
 	}
 	err = u.App.AuthenticationProvider().TestPassword(ctx, sessionUser.Email, request.Password)
 	if err != nil {
-		u.App.GetAuditLogger().Audit(audit.APITokenDeleteAttemptPasswordMismatch, map[string]interface{}{"user": user.Email})
+		u.App.GetAuditLogger().Audit(audit.APITokenDeleteAttemptPasswordMismatch, audit.WithContext(c.Request.Context(), map[string]interface{}{"user": user.Email}))
 		jsonAPIError(c, http.StatusUnauthorized, errors.New("incorrect password"))
 		return
 	}
@@ -324,7 +345,7 @@ func (u *UserController) DeleteAPIToken(c *gin.Context) {
 		return
 	}
 	{
-		u.App.GetAuditLogger().Audit(audit.APITokenDeleted, map[string]interface{}{"user": user.Email})
+		u.App.GetAuditLogger().Audit(audit.APITokenDeleted, audit.WithContext(c.Request.Context(), map[string]interface{}{"user": user.Email}))
 		jsonAPIResponseWithStatus(c, nil, "auth_token", http.StatusNoContent)
 	}
 }
@@ -39,12 +39,12 @@ func (vrfkc *VRFKeysController) Create(c *gin.Context) {
 		return
 	}
 
-	vrfkc.App.GetAuditLogger().Audit(audit.KeyCreated, map[string]interface{}{
+	vrfkc.App.GetAuditLogger().Audit(audit.KeyCreated, audit.WithContext(c.Request.Context(), map[string]interface{}{
 		"type":                "vrf",
 		"id":                  pk.ID(),
 		"vrfPublicKey":        pk.PublicKey,
 		"vrfPublicKeyAddress": pk.PublicKey.Address(),
-	})
+	}))
 
 	jsonAPIResponse(c, presenters.NewVRFKeyResource(pk, vrfkc.App.GetLogger()), "vrfKey")
 }
@@ -67,10 +67,10 @@ func (vrfkc *VRFKeysController) Delete(c *gin.Context) {
 		return
 	}
 
-	vrfkc.App.GetAuditLogger().Audit(audit.KeyDeleted, map[string]interface{}{
+	vrfkc.App.GetAuditLogger().Audit(audit.KeyDeleted, audit.WithContext(c.Request.Context(), map[string]interface{}{
 		"type": "vrf",
 		"id":   keyID,
-	})
+	}))
 
 	jsonAPIResponse(c, presenters.NewVRFKeyResource(key, vrfkc.App.GetLogger()), "vrfKey")
 }
@@ -94,12 +94,12 @@ func (vrfkc *VRFKeysController) Import(c *gin.Context) {
 		return
 	}
 
-	vrfkc.App.GetAuditLogger().Audit(audit.KeyImported, map[string]interface{}{
+	vrfkc.App.GetAuditLogger().Audit(audit.KeyImported, audit.WithContext(c.Request.Context(), map[string]interface{}{
 		"type":                "vrf",
 		"id":                  key.ID(),
 		"vrfPublicKey":        key.PublicKey,
 		"vrfPublicKeyAddress": key.PublicKey.Address(),
-	})
+	}))
 
 	jsonAPIResponse(c, presenters.NewVRFKeyResource(key, vrfkc.App.GetLogger()), "vrfKey")
 }
@@ -119,10 +119,10 @@ func (vrfkc *VRFKeysController) Export(c *gin.Context) {
 		return
 	}
 
-	vrfkc.App.GetAuditLogger().Audit(audit.KeyExported, map[string]interface{}{
+	vrfkc.App.GetAuditLogger().Audit(audit.KeyExported, audit.WithContext(c.Request.Context(), map[string]interface{}{
 		"type": "vrf",
 		"id":   keyID,
-	})
+	}))
 
 	c.Data(http.StatusOK, MediaType, bytes)
 }
@@ -0,0 +1,102 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// WebhookTriggerTokensController manages the trigger tokens of webhook jobs.
+type WebhookTriggerTokensController struct {
+	App chainlink.Application
+}
+
+// Index lists the active trigger tokens of a job.
+// Example:
+// "GET <application>/jobs/:ID/webhook_tokens"
+func (wtc *WebhookTriggerTokensController) Index(c *gin.Context) {
+	jobID, err := strconv.ParseInt(c.Param("ID"), 10, 32)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("bad job ID"))
+		return
+	}
+
+	tokens, err := wtc.App.GetWebhookTriggerTokenManager().TriggerTokens(c.Request.Context(), int32(jobID))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewWebhookTriggerTokenResources(tokens), "webhookTriggerTokens")
+}
+
+// Create creates a trigger token for a webhook job. The secret of the token is
+// only returned in this response.
+// Example:
+// "POST <application>/jobs/:ID/webhook_tokens"
+func (wtc *WebhookTriggerTokensController) Create(c *gin.Context) {
+	jobID, err := strconv.ParseInt(c.Param("ID"), 10, 32)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("bad job ID"))
+		return
+	}
+
+	var req webhook.TriggerTokenRequest
+	if c.Request.ContentLength != 0 {
+		if err = c.ShouldBindJSON(&req); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+	}
+	if err = webhook.ValidateAllowedIPs(req.AllowedIPs); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	token, err := wtc.App.GetWebhookTriggerTokenManager().CreateTriggerToken(c.Request.Context(), int32(jobID), req.AllowedIPs, req.RateLimit)
+	if errors.Is(err, webhook.ErrJobNotExists) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Webhook job not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	wtc.App.GetAuditLogger().Audit(audit.WebhookTriggerTokenCreated, audit.WithContext(c.Request.Context(), map[string]interface{}{
+		"jobID":      jobID,
+		"accessKey":  token.AccessKey,
+		"allowedIPs": req.AllowedIPs,
+		"rateLimit":  req.RateLimit,
+	}))
+
+	res := presenters.NewWebhookTriggerTokenResource(*token)
+	res.Secret = token.Secret
+	jsonAPIResponseWithStatus(c, res, "webhookTriggerToken", http.StatusCreated)
+}
+
+// Destroy revokes a trigger token.
+// Example:
+// "DELETE <application>/webhook_tokens/:AccessKey"
+func (wtc *WebhookTriggerTokensController) Destroy(c *gin.Context) {
+	accessKey := c.Param("AccessKey")
+	err := wtc.App.GetWebhookTriggerTokenManager().RevokeTriggerToken(c.Request.Context(), accessKey)
+	if errors.Is(err, webhook.ErrTriggerTokenNotFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	wtc.App.GetAuditLogger().Audit(audit.WebhookTriggerTokenRevoked, audit.WithContext(c.Request.Context(), map[string]interface{}{"accessKey": accessKey}))
+	jsonAPIResponseWithStatus(c, nil, "webhookTriggerToken", http.StatusNoContent)
+}
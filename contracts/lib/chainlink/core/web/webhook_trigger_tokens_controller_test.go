@@ -0,0 +1,112 @@
+package web_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/static"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestWebhookTriggerTokensController(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+	client := app.NewHTTPClient(nil)
+
+	externalJobID := uuid.New()
+	jb, err := webhook.ValidatedWebhookSpec(ctx, fmt.Sprintf(`
+type              = "webhook"
+schemaVersion     = 1
+externalJobID     = "%s"
+observationSource = """
+parse [type=jsonparse path="data,result" data="$(jobRun.requestBody)"];
+"""
+`, externalJobID), app.GetExternalInitiatorManager())
+	require.NoError(t, err)
+	require.NoError(t, app.AddJobV2(ctx, &jb))
+
+	// Create
+	body := bytes.NewBufferString(`{"allowedIPs":["127.0.0.1"],"rateLimit":60}`)
+	response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%d/webhook_tokens", jb.ID), body)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusCreated)
+
+	var token presenters.WebhookTriggerTokenResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &token))
+	assert.Equal(t, jb.ID, token.JobID)
+	assert.Equal(t, externalJobID, token.ExternalJobID)
+	assert.NotEmpty(t, token.Secret)
+	assert.Equal(t, []string{"127.0.0.1"}, token.AllowedIPs)
+
+	response, cleanup = client.Post(fmt.Sprintf("/v2/jobs/%d/webhook_tokens", jb.ID), bytes.NewBufferString(`{"allowedIPs":["nope"]}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+
+	response, cleanup = client.Post("/v2/jobs/12345/webhook_tokens", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+
+	// Index never shows the secret
+	response, cleanup = client.Get(fmt.Sprintf("/v2/jobs/%d/webhook_tokens", jb.ID))
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	var tokens []presenters.WebhookTriggerTokenResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &tokens))
+	require.Len(t, tokens, 1)
+	assert.Equal(t, token.AccessKey, tokens[0].AccessKey)
+	assert.Empty(t, tokens[0].Secret)
+
+	viewClient := app.NewHTTPClient(&cltest.User{Role: sessions.UserRoleView})
+	response, cleanup = viewClient.Get(fmt.Sprintf("/v2/jobs/%d/webhook_tokens", jb.ID))
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusForbidden)
+
+	// Run with signed requests
+	run := func(path, runBody, signature string, timestamp int64) *http.Response {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, app.Server.URL+path, bytes.NewBufferString(runBody))
+		require.NoError(t, err)
+		req.Header.Set(static.WebhookAccessKeyHeader, token.AccessKey)
+		req.Header.Set(static.WebhookTimestampHeader, strconv.FormatInt(timestamp, 10))
+		req.Header.Set(static.WebhookSignatureHeader, signature)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+	now := time.Now().Unix()
+	runBody := `{"data":{"result":"123.45"}}`
+	signature := webhook.Sign(token.Secret, now, []byte(runBody))
+
+	cltest.AssertServerResponse(t, run("/v2/jobs/"+externalJobID.String()+"/runs", runBody, signature, now), http.StatusOK)
+	cltest.AssertServerResponse(t, run("/v2/jobs/"+externalJobID.String()+"/runs", runBody, signature, now), http.StatusUnauthorized)
+	cltest.AssertServerResponse(t, run("/v2/jobs/"+externalJobID.String()+"/runs", `{"data":{"result":"1"}}`, signature, now), http.StatusUnauthorized)
+	cltest.AssertServerResponse(t, run(fmt.Sprintf("/v2/jobs/%d/runs", jb.ID), runBody, webhook.Sign(token.Secret, now+1, []byte(runBody)), now+1), http.StatusUnauthorized)
+
+	// Revoke
+	response, cleanup = client.Delete("/v2/webhook_tokens/" + token.AccessKey)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusNoContent)
+
+	response, cleanup = client.Delete("/v2/webhook_tokens/" + token.AccessKey)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+
+	now = time.Now().Unix() + 2
+	cltest.AssertServerResponse(t, run("/v2/jobs/"+externalJobID.String()+"/runs", runBody, webhook.Sign(token.Secret, now, []byte(runBody)), now), http.StatusUnauthorized)
+}